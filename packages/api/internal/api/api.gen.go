@@ -32,6 +32,9 @@ type ServerInterface interface {
 	// (POST /sandboxes)
 	PostSandboxes(c *gin.Context)
 
+	// (GET /sandboxes/events)
+	GetSandboxesEvents(c *gin.Context, params GetSandboxesEventsParams)
+
 	// (GET /sandboxes/metrics)
 	GetSandboxesMetrics(c *gin.Context, params GetSandboxesMetricsParams)
 
@@ -41,6 +44,9 @@ type ServerInterface interface {
 	// (GET /sandboxes/{sandboxID})
 	GetSandboxesSandboxID(c *gin.Context, sandboxID SandboxID)
 
+	// (POST /sandboxes/{sandboxID}/fork)
+	PostSandboxesSandboxIDFork(c *gin.Context, sandboxID SandboxID)
+
 	// (GET /sandboxes/{sandboxID}/logs)
 	GetSandboxesSandboxIDLogs(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDLogsParams)
 
@@ -56,12 +62,51 @@ type ServerInterface interface {
 	// (POST /sandboxes/{sandboxID}/resume)
 	PostSandboxesSandboxIDResume(c *gin.Context, sandboxID SandboxID)
 
+	// (GET /sandboxes/{sandboxID}/snapshots)
+	GetSandboxesSandboxIDSnapshots(c *gin.Context, sandboxID SandboxID)
+
+	// (POST /sandboxes/{sandboxID}/snapshots)
+	PostSandboxesSandboxIDSnapshots(c *gin.Context, sandboxID SandboxID)
+
 	// (POST /sandboxes/{sandboxID}/timeout)
 	PostSandboxesSandboxIDTimeout(c *gin.Context, sandboxID SandboxID)
 
+	// (DELETE /snapshots/{snapshotID})
+	DeleteSnapshotsSnapshotID(c *gin.Context, snapshotID SnapshotID)
+
+	// (POST /snapshots/{snapshotID}/restore)
+	PostSnapshotsSnapshotIDRestore(c *gin.Context, snapshotID SnapshotID)
+
 	// (GET /teams)
 	GetTeams(c *gin.Context)
 
+	// (GET /teams/{teamID}/api-keys)
+	GetTeamsTeamIDApiKeys(c *gin.Context, teamID TeamID)
+
+	// (POST /teams/{teamID}/api-keys)
+	PostTeamsTeamIDApiKeys(c *gin.Context, teamID TeamID)
+
+	// (DELETE /teams/{teamID}/api-keys/{apiKeyID})
+	DeleteTeamsTeamIDApiKeysApiKeyID(c *gin.Context, teamID TeamID, apiKeyID ApiKeyID)
+
+	// (POST /teams/{teamID}/api-keys/{apiKeyID}/rotate)
+	PostTeamsTeamIDApiKeysApiKeyIDRotate(c *gin.Context, teamID TeamID, apiKeyID ApiKeyID)
+
+	// (GET /teams/{teamID}/usage)
+	GetTeamsTeamIDUsage(c *gin.Context, teamID TeamID)
+
+	// (GET /teams/{teamID}/webhooks)
+	GetTeamsTeamIDWebhooks(c *gin.Context, teamID TeamID)
+
+	// (POST /teams/{teamID}/webhooks)
+	PostTeamsTeamIDWebhooks(c *gin.Context, teamID TeamID)
+
+	// (DELETE /teams/{teamID}/webhooks/{webhookID})
+	DeleteTeamsTeamIDWebhooksWebhookID(c *gin.Context, teamID TeamID, webhookID WebhookID)
+
+	// (GET /teams/{teamID}/webhooks/{webhookID}/deliveries)
+	GetTeamsTeamIDWebhooksWebhookIDDeliveries(c *gin.Context, teamID TeamID, webhookID WebhookID, params GetTeamsTeamIDWebhooksWebhookIDDeliveriesParams)
+
 	// (GET /templates)
 	GetTemplates(c *gin.Context, params GetTemplatesParams)
 
@@ -178,7 +223,7 @@ func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 
 	var err error
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:read"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -195,6 +240,54 @@ func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "templateID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "templateID", c.Request.URL.Query(), &params.TemplateID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nextToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", c.Request.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nextToken: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -208,7 +301,7 @@ func (siw *ServerInterfaceWrapper) GetSandboxes(c *gin.Context) {
 // PostSandboxes operation middleware
 func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:create"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -224,12 +317,44 @@ func (siw *ServerInterfaceWrapper) PostSandboxes(c *gin.Context) {
 	siw.Handler.PostSandboxes(c)
 }
 
+// GetSandboxesEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesEvents(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:read"})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSandboxesEventsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesEvents(c, params)
+}
+
 // GetSandboxesMetrics operation middleware
 func (siw *ServerInterfaceWrapper) GetSandboxesMetrics(c *gin.Context) {
 
 	var err error
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:read"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetSandboxesMetricsParams
@@ -266,7 +391,7 @@ func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxID(c *gin.Context) {
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:write"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -296,7 +421,7 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:read"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -312,6 +437,36 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
 	siw.Handler.GetSandboxesSandboxID(c, sandboxID)
 }
 
+// PostSandboxesSandboxIDFork operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDFork(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:create"})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDFork(c, sandboxID)
+}
+
 // GetSandboxesSandboxIDLogs operation middleware
 func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 
@@ -326,7 +481,7 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:read"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -375,7 +530,7 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:read"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -405,7 +560,7 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:write"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -435,7 +590,7 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Contex
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:write"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -465,7 +620,7 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:create"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -481,6 +636,66 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context)
 	siw.Handler.PostSandboxesSandboxIDResume(c, sandboxID)
 }
 
+// GetSandboxesSandboxIDSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDSnapshots(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:read"})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSandboxesSandboxIDSnapshots(c, sandboxID)
+}
+
+// PostSandboxesSandboxIDSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDSnapshots(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:write"})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDSnapshots(c, sandboxID)
+}
+
 // PostSandboxesSandboxIDTimeout operation middleware
 func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context) {
 
@@ -495,7 +710,7 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context)
 		return
 	}
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:write"})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
@@ -511,6 +726,66 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context)
 	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
 }
 
+// DeleteSnapshotsSnapshotID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSnapshotsSnapshotID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotID" -------------
+	var snapshotID SnapshotID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotID", c.Param("snapshotID"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:write"})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSnapshotsSnapshotID(c, snapshotID)
+}
+
+// PostSnapshotsSnapshotIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostSnapshotsSnapshotIDRestore(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotID" -------------
+	var snapshotID SnapshotID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotID", c.Param("snapshotID"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"sandboxes:create"})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSnapshotsSnapshotIDRestore(c, snapshotID)
+}
+
 // GetTeams operation middleware
 func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
 
@@ -528,6 +803,305 @@ func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
 	siw.Handler.GetTeams(c)
 }
 
+// GetTeamsTeamIDApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDApiKeys(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDApiKeys(c, teamID)
+}
+
+// PostTeamsTeamIDApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamsTeamIDApiKeys(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamsTeamIDApiKeys(c, teamID)
+}
+
+// DeleteTeamsTeamIDApiKeysApiKeyID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeamsTeamIDApiKeysApiKeyID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID ApiKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTeamsTeamIDApiKeysApiKeyID(c, teamID, apiKeyID)
+}
+
+// PostTeamsTeamIDApiKeysApiKeyIDRotate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamsTeamIDApiKeysApiKeyIDRotate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID ApiKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyID", c.Param("apiKeyID"), &apiKeyID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter apiKeyID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamsTeamIDApiKeysApiKeyIDRotate(c, teamID, apiKeyID)
+}
+
+// GetTeamsTeamIDUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDUsage(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDUsage(c, teamID)
+}
+
+// GetTeamsTeamIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDWebhooks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDWebhooks(c, teamID)
+}
+
+// PostTeamsTeamIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamsTeamIDWebhooks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamsTeamIDWebhooks(c, teamID)
+}
+
+// DeleteTeamsTeamIDWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeamsTeamIDWebhooksWebhookID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTeamsTeamIDWebhooksWebhookID(c, teamID, webhookID)
+}
+
+// GetTeamsTeamIDWebhooksWebhookIDDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDWebhooksWebhookIDDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamsTeamIDWebhooksWebhookIDDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDWebhooksWebhookIDDeliveries(c, teamID, webhookID, params)
+}
+
 // GetTemplates operation middleware
 func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
 
@@ -777,16 +1351,31 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/nodes/:nodeID", wrapper.PostNodesNodeID)
 	router.GET(options.BaseURL+"/sandboxes", wrapper.GetSandboxes)
 	router.POST(options.BaseURL+"/sandboxes", wrapper.PostSandboxes)
+	router.GET(options.BaseURL+"/sandboxes/events", wrapper.GetSandboxesEvents)
 	router.GET(options.BaseURL+"/sandboxes/metrics", wrapper.GetSandboxesMetrics)
 	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID", wrapper.DeleteSandboxesSandboxID)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID", wrapper.GetSandboxesSandboxID)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/fork", wrapper.PostSandboxesSandboxIDFork)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/logs", wrapper.GetSandboxesSandboxIDLogs)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/metrics", wrapper.GetSandboxesSandboxIDMetrics)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/pause", wrapper.PostSandboxesSandboxIDPause)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/refreshes", wrapper.PostSandboxesSandboxIDRefreshes)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/resume", wrapper.PostSandboxesSandboxIDResume)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/snapshots", wrapper.GetSandboxesSandboxIDSnapshots)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/snapshots", wrapper.PostSandboxesSandboxIDSnapshots)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/timeout", wrapper.PostSandboxesSandboxIDTimeout)
+	router.DELETE(options.BaseURL+"/snapshots/:snapshotID", wrapper.DeleteSnapshotsSnapshotID)
+	router.POST(options.BaseURL+"/snapshots/:snapshotID/restore", wrapper.PostSnapshotsSnapshotIDRestore)
 	router.GET(options.BaseURL+"/teams", wrapper.GetTeams)
+	router.GET(options.BaseURL+"/teams/:teamID/api-keys", wrapper.GetTeamsTeamIDApiKeys)
+	router.POST(options.BaseURL+"/teams/:teamID/api-keys", wrapper.PostTeamsTeamIDApiKeys)
+	router.DELETE(options.BaseURL+"/teams/:teamID/api-keys/:apiKeyID", wrapper.DeleteTeamsTeamIDApiKeysApiKeyID)
+	router.POST(options.BaseURL+"/teams/:teamID/api-keys/:apiKeyID/rotate", wrapper.PostTeamsTeamIDApiKeysApiKeyIDRotate)
+	router.GET(options.BaseURL+"/teams/:teamID/usage", wrapper.GetTeamsTeamIDUsage)
+	router.GET(options.BaseURL+"/teams/:teamID/webhooks", wrapper.GetTeamsTeamIDWebhooks)
+	router.POST(options.BaseURL+"/teams/:teamID/webhooks", wrapper.PostTeamsTeamIDWebhooks)
+	router.DELETE(options.BaseURL+"/teams/:teamID/webhooks/:webhookID", wrapper.DeleteTeamsTeamIDWebhooksWebhookID)
+	router.GET(options.BaseURL+"/teams/:teamID/webhooks/:webhookID/deliveries", wrapper.GetTeamsTeamIDWebhooksWebhookIDDeliveries)
 	router.GET(options.BaseURL+"/templates", wrapper.GetTemplates)
 	router.POST(options.BaseURL+"/templates", wrapper.PostTemplates)
 	router.DELETE(options.BaseURL+"/templates/:templateID", wrapper.DeleteTemplatesTemplateID)
@@ -18,63 +18,103 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcbXPbNvL/Khj+/y/uZhRJcdNO65m+sJO0l2mS+mKnvZlUcwORKxENCbAAaEfj0Xe/",
-	"wRMJkqBEyZJjN3kVhwQXi93fPmCx0G0Us7xgFKgU0eltVGCOc5DA9f/mJcmSVy/Un4RGp1GBZRqNIopz",
-	"iE6rt6OIw18l4ZBEp5KXMIpEnEKO1WdyVaihQnJCl9F6PYooS6CXpH25G0WBaTJnn3qJ1u93oyshLzIs",
-	"+7n1BuxCea0Gi4JRAVrKz6ZT9U/MqAQq1Z+4KDISY0kYnfwpGFXPanr/z2ERnUb/N6lVNzFvxeQl54yb",
-	"ORIQMSeFIhKdRuc4QYpFEDJaj6Jn06fHn/OslClQaakiMOPU5M+OP/lbJtGClTQxM/5w/BmfM7rISKzl",
-	"++196PQS+DVwJ9e1w5wG1fOL989ZaaZusXnxHsWMg0ALxpFMAVkDiUbRgvEcy+g0IlR+cxKNohx/InmZ",
-	"R6ffj6KcUPP305HDNKESlqCV+pJe/4aN28BJQtRkOLvgrAAuiQF6k4+X9JpwRnOgEl1jTvA8C/LUNUwj",
-	"EOWtGuRjlkBgGjUY6XeB9XXXkYMQeNlHKMhPbfofIjuRozJbj6I3kDO+enPeJWnetNeMCEVvzjdr4+kP",
-	"J75CTr4PLeUt3FxaMXaEhUvJLnAp7EIXuMxkdLrAmYCAGbMcKzPOshUq1EeiwS9eSDArkCQHVspaSnPG",
-	"MsBUcQM1QDai3Q7TqpA4wXKrgdhFvnHDO767uZ5XiXJKCwIcsYVm2ykQuc+6ah5Fbm2+vJ5+2xbWFckB",
-	"SYYycg0hxQqIGU3EOKhep89pV5stmHnrUxB7a6Hf0nGWsRhLSJ5fvO+K4W2Zz40IqnGo8g3DbKX60EKc",
-	"BDB+lis31JwmN7hXOCfnw6aKOWAJP2GSiU1LcZI2w9FCj/cmKAmV3z0LzlAnJtvgQo2NdxBiJ+/xux0m",
-	"QSBeUkroEjHqEx4gDkviUmIuCV1un9IORJdu7tY84VkkluVWi1XguzQj2zCt8jlLqSWjUROiQUA1Vd+z",
-	"cmcDL0BikgVCA45TSM5VwhqAz2siNEDNKKTzWoFI0lILkZCLQEJXSQ5zjlePDKywQR7bcFoJZBM63plP",
-	"XSAKSOt4KNMob+i+qRsHm8uKg1aCpZ+3JAlUuegPEQecrKJRlHBM1AoVbUYpxNL8p6Qp4Eymq2gWEH89",
-	"7fMU02XAe99ZLJaAWuQ7EGUOyQPKBj5zNFUyaQIzED0JDmDiTD12kNiUL8QZASqHGakZG6RSlJVz34SD",
-	"Kt/XmVZyFogGWpg3KdCGFG9IliH4VBDeCD0JlvBEKSnEVO5ltJuYqjLfuyVzjQ3+NlH2bhy0e+ASdpEN",
-	"Fsh+NFg2u2WebjRacJajm5TEKSJNezIOK9m682hUI/yaRwVEXwIesjx9OuzMOvbxO5HpG5CcxOKrqTxc",
-	"U8lrFQ2KzjUJTuJgcP5qe5/B9h54UAJ6nfwGXBBTxmoSsi8cFTW2yh8J3YqTA+HtQUPBl5+n7tdsGUjG",
-	"2RIBlXyFbohMdU4lJM4LhGmCMkKVmpsY0Q+DdNQb5GqSPSUOTTxspGZeK7LM8TXQOttiqqYaGYabcggE",
-	"mcw+7SxLdOGwi+9TUu84vha3em6Pwzeehx5W7XRfbIVuYxLllEOkOIl3BIUfHPv2oztWf+KifC8guYh7",
-	"isylwEtABfAYqMTLRsxcZAx7EKSaBxsvr5jEWbCWpN9srB71bJdzyBWrQaK2CFsKSHaiuYux5J7K7m4v",
-	"XvTwdNBYZVOQCrlXgPNAPCnIL7AKBJSLV+gj1KVpqb4OeAwiXrjdW5vE7ynIFOrPnUO1270WSW9raE7X",
-	"OjDFOdTuOsyNej7U4YcodFy5Jmc5Gjlh+at2kn0vIHAiAbmtRrVOEtRjx0mpvgxJNhmyDvt1XTIqyfYQ",
-	"pYcY3gz/Nl8IZxvQl29AKOMYXiTTZbatPknjvhmjVWaoPpbD3JR3iL1NmhkWEokyjkGIRZmZSqC2gSW5",
-	"Vvnppsxqj92HzSm2J8WNtdeZyLCs2I4/X9kzgV8X0emHzUxWkF7PRhEtswzPMzAn2+tRpMR0WeAbujPr",
-	"WsDK0x51/1SU8ywUOJseybJFBDLjEeOI0WyFsNY/mWeA5quAt/BclVBS2BfDbTlsCDV7JbMhcZZFsgfi",
-	"jNrMp3uGLz8rrhtHwvsgqz/fPnzOfUS3wdhQScPH+J5OF4O77m4HT6GHhgRcZ6k2LH6YdVoxtFfRA3fx",
-	"l2JQkdpTvitUa15NTdrVrE3LwOxg+6Z99V8V7KsEu6Gid7Zh5fDb4D2cdcLij8AXJAskJy+qd17G1D/9",
-	"Pk5Nlw+e50kQAFyimOW5yv4lQ/AJ4lK5tpYp15X5XvgeOIPyZOYr97225V7t3pf/1scAAuKSE7m6VDI3",
-	"859pAlfsI9CzUqbaNQDmwH9yjs9M8V+phkS270aT1sPqqVIpCyXWsyQntEFQt5OlgBM93DaU/eeJHvjk",
-	"ytJ1LsDknYqO/msbjYtXT0ye2vn+sizwHAt4OoQXN7ifHTfiROULg6k1lOGIKVUQumDaFRKpjCx6eXKO",
-	"zi5eRaPo2hWboun46Xiq5mYFUFyQ6DT6ZjwdT1XYwDLV+puYgzf15xICke5f+jWKU4g/RpoS131Zr5Lo",
-	"NPoZpHkftdr0TkxLV5OUxbCpVlaJo9dhFzLviuxEDTIwnFCWmHmCLOsjWZxlyAwLMP3WvgjxPLgNrYpG",
-	"w9JE3XaynnWrJ91WtUo22QpxkCWnkHgL2klgVXvd5rFqkG/hejltS/wwUymuxCpqf4iwehvNaoVMbs3h",
-	"8rpXMz+D1GtAGr19innrjqj9Btse6dZDJvZkW7F4J71uU6LtmxisuOo4fEe92c7PbWOf3YeOR1HBRKh0",
-	"pc/jkajSKuxO/puqvWDicLrVXuScJauDqrXRYLDudh6fGHW09gFWt04CesupSSSei8tWj1n3yr4bDTCb",
-	"na47vvDbSjp27ndWtZDQylLRXyW4iqNkaEEyl5fVHTf/gPFyjP6ISgH8RzyP/yin05PvcFH8WHCW/BH9",
-	"c4z+ramonA9wnOpynfrPNc5KECgvhURzQO/fvUZAY5ZAMlb7DcWBnr8Ozu6//S3rs/uNK+2eobtFmK72",
-	"NBqnQ9A4vcfI5OV2H2br0W0wW1PbyUDa1YR5vdINbs40neHqgEsffbV2Ll2P56P8KG6r7lleN3cTtvjU",
-	"wuHhrjE0pu26RP9c2tYfAu7wCwFVw39OvKaDHf2oOb5y329yqm+qMV996wF9q9/Wc2g321TuYzCPQWi/",
-	"rQ711wbqGchASegXkmW1d+1g+4X+rIL3pdcosFsaWbcYBJDUk975vuwjybLHkdkdKz72buvq2DhfIX1o",
-	"1u+fjqTAw23z2jnVLls9UTdvfzG46DX6iSvx9+LGocaW+AeA5rUZuTdwRsGasPLGMtC3I5BMsUQiZWWW",
-	"qDhWKZtQlJMsI7avuiem6VJ0I6Z1zrA2X2XqtGCYe22IVkdmm7js4SojOWlyVTeWT6fTXTvEj2mLfp/T",
-	"PoZokPXVGpU1bks+fYMckmhWNtmbcd6fPz9EC+8+8GrkbF88wgp3MSa8kdb3Zlo9fRv2zRW+zH2b+073",
-	"9GKa6Z7e2cSYGh+rLwodU/P2Lv62sT88MpRwWHAQqT3BDCLlnRnSMDX4JIEmujdaCh2t3X2ngTB6V817",
-	"VyjtV8xpHt0mpWE4cERu3+gDctNh7cuhDvMfoZAI6xtf9Q0v//L7N9+paL7lsrR9xOZ/QiwH175brtFI",
-	"9p5y4AeAYGX7m+Cr3u/h6cyHnwmfG/dEzWuRD7fgaN3yve3S/6Y+2rt0Gob4JUj/5mr7yukYXYWvg6FP",
-	"zlF5ZXRSNyxb8I7Rc5xleluWEqHSrJQlKC8zSYoMbJ8guwZ+w4m0LYNXV69HpgipCZbCfA4oLjkHKv3m",
-	"f3tjxe39CkbUe4ZywKLk0Fia89TjgUZ8VV3m/fxRpnF5uN3DqBZXB45aH768bCtUbxjqXvLb5zc6LJez",
-	"g0QjYaHpOHXUv7QcXQLOBxw1mGGBjd6VfXGfNXfd8nTH8rpZ0P1Vxts9cJvU2DhiV8+cqsxB4iB1uaFB",
-	"ldUvW84nVAiqrm34laC9mhln9w0Te/B6Z6g4eT18uNS8DjiqpnCz+XTaR8oxEsZgd/KgtPHk4Dz05Y3m",
-	"0orKGnEcQyF3381/Zhg0XMfktm4d33jeZg7UEO4HiBlRQeTKb0nfLafxutmHV2EaNyrMKu6W0H9+a8Uy",
-	"TruLNd3dGwxVfXYUNRzP4Jsd64MsfjoABvZSy2NoJDmmg38HxmlhOtC9Pw7QfI0SnyVKTMwPuE1u7T2j",
-	"9Yb9vr4649+IGQQ682Ni59U1pv0RONo62l2WCgSak7CHMapNvd9P+dtqdlJfius9/KscrpFLX5v+NjVf",
-	"uqtq96LszhH5K5rAp+rHI1yFZ+6uEvae6Jvfh2jd0Q6dnrOl+HWxENBzhP6gzs+b9zh3OuKsxPAw6yYH",
-	"sR9NlV87hJY8s1fRxOlkggsyhpP5OIHryKNw2/5Rc6FB2PwJ9eZDvdNfz9b/CwAA//8Gg3j/RF4AAA==",
+	"H4sIAAAAAAAC/+x9W3PbOLL/V0Hx/384p0qxFG9masdV+5DLzK7PJBkfy9nZqoxrCiJbFtYkwQVAOyqX",
+	"v/sp3EiABC+SLNlO8pRYBHHp/nWju9Fo3kUxzQqaQy54dHIXFZjhDAQw9RcuyK+wPn0n/0/y6CQqsFhF",
+	"kyjHGUQn9eNJxOA/JWGQRCeClTCJeLyCDMv3lpRlWEQnUVmSJJpEYl3Id7lgJL+K7u8n0aIkadI5iH3a",
+	"N0a7z5wm0NmlebhZjxznyYJ+6ey0fr5hvzku+IqK7o7rBrvRWQDOOkcxD3cdIStSLKBnlKrBZlS6hcWK",
+	"0uvOjuvnu6zgXr7MC5pzUPh/NZvJf2KaC8iFlogiJTEWhObTf3Oay9/q/v8/g2V0Ev2/aS1UU/2UT39m",
+	"jDI9RgI8ZqSQnUQn0RucIDll4CK6n0SvZi/3P+brUqwgF6ZXBLqdHPzV/gf/SAVa0jJP9Ig/7X/EtzRf",
+	"piRW9P3hEDydA7sBZul6bzGoQPX27NNbWuqhG9M8+4RiyoCjJWVIrAAZpRJNauiSXPzlOJpEGf5CsjKL",
+	"Tv46iTKS6/+/rDBNcgFXoJj6lgEWkFwAzl6fnf4Ka7XoNP1tGZ187l+g88795C4qGC2ACaKl4xrW7TVc",
+	"rAC9PjtF17CeICIQ4YiBKFkOCaJ5ukY0jwHdriBHom4qm8V6mogyxKiQ/w3qmFq6P6sZXFaN6OLfIHl8",
+	"6a/5d60aNlu0fam9ag4xgwDz5up3VHJIkKCIkyu9wgKvU4oTPoYaRos51BgkgZlOmAo/5zf/xGYrTxIi",
+	"Z4rTM285/iJ+zm8Io3kGuUA3mBG8SINobM9Ji8JJk1oxTSAwjGyM1LMAstsIzoBzfNXV0SCNzEC2F0mZ",
+	"Xyi7hmRuFtSaNi4FPcMlN0MucZmK6GSJUw4BVUozLFVpmq5RIV/iilpLNYQlGnCElwI0IQXJgJainviC",
+	"0hRwLtca17rBDPuypUDLbAEM0aXTuaAGMmjJaKbZZQwH2XBImfQqEDtdb1I/NGd1QTKQ00jJDVSQaRGB",
+	"5IhDTPOEH/VOZNaeiGTrB8goW39404aCftLEqhzvw5t+/fnyp2OXAsd/DdHgI9zuFS12vuNAArVg9+5P",
+	"ppkSIYETLAa3NLPID7Z5y6rz13OaQC7Ikmg4ymlbwUP2tbZ47oQoh7FbA8lXD876Lj1Oz40AtTmurc4m",
+	"MT7iDCphs+8GVl+Mw4qCVAc4Ktmut4oJomIF7JY0XroGKDhiZZ7L4dtoutdr9s0Df7nwpSAM+OvAtqd4",
+	"1NrOzQsuYxIs4IXke4giw/Q0PYde5jEtQpvZbwUwZdVxb24xztEC9Ea9pGyCcF49uyViRUuBdJdohTla",
+	"lmmKcBwDl8shArJBsatJOZf9RPfVpDFjeD0kUxfmGTp9J40hnBLcXoFR9rVilWp/cCdUdL6sGe7YRg2O",
+	"39iIQGPDVb83jRVeLmSjhTJ9Jt5TBjGQG7n7pSnSvSKyRDkViIMYS1EzTzX6xTpM0pKl7fn+4+LibI4+",
+	"nb9XkzLjYwaIQy6QoIMUk70qghlDpqH505TGUvjenn0K4Lfap6t2qLLxx1k+1Ytm4yOBne91Jk0Gf5hM",
+	"NVe7H3kzbiiNqF8wSXnfUqxasdaGau8MUJJc/PgqOEIdlBnaRHJtsbVFXQ/e4T+F7CKj9xDN3Y5HkMN0",
+	"MReYCZJfDQ9pGqK5HbsxTngUgUU5CH8Jvrlu2ZJoG8syPTVoNPEhGgSUz/qOlVsZeAcCkzRg6ON4Bckb",
+	"GawLwOc94QqguhVSMT2OSNJgS6UL2gZDQ9ifEVihhx5DOB2lHM/1q9Y8DVBrfyhTKPd47/PGwmZezaDh",
+	"NavfG5SEXBpunyMGOJE7fsIwMcZLTPMcYqH/KPMV4FSs3CBATf562LcrnF8FtPfOZDEdyEWeAy+zPXuU",
+	"m/kIj2xjS5r4wAzsngQHMPFa/mwh0edFxCmBXIwTUt022EtRVsq9DwdV3E75X8mwMWypeEvS1FjEk4rE",
+	"iq2VY1wxU79NBLrF3LQZbURnjm/ct5DKh97NLfQORIbI3xk6UiqFCdiEnpgj89Je6SmlG0YSZq7abuws",
+	"29Y6aHO7IvFK2tPuascGAr2jFfcwqpISl9QO7B3gWGBftoT3dyJWH0AwEvPvcszgqcpkVrNolOlQd8FI",
+	"HLQcnoCQjyb2VyN7T3zHhPwm+ScwTmje7sg8sL3ItpVxS/JBnDwQ3p40FFz6Oex+T68CngK9QpALpmNT",
+	"ak/jAmcFwnmCUpJDNGlgRP0Y7Ec+QfbgsyMqqzoPC6ke15AstfMaKZ1NMlVDTfSEfToENpnU/NpaFm/D",
+	"YRPd957qtXuKrzFbNbYzww+Ohh53sGbfGISuN4hUyqGuGIk3BIW7OXY5yxuGpuKi/MQhOYs7TrJLediG",
+	"CmAx5AJfeXvmMqXYgWCu5mD2ywsqcBoMdKknvaGtDl8+g0xONdipOTdS1uMmfW4iLJnDst3lxdk9HB54",
+	"q/QJ6SC3+0jDycIaUpeqqYGeexaxJKkPnnCajY0QjLEGbNfSHKhV8EMdKvQd0my2E5mORO2CBPv0cr3G",
+	"djpM0WZYoh7G34PqXLqaAy44rMPTjtFAW3FVUZrqZMn4V6FgjDxsCJgzKoEwYM+Ygw7r3An5dqBXwt/Z",
+	"yEazi99XIFZQv273cxMKaXTphE2GUdM1mzq/btjewMNnNVVGnprRxBLLXfWloWzXud1oOavOv7YQs20P",
+	"ByfejwkFrk6F9FP/jGjcREgyhvb1QeKgmkoxF1KjbkI9+Q7aLGyD+TUkvXKgVK08QFuUQltfcpR4hRmO",
+	"BTCOViRJIP9+sjr2ZHXUaaqc/7r2DRzZ9dHZL8YKWkaEa1ZXtG2q4uYKu2nfR3pHPVdrOmGAEzdyf6JH",
+	"9n66ZURApwY/B05LFsMnm43V9DwyIkKHHRkRdXqI7mLi/SW1c5mr9yHxqTvCFCuDMvq2ZAxyIR0eXmbq",
+	"1+YkxnTfPA9WO5yhRwcdYprHevC5ewQ0BGyfuvcTpx8L3Pp8bePOxkaigi+7R4L8DNgHkpcCtuvKGCdz",
+	"QRm+gi0ndDM22hd4u5Ud2OaWO4AXo+kgRA+vQiuu8QOBnEnIzAlrI/VC/mwRXMo3t94DzdubWZWqiZ6b",
+	"nX9n+show8OmiWxleOySo4Lroc3OpLvzE1Y0vbKHTFMZxyEzuTFWSjDvZYeMFzWK7LSicHuP0gjviE9C",
+	"V4QSQjHK8Wf+ynsZjGIoT9kbRIFLvizGBTY28ISVHcZLZcMsy9R4xtJtuSI3kFdTeKjzitFy5a19U8Ey",
+	"7d9seFFAabP7y0mUl2kqM8f1BRxjSM8LfJtvPPUtzOktTlyKcpGSeMiJNNMiHOn2iDKdxK9tWLJIAS3W",
+	"AQfP8S65pMK2GG7SoSc4tVX4O6hdigSLLdmmX90y4OXG0Z24RXBXNvxz5cOduYvoJhg9lng6xtV0ai/f",
+	"PWYWdDKruLaJZHy+bN0Qk+8i1XATfclH5dw4zLcug5qrDunYFBx9k+nywU5atuV/lX9UheQ9Fp2be3QP",
+	"f3C2hbJOaHwNTAZF2wO/q545Qa7u4bdRaurA8W2WBAHApF+UZfK8QFAEXyAupWpriHKdaNQJ3wcOejk0",
+	"c5n7SclyJ3cPpb/lbI2N9w5kxhQLBN2wkEOJ3rxEHYnUPaDqhQ3SdEcoZGW8KW0MGRGb7P9mZpsNUltB",
+	"6RpVPYweE+z9sdBNMdfakjmckFiidfoF4zBZzVMvZIy5DZVxv4UzQJINZjUqRmnvK3clWMoEeMQ9ja/I",
+	"aN/rcTi6MTgucbIhKR05lGpZlmUueR1l70iI74mEhxjY8Rzy2h2vgNxseArGkGjkKqgFd70Wc9tysi4q",
+	"4mopqcEmaIPmjWDdUW2x21+q3DX7A9MJp84v1yRNvR/q7FD7i86XjVcQX/9pVlfv4EdKyf+5JDnhq+C6",
+	"JeshLhkR67lkslZ4r5XgX9BryOW9cvnTAjAD9otFklawfwrZJDKXoZViVc1qiK2EKCR1XycZyb0O1ZX/",
+	"FeAEmA2nnkT/eqEavrgw/ZpezEGJ7Ef9b6iPs9MXv8I69P68LPACc3g5Zi62cfd0bItjFeQd25u3FdnO",
+	"JCtIvqSyB0FEKp/9fPxGxoNl8Mom50Szo5dHMzk2LSDHBYlOor8czY5m6rROrBT/phoV8r9XoZvN/1CP",
+	"kUJNpHrSAejTJDqJ/g5CP48atROOZ7N2V2YHb2wYTtmDkD6pup3KRvdq7dOcJsA7p6zy62UARzcLTPqj",
+	"eRCa8+jaAJUtPs5JlmPqi9mNbJN2/QB3M61ujNcL2ohgVc2D/raykSvhajlNSfx8KR18gaXP8jnC8ml0",
+	"WTNkeqdvCtx3cubvINQakEJvF2M+2vsGbj2aDurWTaZ6cBWD2ImvQ0w0l2BGM66627Ah30w5jqG2rw7B",
+	"40lUUB5K9VGXKxwTA6ulTtxsATAP7EUOjQDMAGXkimFRb4nqCmuH1J5R/nDoUHroDU3WDwoM777Jfbug",
+	"zPHsVZuCFwYdloYqZKe6SDyr+jmjR2oI7z5Ut9pu4cY6ZBPtrck/bUJp3U6CKSVcQmmxtlkeR+hUv65O",
+	"FtUpAAiNzBy+CFTIDDVzdGogAU5ik7JX6noK/3rxEb4Ivb3X9rPeuo9Cqsw9T2pAtRGGQP8pwSahCSoT",
+	"qaqL3tUK/wuOro7QH1HJgf0NL+I/ytns+EdcFH8rGE3+iP77CP2v6kU69YDjlToYln/c4LQEjrKSC7lS",
+	"eTYAeUwTSOS0lQGixq/tD/tnT0mmYJZQMxdTF6aQfIlUokiqbtGaW0+hgfWdi0loj93oFkYrFLZWJpL0",
+	"baL7SRB3FbjquduSNBUE3HOLwOz95N/xtPuFQJo0xlZHNpRpQHeMJ5+/8flUhRG9hPPKv/B+k/+GjPwW",
+	"aykTiDJtoYbmYZ+FpoF57ExA/yW7HzXyB12kA+Xhiid6g50ow8inXLX1+nfNA3NXqiEKVglrlQp5OZvN",
+	"+oultJeg1QVdNrROWKtoZWJbFwxuCC15pWw6liB7vXB9qyDqLg9r7DZvpW5v9npyEU2Ms6Sm4hIvlC8c",
+	"Ir0txGRgYW/cqqhIofOUu4l4r/bV2Zh9dXZAK93xc1sZP5cSkyFPVh40BFxSfwOvCd9jAursKWy5FNSX",
+	"bVvO3R73YpDVJXvu/aiTOZZsiMPD1d3zhm0be+4dJ7vFtA295wIyvYIHhJlnK07rLI+gyTgXDHBmjLwl",
+	"xOs4rbIe3Iw9Z3vgiKvyfC9URoRue4QUY3RnlUWoImzOKYiJnKoEERMyltZmgTlX15sElzVbTLWWuGSc",
+	"MnnIYI5V3mMuXqiI4YvTd9ZuRBd1U8KlqUaq0nBVhh/JucB5DEissDCJHDasONHzNLUDcK49qOoNrUU5",
+	"evVy1m+j/mxzPXot1dN3lqoBWky8lBNFNSLq3JMlYbxrF9YU2HH/EvBFaLy80Jz0JTZQ7TOIJd+ENevR",
+	"a7NXeJR5rR5IpmH0P/PfPlqb2hYAUQTdpyi/enmASpYXTY460JaclbsovsFEJXzIhNmMMpCgvwXL98W6",
+	"gqUL5maEgK9omSZS8Iwfh68wyZ/FxuhrLOcSbn9wsu3FKtfTvt8nrR+qNl+bY/moZqp7zX3HQO0Ac5+j",
+	"HTkK/XfVhaN7Df0UQpeKfpWX6bFzncjH+jv1WgX3uXOJabOgXzWbUFS4Ixjn2mf6UO05xOE6eGcy+h/Q",
+	"CeiM69cOwGKNSNJiqqvA9sTRh9sRm/7rFt7q8wLKXvdERytMZU1YOfdeZzKH28Z1HAmvRkXbho6d9Na9",
+	"7HFAKzDKssA7A/LhPVm/WvEenNlNgqzRiG3xwjMwlCl4aEf3cYVpz06xK042gbRTLVuBUA1H6eT3uuXW",
+	"YjAJZhxKSRWBOhLcuLWV/V8Hb3OUkTQlpghZ1HlmwLojuCpDur8a8HDUuW+Wo+PKdRU2FUberJzaPrc6",
+	"t+7GNvucRtb3zS4knUPOoCugYxy/SkY7PcDDmU8PUWJqG7h5PtR3xDUQV1UXD9tXrariI00j9d7B3S9b",
+	"1s/FhIw8+CHafSLBfKBmqO1Pz8N764YNgyUDvgLeDZ1z3cSTRfgidO6sin4Lp5roSFydV+M+jt3tJ+8n",
+	"pZ5w4MaGeaLua+gSYS4darvgGgoZ3pP1VOv6qe4p8l9+nM0GdvvW11xGphI1dKem7IF80qcIaakd+vAs",
+	"n2+hC/WLT9BRbFQhfrrHnvZs7VBxtcfV4gf0Bm2IZEySnW3aru00wv6cVwM9EwvUTnhHG9QueyJDVMDt",
+	"seY3l+sh3dxkZESuAhpm6voNqDTLZslXYqPtI7XwwyFwn7knNeweRRn7wweUcqDC3jcSozucIeKU4g9L",
+	"1RyEW8+/WYjfZKe061CjL9bAdHKuSF3by0D7CL3FaaribyvCpf+8ognKylSQItVvcERvgCmS6PSVi4v3",
+	"E33aqzosuX4dkClz41Yd1W9UX0ApKJHPKcoA85KBtzRrYR+NlPKL6hLb43sH3icVmpdi5eJI3uaHSy+T",
+	"Q9HpPrSri2/zPTMzy8sH8SI4eCn5lo/fRb3a1aZ3dcHN3jNvfajdrO0ZPPi2fc/dUp4bCkD96gaxF3c7",
+	"sDv198PvYb7LGQrK+r1N2cATpta3Ov0jTJVYk1OVXNN7kNmGixltd9R8206qpOF3L3UHaRGAsxGpcLpZ",
+	"wPG8MA8OmRMmx9w1/Usv6HBeYfMO/uceNrr8wvI3h1XTO13R5X6KC/LiGtYjmGeKkPJGpZcwLy9U9xqD",
+	"m3tuenIHChx4Xz/fAQtB+jzNfXRHGA1HDeAWdVT3bm9rD42Xvbj5LkgOu5O1v+s/hErr37tln5+/e78/",
+	"1Te90/U2Buz6c7ih19Aoph0y69uAfm363xrYk8GWdgkjvYCGGpMrCwDm2wTBlFH7iYQuG79IcQx+VXhV",
+	"vF1pPprDxL/ZaRtxQQuObim7VkepWQYJwQLS9UjFaHF0Ts0F6sdA0+wRVZvmzLeJ1NKWIe/MMHLjUKq1",
+	"dzfOlkPnKrVCrIAwXTGBD1hxuqD2Y9lww/VodbnvDU53FDk0Ob8V8JgiZCPMfNtyAzP/d9v5k7fzzUx3",
+	"M/SDFPq6gFTBpdvYP4crwgUwp8a69/XiPKnuhYdu6vq1yns3v4eB197cggpTj+YXeDPoRbFmmVGDt7Uw",
+	"fKO+gQPzHp05vTP/Gx/4r2s+DjgIFtu/2xH2aNRVq9jGR7CnBC3gfMNgmJpan2RMsSupCHlVHpTURZS6",
+	"sBLeYyugvKvHPghkRtyfcNZWle3Z/N7ED8FbE05xnoHaPAcxJpr1oXfKNurGxFcuYdo8GHVwYJsGpaR+",
+	"2BCEcPku88U9F3tbFTW/PPSBhV7n7ocWll5P/+CinuvIsHNvLSIXKfuwCINfKRhlFx4/+By6jj7NZ10x",
+	"VwXxC7H5vYpHhoGnOqZ39r/jjDPcDRBrm5meL9wqf5vur9Wr43MyvC+rPEROxuNLKxbxKvDRKvWVhx5B",
+	"la/thQ37E3j/yxWjJH42Agbm4zbPoWzYPhX8OWilhfOR6v15gOb7LvEou8RUrY1P78z3hu57EnbVJ3Tc",
+	"L+OMAp1iLH9Tfc5oewQOe2hmEaGN5jisYTRrV5gjUyf26+XstP6ESfehiVW4mi5dBeuH2Gw+SnIgZrec",
+	"8dM8gS/VDR+bor2wnxTrrL1QV8Hur3ks7/7/tlzqArsBp/1JVTrwFOymR0NWL35VnrcnP6pXWRpTI1R9",
+	"WVR9lIWfTOXJ7xEcL44SuImcHu6axa+5AqH5sU4HdH5Uh1HO35X3f395/38DAIh2CuCRqAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -25,6 +25,19 @@ const (
 	NodeStatusUnhealthy  NodeStatus = "unhealthy"
 )
 
+// Defines values for SandboxState.
+const (
+	Paused  SandboxState = "paused"
+	Running SandboxState = "running"
+)
+
+// Defines values for TeamAPIKeyScope.
+const (
+	SandboxesCreate TeamAPIKeyScope = "sandboxes:create"
+	SandboxesRead   TeamAPIKeyScope = "sandboxes:read"
+	SandboxesWrite  TeamAPIKeyScope = "sandboxes:write"
+)
+
 // Defines values for TemplateBuildStatus.
 const (
 	TemplateBuildStatusBuilding TemplateBuildStatus = "building"
@@ -32,9 +45,87 @@ const (
 	TemplateBuildStatusReady    TemplateBuildStatus = "ready"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+	Succeeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	SandboxCreated           WebhookEventType = "sandbox.created"
+	SandboxHealthcheckFailed WebhookEventType = "sandbox.healthcheck_failed"
+	SandboxKilled            WebhookEventType = "sandbox.killed"
+	SandboxPaused            WebhookEventType = "sandbox.paused"
+	SandboxResumed           WebhookEventType = "sandbox.resumed"
+	SandboxTimeout           WebhookEventType = "sandbox.timeout"
+	TemplateBuildFinished    WebhookEventType = "template.build_finished"
+)
+
+// Defines values for GetSandboxesParamsSortBy.
+const (
+	EndAt     GetSandboxesParamsSortBy = "endAt"
+	StartedAt GetSandboxesParamsSortBy = "startedAt"
+)
+
+// Defines values for GetSandboxesParamsOrder.
+const (
+	Asc  GetSandboxesParamsOrder = "asc"
+	Desc GetSandboxesParamsOrder = "desc"
+)
+
 // CPUCount CPU cores for the sandbox
 type CPUCount = int32
 
+// CreatedTeamAPIKey defines model for CreatedTeamAPIKey.
+type CreatedTeamAPIKey struct {
+	// CreatedAt Time when the API key was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Time when the API key expires, the API key does not expire if not set
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id Identifier of the API key
+	Id openapi_types.UUID `json:"id"`
+
+	// Key The API key, it is returned only once when the API key is created or rotated
+	Key string `json:"key"`
+
+	// LastUsed Time when the API key was last used
+	LastUsed *time.Time `json:"lastUsed,omitempty"`
+
+	// MaskedKey API key with all but the last characters hidden
+	MaskedKey string `json:"maskedKey"`
+
+	// Name Name of the API key
+	Name string `json:"name"`
+
+	// Scopes Operations the API key can be used for, an API key without scopes has full access
+	Scopes []TeamAPIKeyScope `json:"scopes"`
+
+	// TemplateID Template the API key can create sandboxes from, any template of the team if not set
+	TemplateID *string `json:"templateID,omitempty"`
+}
+
+// CreatedTeamWebhook defines model for CreatedTeamWebhook.
+type CreatedTeamWebhook struct {
+	// CreatedAt Time when the webhook was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events Events the webhook is subscribed to, a webhook without events receives all of them
+	Events []WebhookEventType `json:"events"`
+
+	// Id Identifier of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Secret Secret used to sign the payloads, it is returned only once when the webhook is created
+	Secret string `json:"secret"`
+
+	// Url URL the events are sent to
+	Url string `json:"url"`
+}
+
 // EnvVars defines model for EnvVars.
 type EnvVars map[string]string
 
@@ -47,6 +138,18 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// ForkedSandbox defines model for ForkedSandbox.
+type ForkedSandbox struct {
+	// AutoPause Automatically pauses the forked sandboxes after the timeout
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// Count Number of sandboxes to create from the snapshot of the sandbox
+	Count *int32 `json:"count,omitempty"`
+
+	// Timeout Time to live for the forked sandboxes in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
 // MemoryMB Memory for the sandbox in MB
 type MemoryMB = int32
 
@@ -64,6 +167,39 @@ type NewSandbox struct {
 	Timeout *int32 `json:"timeout,omitempty"`
 }
 
+// NewSandboxSnapshot defines model for NewSandboxSnapshot.
+type NewSandboxSnapshot struct {
+	// Name Name of the snapshot
+	Name *string `json:"name,omitempty"`
+
+	// Pause Pause the sandbox after the snapshot is created, otherwise the sandbox keeps running
+	Pause *bool `json:"pause,omitempty"`
+}
+
+// NewTeamAPIKey defines model for NewTeamAPIKey.
+type NewTeamAPIKey struct {
+	// ExpiresAt Time when the API key expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Name Name of the API key
+	Name string `json:"name"`
+
+	// Scopes Operations the API key can be used for, an API key without scopes has full access
+	Scopes *[]TeamAPIKeyScope `json:"scopes,omitempty"`
+
+	// TemplateID Template ID or alias the API key can create sandboxes from
+	TemplateID *string `json:"templateID,omitempty"`
+}
+
+// NewTeamWebhook defines model for NewTeamWebhook.
+type NewTeamWebhook struct {
+	// Events Events the webhook is subscribed to, the webhook receives all events if not set
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Url HTTPS URL the events are sent to
+	Url string `json:"url"`
+}
+
 // Node defines model for Node.
 type Node struct {
 	// AllocatedCPU Number of allocated CPU cores
@@ -135,7 +271,7 @@ type RunningSandbox struct {
 	// CpuCount CPU cores for the sandbox
 	CpuCount CPUCount `json:"cpuCount"`
 
-	// EndAt Time when the sandbox will expire
+	// EndAt Time when the sandbox will expire, for the paused sandbox the time when it was paused
 	EndAt time.Time `json:"endAt"`
 
 	// MemoryMB Memory for the sandbox in MB
@@ -145,9 +281,12 @@ type RunningSandbox struct {
 	// SandboxID Identifier of the sandbox
 	SandboxID string `json:"sandboxID"`
 
-	// StartedAt Time when the sandbox was started
+	// StartedAt Time when the sandbox was started, for the paused sandbox the time when it was paused
 	StartedAt time.Time `json:"startedAt"`
 
+	// State State of the sandbox
+	State *SandboxState `json:"state,omitempty"`
+
 	// TemplateID Identifier of the template from which is the sandbox created
 	TemplateID string `json:"templateID"`
 }
@@ -235,6 +374,27 @@ type SandboxMetric struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// SandboxSnapshot defines model for SandboxSnapshot.
+type SandboxSnapshot struct {
+	// BuildID Identifier of the build with the snapshot files
+	BuildID openapi_types.UUID `json:"buildID"`
+
+	// CreatedAt Time when the snapshot was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the snapshot
+	Name *string `json:"name,omitempty"`
+
+	// SandboxID Identifier of the snapshotted sandbox
+	SandboxID string `json:"sandboxID"`
+
+	// SnapshotID Identifier of the snapshot
+	SnapshotID openapi_types.UUID `json:"snapshotID"`
+}
+
+// SandboxState State of the sandbox
+type SandboxState string
+
 // Team defines model for Team.
 type Team struct {
 	// ApiKey API key for the team
@@ -250,6 +410,55 @@ type Team struct {
 	TeamID string `json:"teamID"`
 }
 
+// TeamAPIKey defines model for TeamAPIKey.
+type TeamAPIKey struct {
+	// CreatedAt Time when the API key was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Time when the API key expires, the API key does not expire if not set
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id Identifier of the API key
+	Id openapi_types.UUID `json:"id"`
+
+	// LastUsed Time when the API key was last used
+	LastUsed *time.Time `json:"lastUsed,omitempty"`
+
+	// MaskedKey API key with all but the last characters hidden
+	MaskedKey string `json:"maskedKey"`
+
+	// Name Name of the API key
+	Name string `json:"name"`
+
+	// Scopes Operations the API key can be used for, an API key without scopes has full access
+	Scopes []TeamAPIKeyScope `json:"scopes"`
+
+	// TemplateID Template the API key can create sandboxes from, any template of the team if not set
+	TemplateID *string `json:"templateID,omitempty"`
+}
+
+// TeamAPIKeyScope Operation the API key can be used for
+type TeamAPIKeyScope string
+
+// TeamResourceUsage defines model for TeamResourceUsage.
+type TeamResourceUsage struct {
+	// Limit Limit of the resource, the resource is unlimited if not set
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Used Current consumption of the resource
+	Used int64 `json:"used"`
+}
+
+// TeamUsage defines model for TeamUsage.
+type TeamUsage struct {
+	ConcurrentSandboxes      TeamResourceUsage `json:"concurrentSandboxes"`
+	ConcurrentTemplateBuilds TeamResourceUsage `json:"concurrentTemplateBuilds"`
+	MemoryMB                 TeamResourceUsage `json:"memoryMB"`
+	SandboxStartsPerMinute   TeamResourceUsage `json:"sandboxStartsPerMinute"`
+	SnapshotStorageMB        TeamResourceUsage `json:"snapshotStorageMB"`
+	VcpuCount                TeamResourceUsage `json:"vcpuCount"`
+}
+
 // TeamUser defines model for TeamUser.
 type TeamUser struct {
 	// Email Email of the user
@@ -259,6 +468,21 @@ type TeamUser struct {
 	Id openapi_types.UUID `json:"id"`
 }
 
+// TeamWebhook defines model for TeamWebhook.
+type TeamWebhook struct {
+	// CreatedAt Time when the webhook was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events Events the webhook is subscribed to, a webhook without events receives all of them
+	Events []WebhookEventType `json:"events"`
+
+	// Id Identifier of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Url URL the events are sent to
+	Url string `json:"url"`
+}
+
 // Template defines model for Template.
 type Template struct {
 	// Aliases Aliases of the template
@@ -341,6 +565,45 @@ type TemplateUpdateRequest struct {
 	Public *bool `json:"public,omitempty"`
 }
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Number of the delivery attempts
+	Attempts int32 `json:"attempts"`
+
+	// CreatedAt Time when the event was emitted
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveredAt Time when the event was successfully delivered
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// Error Error of the last failed attempt
+	Error *string `json:"error,omitempty"`
+
+	// EventID Identifier of the delivered event
+	EventID openapi_types.UUID `json:"eventID"`
+
+	// EventType Type of the event delivered to the webhook
+	EventType WebhookEventType `json:"eventType"`
+
+	// Id Identifier of the delivery
+	Id openapi_types.UUID `json:"id"`
+
+	// ResponseStatus HTTP status of the last response of the webhook
+	ResponseStatus *int32 `json:"responseStatus,omitempty"`
+
+	// Status Status of the delivery
+	Status WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryStatus Status of the delivery
+type WebhookDeliveryStatus string
+
+// WebhookEventType Type of the event delivered to the webhook
+type WebhookEventType string
+
+// ApiKeyID defines model for apiKeyID.
+type ApiKeyID = openapi_types.UUID
+
 // BuildID defines model for buildID.
 type BuildID = string
 
@@ -350,9 +613,18 @@ type NodeID = string
 // SandboxID defines model for sandboxID.
 type SandboxID = string
 
+// SnapshotID defines model for snapshotID.
+type SnapshotID = openapi_types.UUID
+
+// TeamID defines model for teamID.
+type TeamID = openapi_types.UUID
+
 // TemplateID defines model for templateID.
 type TemplateID = string
 
+// WebhookID defines model for webhookID.
+type WebhookID = openapi_types.UUID
+
 // N400 defines model for 400.
 type N400 = Error
 
@@ -372,6 +644,36 @@ type N500 = Error
 type GetSandboxesParams struct {
 	// Query A query used to filter the sandboxes (e.g. "user=abc&app=prod"). Query and each key and values must be URL encoded.
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// State States of the sandboxes to list
+	State *[]SandboxState `form:"state,omitempty" json:"state,omitempty"`
+
+	// TemplateID List only the sandboxes created from the template
+	TemplateID *string `form:"templateID,omitempty" json:"templateID,omitempty"`
+
+	// SortBy Field the sandboxes are sorted by
+	SortBy *GetSandboxesParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Order Sort order
+	Order *GetSandboxesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Maximum number of sandboxes to return, all sandboxes are returned if not set
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextToken Token of the next page from the X-Next-Token header of the previous response
+	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
+// GetSandboxesParamsSortBy defines parameters for GetSandboxes.
+type GetSandboxesParamsSortBy string
+
+// GetSandboxesParamsOrder defines parameters for GetSandboxes.
+type GetSandboxesParamsOrder string
+
+// GetSandboxesEventsParams defines parameters for GetSandboxesEvents.
+type GetSandboxesEventsParams struct {
+	// Cursor ID of the last received event, the events after it are sent first
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // GetSandboxesMetricsParams defines parameters for GetSandboxesMetrics.
@@ -401,6 +703,12 @@ type PostSandboxesSandboxIDTimeoutJSONBody struct {
 	Timeout int32 `json:"timeout"`
 }
 
+// GetTeamsTeamIDWebhooksWebhookIDDeliveriesParams defines parameters for GetTeamsTeamIDWebhooksWebhookIDDeliveries.
+type GetTeamsTeamIDWebhooksWebhookIDDeliveriesParams struct {
+	// Limit Maximum number of deliveries to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetTemplatesParams defines parameters for GetTemplates.
 type GetTemplatesParams struct {
 	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
@@ -418,15 +726,30 @@ type PostNodesNodeIDJSONRequestBody = NodeStatusChange
 // PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
 type PostSandboxesJSONRequestBody = NewSandbox
 
+// PostSandboxesSandboxIDForkJSONRequestBody defines body for PostSandboxesSandboxIDFork for application/json ContentType.
+type PostSandboxesSandboxIDForkJSONRequestBody = ForkedSandbox
+
 // PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
 type PostSandboxesSandboxIDRefreshesJSONRequestBody PostSandboxesSandboxIDRefreshesJSONBody
 
 // PostSandboxesSandboxIDResumeJSONRequestBody defines body for PostSandboxesSandboxIDResume for application/json ContentType.
 type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
 
+// PostSandboxesSandboxIDSnapshotsJSONRequestBody defines body for PostSandboxesSandboxIDSnapshots for application/json ContentType.
+type PostSandboxesSandboxIDSnapshotsJSONRequestBody = NewSandboxSnapshot
+
 // PostSandboxesSandboxIDTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDTimeout for application/json ContentType.
 type PostSandboxesSandboxIDTimeoutJSONRequestBody PostSandboxesSandboxIDTimeoutJSONBody
 
+// PostSnapshotsSnapshotIDRestoreJSONRequestBody defines body for PostSnapshotsSnapshotIDRestore for application/json ContentType.
+type PostSnapshotsSnapshotIDRestoreJSONRequestBody = ResumedSandbox
+
+// PostTeamsTeamIDApiKeysJSONRequestBody defines body for PostTeamsTeamIDApiKeys for application/json ContentType.
+type PostTeamsTeamIDApiKeysJSONRequestBody = NewTeamAPIKey
+
+// PostTeamsTeamIDWebhooksJSONRequestBody defines body for PostTeamsTeamIDWebhooks for application/json ContentType.
+type PostTeamsTeamIDWebhooksJSONRequestBody = NewTeamWebhook
+
 // PostTemplatesJSONRequestBody defines body for PostTemplates for application/json ContentType.
 type PostTemplatesJSONRequestBody = TemplateBuildRequest
 
@@ -43,8 +43,10 @@ type commonAuthenticator[T any] struct {
 	securitySchemeName string
 	headerKey          headerKey
 	validationFunction func(context.Context, string) (T, *api.APIError)
-	contextKey         string
-	errorMessage       string
+	// scopeValidationFunction checks the result has the scopes required by the operation, if set.
+	scopeValidationFunction func(T, []string) error
+	contextKey              string
+	errorMessage            string
 }
 
 type authenticator interface {
@@ -100,6 +102,15 @@ func (a *commonAuthenticator[T]) Authenticate(ctx context.Context, input *openap
 
 	telemetry.ReportEvent(ctx, "api key validated")
 
+	if a.scopeValidationFunction != nil {
+		err = a.scopeValidationFunction(result, input.Scopes)
+		if err != nil {
+			telemetry.ReportError(ctx, fmt.Errorf("%s %w", a.errorMessage, err))
+
+			return err
+		}
+	}
+
 	// Set the property on the gin context
 	if a.contextKey != "" {
 		middleware.GetGinContext(ctx).Set(a.contextKey, result)
@@ -139,9 +150,10 @@ func CreateAuthenticationFunc(
 				prefix:       "e2b_",
 				removePrefix: "",
 			},
-			validationFunction: teamValidationFunction,
-			contextKey:         TeamContextKey,
-			errorMessage:       "Invalid API key, please visit https://e2b.dev/docs?reason=sdk-missing-api-key to get your API key.",
+			validationFunction:      teamValidationFunction,
+			scopeValidationFunction: validateAPIKeyScopes,
+			contextKey:              TeamContextKey,
+			errorMessage:            "Invalid API key, please visit https://e2b.dev/docs?reason=sdk-missing-api-key to get your API key.",
 		},
 		&commonAuthenticator[uuid.UUID]{
 			securitySchemeName: "AccessTokenAuth",
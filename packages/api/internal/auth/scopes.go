@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+)
+
+// validateAPIKeyScopes checks that the API key has all scopes required by the operation.
+// The API keys without scopes have full access.
+func validateAPIKeyScopes(info authcache.AuthTeamInfo, required []string) error {
+	if info.APIKey == nil || len(info.APIKey.Scopes) == 0 {
+		return nil
+	}
+
+	for _, scope := range required {
+		if !slices.Contains(info.APIKey.Scopes, scope) {
+			return fmt.Errorf("the API key is missing the '%s' scope", scope)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+	middleware "github.com/oapi-codegen/gin-middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestValidateAPIKeyScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     authcache.AuthTeamInfo
+		required []string
+		wantErr  bool
+	}{
+		{
+			name:     "team auth without api key",
+			info:     authcache.AuthTeamInfo{Team: &models.Team{}},
+			required: []string{string(api.SandboxesCreate)},
+		},
+		{
+			name:     "api key without scopes has full access",
+			info:     authcache.AuthTeamInfo{APIKey: &models.TeamAPIKey{}},
+			required: []string{string(api.SandboxesCreate), string(api.SandboxesWrite)},
+		},
+		{
+			name:     "api key with the required scopes",
+			info:     authcache.AuthTeamInfo{APIKey: &models.TeamAPIKey{Scopes: []string{string(api.SandboxesRead), string(api.SandboxesCreate)}}},
+			required: []string{string(api.SandboxesCreate)},
+		},
+		{
+			name:     "operation without required scopes",
+			info:     authcache.AuthTeamInfo{APIKey: &models.TeamAPIKey{Scopes: []string{string(api.SandboxesRead)}}},
+			required: nil,
+		},
+		{
+			name:     "api key missing one of the scopes",
+			info:     authcache.AuthTeamInfo{APIKey: &models.TeamAPIKey{Scopes: []string{string(api.SandboxesRead)}}},
+			required: []string{string(api.SandboxesRead), string(api.SandboxesWrite)},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAPIKeyScopes(tt.info, tt.required)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func authenticateAPIKey(t *testing.T, info authcache.AuthTeamInfo, scopes []string) (*gin.Context, error) {
+	t.Helper()
+
+	ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ginCtx.Request = httptest.NewRequest(http.MethodPost, "/sandboxes", nil)
+	ginCtx.Request.Header.Set("X-API-Key", "e2b_key")
+
+	authenticator := &commonAuthenticator[authcache.AuthTeamInfo]{
+		securitySchemeName: "ApiKeyAuth",
+		headerKey:          headerKey{name: "X-API-Key", prefix: "e2b_"},
+		validationFunction: func(context.Context, string) (authcache.AuthTeamInfo, *api.APIError) {
+			return info, nil
+		},
+		scopeValidationFunction: validateAPIKeyScopes,
+		contextKey:              TeamContextKey,
+		errorMessage:            "Invalid API key.",
+	}
+
+	ctx := context.WithValue(context.Background(), middleware.GinContextKey, ginCtx)
+
+	err := authenticator.Authenticate(ctx, &openapi3filter.AuthenticationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{Request: ginCtx.Request},
+		SecuritySchemeName:     "ApiKeyAuth",
+		Scopes:                 scopes,
+	})
+
+	return ginCtx, err
+}
+
+func TestAuthenticateChecksScopes(t *testing.T) {
+	info := authcache.AuthTeamInfo{
+		Team:   &models.Team{},
+		APIKey: &models.TeamAPIKey{Scopes: []string{string(api.SandboxesRead)}},
+	}
+
+	ginCtx, err := authenticateAPIKey(t, info, []string{string(api.SandboxesRead)})
+	require.NoError(t, err)
+
+	_, ok := ginCtx.Get(TeamContextKey)
+	assert.True(t, ok)
+
+	ginCtx, err = authenticateAPIKey(t, info, []string{string(api.SandboxesCreate)})
+	require.ErrorContains(t, err, string(api.SandboxesCreate))
+
+	_, ok = ginCtx.Get(TeamContextKey)
+	assert.False(t, ok)
+}
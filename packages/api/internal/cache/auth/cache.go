@@ -2,10 +2,13 @@ package autchcache
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/jellydator/ttlcache/v3"
 	"golang.org/x/sync/singleflight"
 
@@ -15,62 +18,110 @@ import (
 const authInfoExpiration = 5 * time.Minute
 const refreshInterval = 1 * time.Minute
 
+// invalidationChannel is the Redis channel where the deleted keys are published, so they are removed from the caches of all API replicas.
+// Only the hashes of the keys are published.
+const invalidationChannel = "auth-cache:invalidate"
+
 type AuthTeamInfo struct {
 	Team *models.Team
 	Tier *models.Tier
+	// APIKey is set only when the team was authenticated with an API key.
+	APIKey *models.TeamAPIKey
 }
 
 type TeamInfo struct {
-	team *models.Team
-	tier *models.Tier
+	info AuthTeamInfo
 
 	lastRefresh time.Time
 	once        singleflight.Group
 	lock        sync.Mutex
 }
 
-type DataCallback = func(ctx context.Context, key string) (*models.Team, *models.Tier, error)
+type DataCallback = func(ctx context.Context, key string) (AuthTeamInfo, error)
 
 type TeamAuthCache struct {
-	cache *ttlcache.Cache[string, *TeamInfo]
+	cache       *ttlcache.Cache[string, *TeamInfo]
+	redisClient *redis.Client
 }
 
-func NewTeamAuthCache() *TeamAuthCache {
+// NewTeamAuthCache creates the cache, the deleted keys are propagated to the other replicas if the Redis client is set.
+func NewTeamAuthCache(ctx context.Context, redisClient *redis.Client) *TeamAuthCache {
 	cache := ttlcache.New(ttlcache.WithTTL[string, *TeamInfo](authInfoExpiration))
 	go cache.Start()
 
-	return &TeamAuthCache{
-		cache: cache,
+	c := &TeamAuthCache{
+		cache:       cache,
+		redisClient: redisClient,
+	}
+
+	if redisClient != nil {
+		sub := redisClient.Subscribe(ctx, invalidationChannel)
+		go c.receiveInvalidations(ctx, sub)
+	}
+
+	return c
+}
+
+func (c *TeamAuthCache) receiveInvalidations(ctx context.Context, sub *redis.PubSub) {
+	defer sub.Close()
+
+	messages := sub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			c.deleteByHash(msg.Payload)
+		}
+	}
+}
+
+func (c *TeamAuthCache) deleteByHash(hash string) {
+	for _, key := range c.cache.Keys() {
+		if hashKey(key) == hash {
+			c.cache.Delete(key)
+		}
 	}
 }
 
+func hashKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(h[:])
+}
+
 // TODO: save blocked teams to cache as well, handle the condition in the GetOrSet method
-func (c *TeamAuthCache) GetOrSet(ctx context.Context, key string, dataCallback DataCallback) (team *models.Team, tier *models.Tier, err error) {
+func (c *TeamAuthCache) GetOrSet(ctx context.Context, key string, dataCallback DataCallback) (AuthTeamInfo, error) {
 	var item *ttlcache.Item[string, *TeamInfo]
 	var templateInfo *TeamInfo
 
 	item = c.cache.Get(key)
 	if item == nil {
-		team, tier, err = dataCallback(ctx, key)
+		info, err := dataCallback(ctx, key)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to get the team from db for a key: %w", err)
+			return AuthTeamInfo{}, fmt.Errorf("failed to get the team from db for a key: %w", err)
 		}
 
-		templateInfo = &TeamInfo{team: team, tier: tier, lastRefresh: time.Now()}
+		templateInfo = &TeamInfo{info: info, lastRefresh: time.Now()}
 		c.cache.Set(key, templateInfo, authInfoExpiration)
 
-		return team, tier, nil
+		return info, nil
 	}
 
 	templateInfo = item.Value()
 	if time.Since(templateInfo.lastRefresh) > refreshInterval {
 		go templateInfo.once.Do(key, func() (interface{}, error) {
 			c.Refresh(key, dataCallback)
-			return nil, err
+			return nil, nil
 		})
 	}
 
-	return templateInfo.team, templateInfo.tier, nil
+	return templateInfo.info, nil
 }
 
 // Refresh refreshes the cache for the given team ID.
@@ -78,12 +129,29 @@ func (c *TeamAuthCache) Refresh(key string, dataCallback DataCallback) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	team, tier, err := dataCallback(ctx, key)
+	info, err := dataCallback(ctx, key)
 	if err != nil {
 		c.cache.Delete(key)
 
 		return
 	}
 
-	c.cache.Set(key, &TeamInfo{team: team, tier: tier, lastRefresh: time.Now()}, authInfoExpiration)
+	c.cache.Set(key, &TeamInfo{info: info, lastRefresh: time.Now()}, authInfoExpiration)
+}
+
+// Delete removes the key from the caches of all replicas, so the revoked or rotated API keys stop working immediately.
+// The key is removed from the local cache even if the other replicas couldn't be notified.
+func (c *TeamAuthCache) Delete(ctx context.Context, key string) error {
+	c.cache.Delete(key)
+
+	if c.redisClient == nil {
+		return nil
+	}
+
+	err := c.redisClient.Publish(ctx, invalidationChannel, hashKey(key)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to publish the invalidation of the key: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,86 @@
+package autchcache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func countingCallback(calls *atomic.Int32) DataCallback {
+	return func(context.Context, string) (AuthTeamInfo, error) {
+		calls.Add(1)
+
+		return AuthTeamInfo{Team: &models.Team{}}, nil
+	}
+}
+
+func TestTeamAuthCacheDelete(t *testing.T) {
+	ctx := context.Background()
+	cache := NewTeamAuthCache(ctx, nil)
+
+	var calls atomic.Int32
+
+	_, err := cache.GetOrSet(ctx, "e2b_key", countingCallback(&calls))
+	require.NoError(t, err)
+
+	_, err = cache.GetOrSet(ctx, "e2b_key", countingCallback(&calls))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	require.NoError(t, cache.Delete(ctx, "e2b_key"))
+
+	_, err = cache.GetOrSet(ctx, "e2b_key", func(context.Context, string) (AuthTeamInfo, error) {
+		return AuthTeamInfo{}, errors.New("key was revoked")
+	})
+	assert.Error(t, err)
+}
+
+func TestTeamAuthCacheDeletePropagatesToReplicas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	server := miniredis.RunT(t)
+
+	newReplica := func() *TeamAuthCache {
+		client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+		t.Cleanup(func() { client.Close() })
+
+		return NewTeamAuthCache(ctx, client)
+	}
+
+	first := newReplica()
+	second := newReplica()
+
+	var calls atomic.Int32
+
+	for _, replica := range []*TeamAuthCache{first, second} {
+		_, err := replica.GetOrSet(ctx, "e2b_key", countingCallback(&calls))
+		require.NoError(t, err)
+
+		_, err = replica.GetOrSet(ctx, "e2b_other", countingCallback(&calls))
+		require.NoError(t, err)
+	}
+
+	// Wait for both replicas to subscribe before publishing.
+	require.Eventually(t, func() bool {
+		return server.PubSubNumSub(invalidationChannel)[invalidationChannel] == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, first.Delete(ctx, "e2b_key"))
+
+	require.Eventually(t, func() bool {
+		return second.cache.Get("e2b_key") == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Nil(t, first.cache.Get("e2b_key"))
+	assert.NotNil(t, second.cache.Get("e2b_other"))
+}
@@ -90,7 +90,17 @@ type InstanceInfo struct {
 	Node               *node.NodeInfo
 	AutoPause          atomic.Bool
 	Pausing            *utils.SetOnce[*node.NodeInfo]
-	mu                 sync.RWMutex
+	// IsResume is set if the sandbox was resumed from a snapshot.
+	IsResume bool
+	// Unhealthy is set if the last healthcheck of the sandbox on the node failed.
+	Unhealthy    atomic.Bool
+	newlyCreated bool
+	migrating    atomic.Bool
+	// migration is held while the instance migrates, the removal of the instance waits for it.
+	migration sync.Mutex
+	// removed is set when the instance is removed from the cache, so it isn't migrated anymore.
+	removed bool
+	mu      sync.RWMutex
 }
 
 func (i *InstanceInfo) LoggerMetadata() sbxlogger.SandboxMetadata {
@@ -101,6 +111,11 @@ func (i *InstanceInfo) LoggerMetadata() sbxlogger.SandboxMetadata {
 	}
 }
 
+// IsNewlyCreated returns false if the instance was loaded to the cache from the node.
+func (i *InstanceInfo) IsNewlyCreated() bool {
+	return i.newlyCreated
+}
+
 func (i *InstanceInfo) IsExpired() bool {
 	i.mu.RLock()
 	defer i.mu.RUnlock()
@@ -126,6 +141,68 @@ func (i *InstanceInfo) SetExpired() {
 	i.SetEndTime(time.Now())
 }
 
+// GetClientID returns the ID of the node the instance runs on, it changes when the instance is migrated.
+func (i *InstanceInfo) GetClientID() string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.Instance.ClientID
+}
+
+// GetNode returns the node the instance runs on, it changes when the instance is migrated.
+func (i *InstanceInfo) GetNode() *node.NodeInfo {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.Node
+}
+
+// GetBuildID returns the build the instance runs from, it changes when the instance is migrated.
+func (i *InstanceInfo) GetBuildID() *uuid.UUID {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.BuildID
+}
+
+// StartMigration marks the instance as migrating, it isn't removed by the sync of the nodes until the migration finishes.
+// The kill and the pause of the instance wait for the migration, so they reach the node the instance runs on.
+// It returns false if the instance is already migrating or was already removed.
+func (i *InstanceInfo) StartMigration() bool {
+	if !i.migration.TryLock() {
+		return false
+	}
+
+	if i.removed {
+		i.migration.Unlock()
+
+		return false
+	}
+
+	i.migrating.Store(true)
+
+	return true
+}
+
+// FinishMigration moves the instance to the target node, where it runs from the migration build.
+// The node and the build are nil if the migration failed.
+func (i *InstanceInfo) FinishMigration(target *node.NodeInfo, buildID *uuid.UUID) {
+	if target != nil {
+		i.mu.Lock()
+		i.Instance.ClientID = target.ID
+		i.Node = target
+		i.BuildID = buildID
+		i.mu.Unlock()
+	}
+
+	i.migrating.Store(false)
+	i.migration.Unlock()
+}
+
+func (i *InstanceInfo) IsMigrating() bool {
+	return i.migrating.Load()
+}
+
 type InstanceCache struct {
 	reservations *ReservationCache
 	pausing      *smap.Map[*InstanceInfo]
@@ -233,7 +310,7 @@ func (c *InstanceCache) WaitForPause(ctx context.Context, sandboxID string) (*no
 
 func (c *InstanceInfo) PauseDone(err error) {
 	if err == nil {
-		err := c.Pausing.SetValue(c.Node)
+		err := c.Pausing.SetValue(c.GetNode())
 		if err != nil {
 			zap.L().Error("error setting PauseDone value", zap.Error(err))
 
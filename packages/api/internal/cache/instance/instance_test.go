@@ -0,0 +1,69 @@
+package instance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+func newMigrationTestCache(t *testing.T, sandboxID string) (*InstanceCache, *InstanceInfo) {
+	t.Helper()
+
+	c := &InstanceCache{
+		cache:        newLifecycleCache[*InstanceInfo](),
+		reservations: NewReservationCache(),
+		pausing:      smap.New[*InstanceInfo](),
+	}
+
+	teamID := uuid.New()
+	info := NewInstanceInfo(
+		&api.Sandbox{SandboxID: sandboxID, ClientID: "source"},
+		&teamID, nil, nil, time.Hour, time.Now(), time.Now().Add(time.Hour),
+		2, 1024, 512, "", "", "", &node.NodeInfo{ID: "source"}, true,
+	)
+	require.True(t, c.cache.SetIfAbsent(sandboxID, info))
+
+	return c, info
+}
+
+func TestDeleteWaitsForMigration(t *testing.T) {
+	c, info := newMigrationTestCache(t, "sbx")
+
+	require.True(t, info.StartMigration())
+	assert.False(t, info.StartMigration())
+
+	deleted := make(chan bool)
+	go func() {
+		deleted <- c.Delete("sbx", true)
+	}()
+
+	select {
+	case <-deleted:
+		t.Fatal("the instance was removed during the migration")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	buildID := uuid.New()
+	info.FinishMigration(&node.NodeInfo{ID: "target"}, &buildID)
+
+	assert.True(t, <-deleted)
+	assert.Equal(t, "target", info.GetClientID())
+	assert.Equal(t, "target", info.GetNode().ID)
+	assert.Equal(t, &buildID, info.GetBuildID())
+	assert.Len(t, c.GetPausingInstances(), 1)
+}
+
+func TestStartMigrationOfRemovedInstance(t *testing.T) {
+	c, info := newMigrationTestCache(t, "sbx")
+
+	require.True(t, c.Delete("sbx", false))
+	assert.False(t, info.StartMigration())
+	assert.False(t, info.IsMigrating())
+}
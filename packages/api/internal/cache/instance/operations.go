@@ -57,6 +57,15 @@ func (c *InstanceCache) GetInstances(teamID *uuid.UUID) (instances []*InstanceIn
 	return instances
 }
 
+// GetPausingInstances returns the instances whose pause hasn't finished yet, they are already removed from the cache.
+func (c *InstanceCache) GetPausingInstances() (instances []*InstanceInfo) {
+	for _, item := range c.pausing.Items() {
+		instances = append(instances, item)
+	}
+
+	return instances
+}
+
 // Add the instance to the cache and start expiration timer.
 // If the instance already exists we do nothing - it was loaded from Orchestrator.
 // TODO: Any error here should delete the sandbox
@@ -79,8 +88,8 @@ func (c *InstanceCache) Add(ctx context.Context, instance *InstanceInfo, newlyCr
 		return fmt.Errorf("instance %s is missing team ID", instance.Instance.SandboxID)
 	}
 
-	if instance.Instance.ClientID == "" {
-		return fmt.Errorf("instance %s is missing client ID", instance.Instance.ClientID)
+	if instance.GetClientID() == "" {
+		return fmt.Errorf("instance %s is missing client ID", instance.Instance.SandboxID)
 	}
 
 	if instance.Instance.TemplateID == "" {
@@ -97,6 +106,8 @@ func (c *InstanceCache) Add(ctx context.Context, instance *InstanceInfo, newlyCr
 		instance.SetEndTime(instance.StartTime.Add(instance.MaxInstanceLength))
 	}
 
+	instance.newlyCreated = newlyCreated
+
 	c.Set(instance.Instance.SandboxID, instance)
 	c.UpdateCounters(ctx, instance, 1, newlyCreated)
 
@@ -107,7 +118,15 @@ func (c *InstanceCache) Add(ctx context.Context, instance *InstanceInfo, newlyCr
 }
 
 // Delete the instance and remove it from the cache.
+// If the instance is migrating, it is removed after the migration finishes, so it's killed or paused on its new node.
 func (c *InstanceCache) Delete(instanceID string, pause bool) bool {
+	if current, ok := c.cache.Get(instanceID); ok {
+		current.migration.Lock()
+		defer current.migration.Unlock()
+
+		current.removed = true
+	}
+
 	value, found := c.cache.GetAndRemove(instanceID)
 	if found {
 		value.AutoPause.Store(pause)
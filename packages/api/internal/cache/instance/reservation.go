@@ -2,34 +2,80 @@ package instance
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 
 	"github.com/google/uuid"
 )
 
+// startsWindow is the window of the sandbox starts rate limit.
+const startsWindow = time.Minute
+
+// ReservationLimits are the limits of the team checked when reserving a sandbox, the nil limits are unlimited.
+type ReservationLimits struct {
+	ConcurrentInstances int64
+	VCpu                *int64
+	RamMB               *int64
+	StartsPerMinute     *int64
+}
+
+// LimitExceededError is returned when the reservation would exceed one of the team limits.
+type LimitExceededError struct {
+	Resource string
+	Limit    int64
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("team has reached the limit of %d %s", e.Limit, e.Resource)
+}
+
+// AlreadyReservedError is returned when the instance is already reserved, e.g. by a concurrent request for the same sandbox.
+type AlreadyReservedError struct {
+	InstanceID string
+}
+
+func (e *AlreadyReservedError) Error() string {
+	return fmt.Sprintf("reservation for instance %s already exists", e.InstanceID)
+}
+
+// TeamUsage is the current usage of the running and reserved sandboxes of the team.
+type TeamUsage struct {
+	Instances       int64
+	VCpu            int64
+	RamMB           int64
+	StartsPerMinute int64
+}
+
 type Reservation struct {
 	instanceID string
 	team       uuid.UUID
+	vcpu       int64
+	ramMB      int64
 }
 
 type ReservationCache struct {
 	reservations *smap.Map[*Reservation]
+	// starts are the times of the reservations of each team in the last minute, guarded by the instance cache mutex.
+	starts map[uuid.UUID][]time.Time
 }
 
 func NewReservationCache() *ReservationCache {
 	return &ReservationCache{
 		reservations: smap.New[*Reservation](),
+		starts:       make(map[uuid.UUID][]time.Time),
 	}
 }
 
-func (r *ReservationCache) reserve(instanceID string, team uuid.UUID) error {
+func (r *ReservationCache) reserve(instanceID string, team uuid.UUID, vcpu, ramMB int64) error {
 	inserted := r.reservations.InsertIfAbsent(instanceID, &Reservation{
 		team:       team,
 		instanceID: instanceID,
+		vcpu:       vcpu,
+		ramMB:      ramMB,
 	})
 	if !inserted {
-		return fmt.Errorf("reservation for instance %s already exists", instanceID)
+		return &AlreadyReservedError{InstanceID: instanceID}
 	}
 
 	return nil
@@ -39,19 +85,40 @@ func (r *ReservationCache) release(instanceID string) {
 	r.reservations.Remove(instanceID)
 }
 
-func (r *ReservationCache) list(teamID uuid.UUID) (instanceIDs []string) {
+func (r *ReservationCache) list(teamID uuid.UUID) (reservations []*Reservation) {
 	for _, item := range r.reservations.Items() {
 		currentTeamID := item.team
 
 		if currentTeamID == teamID {
-			instanceIDs = append(instanceIDs, item.instanceID)
+			reservations = append(reservations, item)
+		}
+	}
+
+	return reservations
+}
+
+// recentStarts returns the number of the reservations of the team in the last minute and drops the older ones.
+func (r *ReservationCache) recentStarts(teamID uuid.UUID, now time.Time) int64 {
+	starts := r.starts[teamID]
+
+	n := 0
+	for _, start := range starts {
+		if now.Sub(start) < startsWindow {
+			starts[n] = start
+			n++
 		}
 	}
 
-	return instanceIDs
+	if n == 0 {
+		delete(r.starts, teamID)
+	} else {
+		r.starts[teamID] = starts[:n]
+	}
+
+	return int64(n)
 }
 
-func (c *InstanceCache) list(teamID uuid.UUID) (instanceIDs []string) {
+func (c *InstanceCache) list(teamID uuid.UUID) (instances []*InstanceInfo) {
 	for _, value := range c.cache.Items() {
 		currentTeamID := value.TeamID
 
@@ -60,33 +127,77 @@ func (c *InstanceCache) list(teamID uuid.UUID) (instanceIDs []string) {
 		}
 
 		if *currentTeamID == teamID {
-			instanceIDs = append(instanceIDs, value.Instance.SandboxID)
+			instances = append(instances, value)
 		}
 	}
 
-	return instanceIDs
+	return instances
 }
 
-func (c *InstanceCache) Reserve(instanceID string, team uuid.UUID, limit int64) (error, func()) {
+// teamUsage has to be called with the mutex locked.
+func (c *InstanceCache) teamUsage(team uuid.UUID, now time.Time) TeamUsage {
+	// Count unique IDs for team, the reservation is released only after the instance is added to the cache.
+	resources := make(map[string][2]int64)
+
+	for _, reservation := range c.reservations.list(team) {
+		resources[reservation.instanceID] = [2]int64{reservation.vcpu, reservation.ramMB}
+	}
+
+	for _, instance := range c.list(team) {
+		resources[instance.Instance.SandboxID] = [2]int64{instance.VCpu, instance.RamMB}
+	}
+
+	usage := TeamUsage{
+		Instances:       int64(len(resources)),
+		StartsPerMinute: c.reservations.recentStarts(team, now),
+	}
+
+	for _, r := range resources {
+		usage.VCpu += r[0]
+		usage.RamMB += r[1]
+	}
+
+	return usage
+}
+
+// TeamUsage returns the current usage of the running and reserved sandboxes of the team.
+func (c *InstanceCache) TeamUsage(team uuid.UUID) TeamUsage {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Count unique IDs for team
-	ids := map[string]struct{}{}
+	return c.teamUsage(team, time.Now())
+}
+
+func (c *InstanceCache) Reserve(instanceID string, team uuid.UUID, vcpu, ramMB int64, limits ReservationLimits) (error, func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	usage := c.teamUsage(team, now)
 
-	for _, item := range append(c.reservations.list(team), c.list(team)...) {
-		ids[item] = struct{}{}
+	if usage.Instances >= limits.ConcurrentInstances {
+		return &LimitExceededError{Resource: "concurrent sandboxes", Limit: limits.ConcurrentInstances}, nil
 	}
 
-	if int64(len(ids)) >= limit {
-		return fmt.Errorf("team %s has reached the limit of reserved instances", team), nil
+	if limits.VCpu != nil && usage.VCpu+vcpu > *limits.VCpu {
+		return &LimitExceededError{Resource: "vCPUs in use", Limit: *limits.VCpu}, nil
 	}
 
-	err := c.reservations.reserve(instanceID, team)
+	if limits.RamMB != nil && usage.RamMB+ramMB > *limits.RamMB {
+		return &LimitExceededError{Resource: "MB of RAM in use", Limit: *limits.RamMB}, nil
+	}
+
+	if limits.StartsPerMinute != nil && usage.StartsPerMinute >= *limits.StartsPerMinute {
+		return &LimitExceededError{Resource: "sandbox starts per minute", Limit: *limits.StartsPerMinute}, nil
+	}
+
+	err := c.reservations.reserve(instanceID, team, vcpu, ramMB)
 	if err != nil {
 		return fmt.Errorf("error when reserving instance: %w", err), nil
 	}
 
+	c.reservations.starts[team] = append(c.reservations.starts[team], now)
+
 	return nil, func() {
 		// We will call this method with defer to ensure the reservation is released even if the function panics/returns an error.
 		c.reservations.release(instanceID)
@@ -0,0 +1,113 @@
+package instance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReservationTestCache() *InstanceCache {
+	return &InstanceCache{
+		cache:        newLifecycleCache[*InstanceInfo](),
+		reservations: NewReservationCache(),
+	}
+}
+
+func limit(value int64) *int64 {
+	return &value
+}
+
+func assertLimitExceeded(t *testing.T, err error, resource string) {
+	t.Helper()
+
+	var limitErr *LimitExceededError
+	require.True(t, errors.As(err, &limitErr), "expected limit exceeded error, got %v", err)
+	assert.Equal(t, resource, limitErr.Resource)
+}
+
+func TestReserveConcurrentInstances(t *testing.T) {
+	c := newReservationTestCache()
+	team := uuid.New()
+	limits := ReservationLimits{ConcurrentInstances: 1}
+
+	err, release := c.Reserve("sbx-1", team, 2, 512, limits)
+	require.NoError(t, err)
+
+	err, _ = c.Reserve("sbx-2", team, 2, 512, limits)
+	assertLimitExceeded(t, err, "concurrent sandboxes")
+
+	// Other teams are not affected.
+	err, _ = c.Reserve("sbx-3", uuid.New(), 2, 512, limits)
+	require.NoError(t, err)
+
+	release()
+
+	err, _ = c.Reserve("sbx-2", team, 2, 512, limits)
+	assert.NoError(t, err)
+}
+
+func TestReserveResources(t *testing.T) {
+	c := newReservationTestCache()
+	team := uuid.New()
+	limits := ReservationLimits{ConcurrentInstances: 10, VCpu: limit(4), RamMB: limit(1024)}
+
+	err, _ := c.Reserve("sbx-1", team, 2, 512, limits)
+	require.NoError(t, err)
+
+	err, _ = c.Reserve("sbx-2", team, 4, 256, limits)
+	assertLimitExceeded(t, err, "vCPUs in use")
+
+	err, _ = c.Reserve("sbx-2", team, 2, 1024, limits)
+	assertLimitExceeded(t, err, "MB of RAM in use")
+
+	err, _ = c.Reserve("sbx-2", team, 2, 512, limits)
+	require.NoError(t, err)
+
+	usage := c.TeamUsage(team)
+	assert.Equal(t, int64(2), usage.Instances)
+	assert.Equal(t, int64(4), usage.VCpu)
+	assert.Equal(t, int64(1024), usage.RamMB)
+}
+
+func TestReserveStartsPerMinute(t *testing.T) {
+	c := newReservationTestCache()
+	team := uuid.New()
+	limits := ReservationLimits{ConcurrentInstances: 10, StartsPerMinute: limit(2)}
+
+	for _, id := range []string{"sbx-1", "sbx-2"} {
+		err, release := c.Reserve(id, team, 2, 512, limits)
+		require.NoError(t, err)
+
+		release()
+	}
+
+	// The released reservations still count towards the rate limit.
+	err, _ := c.Reserve("sbx-3", team, 2, 512, limits)
+	assertLimitExceeded(t, err, "sandbox starts per minute")
+
+	assert.Equal(t, int64(2), c.TeamUsage(team).StartsPerMinute)
+	assert.Equal(t, int64(0), c.TeamUsage(team).Instances)
+}
+
+func TestReserveAlreadyReserved(t *testing.T) {
+	c := newReservationTestCache()
+	team := uuid.New()
+	limits := ReservationLimits{ConcurrentInstances: 10}
+
+	err, release := c.Reserve("sbx-1", team, 2, 512, limits)
+	require.NoError(t, err)
+
+	err, _ = c.Reserve("sbx-1", team, 2, 512, limits)
+
+	var reservedErr *AlreadyReservedError
+	require.True(t, errors.As(err, &reservedErr), "expected already reserved error, got %v", err)
+	assert.Equal(t, "sbx-1", reservedErr.InstanceID)
+
+	release()
+
+	err, _ = c.Reserve("sbx-1", team, 2, 512, limits)
+	assert.NoError(t, err)
+}
@@ -59,7 +59,12 @@ func (c *InstanceCache) Sync(ctx context.Context, instances []*InstanceInfo, nod
 
 	// Delete instances that are not in Orchestrator anymore
 	for _, item := range c.cache.Items() {
-		if item.Instance.ClientID != nodeID {
+		if item.GetClientID() != nodeID {
+			continue
+		}
+
+		// The migrating instance can be missing on both nodes.
+		if item.IsMigrating() {
 			continue
 		}
 		_, found := instanceMap[item.Instance.SandboxID]
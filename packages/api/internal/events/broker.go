@@ -0,0 +1,254 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+)
+
+const (
+	// bufferSize is the number of the latest events of each team kept for resuming the streams.
+	bufferSize = 1000
+	// retention is how long the events are kept for resuming the streams.
+	retention = time.Hour
+	// subscriberBufferSize is the number of events waiting to be sent to a subscriber,
+	// a subscriber that can't keep up is closed and has to resume the stream.
+	subscriberBufferSize = 256
+
+	pruneInterval = time.Minute
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrCursorExpired = errors.New("events after the cursor are not available anymore")
+)
+
+// SandboxEvent is a lifecycle transition of the sandbox, the JSON encoding matches the SandboxEvent schema in the API spec.
+type SandboxEvent struct {
+	ID         string             `json:"id"`
+	Type       webhooks.EventType `json:"type"`
+	Timestamp  time.Time          `json:"timestamp"`
+	SandboxID  string             `json:"sandboxID"`
+	TemplateID string             `json:"templateID"`
+	Alias      *string            `json:"alias,omitempty"`
+	Metadata   map[string]string  `json:"metadata,omitempty"`
+
+	seq uint64
+}
+
+type Subscription struct {
+	// Events are the buffered events after the cursor, they should be sent before the events from C.
+	Events []SandboxEvent
+	// C is closed when the subscriber is too slow or the subscription is closed.
+	C <-chan SandboxEvent
+
+	broker *Broker
+	teamID uuid.UUID
+	ch     chan SandboxEvent
+}
+
+type teamEvents struct {
+	events []SandboxEvent
+	// dropped is the sequence number of the latest event removed from the buffer.
+	dropped     uint64
+	subscribers map[*Subscription]struct{}
+}
+
+// Broker keeps the latest sandbox events of the teams in memory and fans them out to the subscribers.
+//
+// The events are not shared between the API replicas, each replica emits the events of the sandboxes it syncs from the nodes.
+// The streams can be resumed only on the replica that sent the events, the event IDs contain the ID of the broker,
+// so the cursors from the other replicas or from before the restart of the API are detected as expired.
+type Broker struct {
+	mu    sync.Mutex
+	id    string
+	seq   uint64
+	teams map[uuid.UUID]*teamEvents
+}
+
+func NewBroker(ctx context.Context) *Broker {
+	b := newBroker()
+
+	go b.startPruning(ctx)
+
+	return b
+}
+
+func newBroker() *Broker {
+	return &Broker{
+		id:    uuid.NewString(),
+		teams: make(map[uuid.UUID]*teamEvents),
+	}
+}
+
+func (b *Broker) eventID(seq uint64) string {
+	return fmt.Sprintf("%s.%d", b.id, seq)
+}
+
+// parseCursor returns the sequence number of the event, the cursor is rejected if the event was sent by another broker.
+func (b *Broker) parseCursor(cursor string) (uint64, error) {
+	brokerID, seq, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return 0, ErrInvalidCursor
+	}
+
+	after, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	if brokerID != b.id {
+		return 0, ErrCursorExpired
+	}
+
+	return after, nil
+}
+
+func (b *Broker) team(teamID uuid.UUID) *teamEvents {
+	t, ok := b.teams[teamID]
+	if !ok {
+		t = &teamEvents{
+			dropped:     b.seq,
+			subscribers: make(map[*Subscription]struct{}),
+		}
+		b.teams[teamID] = t
+	}
+
+	return t
+}
+
+// Publish assigns the ID to the event and sends it to the subscribers of the team.
+func (b *Broker) Publish(teamID uuid.UUID, event SandboxEvent) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event.seq = b.seq
+	event.ID = b.eventID(b.seq)
+
+	t := b.team(teamID)
+
+	t.events = append(t.events, event)
+	if len(t.events) > bufferSize {
+		t.dropped = t.events[0].seq
+		t.events[0] = SandboxEvent{}
+		t.events = t.events[1:]
+	}
+
+	for sub := range t.subscribers {
+		select {
+		case sub.ch <- event:
+		default:
+			delete(t.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// Subscribe starts receiving the events of the team, if the cursor is set the buffered events after it are returned.
+func (b *Broker) Subscribe(teamID uuid.UUID, cursor *string) (*Subscription, error) {
+	var after uint64
+
+	if cursor != nil {
+		var err error
+
+		after, err = b.parseCursor(*cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t := b.team(teamID)
+
+	var replay []SandboxEvent
+
+	if cursor != nil {
+		if after < t.dropped || after > b.seq {
+			return nil, ErrCursorExpired
+		}
+
+		for _, event := range t.events {
+			if event.seq > after {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	ch := make(chan SandboxEvent, subscriberBufferSize)
+
+	sub := &Subscription{
+		Events: replay,
+		C:      ch,
+		broker: b,
+		teamID: teamID,
+		ch:     ch,
+	}
+
+	t.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	t, ok := s.broker.teams[s.teamID]
+	if !ok {
+		return
+	}
+
+	if _, ok := t.subscribers[s]; ok {
+		delete(t.subscribers, s)
+		close(s.ch)
+	}
+}
+
+func (b *Broker) startPruning(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.prune(time.Now().Add(-retention))
+		}
+	}
+}
+
+// prune removes the events older than the time and the teams without events and subscribers.
+func (b *Broker) prune(before time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for teamID, t := range b.teams {
+		n := 0
+		for n < len(t.events) && t.events[n].Timestamp.Before(before) {
+			t.dropped = t.events[n].seq
+			n++
+		}
+
+		t.events = t.events[n:]
+
+		if len(t.events) == 0 && len(t.subscribers) == 0 {
+			delete(b.teams, teamID)
+		}
+	}
+}
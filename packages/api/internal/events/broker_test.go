@@ -0,0 +1,109 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerResumeFromCursor(t *testing.T) {
+	b := newBroker()
+	teamID := uuid.New()
+
+	sub, err := b.Subscribe(teamID, nil)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	for _, sandboxID := range []string{"a", "b", "c"} {
+		b.Publish(teamID, SandboxEvent{SandboxID: sandboxID, Timestamp: time.Now()})
+	}
+
+	first := <-sub.C
+
+	resumed, err := b.Subscribe(teamID, &first.ID)
+	require.NoError(t, err)
+	defer resumed.Close()
+
+	require.Len(t, resumed.Events, 2)
+	assert.Equal(t, "b", resumed.Events[0].SandboxID)
+	assert.Equal(t, "c", resumed.Events[1].SandboxID)
+}
+
+func TestBrokerOtherTeamsEvents(t *testing.T) {
+	b := newBroker()
+	teamID := uuid.New()
+
+	sub, err := b.Subscribe(teamID, nil)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	b.Publish(uuid.New(), SandboxEvent{SandboxID: "other"})
+	b.Publish(teamID, SandboxEvent{SandboxID: "own"})
+
+	event := <-sub.C
+	assert.Equal(t, "own", event.SandboxID)
+}
+
+func TestBrokerExpiredCursor(t *testing.T) {
+	b := newBroker()
+	teamID := uuid.New()
+
+	for i := 0; i < bufferSize+1; i++ {
+		b.Publish(teamID, SandboxEvent{Timestamp: time.Now()})
+	}
+
+	// The first event after the cursor was dropped from the buffer.
+	oldest := b.eventID(0)
+	_, err := b.Subscribe(teamID, &oldest)
+	assert.True(t, errors.Is(err, ErrCursorExpired))
+
+	for _, invalid := range []string{"abc", b.id + ".abc"} {
+		_, err = b.Subscribe(teamID, &invalid)
+		assert.True(t, errors.Is(err, ErrInvalidCursor), invalid)
+	}
+}
+
+func TestBrokerOtherBrokerCursor(t *testing.T) {
+	b := newBroker()
+	// The other replica or the broker from before the restart of the API.
+	other := newBroker()
+	teamID := uuid.New()
+
+	b.Publish(teamID, SandboxEvent{Timestamp: time.Now()})
+	other.Publish(teamID, SandboxEvent{Timestamp: time.Now()})
+
+	cursor := other.eventID(1)
+	_, err := b.Subscribe(teamID, &cursor)
+	assert.True(t, errors.Is(err, ErrCursorExpired))
+
+	cursor = b.eventID(1)
+	sub, err := b.Subscribe(teamID, &cursor)
+	require.NoError(t, err)
+	sub.Close()
+}
+
+func TestBrokerSlowSubscriberClosed(t *testing.T) {
+	b := newBroker()
+	teamID := uuid.New()
+
+	sub, err := b.Subscribe(teamID, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < subscriberBufferSize+1; i++ {
+		b.Publish(teamID, SandboxEvent{})
+	}
+
+	received := 0
+	for range sub.C {
+		received++
+	}
+
+	assert.Equal(t, subscriberBufferSize, received)
+
+	// Closing the already closed subscription is a no-op.
+	sub.Close()
+}
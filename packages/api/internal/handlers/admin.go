@@ -53,7 +53,14 @@ func (a *APIStore) PostNodesNodeID(c *gin.Context, nodeId api.NodeID) {
 		return
 	}
 
-	node.SetStatus(body.Status)
+	err = a.orchestrator.SetNodeStatus(ctx, node, body.Status)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting node status")
+
+		telemetry.ReportCriticalError(ctx, err)
+
+		return
+	}
 
 	c.Status(http.StatusNoContent)
 }
@@ -31,6 +31,13 @@ func (a *APIStore) startSandbox(
 	baseTemplateID string,
 	autoPause bool,
 ) (*api.Sandbox, *api.APIError) {
+	apiKeyErr := checkAPIKeyTemplate(team, baseTemplateID)
+	if apiKeyErr != nil {
+		telemetry.ReportError(ctx, apiKeyErr.Err)
+
+		return nil, apiKeyErr
+	}
+
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
 
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const maxForkCount = 10
+
+func (a *APIStore) PostSandboxesSandboxIDFork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	// Get team from context, use TeamContextKey
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDForkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "Parsed body")
+
+	count := 1
+	if body.Count != nil {
+		count = int(*body.Count)
+	}
+
+	if count < 1 || count > maxForkCount {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Count must be between 1 and %d", maxForkCount))
+
+		return
+	}
+
+	timeout := instance.InstanceExpiration
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	autoPause := instance.InstanceAutoPauseDefault
+	if body.AutoPause != nil {
+		autoPause = *body.AutoPause
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error forking sandbox - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamInfo.Team.ID {
+		errMsg := fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamInfo.Team.ID.String())
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error forking sandbox - sandbox '%s' does not belong to your team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		return
+	}
+
+	apiKeyErr := checkAPIKeyTemplate(teamInfo, sbx.Instance.TemplateID)
+	if apiKeyErr != nil {
+		telemetry.ReportError(ctx, apiKeyErr.Err)
+		a.sendAPIStoreError(c, apiKeyErr.Code, apiKeyErr.ClientMsg)
+
+		return
+	}
+
+	// All the forks are started from a single build, it's stored as long as any of them runs.
+	if !a.checkSnapshotStorage(c, teamInfo.Tier, sbx) {
+		return
+	}
+
+	sandboxIDs := make([]string, 0, count)
+	for range count {
+		sandboxIDs = append(sandboxIDs, InstanceIDPrefix+id.Generate())
+	}
+
+	sbxlogger.E(&sbxlogger.SandboxMetadata{
+		SandboxID:  sandboxID,
+		TemplateID: sbx.Instance.TemplateID,
+		TeamID:     teamInfo.Team.ID.String(),
+	}).Debug("Started forking sandbox", zap.Strings("fork_ids", sandboxIDs))
+
+	sandboxes, forkErr := a.orchestrator.ForkInstance(ctx, sbx, teamInfo, sandboxIDs, timeout, autoPause)
+	if forkErr != nil {
+		zap.L().Error("Failed to fork sandbox", zap.String("sandbox_id", sandboxID), zap.Error(forkErr.Err))
+		a.sendAPIStoreError(c, forkErr.Code, forkErr.ClientMsg)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "Forked sandbox")
+
+	c.JSON(http.StatusCreated, &sandboxes)
+}
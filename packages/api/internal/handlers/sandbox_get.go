@@ -39,7 +39,7 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
 	a.posthog.CreateAnalyticsTeamEvent(team.ID.String(), "get running instance", properties)
 
-	build, err := a.db.Client.EnvBuild.Query().Where(envbuild.ID(*info.BuildID)).First(ctx)
+	build, err := a.db.Client.EnvBuild.Query().Where(envbuild.ID(*info.GetBuildID())).First(ctx)
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, err)
 		c.JSON(http.StatusInternalServerError, fmt.Sprintf("Error getting build for instance %s", id))
@@ -56,7 +56,7 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 	}
 
 	instance := api.RunningSandbox{
-		ClientID:   info.Instance.ClientID,
+		ClientID:   info.GetClientID(),
 		TemplateID: info.Instance.TemplateID,
 		Alias:      info.Instance.Alias,
 		SandboxID:  info.Instance.SandboxID,
@@ -21,7 +21,8 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 	ctx := c.Request.Context()
 	// Get team from context, use TeamContextKey
 
-	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+	teamID := teamInfo.Team.ID
 
 	sandboxID = utils.ShortID(sandboxID)
 
@@ -59,6 +60,10 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 		return
 	}
 
+	if !a.checkSnapshotStorage(c, teamInfo.Tier, sbx) {
+		return
+	}
+
 	found := a.orchestrator.DeleteInstance(ctx, sandboxID, true)
 	if !found {
 		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error pausing sandbox - sandbox '%s' was not found", sandboxID))
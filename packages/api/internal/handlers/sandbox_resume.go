@@ -84,7 +84,7 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 			zap.Time("end_time", sbxCache.GetEndTime()),
 			zap.Bool("auto_pause", sbxCache.AutoPause.Load()),
 			zap.Time("start_time", sbxCache.StartTime),
-			zap.String("node_id", sbxCache.Node.ID),
+			zap.String("node_id", sbxCache.GetNode().ID),
 		)
 		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox %s is already running", sandboxID))
 
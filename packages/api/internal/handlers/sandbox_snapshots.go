@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func checkpointToAPI(checkpoint *models.Checkpoint) api.SandboxSnapshot {
+	return api.SandboxSnapshot{
+		SnapshotID: checkpoint.ID,
+		SandboxID:  checkpoint.SandboxID,
+		Name:       checkpoint.Name,
+		BuildID:    checkpoint.BuildID,
+		CreatedAt:  checkpoint.CreatedAt,
+	}
+}
+
+// sandboxSnapshotter is the part of the orchestrator used for creating the snapshots of the running sandboxes.
+type sandboxSnapshotter interface {
+	GetSandbox(sandboxID string) (*instance.InstanceInfo, error)
+	DeleteInstance(ctx context.Context, sandboxID string, pause bool) bool
+	CheckpointInstance(ctx context.Context, sbx *instance.InstanceInfo, teamID uuid.UUID) (*models.EnvBuild, error)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDSnapshots(c *gin.Context, sandboxID api.SandboxID) {
+	a.createSandboxSnapshot(c, a.orchestrator, sandboxID)
+}
+
+func (a *APIStore) createSandboxSnapshot(c *gin.Context, snapshotter sandboxSnapshotter, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	// Get team from context, use TeamContextKey
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+	teamID := teamInfo.Team.ID
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDSnapshotsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "Parsed body")
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	sbx, err := snapshotter.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error creating snapshot - sandbox '%s' was not found", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		errMsg := fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String())
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error creating snapshot - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	if !a.checkSnapshotStorage(c, teamInfo.Tier, sbx) {
+		return
+	}
+
+	sbxlogger.E(&sbxlogger.SandboxMetadata{
+		SandboxID:  sandboxID,
+		TemplateID: sbx.Instance.TemplateID,
+		TeamID:     teamID.String(),
+	}).Debug("Started creating sandbox snapshot")
+
+	var build *models.EnvBuild
+
+	if body.Pause != nil && *body.Pause {
+		found := snapshotter.DeleteInstance(ctx, sandboxID, true)
+		if !found {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error creating snapshot - sandbox '%s' was not found", sandboxID))
+
+			return
+		}
+
+		_, err = sbx.Pausing.WaitWithContext(ctx)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error pausing sandbox: %s", err))
+
+			return
+		}
+
+		_, build, err = a.db.GetLastSnapshot(ctx, sandboxID, teamID)
+		if err != nil {
+			zap.L().Error("Error getting last snapshot", zap.String("sandboxID", sandboxID), zap.Error(err))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating snapshot")
+
+			return
+		}
+	} else {
+		build, err = snapshotter.CheckpointInstance(ctx, sbx, teamID)
+		if err != nil {
+			zap.L().Error("Failed to checkpoint sandbox", zap.String("sandboxID", sandboxID), zap.Error(err))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating snapshot")
+
+			return
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Created snapshot build")
+
+	checkpoint, err := a.db.CreateCheckpoint(ctx, body.Name, sandboxID, sbx.Instance.TemplateID, sbx.Metadata, build)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating snapshot")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, checkpointToAPI(checkpoint))
+}
+
+func (a *APIStore) GetSandboxesSandboxIDSnapshots(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	checkpoints, err := a.db.GetCheckpoints(ctx, sandboxID, teamID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error listing snapshots")
+
+		return
+	}
+
+	snapshots := make([]api.SandboxSnapshot, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		snapshots = append(snapshots, checkpointToAPI(checkpoint))
+	}
+
+	c.JSON(http.StatusOK, snapshots)
+}
+
+func (a *APIStore) PostSnapshotsSnapshotIDRestore(c *gin.Context, snapshotID api.SnapshotID) {
+	ctx := c.Request.Context()
+
+	// Get team from context, use TeamContextKey
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSnapshotsSnapshotIDRestoreJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "Parsed body")
+
+	timeout := instance.InstanceExpiration
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	autoPause := instance.InstanceAutoPauseDefault
+	if body.AutoPause != nil {
+		autoPause = *body.AutoPause
+	}
+
+	checkpoint, err := a.db.GetCheckpoint(ctx, snapshotID, teamInfo.Team.ID)
+	if errors.Is(err, db.CheckpointNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Snapshot '%s' was not found", snapshotID))
+
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Error getting snapshot", zap.String("snapshotID", snapshotID.String()), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting snapshot")
+
+		return
+	}
+
+	sandboxID := checkpoint.SandboxID
+
+	_, err = a.orchestrator.GetSandbox(sandboxID)
+	if err == nil {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox %s is running, kill or pause it before restoring the snapshot", sandboxID))
+
+		return
+	}
+
+	var clientID *string
+
+	// Wait for any pausing for this sandbox in progress.
+	pausedOnNode, err := a.orchestrator.WaitForPause(ctx, sandboxID)
+	if err != nil && !errors.Is(err, instance.ErrPausingInstanceNotFound) {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error while pausing sandbox %s: %s", sandboxID, err))
+
+		return
+	}
+
+	if err == nil {
+		// If the pausing was in progress, prefer to restore on the node where the pausing happened.
+		clientID = &pausedOnNode.ID
+	}
+
+	sbxlogger.E(&sbxlogger.SandboxMetadata{
+		SandboxID:  sandboxID,
+		TemplateID: checkpoint.EnvID,
+		TeamID:     teamInfo.Team.ID.String(),
+	}).Debug("Started restoring sandbox snapshot", zap.String("snapshot_id", snapshotID.String()))
+
+	sbx, createErr := a.startSandbox(
+		ctx,
+		sandboxID,
+		timeout,
+		nil,
+		checkpoint.Metadata,
+		"",
+		teamInfo,
+		checkpoint.Edges.Build,
+		&c.Request.Header,
+		true,
+		clientID,
+		checkpoint.BaseEnvID,
+		autoPause,
+	)
+	if createErr != nil {
+		zap.L().Error("Failed to restore sandbox", zap.Error(createErr.Err))
+		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, &sbx)
+}
+
+func (a *APIStore) DeleteSnapshotsSnapshotID(c *gin.Context, snapshotID api.SnapshotID) {
+	ctx := c.Request.Context()
+
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	err := a.db.DeleteCheckpoint(ctx, snapshotID, teamID)
+	if errors.Is(err, db.CheckpointNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Snapshot '%s' was not found", snapshotID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting snapshot")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
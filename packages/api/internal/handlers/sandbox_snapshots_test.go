@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+// fakeSnapshotter creates the builds in the database the same way as the orchestrator, without any node.
+type fakeSnapshotter struct {
+	db        *db.DB
+	sandboxes map[string]*instance.InstanceInfo
+}
+
+func (f *fakeSnapshotter) snapshotInfo(sbx *instance.InstanceInfo) *db.SnapshotInfo {
+	return &db.SnapshotInfo{
+		SandboxID:      sbx.Instance.SandboxID,
+		BaseTemplateID: sbx.Instance.TemplateID,
+		VCPU:           sbx.VCpu,
+		RAMMB:          sbx.RamMB,
+		Metadata:       sbx.Metadata,
+	}
+}
+
+func (f *fakeSnapshotter) GetSandbox(sandboxID string) (*instance.InstanceInfo, error) {
+	sbx, ok := f.sandboxes[sandboxID]
+	if !ok {
+		return nil, fmt.Errorf("sandbox '%s' not found", sandboxID)
+	}
+
+	return sbx, nil
+}
+
+func (f *fakeSnapshotter) DeleteInstance(ctx context.Context, sandboxID string, pause bool) bool {
+	sbx, ok := f.sandboxes[sandboxID]
+	if !ok {
+		return false
+	}
+
+	delete(f.sandboxes, sandboxID)
+
+	if !pause {
+		return true
+	}
+
+	build, err := f.db.NewSnapshotBuild(ctx, f.snapshotInfo(sbx), *sbx.TeamID)
+	if err == nil {
+		err = f.db.EnvBuildSetStatus(ctx, *build.EnvID, build.ID, envbuild.StatusSuccess)
+	}
+
+	sbx.PauseDone(err)
+
+	return true
+}
+
+func (f *fakeSnapshotter) CheckpointInstance(ctx context.Context, sbx *instance.InstanceInfo, teamID uuid.UUID) (*models.EnvBuild, error) {
+	build, err := f.db.NewForkBuild(ctx, f.snapshotInfo(sbx), teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.db.EnvBuildSetStatus(ctx, *build.EnvID, build.ID, envbuild.StatusSuccess)
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+func postSandboxSnapshot(t *testing.T, store *APIStore, snapshotter sandboxSnapshotter, team authcache.AuthTeamInfo, sandboxID string, body api.PostSandboxesSandboxIDSnapshotsJSONRequestBody) *httptest.ResponseRecorder {
+	t.Helper()
+
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/sandboxes/"+sandboxID+"/snapshots", bytes.NewReader(data))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set(auth.TeamContextKey, team)
+
+	store.createSandboxSnapshot(c, snapshotter, sandboxID)
+
+	return w
+}
+
+func newSnapshotTest(t *testing.T) (*APIStore, *fakeSnapshotter, authcache.AuthTeamInfo) {
+	database := dbtest.New(t)
+	team, tier := dbtest.CreateTeam(t, database, 10)
+
+	sbx := instance.NewInstanceInfo(
+		&api.Sandbox{SandboxID: "sbx", TemplateID: "base", ClientID: "node"},
+		&team.ID, nil, map[string]string{"key": "value"}, time.Hour, time.Now(), time.Now().Add(time.Hour),
+		2, 1024, 512, "", "", "", nil, false,
+	)
+
+	snapshotter := &fakeSnapshotter{db: database, sandboxes: map[string]*instance.InstanceInfo{"sbx": sbx}}
+
+	return &APIStore{db: database}, snapshotter, authcache.AuthTeamInfo{Team: team, Tier: tier}
+}
+
+func TestCreateSandboxSnapshotWithoutPause(t *testing.T) {
+	ctx := context.Background()
+	store, snapshotter, team := newSnapshotTest(t)
+
+	name := "checkpoint"
+	w := postSandboxSnapshot(t, store, snapshotter, team, "sbx", api.PostSandboxesSandboxIDSnapshotsJSONRequestBody{Name: &name})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var snapshot api.SandboxSnapshot
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &snapshot))
+	assert.Equal(t, "sbx", snapshot.SandboxID)
+	assert.Equal(t, &name, snapshot.Name)
+
+	// The sandbox keeps running and must not be resumable.
+	_, err := snapshotter.GetSandbox("sbx")
+	assert.NoError(t, err)
+
+	_, _, err = store.db.GetLastSnapshot(ctx, "sbx", team.Team.ID)
+	assert.ErrorIs(t, err, db.SnapshotNotFound{})
+
+	checkpoint, err := store.db.GetCheckpoint(ctx, snapshot.SnapshotID, team.Team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.BuildID, checkpoint.BuildID)
+	assert.Equal(t, envbuild.StatusSuccess, checkpoint.Edges.Build.Status)
+}
+
+func TestCreateSandboxSnapshotWithPause(t *testing.T) {
+	ctx := context.Background()
+	store, snapshotter, team := newSnapshotTest(t)
+
+	pause := true
+	w := postSandboxSnapshot(t, store, snapshotter, team, "sbx", api.PostSandboxesSandboxIDSnapshotsJSONRequestBody{Pause: &pause})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var snapshot api.SandboxSnapshot
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &snapshot))
+
+	_, err := snapshotter.GetSandbox("sbx")
+	assert.Error(t, err)
+
+	// The checkpoint points to the build the sandbox is resumed from.
+	_, build, err := store.db.GetLastSnapshot(ctx, "sbx", team.Team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, build.ID, snapshot.BuildID)
+}
+
+func TestCreateSandboxSnapshotNotFound(t *testing.T) {
+	store, snapshotter, team := newSnapshotTest(t)
+
+	w := postSandboxSnapshot(t, store, snapshotter, team, "unknown", api.PostSandboxesSandboxIDSnapshotsJSONRequestBody{})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCreateSandboxSnapshotOtherTeam(t *testing.T) {
+	store, snapshotter, _ := newSnapshotTest(t)
+	otherTeam, otherTier := dbtest.CreateTeam(t, store.db, 10)
+
+	w := postSandboxSnapshot(t, store, snapshotter, authcache.AuthTeamInfo{Team: otherTeam, Tier: otherTier}, "sbx", api.PostSandboxesSandboxIDSnapshotsJSONRequestBody{})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	_, err := snapshotter.GetSandbox("sbx")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/events"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// sandboxEventsKeepAliveInterval is the interval of the comments sent to keep the idle stream open.
+	sandboxEventsKeepAliveInterval = 15 * time.Second
+	// sandboxEventsWriteTimeout overrides the server write timeout for each write to the long-lived stream.
+	sandboxEventsWriteTimeout = 30 * time.Second
+)
+
+func writeSandboxEvent(c *gin.Context, event events.SandboxEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error marshalling event: %w", err)
+	}
+
+	_, err = fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	if err != nil {
+		return fmt.Errorf("error writing event: %w", err)
+	}
+
+	return nil
+}
+
+func (a *APIStore) GetSandboxesEvents(c *gin.Context, params api.GetSandboxesEventsParams) {
+	ctx := c.Request.Context()
+
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+	teamID := teamInfo.Team.ID
+
+	// The browsers send the ID of the last received event when reconnecting.
+	cursor := params.Cursor
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		cursor = &lastEventID
+	}
+
+	sub, err := a.sandboxEvents.Subscribe(teamID, cursor)
+	if errors.Is(err, events.ErrInvalidCursor) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid cursor '%s'", *cursor))
+
+		return
+	}
+
+	if errors.Is(err, events.ErrCursorExpired) {
+		a.sendAPIStoreError(c, http.StatusGone, "The events after the cursor are not available anymore, list the sandboxes again and start a new stream")
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error subscribing to the sandbox events")
+
+		return
+	}
+	defer sub.Close()
+
+	rc := http.NewResponseController(c.Writer)
+
+	write := func(fn func() error) error {
+		// The server write timeout would close the stream otherwise.
+		err := rc.SetWriteDeadline(time.Now().Add(sandboxEventsWriteTimeout))
+		if err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return fmt.Errorf("error setting write deadline: %w", err)
+		}
+
+		err = fn()
+		if err != nil {
+			return err
+		}
+
+		return rc.Flush()
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Disable the buffering in the proxies.
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	err = write(func() error {
+		for _, event := range sub.Events {
+			err := writeSandboxEvent(c, event)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		zap.L().Debug("error writing sandbox events", zap.String("team_id", teamID.String()), zap.Error(err))
+
+		return
+	}
+
+	keepAlive := time.NewTicker(sandboxEventsKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.C:
+			// The subscription is closed when the client can't keep up, it should resume the stream from the last event.
+			if !ok {
+				return
+			}
+
+			err = write(func() error {
+				return writeSandboxEvent(c, event)
+			})
+		case <-keepAlive.C:
+			err = write(func() error {
+				_, err := fmt.Fprint(c.Writer, ": keep-alive\n\n")
+
+				return err
+			})
+		}
+
+		if err != nil {
+			zap.L().Debug("error writing sandbox events", zap.String("team_id", teamID.String()), zap.Error(err))
+
+			return
+		}
+	}
+}
@@ -2,11 +2,14 @@ package handlers
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"net/url"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -15,59 +18,80 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
-func (a *APIStore) getSandboxes(ctx context.Context, teamID uuid.UUID, query *string) ([]api.RunningSandbox, error) {
-	instanceInfo := a.orchestrator.GetSandboxes(ctx, &teamID)
+const nextTokenHeader = "X-Next-Token"
 
-	if query != nil {
-		// Unescape query
-		query, err := url.QueryUnescape(*query)
+// parseMetadataQuery parses the URL encoded metadata filters, both key and value are also unescaped.
+func parseMetadataQuery(query string) (map[string]string, error) {
+	query, err := url.QueryUnescape(query)
+	if err != nil {
+		return nil, fmt.Errorf("error when unescaping query: %w", err)
+	}
+
+	filters := make(map[string]string)
+
+	for _, filter := range strings.Split(query, "&") {
+		parts := strings.Split(filter, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid key value pair in query")
+		}
+
+		key, err := url.QueryUnescape(parts[0])
 		if err != nil {
-			return nil, fmt.Errorf("error when unescaping query: %w", err)
+			return nil, fmt.Errorf("error when unescaping key: %w", err)
 		}
 
-		// Parse filters, both key and value are also unescaped
-		filters := make(map[string]string)
+		value, err := url.QueryUnescape(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("error when unescaping value: %w", err)
+		}
 
-		for _, filter := range strings.Split(query, "&") {
-			parts := strings.Split(filter, "=")
-			if len(parts) != 2 {
-				return nil, fmt.Errorf("invalid key value pair in query")
-			}
+		filters[key] = value
+	}
 
-			key, err := url.QueryUnescape(parts[0])
-			if err != nil {
-				return nil, fmt.Errorf("error when unescaping key: %w", err)
-			}
+	return filters, nil
+}
 
-			value, err := url.QueryUnescape(parts[1])
-			if err != nil {
-				return nil, fmt.Errorf("error when unescaping value: %w", err)
-			}
+func matchesMetadata(metadata map[string]string, filters map[string]string) bool {
+	if len(filters) > 0 && metadata == nil {
+		return false
+	}
+
+	for key, value := range filters {
+		if metadataValue, ok := metadata[key]; !ok || metadataValue != value {
+			return false
+		}
+	}
 
-			filters[key] = value
+	return true
+}
+
+// sandboxLister is the part of the orchestrator used for listing the running sandboxes.
+type sandboxLister interface {
+	GetSandboxes(ctx context.Context, teamID *uuid.UUID) []*instance.InstanceInfo
+}
+
+func (a *APIStore) getSandboxes(ctx context.Context, teamID uuid.UUID, query *string) ([]api.RunningSandbox, error) {
+	return a.runningSandboxes(ctx, teamID, a.orchestrator.GetSandboxes(ctx, &teamID), query)
+}
+
+func (a *APIStore) runningSandboxes(ctx context.Context, teamID uuid.UUID, instanceInfo []*instance.InstanceInfo, query *string) ([]api.RunningSandbox, error) {
+	if query != nil {
+		filters, err := parseMetadataQuery(*query)
+		if err != nil {
+			return nil, err
 		}
 
 		// Filter instances to match all filters
 		n := 0
 		for _, instance := range instanceInfo {
-			if instance.Metadata == nil {
-				continue
-			}
-
-			matchesAll := true
-			for key, value := range filters {
-				if metadataValue, ok := instance.Metadata[key]; !ok || metadataValue != value {
-					matchesAll = false
-					break
-				}
-			}
-
-			if matchesAll {
+			if matchesMetadata(instance.Metadata, filters) {
 				instanceInfo[n] = instance
 				n++
 			}
@@ -77,6 +101,8 @@ func (a *APIStore) getSandboxes(ctx context.Context, teamID uuid.UUID, query *st
 		instanceInfo = instanceInfo[:n]
 	}
 
+	// The build changes when the sandbox is migrated, it's read once so it matches the loaded builds.
+	sandboxBuilds := make(map[string]uuid.UUID)
 	buildIDs := make([]uuid.UUID, 0)
 	for _, info := range instanceInfo {
 		if info.TeamID == nil {
@@ -87,7 +113,10 @@ func (a *APIStore) getSandboxes(ctx context.Context, teamID uuid.UUID, query *st
 			continue
 		}
 
-		buildIDs = append(buildIDs, *info.BuildID)
+		if buildID := info.GetBuildID(); buildID != nil {
+			sandboxBuilds[info.Instance.SandboxID] = *buildID
+			buildIDs = append(buildIDs, *buildID)
+		}
 	}
 
 	builds, err := a.db.Client.EnvBuild.Query().Where(envbuild.IDIn(buildIDs...)).All(ctx)
@@ -113,19 +142,25 @@ func (a *APIStore) getSandboxes(ctx context.Context, teamID uuid.UUID, query *st
 			continue
 		}
 
-		if info.BuildID == nil {
+		buildID, ok := sandboxBuilds[info.Instance.SandboxID]
+		if !ok {
 			continue
 		}
 
+		build := buildsMap[buildID]
+
+		state := api.Running
+
 		instance := api.RunningSandbox{
-			ClientID:   info.Instance.ClientID,
+			ClientID:   info.GetClientID(),
 			TemplateID: info.Instance.TemplateID,
 			Alias:      info.Instance.Alias,
 			SandboxID:  info.Instance.SandboxID,
 			StartedAt:  info.StartTime,
-			CpuCount:   int32(buildsMap[*info.BuildID].Vcpu),
-			MemoryMB:   int32(buildsMap[*info.BuildID].RAMMB),
+			CpuCount:   int32(build.Vcpu),
+			MemoryMB:   int32(build.RAMMB),
 			EndAt:      info.GetEndTime(),
+			State:      &state,
 		}
 
 		if info.Metadata != nil {
@@ -136,7 +171,7 @@ func (a *APIStore) getSandboxes(ctx context.Context, teamID uuid.UUID, query *st
 		sandboxes = append(sandboxes, instance)
 	}
 
-	// Sort sandboxes by start time descending
+	// Sort sandboxes by start time ascending
 	slices.SortFunc(sandboxes, func(a, b api.RunningSandbox) int {
 		return a.StartedAt.Compare(b.StartedAt)
 	})
@@ -144,6 +179,196 @@ func (a *APIStore) getSandboxes(ctx context.Context, teamID uuid.UUID, query *st
 	return sandboxes, nil
 }
 
+// getPausedSandboxes returns the page of the paused sandboxes of the team from their snapshots, sorted and filtered in the database.
+// Both the start and end time of the paused sandbox are the time when it was paused, so the page is the same for both sort keys.
+func (a *APIStore) getPausedSandboxes(
+	ctx context.Context,
+	teamID uuid.UUID,
+	params api.GetSandboxesParams,
+	order api.GetSandboxesParamsOrder,
+	after *sandboxesPageKey,
+	runningIDs []string,
+) ([]api.RunningSandbox, error) {
+	filter := db.TeamSnapshotBuildsFilter{
+		// The snapshot is kept after the sandbox is resumed.
+		ExcludeSandboxIDs: runningIDs,
+		Template:          params.TemplateID,
+		Desc:              order == api.Desc,
+	}
+
+	if params.Query != nil {
+		var err error
+
+		filter.Metadata, err = parseMetadataQuery(*params.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if after != nil {
+		filter.AfterFinishedAt = &after.time
+		filter.AfterSandboxID = after.sandboxID
+	}
+
+	// The page is merged with the running sandboxes, so the whole page can come from the snapshots,
+	// the extra snapshot shows whether there is a next page.
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit) + 1
+	}
+
+	snapshots, err := a.db.GetTeamSnapshotBuildsPage(ctx, teamID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error when getting snapshots: %w", err)
+	}
+
+	templateIDs := make([]string, 0, len(snapshots))
+	for _, s := range snapshots {
+		templateIDs = append(templateIDs, s.Snapshot.BaseEnvID)
+	}
+
+	aliases, err := a.db.GetEnvAliases(ctx, templateIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error when getting template aliases: %w", err)
+	}
+
+	sandboxes := make([]api.RunningSandbox, 0, len(snapshots))
+
+	for _, s := range snapshots {
+		state := api.Paused
+
+		sandbox := api.RunningSandbox{
+			TemplateID: s.Snapshot.BaseEnvID,
+			SandboxID:  s.SandboxID,
+			StartedAt:  *s.Build.FinishedAt,
+			EndAt:      *s.Build.FinishedAt,
+			CpuCount:   int32(s.Build.Vcpu),
+			MemoryMB:   int32(s.Build.RAMMB),
+			State:      &state,
+		}
+
+		if alias, ok := aliases[s.Snapshot.BaseEnvID]; ok {
+			sandbox.Alias = &alias
+		}
+
+		if s.Snapshot.Metadata != nil {
+			meta := api.SandboxMetadata(s.Snapshot.Metadata)
+			sandbox.Metadata = &meta
+		}
+
+		sandboxes = append(sandboxes, sandbox)
+	}
+
+	return sandboxes, nil
+}
+
+type sandboxesPageKey struct {
+	time      time.Time
+	sandboxID string
+}
+
+func sandboxSortKey(sandbox api.RunningSandbox, sortBy api.GetSandboxesParamsSortBy) sandboxesPageKey {
+	if sortBy == api.EndAt {
+		return sandboxesPageKey{time: sandbox.EndAt, sandboxID: sandbox.SandboxID}
+	}
+
+	return sandboxesPageKey{time: sandbox.StartedAt, sandboxID: sandbox.SandboxID}
+}
+
+func compareSandboxesPageKeys(a, b sandboxesPageKey) int {
+	if c := a.time.Compare(b.time); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.sandboxID, b.sandboxID)
+}
+
+// The token is the sort key of the last sandbox of the page.
+func encodeNextToken(key sandboxesPageKey) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(key.time.UnixNano(), 10) + "|" + key.sandboxID))
+}
+
+func decodeNextToken(token string) (sandboxesPageKey, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return sandboxesPageKey{}, fmt.Errorf("error decoding token: %w", err)
+	}
+
+	nanos, sandboxID, ok := strings.Cut(string(data), "|")
+	if !ok {
+		return sandboxesPageKey{}, fmt.Errorf("invalid token format")
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return sandboxesPageKey{}, fmt.Errorf("invalid token time: %w", err)
+	}
+
+	return sandboxesPageKey{time: time.Unix(0, n), sandboxID: sandboxID}, nil
+}
+
+// paginateSandboxes sorts the sandboxes and returns the page after the token with the token of the next page.
+func paginateSandboxes(
+	sandboxes []api.RunningSandbox,
+	sortBy api.GetSandboxesParamsSortBy,
+	order api.GetSandboxesParamsOrder,
+	limit *int32,
+	nextToken *string,
+) ([]api.RunningSandbox, *string, error) {
+	compare := func(a, b api.RunningSandbox) int {
+		c := compareSandboxesPageKeys(sandboxSortKey(a, sortBy), sandboxSortKey(b, sortBy))
+		if order == api.Desc {
+			return -c
+		}
+
+		return c
+	}
+
+	slices.SortFunc(sandboxes, compare)
+
+	if nextToken != nil {
+		after, err := decodeNextToken(*nextToken)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		start, _ := slices.BinarySearchFunc(sandboxes, after, func(sandbox api.RunningSandbox, key sandboxesPageKey) int {
+			c := compareSandboxesPageKeys(sandboxSortKey(sandbox, sortBy), key)
+			if order == api.Desc {
+				return -c
+			}
+
+			return c
+		})
+
+		// Skip the last sandbox of the previous page.
+		if start < len(sandboxes) && compareSandboxesPageKeys(sandboxSortKey(sandboxes[start], sortBy), after) == 0 {
+			start++
+		}
+
+		sandboxes = sandboxes[start:]
+	}
+
+	if limit == nil || len(sandboxes) <= int(*limit) {
+		return sandboxes, nil, nil
+	}
+
+	page := sandboxes[:*limit]
+	token := encodeNextToken(sandboxSortKey(page[len(page)-1], sortBy))
+
+	return page, &token, nil
+}
+
+// filterSandboxesByTemplate keeps the sandboxes of the template, it matches both the template ID and its alias.
+func filterSandboxesByTemplate(sandboxes []api.RunningSandbox, templateID *string) []api.RunningSandbox {
+	if templateID == nil {
+		return sandboxes
+	}
+
+	return slices.DeleteFunc(sandboxes, func(sandbox api.RunningSandbox) bool {
+		return sandbox.TemplateID != *templateID && (sandbox.Alias == nil || *sandbox.Alias != *templateID)
+	})
+}
+
 func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 	ctx := c.Request.Context()
 	telemetry.ReportEvent(ctx, "list running instances")
@@ -155,13 +380,83 @@ func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
 	a.posthog.CreateAnalyticsTeamEvent(team.ID.String(), "listed running instances", properties)
 
-	sandboxes, err := a.getSandboxes(ctx, team.ID, params.Query)
+	a.listSandboxes(c, a.orchestrator, params)
+}
+
+func (a *APIStore) listSandboxes(c *gin.Context, lister sandboxLister, params api.GetSandboxesParams) {
+	ctx := c.Request.Context()
+	teamID := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team.ID
+
+	states := []api.SandboxState{api.Running}
+	if params.State != nil && len(*params.State) > 0 {
+		states = *params.State
+	}
+
+	sortBy := api.StartedAt
+	if params.SortBy != nil {
+		sortBy = *params.SortBy
+	}
+
+	order := api.Asc
+	if params.Order != nil {
+		order = *params.Order
+	}
+
+	var after *sandboxesPageKey
+	if params.NextToken != nil {
+		key, err := decodeNextToken(*params.NextToken)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid next token: %s", err))
+
+			return
+		}
+
+		after = &key
+	}
+
+	instances := lister.GetSandboxes(ctx, &teamID)
+
+	runningIDs := make([]string, 0, len(instances))
+	for _, info := range instances {
+		runningIDs = append(runningIDs, info.Instance.SandboxID)
+	}
+
+	running, err := a.runningSandboxes(ctx, teamID, instances, params.Query)
 	if err != nil {
 		zap.L().Error("Error fetching sandboxes", zap.Error(err))
-		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error returning sandboxes for team '%s'", team.ID))
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error returning sandboxes for team '%s'", teamID))
+
+		return
+	}
+
+	sandboxes := make([]api.RunningSandbox, 0, len(running))
+
+	if slices.Contains(states, api.Running) {
+		sandboxes = append(sandboxes, filterSandboxesByTemplate(running, params.TemplateID)...)
+	}
+
+	if slices.Contains(states, api.Paused) {
+		paused, err := a.getPausedSandboxes(ctx, teamID, params, order, after, runningIDs)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error returning paused sandboxes for team '%s'", teamID))
+
+			return
+		}
+
+		sandboxes = append(sandboxes, paused...)
+	}
+
+	page, nextToken, err := paginateSandboxes(sandboxes, sortBy, order, params.Limit, params.NextToken)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid next token: %s", err))
 
 		return
 	}
 
-	c.JSON(http.StatusOK, sandboxes)
+	if nextToken != nil {
+		c.Header(nextTokenHeader, *nextToken)
+	}
+
+	c.JSON(http.StatusOK, page)
 }
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func sandboxIDs(sandboxes []api.RunningSandbox) []string {
+	ids := make([]string, 0, len(sandboxes))
+	for _, sandbox := range sandboxes {
+		ids = append(ids, sandbox.SandboxID)
+	}
+
+	return ids
+}
+
+func TestPaginateSandboxes(t *testing.T) {
+	now := time.Now()
+
+	newSandboxes := func() []api.RunningSandbox {
+		return []api.RunningSandbox{
+			{SandboxID: "c", StartedAt: now.Add(2 * time.Second), EndAt: now.Add(time.Hour)},
+			{SandboxID: "a", StartedAt: now, EndAt: now.Add(3 * time.Hour)},
+			{SandboxID: "b", StartedAt: now.Add(time.Second), EndAt: now.Add(2 * time.Hour)},
+			// Same start time as "a", the sandbox ID breaks the tie.
+			{SandboxID: "d", StartedAt: now, EndAt: now.Add(4 * time.Hour)},
+		}
+	}
+
+	limit := int32(2)
+
+	page, token, err := paginateSandboxes(newSandboxes(), api.StartedAt, api.Asc, &limit, nil)
+	require.NoError(t, err)
+	require.NotNil(t, token)
+	assert.Equal(t, []string{"a", "d"}, sandboxIDs(page))
+
+	page, token, err = paginateSandboxes(newSandboxes(), api.StartedAt, api.Asc, &limit, token)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+	assert.Equal(t, []string{"b", "c"}, sandboxIDs(page))
+
+	page, token, err = paginateSandboxes(newSandboxes(), api.EndAt, api.Desc, &limit, nil)
+	require.NoError(t, err)
+	require.NotNil(t, token)
+	assert.Equal(t, []string{"d", "a"}, sandboxIDs(page))
+
+	page, token, err = paginateSandboxes(newSandboxes(), api.EndAt, api.Desc, &limit, token)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+	assert.Equal(t, []string{"b", "c"}, sandboxIDs(page))
+}
+
+func TestPaginateSandboxesWithoutLimit(t *testing.T) {
+	now := time.Now()
+
+	sandboxes := []api.RunningSandbox{
+		{SandboxID: "b", StartedAt: now.Add(time.Second)},
+		{SandboxID: "a", StartedAt: now},
+	}
+
+	page, token, err := paginateSandboxes(sandboxes, api.StartedAt, api.Asc, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+	assert.Equal(t, []string{"a", "b"}, sandboxIDs(page))
+}
+
+func TestPaginateSandboxesInvalidToken(t *testing.T) {
+	invalid := "not a token"
+
+	_, _, err := paginateSandboxes(nil, api.StartedAt, api.Asc, nil, &invalid)
+	assert.Error(t, err)
+}
+
+type fakeSandboxLister struct {
+	sandboxes []*instance.InstanceInfo
+}
+
+func (f *fakeSandboxLister) GetSandboxes(_ context.Context, teamID *uuid.UUID) []*instance.InstanceInfo {
+	sandboxes := make([]*instance.InstanceInfo, 0, len(f.sandboxes))
+	for _, sbx := range f.sandboxes {
+		if *sbx.TeamID == *teamID {
+			sandboxes = append(sandboxes, sbx)
+		}
+	}
+
+	return sandboxes
+}
+
+// newListTest creates the running sandboxes "running" and "resumed" of the "base" template with the "base-alias" alias
+// and the paused sandboxes "paused-base" and "paused-other", the snapshot of "resumed" is kept after it was resumed.
+func newListTest(t *testing.T) (*APIStore, *fakeSandboxLister, authcache.AuthTeamInfo) {
+	t.Helper()
+
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, tier := dbtest.CreateTeam(t, database, 10)
+
+	var baseBuildID uuid.UUID
+
+	for _, templateID := range []string{"base", "other"} {
+		template := database.Client.Env.Create().SetID(templateID).SetTeamID(team.ID).SetPublic(false).SaveX(ctx)
+		build := database.Client.EnvBuild.Create().
+			SetEnv(template).
+			SetStatus(envbuild.StatusUploaded).
+			SetVcpu(2).
+			SetRAMMB(512).
+			SetFreeDiskSizeMB(512).
+			SaveX(ctx)
+
+		if templateID == "base" {
+			baseBuildID = build.ID
+		}
+	}
+
+	database.Client.EnvAlias.Create().SetID("base-alias").SetEnvID("base").ExecX(ctx)
+
+	// SQLite compares the times as strings, the whole seconds keep the same format.
+	pausedAt := time.Now().Truncate(time.Second).Add(-time.Hour)
+
+	for i, snapshot := range []db.SnapshotInfo{
+		{SandboxID: "paused-base", BaseTemplateID: "base", Metadata: map[string]string{"key": "value"}},
+		{SandboxID: "paused-other", BaseTemplateID: "other"},
+		{SandboxID: "resumed", BaseTemplateID: "base"},
+	} {
+		build, err := database.NewSnapshotBuild(ctx, &snapshot, team.ID)
+		require.NoError(t, err)
+
+		database.Client.EnvBuild.UpdateOneID(build.ID).
+			SetStatus(envbuild.StatusSuccess).
+			SetFinishedAt(pausedAt.Add(time.Duration(i) * time.Second)).
+			ExecX(ctx)
+	}
+
+	alias := "base-alias"
+	lister := &fakeSandboxLister{}
+
+	for i, sandboxID := range []string{"running", "resumed"} {
+		lister.sandboxes = append(lister.sandboxes, instance.NewInstanceInfo(
+			&api.Sandbox{SandboxID: sandboxID, TemplateID: "base", Alias: &alias, ClientID: "node"},
+			&team.ID, &baseBuildID, nil, time.Hour, time.Now().Add(time.Duration(i)*time.Second), time.Now().Add(time.Hour),
+			2, 512, 512, "", "", "", nil, false,
+		))
+	}
+
+	return &APIStore{db: database}, lister, authcache.AuthTeamInfo{Team: team, Tier: tier}
+}
+
+func listSandboxes(t *testing.T, store *APIStore, lister sandboxLister, team authcache.AuthTeamInfo, params api.GetSandboxesParams) ([]api.RunningSandbox, *string) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v2/sandboxes", nil)
+	c.Set(auth.TeamContextKey, team)
+
+	store.listSandboxes(c, lister, params)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var sandboxes []api.RunningSandbox
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &sandboxes))
+
+	var nextToken *string
+	if token := w.Header().Get(nextTokenHeader); token != "" {
+		nextToken = &token
+	}
+
+	return sandboxes, nextToken
+}
+
+func TestListSandboxesStateFilter(t *testing.T) {
+	store, lister, team := newListTest(t)
+
+	sandboxes, _ := listSandboxes(t, store, lister, team, api.GetSandboxesParams{})
+	assert.Equal(t, []string{"running", "resumed"}, sandboxIDs(sandboxes))
+
+	paused := []api.SandboxState{api.Paused}
+	sandboxes, _ = listSandboxes(t, store, lister, team, api.GetSandboxesParams{State: &paused})
+	assert.ElementsMatch(t, []string{"paused-base", "paused-other"}, sandboxIDs(sandboxes))
+
+	for _, sandbox := range sandboxes {
+		assert.Equal(t, api.Paused, *sandbox.State)
+
+		if sandbox.SandboxID == "paused-base" {
+			require.NotNil(t, sandbox.Alias)
+			assert.Equal(t, "base-alias", *sandbox.Alias)
+			assert.Equal(t, "base", sandbox.TemplateID)
+		} else {
+			assert.Nil(t, sandbox.Alias)
+		}
+	}
+
+	both := []api.SandboxState{api.Running, api.Paused}
+	sandboxes, _ = listSandboxes(t, store, lister, team, api.GetSandboxesParams{State: &both})
+	assert.ElementsMatch(t, []string{"running", "resumed", "paused-base", "paused-other"}, sandboxIDs(sandboxes))
+
+	query := "key=value"
+	sandboxes, _ = listSandboxes(t, store, lister, team, api.GetSandboxesParams{State: &both, Query: &query})
+	assert.Equal(t, []string{"paused-base"}, sandboxIDs(sandboxes))
+}
+
+func TestListSandboxesTemplateFilter(t *testing.T) {
+	store, lister, team := newListTest(t)
+
+	both := []api.SandboxState{api.Running, api.Paused}
+
+	for _, template := range []string{"base", "base-alias"} {
+		sandboxes, _ := listSandboxes(t, store, lister, team, api.GetSandboxesParams{State: &both, TemplateID: &template})
+		assert.ElementsMatch(t, []string{"running", "resumed", "paused-base"}, sandboxIDs(sandboxes), template)
+	}
+
+	other := "other"
+	sandboxes, _ := listSandboxes(t, store, lister, team, api.GetSandboxesParams{State: &both, TemplateID: &other})
+	assert.Equal(t, []string{"paused-other"}, sandboxIDs(sandboxes))
+
+	unknown := "unknown"
+	sandboxes, _ = listSandboxes(t, store, lister, team, api.GetSandboxesParams{State: &both, TemplateID: &unknown})
+	assert.Empty(t, sandboxes)
+}
+
+func TestListSandboxesPagination(t *testing.T) {
+	store, lister, team := newListTest(t)
+
+	both := []api.SandboxState{api.Running, api.Paused}
+	limit := int32(1)
+
+	for _, order := range []api.GetSandboxesParamsOrder{api.Asc, api.Desc} {
+		var (
+			nextToken *string
+			seen      []string
+		)
+
+		for {
+			page, token := listSandboxes(t, store, lister, team, api.GetSandboxesParams{State: &both, Limit: &limit, Order: &order, NextToken: nextToken})
+			require.LessOrEqual(t, len(page), int(limit))
+
+			seen = append(seen, sandboxIDs(page)...)
+
+			if token == nil {
+				break
+			}
+
+			nextToken = token
+		}
+
+		expected := []string{"paused-base", "paused-other", "running", "resumed"}
+		if order == api.Desc {
+			slices.Reverse(expected)
+		}
+
+		assert.Equal(t, expected, seen, order)
+	}
+}
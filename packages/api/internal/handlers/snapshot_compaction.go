@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	snapshotCompactionInterval = time.Hour
+	// Snapshots are compacted when they were paused more times than this, or when any of their headers has more mappings.
+	// The compaction bounds the number of objects a cold start of the snapshot has to fetch.
+	snapshotCompactionMaxGeneration = 16
+	snapshotCompactionMaxMappings   = 4096
+)
+
+func (a *APIStore) startSnapshotCompaction(ctx context.Context) {
+	ticker := time.NewTicker(snapshotCompactionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.compactSnapshots(ctx)
+		}
+	}
+}
+
+// compactSnapshots rewrites the deep header chains of the paused sandboxes into single layer builds.
+// The replaced builds are marked as deleted and their files are removed by the snapshot garbage collection when no other build references them.
+func (a *APIStore) compactSnapshots(ctx context.Context) {
+	snapshots, err := a.db.GetLastSnapshotBuilds(ctx)
+	if err != nil {
+		zap.L().Error("Failed to get snapshot builds for compaction", zap.Error(err))
+
+		return
+	}
+
+	var compactedCount int
+
+	for _, snapshot := range snapshots {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Running sandboxes will create a new snapshot when they are paused again.
+		if _, err := a.orchestrator.GetSandbox(snapshot.SandboxID); err == nil {
+			continue
+		}
+
+		newBuildID := uuid.New()
+
+		compacted, err := a.templateManager.CompactBuild(ctx, snapshot.Build.ID, newBuildID, snapshotCompactionMaxGeneration, snapshotCompactionMaxMappings)
+		if err != nil {
+			zap.L().Error("Failed to compact snapshot", zap.String("sandbox_id", snapshot.SandboxID), zap.String("build_id", snapshot.Build.ID.String()), zap.Error(err))
+
+			continue
+		}
+
+		if !compacted {
+			continue
+		}
+
+		err = a.db.ReplaceSnapshotBuild(ctx, snapshot.Build, newBuildID)
+		if err != nil {
+			zap.L().Warn("Failed to replace compacted snapshot build", zap.String("sandbox_id", snapshot.SandboxID), zap.String("build_id", snapshot.Build.ID.String()), zap.Error(err))
+
+			// The compacted build is not referenced by any snapshot, so it can be removed right away.
+			deleteErr := a.templateManager.DeleteBuild(ctx, newBuildID)
+			if deleteErr != nil {
+				zap.L().Error("Failed to delete unused compacted build", zap.String("build_id", newBuildID.String()), zap.Error(deleteErr))
+			}
+
+			continue
+		}
+
+		compactedCount++
+	}
+
+	if compactedCount > 0 {
+		zap.L().Info("Compacted snapshots", zap.Int("count", compactedCount))
+	}
+}
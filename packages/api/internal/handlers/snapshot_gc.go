@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	snapshotGCInterval = time.Hour
+	// snapshotGCGracePeriod gives the uploads of the snapshots based on the deleted builds time to finish,
+	// so their headers already reference the layers of the deleted builds when the garbage is collected.
+	snapshotGCGracePeriod = time.Hour
+	// snapshotGCBatchSize is the number of the deleted builds sent to the template manager at once.
+	snapshotGCBatchSize = 1000
+	// snapshotGCBuildingTimeout is how long a snapshot build can stay building, older ones belong to the failed pauses.
+	snapshotGCBuildingTimeout = 24 * time.Hour
+)
+
+func (a *APIStore) startSnapshotGarbageCollector(ctx context.Context) {
+	ticker := time.NewTicker(snapshotGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.collectSnapshotGarbage(ctx)
+		}
+	}
+}
+
+// collectSnapshotGarbage removes the storage files of the deleted snapshot builds that are not referenced by any live or used build.
+// The deleted builds are processed in batches of a single session, the template manager reads the headers of the live builds only once.
+func (a *APIStore) collectSnapshotGarbage(ctx context.Context) {
+	deletedBefore := time.Now().Add(-snapshotGCGracePeriod)
+
+	live, err := a.db.GetLiveSnapshotBuilds(ctx)
+	if err != nil {
+		zap.L().Error("Failed to get live builds", zap.Error(err))
+
+		return
+	}
+
+	// The snapshots being created can reference the deleted build their sandbox was resumed from.
+	used, err := a.db.GetSnapshotBaseBuilds(ctx, time.Now().Add(-snapshotGCBuildingTimeout))
+	if err != nil {
+		zap.L().Error("Failed to get base builds of the snapshots being created", zap.Error(err))
+
+		return
+	}
+
+	// Running and pausing sandboxes can be restored from a deleted snapshot, their builds are still in use.
+	for _, sbx := range append(a.orchestrator.GetSandboxes(ctx, nil), a.orchestrator.GetPausingSandboxes(ctx)...) {
+		if buildID := sbx.GetBuildID(); buildID != nil {
+			used = append(used, *buildID)
+		}
+	}
+
+	gcCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	gc, err := a.templateManager.StartGarbageCollection(gcCtx, live, used)
+	if err != nil {
+		zap.L().Error("Failed to start snapshot garbage collection", zap.Error(err))
+
+		return
+	}
+
+	var (
+		after        *uuid.UUID
+		deletedCount int
+	)
+
+	for {
+		candidates, err := a.db.GetDeletedBuilds(ctx, deletedBefore, after, snapshotGCBatchSize)
+		if err != nil {
+			zap.L().Error("Failed to get deleted builds", zap.Error(err))
+
+			break
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		deleted, err := gc.Collect(candidates)
+		if err != nil {
+			// The live headers couldn't be read, the next run will try again.
+			zap.L().Error("Failed to collect snapshot garbage", zap.Error(err))
+
+			break
+		}
+
+		if len(deleted) > 0 {
+			err = a.db.DeleteEnvBuilds(ctx, deleted)
+			if err != nil {
+				zap.L().Error("Failed to delete collected builds", zap.Error(err))
+
+				break
+			}
+
+			deletedCount += len(deleted)
+		}
+
+		if len(candidates) < snapshotGCBatchSize {
+			break
+		}
+
+		after = &candidates[len(candidates)-1]
+	}
+
+	err = gc.Close()
+	if err != nil {
+		zap.L().Error("Failed to finish snapshot garbage collection", zap.Error(err))
+	}
+
+	if deletedCount > 0 {
+		zap.L().Info("Collected snapshot garbage", zap.Int("builds", deletedCount))
+	}
+}
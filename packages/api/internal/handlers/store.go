@@ -25,13 +25,14 @@ import (
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/builds"
 	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/api/internal/events"
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
 	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
-	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 )
 
 var supabaseJWTSecretsString = strings.TrimSpace(os.Getenv("SUPABASE_JWT_SECRETS"))
@@ -57,6 +58,8 @@ type APIStore struct {
 	templateCache        *templatecache.TemplateCache
 	authCache            *authcache.TeamAuthCache
 	templateSpawnCounter *utils.TemplateSpawnCounter
+	webhooks             *webhooks.Dispatcher
+	sandboxEvents        *events.Broker
 	clickhouseStore      chdb.Store
 	// should use something like this: https://github.com/spf13/viper
 	// but for now this is good
@@ -118,7 +121,10 @@ func NewAPIStore(ctx context.Context) *APIStore {
 		zap.L().Warn("REDIS_URL not set, using local caches")
 	}
 
-	orch, err := orchestrator.New(ctx, tracer, nomadClient, posthogClient, redisClient, dbClient)
+	webhooksDispatcher := webhooks.NewDispatcher(ctx, dbClient)
+	sandboxEvents := events.NewBroker(ctx)
+
+	orch, err := orchestrator.New(ctx, tracer, nomadClient, posthogClient, redisClient, dbClient, webhooksDispatcher, sandboxEvents)
 	if err != nil {
 		zap.L().Fatal("initializing Orchestrator client", zap.Error(err))
 	}
@@ -140,7 +146,7 @@ func NewAPIStore(ctx context.Context) *APIStore {
 	buildCache := builds.NewBuildCache()
 
 	templateCache := templatecache.NewTemplateCache(dbClient)
-	authCache := authcache.NewTeamAuthCache()
+	authCache := authcache.NewTeamAuthCache(ctx, redisClient)
 	templateSpawnCounter := utils.NewTemplateSpawnCounter(time.Minute, dbClient)
 
 	a := &APIStore{
@@ -155,10 +161,15 @@ func NewAPIStore(ctx context.Context) *APIStore {
 		templateCache:             templateCache,
 		authCache:                 authCache,
 		templateSpawnCounter:      templateSpawnCounter,
+		webhooks:                  webhooksDispatcher,
+		sandboxEvents:             sandboxEvents,
 		clickhouseStore:           clickhouseStore,
 		readMetricsFromClickHouse: readMetricsFromClickHouse,
 	}
 
+	go a.startSnapshotGarbageCollector(ctx)
+	go a.startSnapshotCompaction(ctx)
+
 	// Wait till there's at least one, otherwise we can't create sandboxes yet
 	go func() {
 		ticker := time.NewTicker(5 * time.Millisecond)
@@ -225,8 +236,17 @@ func (a *APIStore) GetHealth(c *gin.Context) {
 }
 
 func (a *APIStore) GetTeamFromAPIKey(ctx context.Context, apiKey string) (authcache.AuthTeamInfo, *api.APIError) {
-	team, tier, err := a.authCache.GetOrSet(ctx, apiKey, func(ctx context.Context, key string) (*models.Team, *models.Tier, error) {
-		return a.db.GetTeamAuth(ctx, key)
+	info, err := a.authCache.GetOrSet(ctx, apiKey, func(ctx context.Context, key string) (authcache.AuthTeamInfo, error) {
+		team, tier, teamAPIKey, err := a.db.GetTeamAuth(ctx, key)
+		if err != nil {
+			return authcache.AuthTeamInfo{}, err
+		}
+
+		return authcache.AuthTeamInfo{
+			Team:   team,
+			Tier:   tier,
+			APIKey: teamAPIKey,
+		}, nil
 	})
 	if err != nil {
 		return authcache.AuthTeamInfo{}, &api.APIError{
@@ -236,10 +256,15 @@ func (a *APIStore) GetTeamFromAPIKey(ctx context.Context, apiKey string) (authca
 		}
 	}
 
-	return authcache.AuthTeamInfo{
-		Team: team,
-		Tier: tier,
-	}, nil
+	if info.APIKey.ExpiresAt != nil && time.Now().After(*info.APIKey.ExpiresAt) {
+		return authcache.AuthTeamInfo{}, &api.APIError{
+			Err:       fmt.Errorf("api key '%s' expired at %s", info.APIKey.ID, info.APIKey.ExpiresAt),
+			ClientMsg: "The API key has expired",
+			Code:      http.StatusUnauthorized,
+		}
+	}
+
+	return info, nil
 }
 
 func (a *APIStore) GetUserFromAccessToken(ctx context.Context, accessToken string) (uuid.UUID, *api.APIError) {
@@ -332,8 +357,16 @@ func (a *APIStore) GetUserIDFromSupabaseToken(ctx context.Context, supabaseToken
 func (a *APIStore) GetTeamFromSupabaseToken(ctx context.Context, teamID string) (authcache.AuthTeamInfo, *api.APIError) {
 	userID := a.GetUserID(middleware.GetGinContext(ctx))
 
-	team, tier, err := a.authCache.GetOrSet(ctx, teamID, func(ctx context.Context, key string) (*models.Team, *models.Tier, error) {
-		return a.db.GetTeamByIDAndUserIDAuth(ctx, teamID, userID)
+	info, err := a.authCache.GetOrSet(ctx, teamID, func(ctx context.Context, key string) (authcache.AuthTeamInfo, error) {
+		team, tier, err := a.db.GetTeamByIDAndUserIDAuth(ctx, teamID, userID)
+		if err != nil {
+			return authcache.AuthTeamInfo{}, err
+		}
+
+		return authcache.AuthTeamInfo{
+			Team: team,
+			Tier: tier,
+		}, nil
 	})
 	if errors.Is(err, &db.TeamUsageError{}) {
 		return authcache.AuthTeamInfo{}, &api.APIError{
@@ -350,8 +383,5 @@ func (a *APIStore) GetTeamFromSupabaseToken(ctx context.Context, teamID string)
 		}
 	}
 
-	return authcache.AuthTeamInfo{
-		Team: team,
-		Tier: tier,
-	}, nil
+	return info, nil
 }
@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	teamAPIKeyPrefix = "e2b_"
+	// The key is stored in a 44 character column, the prefix and 20 random bytes hex encoded.
+	teamAPIKeyBytes = 20
+	// Number of the last characters of the key shown when listing the keys.
+	teamAPIKeyVisibleChars = 4
+)
+
+var teamAPIKeyScopes = []api.TeamAPIKeyScope{
+	api.SandboxesRead,
+	api.SandboxesCreate,
+	api.SandboxesWrite,
+}
+
+func generateTeamAPIKey() (string, error) {
+	b := make([]byte, teamAPIKeyBytes)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	return teamAPIKeyPrefix + hex.EncodeToString(b), nil
+}
+
+func maskTeamAPIKey(key string) string {
+	if len(key) <= len(teamAPIKeyPrefix)+teamAPIKeyVisibleChars {
+		return teamAPIKeyPrefix + "..."
+	}
+
+	return teamAPIKeyPrefix + "..." + key[len(key)-teamAPIKeyVisibleChars:]
+}
+
+func teamAPIKeyToAPI(key *models.TeamAPIKey) api.TeamAPIKey {
+	scopes := make([]api.TeamAPIKeyScope, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, api.TeamAPIKeyScope(scope))
+	}
+
+	return api.TeamAPIKey{
+		Id:         key.ID,
+		Name:       key.Name,
+		MaskedKey:  maskTeamAPIKey(key.APIKey),
+		Scopes:     scopes,
+		TemplateID: key.TemplateID,
+		ExpiresAt:  key.ExpiresAt,
+		CreatedAt:  key.CreatedAt,
+		LastUsed:   key.LastUsed,
+	}
+}
+
+func createdTeamAPIKeyToAPI(key *models.TeamAPIKey) api.CreatedTeamAPIKey {
+	k := teamAPIKeyToAPI(key)
+
+	return api.CreatedTeamAPIKey{
+		Id:         k.Id,
+		Name:       k.Name,
+		MaskedKey:  k.MaskedKey,
+		Scopes:     k.Scopes,
+		TemplateID: k.TemplateID,
+		ExpiresAt:  k.ExpiresAt,
+		CreatedAt:  k.CreatedAt,
+		LastUsed:   k.LastUsed,
+		Key:        key.APIKey,
+	}
+}
+
+// checkAPIKeyTemplate checks that the API key used for the request can create sandboxes from the template.
+func checkAPIKeyTemplate(team authcache.AuthTeamInfo, templateID string) *api.APIError {
+	if team.APIKey == nil || team.APIKey.TemplateID == nil || *team.APIKey.TemplateID == templateID {
+		return nil
+	}
+
+	return &api.APIError{
+		Err:       fmt.Errorf("api key '%s' is restricted to template '%s', requested '%s'", team.APIKey.ID, *team.APIKey.TemplateID, templateID),
+		ClientMsg: fmt.Sprintf("The API key can only create sandboxes from the template '%s'", *team.APIKey.TemplateID),
+		Code:      http.StatusForbidden,
+	}
+}
+
+// getUserTeam returns the team if the authenticated user is its member and the team is not blocked, otherwise it sends the error response.
+func (a *APIStore) getUserTeam(c *gin.Context, teamID uuid.UUID) (*models.Team, bool) {
+	return a.getUserTeamWithUsage(c, teamID, true)
+}
+
+// getUserTeamAllowBlocked is getUserTeam for the actions that the members of the blocked and banned teams can still do.
+func (a *APIStore) getUserTeamAllowBlocked(c *gin.Context, teamID uuid.UUID) (*models.Team, bool) {
+	return a.getUserTeamWithUsage(c, teamID, false)
+}
+
+func (a *APIStore) getUserTeamWithUsage(c *gin.Context, teamID uuid.UUID, validateUsage bool) (*models.Team, bool) {
+	ctx := c.Request.Context()
+
+	userID := a.GetUserID(c)
+
+	var (
+		team *models.Team
+		err  error
+	)
+
+	if validateUsage {
+		team, _, err = a.db.GetTeamByIDAndUserIDAuth(ctx, teamID.String(), userID)
+	} else {
+		team, err = a.db.GetTeamByIDAndUserID(ctx, teamID, userID)
+	}
+
+	if err == nil {
+		return team, true
+	}
+
+	var usageErr *db.TeamUsageError
+
+	switch {
+	case models.IsNotFound(err):
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Team '%s' was not found", teamID))
+	case errors.As(err, &usageErr):
+		a.sendAPIStoreError(c, http.StatusForbidden, usageErr.Error())
+	default:
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting team")
+	}
+
+	return nil, false
+}
+
+func (a *APIStore) GetTeamsTeamIDApiKeys(c *gin.Context, teamID api.TeamID) {
+	ctx := c.Request.Context()
+
+	// The blocked teams can list their keys to find the ones to revoke.
+	if _, ok := a.getUserTeamAllowBlocked(c, teamID); !ok {
+		return
+	}
+
+	keys, err := a.db.GetTeamAPIKeys(ctx, teamID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error listing API keys")
+
+		return
+	}
+
+	result := make([]api.TeamAPIKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, teamAPIKeyToAPI(key))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostTeamsTeamIDApiKeys(c *gin.Context, teamID api.TeamID) {
+	ctx := c.Request.Context()
+
+	if _, ok := a.getUserTeam(c, teamID); !ok {
+		return
+	}
+
+	body, err := utils.ParseBody[api.PostTeamsTeamIDApiKeysJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	scopes := make([]string, 0)
+	if body.Scopes != nil {
+		for _, scope := range *body.Scopes {
+			if !slices.Contains(teamAPIKeyScopes, scope) {
+				a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid scope '%s'", scope))
+
+				return
+			}
+
+			if !slices.Contains(scopes, string(scope)) {
+				scopes = append(scopes, string(scope))
+			}
+		}
+	}
+
+	if body.ExpiresAt != nil && !body.ExpiresAt.After(time.Now()) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Expiration time must be in the future")
+
+		return
+	}
+
+	var templateID *string
+	if body.TemplateID != nil {
+		cleanedAliasOrEnvID, err := id.CleanEnvID(*body.TemplateID)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid template ID: %s", err))
+
+			return
+		}
+
+		// The alias is resolved, so the key keeps working with the same template even if the alias is reassigned.
+		env, _, checkErr := a.templateCache.Get(ctx, cleanedAliasOrEnvID, teamID, true)
+		if checkErr != nil {
+			telemetry.ReportError(ctx, checkErr.Err)
+			a.sendAPIStoreError(c, checkErr.Code, checkErr.ClientMsg)
+
+			return
+		}
+
+		templateID = &env.TemplateID
+	}
+
+	apiKey, err := generateTeamAPIKey()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating API key")
+
+		return
+	}
+
+	key, err := a.db.CreateTeamAPIKey(ctx, teamID, a.GetUserID(c), apiKey, &body.Name, scopes, body.ExpiresAt, templateID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating API key")
+
+		return
+	}
+
+	zap.L().Info("Created team API key", zap.String("team_id", teamID.String()), zap.String("api_key_id", key.ID.String()))
+
+	c.JSON(http.StatusCreated, createdTeamAPIKeyToAPI(key))
+}
+
+func (a *APIStore) DeleteTeamsTeamIDApiKeysApiKeyID(c *gin.Context, teamID api.TeamID, apiKeyID api.ApiKeyID) {
+	ctx := c.Request.Context()
+
+	// The blocked teams can still revoke their keys, e.g. the leaked ones.
+	if _, ok := a.getUserTeamAllowBlocked(c, teamID); !ok {
+		return
+	}
+
+	key, err := a.db.GetTeamAPIKey(ctx, teamID, apiKeyID)
+	if errors.Is(err, db.TeamAPIKeyNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("API key '%s' was not found", apiKeyID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting API key")
+
+		return
+	}
+
+	err = a.db.DeleteTeamAPIKey(ctx, key.ID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error revoking API key")
+
+		return
+	}
+
+	err = a.authCache.Delete(ctx, key.APIKey)
+	if err != nil {
+		// The key is already deleted, the other replicas stop accepting it when their cache entries are refreshed.
+		telemetry.ReportError(ctx, fmt.Errorf("error invalidating API key '%s' in the auth cache: %w", key.ID, err))
+	}
+
+	zap.L().Info("Revoked team API key", zap.String("team_id", teamID.String()), zap.String("api_key_id", key.ID.String()))
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) PostTeamsTeamIDApiKeysApiKeyIDRotate(c *gin.Context, teamID api.TeamID, apiKeyID api.ApiKeyID) {
+	ctx := c.Request.Context()
+
+	if _, ok := a.getUserTeam(c, teamID); !ok {
+		return
+	}
+
+	key, err := a.db.GetTeamAPIKey(ctx, teamID, apiKeyID)
+	if errors.Is(err, db.TeamAPIKeyNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("API key '%s' was not found", apiKeyID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting API key")
+
+		return
+	}
+
+	apiKey, err := generateTeamAPIKey()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error rotating API key")
+
+		return
+	}
+
+	rotated, err := a.db.RotateTeamAPIKey(ctx, key.ID, apiKey)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error rotating API key")
+
+		return
+	}
+
+	err = a.authCache.Delete(ctx, key.APIKey)
+	if err != nil {
+		// The key is already deleted, the other replicas stop accepting it when their cache entries are refreshed.
+		telemetry.ReportError(ctx, fmt.Errorf("error invalidating API key '%s' in the auth cache: %w", key.ID, err))
+	}
+
+	zap.L().Info("Rotated team API key", zap.String("team_id", teamID.String()), zap.String("api_key_id", key.ID.String()))
+
+	c.JSON(http.StatusOK, createdTeamAPIKeyToAPI(rotated))
+}
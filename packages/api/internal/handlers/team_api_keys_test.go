@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestGetTeamFromAPIKeyExpired(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+	user := dbtest.CreateUser(t, database, team.ID)
+
+	store := &APIStore{db: database, authCache: authcache.NewTeamAuthCache(ctx, nil)}
+
+	expired := time.Now().Add(-time.Minute)
+	_, err := database.CreateTeamAPIKey(ctx, team.ID, user.ID, "e2b_expired", nil, nil, &expired, nil)
+	require.NoError(t, err)
+
+	valid := time.Now().Add(time.Hour)
+	_, err = database.CreateTeamAPIKey(ctx, team.ID, user.ID, "e2b_valid", nil, nil, &valid, nil)
+	require.NoError(t, err)
+
+	_, apiErr := store.GetTeamFromAPIKey(ctx, "e2b_expired")
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.Code)
+
+	info, apiErr := store.GetTeamFromAPIKey(ctx, "e2b_valid")
+	require.Nil(t, apiErr)
+	assert.Equal(t, team.ID, info.Team.ID)
+}
+
+func TestCheckAPIKeyTemplate(t *testing.T) {
+	templateID := "template"
+
+	assert.Nil(t, checkAPIKeyTemplate(authcache.AuthTeamInfo{}, "other"))
+	assert.Nil(t, checkAPIKeyTemplate(authcache.AuthTeamInfo{APIKey: &models.TeamAPIKey{}}, "other"))
+	assert.Nil(t, checkAPIKeyTemplate(authcache.AuthTeamInfo{APIKey: &models.TeamAPIKey{TemplateID: &templateID}}, templateID))
+
+	apiErr := checkAPIKeyTemplate(authcache.AuthTeamInfo{APIKey: &models.TeamAPIKey{TemplateID: &templateID}}, "other")
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.Code)
+}
+
+func TestRevokeTeamAPIKeyInvalidatesCache(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+	user := dbtest.CreateUser(t, database, team.ID)
+
+	store := &APIStore{db: database, authCache: authcache.NewTeamAuthCache(ctx, nil)}
+
+	key, err := database.CreateTeamAPIKey(ctx, team.ID, user.ID, "e2b_revoked", nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, apiErr := store.GetTeamFromAPIKey(ctx, key.APIKey)
+	require.Nil(t, apiErr)
+
+	c, w := newUserTestContext(t, http.MethodDelete, "/teams/"+team.ID.String()+"/api-keys/"+key.ID.String(), nil, user.ID)
+	store.DeleteTeamsTeamIDApiKeysApiKeyID(c, team.ID, key.ID)
+	require.Equal(t, http.StatusNoContent, c.Writer.Status(), w.Body.String())
+
+	_, apiErr = store.GetTeamFromAPIKey(ctx, key.APIKey)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.Code)
+}
+
+func TestBlockedTeamCanRevokeAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+	user := dbtest.CreateUser(t, database, team.ID)
+
+	key, err := database.CreateTeamAPIKey(ctx, team.ID, user.ID, "e2b_leaked", nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	database.Client.Team.UpdateOneID(team.ID).SetIsBlocked(true).ExecX(ctx)
+
+	store := &APIStore{db: database, authCache: authcache.NewTeamAuthCache(ctx, nil)}
+
+	c, w := newUserTestContext(t, http.MethodPost, "/teams/"+team.ID.String()+"/api-keys", api.PostTeamsTeamIDApiKeysJSONRequestBody{Name: "new"}, user.ID)
+	store.PostTeamsTeamIDApiKeys(c, team.ID)
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	c, w = newUserTestContext(t, http.MethodGet, "/teams/"+team.ID.String()+"/api-keys", nil, user.ID)
+	store.GetTeamsTeamIDApiKeys(c, team.ID)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), key.ID.String())
+
+	c, w = newUserTestContext(t, http.MethodDelete, "/teams/"+team.ID.String()+"/api-keys/"+key.ID.String(), nil, user.ID)
+	store.DeleteTeamsTeamIDApiKeysApiKeyID(c, team.ID, key.ID)
+	require.Equal(t, http.StatusNoContent, c.Writer.Status(), w.Body.String())
+
+	keys, err := database.GetTeamAPIKeys(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
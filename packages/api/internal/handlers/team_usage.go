@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// checkSnapshotStorage checks if the snapshot of the sandbox fits into the snapshot storage limit of the tier, otherwise it sends the error response.
+func (a *APIStore) checkSnapshotStorage(c *gin.Context, tier *models.Tier, sbx *instance.InstanceInfo) bool {
+	ctx := c.Request.Context()
+
+	if tier.MaxSnapshotStorageMB == nil {
+		return true
+	}
+
+	storageMB, err := a.db.GetTeamSnapshotStorageMB(ctx, *sbx.TeamID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when checking the snapshot storage")
+
+		return false
+	}
+
+	if storageMB+sbx.RamMB+sbx.TotalDiskSizeMB > *tier.MaxSnapshotStorageMB {
+		a.sendAPIStoreError(c, http.StatusTooManyRequests, fmt.Sprintf(
+			"You have reached the snapshot storage limit (%d MB), delete some of the snapshots or kill the paused sandboxes", *tier.MaxSnapshotStorageMB,
+		))
+
+		return false
+	}
+
+	return true
+}
+
+func teamResourceUsage(used int64, limit *int64) api.TeamResourceUsage {
+	return api.TeamResourceUsage{
+		Used:  used,
+		Limit: limit,
+	}
+}
+
+func (a *APIStore) GetTeamsTeamIDUsage(c *gin.Context, teamID api.TeamID) {
+	ctx := c.Request.Context()
+
+	team, ok := a.getUserTeam(c, teamID)
+	if !ok {
+		return
+	}
+
+	tier := team.Edges.TeamTier
+
+	runningBuilds, err := a.db.GetTeamRunningTemplateBuildsCount(ctx, team.ID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting the team usage")
+
+		return
+	}
+
+	snapshotStorageMB, err := a.db.GetTeamSnapshotStorageMB(ctx, team.ID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting the team usage")
+
+		return
+	}
+
+	usage := a.orchestrator.GetTeamUsage(team.ID)
+
+	c.JSON(http.StatusOK, api.TeamUsage{
+		ConcurrentSandboxes:      teamResourceUsage(usage.Instances, &tier.ConcurrentInstances),
+		VcpuCount:                teamResourceUsage(usage.VCpu, tier.MaxVcpu),
+		MemoryMB:                 teamResourceUsage(usage.RamMB, tier.MaxRAMMB),
+		SandboxStartsPerMinute:   teamResourceUsage(usage.StartsPerMinute, tier.MaxSandboxStartsPerMinute),
+		ConcurrentTemplateBuilds: teamResourceUsage(runningBuilds, tier.MaxConcurrentBuilds),
+		SnapshotStorageMB:        teamResourceUsage(snapshotStorageMB, tier.MaxSnapshotStorageMB),
+	})
+}
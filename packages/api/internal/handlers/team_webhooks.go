@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	webhookSecretPrefix = "whsec_"
+	webhookSecretBytes  = 32
+
+	defaultWebhookDeliveriesLimit = 50
+	maxWebhookDeliveriesLimit     = 100
+)
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
+
+func teamWebhookToAPI(webhook *models.TeamWebhook) api.TeamWebhook {
+	events := make([]api.WebhookEventType, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, api.WebhookEventType(event))
+	}
+
+	return api.TeamWebhook{
+		Id:        webhook.ID,
+		Url:       webhook.URL,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func webhookDeliveryToAPI(delivery *models.WebhookDelivery) api.WebhookDelivery {
+	return api.WebhookDelivery{
+		Id:             delivery.ID,
+		EventID:        delivery.EventID,
+		EventType:      api.WebhookEventType(delivery.EventType),
+		Status:         api.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+func (a *APIStore) GetTeamsTeamIDWebhooks(c *gin.Context, teamID api.TeamID) {
+	ctx := c.Request.Context()
+
+	if _, ok := a.getUserTeam(c, teamID); !ok {
+		return
+	}
+
+	teamWebhooks, err := a.db.GetTeamWebhooks(ctx, teamID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error listing webhooks")
+
+		return
+	}
+
+	result := make([]api.TeamWebhook, 0, len(teamWebhooks))
+	for _, webhook := range teamWebhooks {
+		result = append(result, teamWebhookToAPI(webhook))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostTeamsTeamIDWebhooks(c *gin.Context, teamID api.TeamID) {
+	ctx := c.Request.Context()
+
+	if _, ok := a.getUserTeam(c, teamID); !ok {
+		return
+	}
+
+	body, err := utils.ParseBody[api.PostTeamsTeamIDWebhooksJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		errMsg := fmt.Errorf("error when parsing request: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return
+	}
+
+	err = webhooks.ValidateURL(ctx, body.Url, env.IsLocal())
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid webhook URL: %s", err))
+
+		return
+	}
+
+	events := make([]string, 0)
+	if body.Events != nil {
+		for _, event := range *body.Events {
+			if !slices.Contains(webhooks.EventTypes, webhooks.EventType(event)) {
+				a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid event type '%s'", event))
+
+				return
+			}
+
+			if !slices.Contains(events, string(event)) {
+				events = append(events, string(event))
+			}
+		}
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating webhook")
+
+		return
+	}
+
+	webhook, err := a.db.CreateTeamWebhook(ctx, teamID, body.Url, secret, events)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating webhook")
+
+		return
+	}
+
+	a.webhooks.Invalidate(teamID)
+
+	zap.L().Info("Created team webhook", zap.String("team_id", teamID.String()), zap.String("webhook_id", webhook.ID.String()))
+
+	w := teamWebhookToAPI(webhook)
+
+	c.JSON(http.StatusCreated, api.CreatedTeamWebhook{
+		Id:        w.Id,
+		Url:       w.Url,
+		Events:    w.Events,
+		CreatedAt: w.CreatedAt,
+		Secret:    webhook.Secret,
+	})
+}
+
+func (a *APIStore) DeleteTeamsTeamIDWebhooksWebhookID(c *gin.Context, teamID api.TeamID, webhookID api.WebhookID) {
+	ctx := c.Request.Context()
+
+	if _, ok := a.getUserTeam(c, teamID); !ok {
+		return
+	}
+
+	webhook, err := a.db.GetTeamWebhook(ctx, teamID, webhookID)
+	if errors.Is(err, db.TeamWebhookNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Webhook '%s' was not found", webhookID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting webhook")
+
+		return
+	}
+
+	err = a.db.DeleteTeamWebhook(ctx, webhook.ID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting webhook")
+
+		return
+	}
+
+	a.webhooks.Invalidate(teamID)
+
+	zap.L().Info("Deleted team webhook", zap.String("team_id", teamID.String()), zap.String("webhook_id", webhook.ID.String()))
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) GetTeamsTeamIDWebhooksWebhookIDDeliveries(
+	c *gin.Context,
+	teamID api.TeamID,
+	webhookID api.WebhookID,
+	params api.GetTeamsTeamIDWebhooksWebhookIDDeliveriesParams,
+) {
+	ctx := c.Request.Context()
+
+	if _, ok := a.getUserTeam(c, teamID); !ok {
+		return
+	}
+
+	webhook, err := a.db.GetTeamWebhook(ctx, teamID, webhookID)
+	if errors.Is(err, db.TeamWebhookNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Webhook '%s' was not found", webhookID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting webhook")
+
+		return
+	}
+
+	limit := defaultWebhookDeliveriesLimit
+	if params.Limit != nil {
+		limit = min(max(int(*params.Limit), 1), maxWebhookDeliveriesLimit)
+	}
+
+	deliveries, err := a.db.GetWebhookDeliveries(ctx, webhook.ID, limit)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error listing webhook deliveries")
+
+		return
+	}
+
+	result := make([]api.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, webhookDeliveryToAPI(delivery))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+)
+
+func newUserTestContext(t *testing.T, method, target string, body any, userID uuid.UUID) (*gin.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, bytes.NewReader(data))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set(auth.UserIDContextKey, userID)
+
+	return c, w
+}
+
+func TestTeamWebhooks(t *testing.T) {
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+	user := dbtest.CreateUser(t, database, team.ID)
+
+	store := &APIStore{db: database}
+
+	events := []api.WebhookEventType{api.WebhookEventType(webhooks.SandboxKilled)}
+
+	c, w := newUserTestContext(t, http.MethodPost, "/teams/"+team.ID.String()+"/webhooks", api.PostTeamsTeamIDWebhooksJSONRequestBody{
+		Url:    "https://8.8.8.8/webhook",
+		Events: &events,
+	}, user.ID)
+	store.PostTeamsTeamIDWebhooks(c, team.ID)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var created api.CreatedTeamWebhook
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, "https://8.8.8.8/webhook", created.Url)
+	assert.Equal(t, events, created.Events)
+	assert.Regexp(t, "^whsec_[0-9a-f]{64}$", created.Secret)
+
+	c, w = newUserTestContext(t, http.MethodGet, "/teams/"+team.ID.String()+"/webhooks", nil, user.ID)
+	store.GetTeamsTeamIDWebhooks(c, team.ID)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var listed []api.TeamWebhook
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listed))
+	require.Len(t, listed, 1)
+	assert.Equal(t, created.Id, listed[0].Id)
+	assert.NotContains(t, w.Body.String(), created.Secret)
+
+	_, err := database.CreateWebhookDelivery(context.Background(), created.Id, uuid.New(), string(webhooks.SandboxKilled), "{}", created.CreatedAt)
+	require.NoError(t, err)
+
+	c, w = newUserTestContext(t, http.MethodGet, "/teams/"+team.ID.String()+"/webhooks/"+created.Id.String()+"/deliveries", nil, user.ID)
+	store.GetTeamsTeamIDWebhooksWebhookIDDeliveries(c, team.ID, created.Id, api.GetTeamsTeamIDWebhooksWebhookIDDeliveriesParams{})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var deliveries []api.WebhookDelivery
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &deliveries))
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, api.WebhookDeliveryStatus("pending"), deliveries[0].Status)
+
+	c, w = newUserTestContext(t, http.MethodDelete, "/teams/"+team.ID.String()+"/webhooks/"+created.Id.String(), nil, user.ID)
+	store.DeleteTeamsTeamIDWebhooksWebhookID(c, team.ID, created.Id)
+	// The status without a body is written to the recorder only when the response is flushed.
+	require.Equal(t, http.StatusNoContent, c.Writer.Status(), w.Body.String())
+
+	c, w = newUserTestContext(t, http.MethodDelete, "/teams/"+team.ID.String()+"/webhooks/"+created.Id.String(), nil, user.ID)
+	store.DeleteTeamsTeamIDWebhooksWebhookID(c, team.ID, created.Id)
+	assert.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestPostTeamWebhookValidation(t *testing.T) {
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+	user := dbtest.CreateUser(t, database, team.ID)
+
+	otherTeam, _ := dbtest.CreateTeam(t, database, 10)
+
+	store := &APIStore{db: database}
+
+	invalidEvents := []api.WebhookEventType{"sandbox.unknown"}
+
+	tests := []struct {
+		name   string
+		teamID uuid.UUID
+		body   api.PostTeamsTeamIDWebhooksJSONRequestBody
+		code   int
+	}{
+		{name: "invalid url", teamID: team.ID, body: api.PostTeamsTeamIDWebhooksJSONRequestBody{Url: "8.8.8.8/webhook"}, code: http.StatusBadRequest},
+		{name: "unknown event", teamID: team.ID, body: api.PostTeamsTeamIDWebhooksJSONRequestBody{Url: "https://8.8.8.8/webhook", Events: &invalidEvents}, code: http.StatusBadRequest},
+		{name: "other team", teamID: otherTeam.ID, body: api.PostTeamsTeamIDWebhooksJSONRequestBody{Url: "https://8.8.8.8/webhook"}, code: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, w := newUserTestContext(t, http.MethodPost, "/teams/"+tt.teamID.String()+"/webhooks", tt.body, user.ID)
+			store.PostTeamsTeamIDWebhooks(c, tt.teamID)
+
+			assert.Equal(t, tt.code, w.Code, w.Body.String())
+		})
+	}
+
+	webhooksList, err := database.GetTeamWebhooks(context.Background(), team.ID)
+	require.NoError(t, err)
+	assert.Empty(t, webhooksList)
+}
@@ -173,6 +173,28 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		return nil
 	}
 
+	// Fail early, the limit is enforced when the build is started, the waiting builds are not counted
+	if maxBuilds := team.Edges.TeamTier.MaxConcurrentBuilds; maxBuilds != nil {
+		runningBuilds, err := a.db.GetTeamRunningTemplateBuildsCount(ctx, team.ID)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when checking the running builds")
+
+			err = fmt.Errorf("error when counting running builds: %w", err)
+			telemetry.ReportCriticalError(ctx, err)
+
+			return nil
+		}
+
+		if runningBuilds >= *maxBuilds {
+			a.sendAPIStoreError(c, http.StatusTooManyRequests, fmt.Sprintf("You have reached the maximum number of concurrent template builds (%d), wait for the running builds to finish", *maxBuilds))
+
+			err = fmt.Errorf("team '%s' has reached the maximum number of concurrent builds (%d)", team.ID, *maxBuilds)
+			telemetry.ReportError(ctx, err)
+
+			return nil
+		}
+	}
+
 	var alias string
 	if body.Alias != nil {
 		alias, err = id.CleanEnvID(*body.Alias)
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -14,6 +15,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
@@ -99,8 +102,20 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		return
 	}
 
-	// Set the build status to building
-	err = a.db.EnvBuildSetStatus(ctx, envDB.ID, buildUUID, envbuild.StatusBuilding)
+	// Set the build status to building, the concurrent builds limit is checked in the same transaction
+	err = a.db.StartTemplateBuild(ctx, team.ID, envDB.ID, buildUUID, team.Edges.TeamTier.MaxConcurrentBuilds)
+	var limitErr db.TemplateBuildLimitExceeded
+	if errors.As(err, &limitErr) {
+		a.sendAPIStoreError(c, http.StatusTooManyRequests, fmt.Sprintf("You have reached the maximum number of concurrent template builds (%d), wait for the running builds to finish", limitErr.Limit))
+
+		err = fmt.Errorf("team '%s' has reached the maximum number of concurrent builds: %w", team.ID, err)
+		telemetry.ReportError(ctx, err)
+
+		a.buildCache.Delete(templateID, buildUUID, team.ID)
+
+		return
+	}
+
 	if err != nil {
 		err = fmt.Errorf("error when setting build status: %w", err)
 		telemetry.ReportCriticalError(ctx, err)
@@ -161,9 +176,21 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 				telemetry.ReportCriticalError(buildContext, fmt.Errorf("error when setting build done in logs: %w", cacheErr))
 			}
 
+			a.webhooks.Emit(team.ID, webhooks.TemplateBuildFinished, webhooks.TemplateBuildEventData{
+				TemplateID: templateID,
+				BuildID:    buildID,
+				Status:     webhooks.TemplateBuildStatusError,
+			})
+
 			return
 		}
 
+		a.webhooks.Emit(team.ID, webhooks.TemplateBuildFinished, webhooks.TemplateBuildEventData{
+			TemplateID: templateID,
+			BuildID:    buildID,
+			Status:     webhooks.TemplateBuildStatusReady,
+		})
+
 		// Invalidate the cache
 		a.templateCache.Invalidate(templateID)
 
@@ -39,7 +39,7 @@ func (o *Orchestrator) reportNodesAnalytics(parentCtx context.Context) {
 	sandboxes := o.instanceCache.Items()
 	sbxsByNode := make(map[string][]*instance.InstanceInfo)
 	for _, sbx := range sandboxes {
-		nodeID := sbx.Node.ID
+		nodeID := sbx.GetNode().ID
 		sbxsByNode[nodeID] = append(sbxsByNode[nodeID], sbx)
 	}
 
@@ -13,7 +13,9 @@ import (
 
 	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/events"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -139,6 +141,8 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 
 	instanceCache.Sync(ctx, activeInstances, node.Info.ID)
 
+	o.reportHealthChanges(activeInstances, instanceCache)
+
 	builds, buildsErr := o.listCachedBuilds(ctx, node.Info.ID)
 	if buildsErr != nil {
 		zap.L().Error("Error listing cached builds", zap.Error(buildsErr))
@@ -146,6 +150,51 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 	}
 
 	node.SyncBuilds(builds)
+
+	statusErr := o.syncNodeStatus(ctx, node)
+	if statusErr != nil {
+		zap.L().Error("Error syncing node status", zap.Error(statusErr))
+	}
+}
+
+// reportHealthChanges updates the health of the cached instances and emits the event when the healthcheck starts failing.
+func (o *Orchestrator) reportHealthChanges(activeInstances []*instance.InstanceInfo, instanceCache *instance.InstanceCache) {
+	for _, active := range activeInstances {
+		cached, err := instanceCache.Get(active.Instance.SandboxID)
+		// The instances just added to the cache already have the current health.
+		if err != nil || cached == active {
+			continue
+		}
+
+		if !active.Unhealthy.Load() {
+			cached.Unhealthy.Store(false)
+
+			continue
+		}
+
+		if cached.Unhealthy.CompareAndSwap(false, true) {
+			o.emitSandboxEvent(cached, webhooks.SandboxHealthcheckFailed)
+		}
+	}
+}
+
+// emitSandboxEvent sends the lifecycle event of the sandbox to the team webhooks and the event streams.
+func (o *Orchestrator) emitSandboxEvent(info *instance.InstanceInfo, eventType webhooks.EventType) {
+	o.webhooks.Emit(*info.TeamID, eventType, webhooks.SandboxEventData{
+		SandboxID:  info.Instance.SandboxID,
+		TemplateID: info.Instance.TemplateID,
+		Alias:      info.Instance.Alias,
+		Metadata:   info.Metadata,
+	})
+
+	o.sandboxEvents.Publish(*info.TeamID, events.SandboxEvent{
+		Type:       eventType,
+		Timestamp:  time.Now().UTC(),
+		SandboxID:  info.Instance.SandboxID,
+		TemplateID: info.Instance.TemplateID,
+		Alias:      info.Instance.Alias,
+		Metadata:   info.Metadata,
+	})
 }
 
 func (o *Orchestrator) getDeleteInstanceFunction(
@@ -175,6 +224,15 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			ct = CloseDelete
 		}
 
+		switch {
+		case ct == ClosePause:
+			o.emitSandboxEvent(info, webhooks.SandboxPaused)
+		case info.IsExpired():
+			o.emitSandboxEvent(info, webhooks.SandboxTimeout)
+		default:
+			o.emitSandboxEvent(info, webhooks.SandboxKilled)
+		}
+
 		// Run in separate goroutine to not block sandbox deletion
 		// Also use parentCtx to not cancel the request with this hook timeout
 		go reportInstanceStopAnalytics(
@@ -189,11 +247,11 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			duration,
 		)
 
-		node := o.GetNode(info.Instance.ClientID)
+		node := o.GetNode(info.GetClientID())
 		if node == nil {
-			zap.L().Error("failed to get node", zap.String("node_id", info.Instance.ClientID))
+			zap.L().Error("failed to get node", zap.String("node_id", info.GetClientID()))
 
-			return fmt.Errorf("node '%s' not found", info.Instance.ClientID)
+			return fmt.Errorf("node '%s' not found", info.GetClientID())
 		}
 
 		node.CPUUsage.Add(-info.VCpu)
@@ -202,9 +260,9 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 		o.dns.Remove(ctx, info.Instance.SandboxID, node.Info.IPAddress)
 
 		if node.Client == nil {
-			zap.L().Error("client for node not found", zap.String("node_id", info.Instance.ClientID))
+			zap.L().Error("client for node not found", zap.String("node_id", info.GetClientID()))
 
-			return fmt.Errorf("client for node '%s' not found", info.Instance.ClientID)
+			return fmt.Errorf("client for node '%s' not found", info.GetClientID())
 		}
 
 		if ct == ClosePause {
@@ -285,9 +343,9 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 			zap.Bool("auto_pause", info.AutoPause.Load()),
 		)
 
-		node := o.GetNode(info.Instance.ClientID)
+		node := o.GetNode(info.GetClientID())
 		if node == nil {
-			zap.L().Error("failed to get node", zap.String("node_id", info.Instance.ClientID))
+			zap.L().Error("failed to get node", zap.String("node_id", info.GetClientID()))
 		} else {
 			node.CPUUsage.Add(info.VCpu)
 			node.RamUsage.Add(info.RamMB)
@@ -299,6 +357,15 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 			o.instanceCache.MarkAsPausing(info)
 		}
 
+		// The instances loaded from the nodes were already reported when they were created.
+		if info.IsNewlyCreated() {
+			if info.IsResume {
+				o.emitSandboxEvent(info, webhooks.SandboxResumed)
+			} else {
+				o.emitSandboxEvent(info, webhooks.SandboxCreated)
+			}
+		}
+
 		// Run in separate goroutine to not block sandbox creation
 		// Also use parentCtx to not cancel the request with this hook timeout
 		go reportInstanceStartAnalytics(
@@ -307,7 +374,7 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 			info.TeamID.String(),
 			info.Instance.SandboxID,
 			info.Instance.TemplateID,
-			info.BuildID.String(),
+			info.GetBuildID().String(),
 		)
 
 		sbxlogger.I(info).Debug("Inserted sandbox to cache hook",
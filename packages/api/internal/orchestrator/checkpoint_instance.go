@@ -0,0 +1,82 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// CheckpointInstance creates a new snapshot build of the running sandbox without stopping it.
+// The sandbox is only paused for the time it takes to create the snapshot.
+// The build is created without a snapshot record, so the running sandbox is not considered paused.
+func (o *Orchestrator) CheckpointInstance(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	teamID uuid.UUID,
+) (*models.EnvBuild, error) {
+	ctx, span := o.tracer.Start(ctx, "checkpoint-sandbox")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("instance.id", sbx.Instance.SandboxID))
+
+	client, err := o.GetClient(sbx.GetClientID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client '%s': %w", sbx.GetClientID(), err)
+	}
+
+	snapshotConfig := &db.SnapshotInfo{
+		BaseTemplateID:     sbx.Instance.TemplateID,
+		SandboxID:          sbx.Instance.SandboxID,
+		VCPU:               sbx.VCpu,
+		RAMMB:              sbx.RamMB,
+		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+		Metadata:           sbx.Metadata,
+		KernelVersion:      sbx.KernelVersion,
+		FirecrackerVersion: sbx.FirecrackerVersion,
+		EnvdVersion:        sbx.Instance.EnvdVersion,
+	}
+
+	envBuild, err := o.dbClient.NewForkBuild(ctx, snapshotConfig, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating checkpoint build: %w", err)
+	}
+
+	// Fork without children only snapshots the sandbox and uploads the snapshot.
+	_, err = client.Sandbox.Fork(ctx, &orchestrator.SandboxForkRequest{
+		SandboxId:  sbx.Instance.SandboxID,
+		TemplateId: *envBuild.EnvID,
+		BuildId:    envBuild.ID.String(),
+	})
+	if err != nil {
+		statusErr := o.dbClient.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusFailed)
+		if statusErr != nil {
+			telemetry.ReportError(ctx, fmt.Errorf("error setting checkpoint build status: %w", statusErr))
+		}
+
+		return nil, fmt.Errorf("failed to checkpoint sandbox '%s': %w", sbx.Instance.SandboxID, utils.UnwrapGRPCError(err))
+	}
+
+	telemetry.ReportEvent(ctx, "Checkpointed sandbox")
+
+	err = o.dbClient.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusSuccess)
+	if err != nil {
+		return nil, fmt.Errorf("error setting checkpoint build status: %w", err)
+	}
+
+	if node := o.GetNode(sbx.GetClientID()); node != nil {
+		// The build should be cached on the node now
+		node.InsertBuild(envBuild.ID.String())
+	}
+
+	return envBuild, nil
+}
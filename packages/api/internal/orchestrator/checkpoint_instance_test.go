@@ -0,0 +1,118 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+type fakeSandboxClient struct {
+	orchestrator.SandboxServiceClient
+
+	forks []*orchestrator.SandboxForkRequest
+}
+
+func (f *fakeSandboxClient) Fork(_ context.Context, in *orchestrator.SandboxForkRequest, _ ...grpc.CallOption) (*orchestrator.SandboxForkResponse, error) {
+	f.forks = append(f.forks, in)
+
+	return &orchestrator.SandboxForkResponse{}, nil
+}
+
+func newTestOrchestrator(t *testing.T, client orchestrator.SandboxServiceClient) (*Orchestrator, *db.DB) {
+	database := dbtest.New(t)
+
+	o := &Orchestrator{
+		tracer:   noop.NewTracerProvider().Tracer("test"),
+		dbClient: database,
+		nodes:    smap.New[*Node](),
+	}
+
+	o.nodes.Insert("node", &Node{
+		Client:     &GRPCClient{Sandbox: client},
+		Info:       &node.NodeInfo{ID: "node"},
+		buildCache: ttlcache.New[string, interface{}](),
+	})
+
+	return o, database
+}
+
+func TestCheckpointInstanceDoesNotPauseSandbox(t *testing.T) {
+	ctx := context.Background()
+
+	client := &fakeSandboxClient{}
+	o, database := newTestOrchestrator(t, client)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	envdVersion := "0.1.5"
+	sbx := instance.NewInstanceInfo(
+		&api.Sandbox{SandboxID: "sbx", TemplateID: "base", ClientID: "node", EnvdVersion: envdVersion},
+		&team.ID,
+		nil,
+		nil,
+		0,
+		time.Now(),
+		time.Now().Add(time.Hour),
+		2,
+		1024,
+		512,
+		"vmlinux",
+		"v1.10.1",
+		envdVersion,
+		&node.NodeInfo{ID: "node"},
+		false,
+	)
+
+	build, err := o.CheckpointInstance(ctx, sbx, team.ID)
+	require.NoError(t, err)
+
+	require.Len(t, client.forks, 1)
+	assert.Equal(t, build.ID.String(), client.forks[0].BuildId)
+	assert.Equal(t, *build.EnvID, client.forks[0].TemplateId)
+
+	stored, err := database.Client.EnvBuild.Get(ctx, build.ID)
+	require.NoError(t, err)
+	assert.Equal(t, envbuild.StatusSuccess, stored.Status)
+
+	// The running sandbox must not look paused.
+	_, _, err = database.GetLastSnapshot(ctx, "sbx", team.ID)
+	assert.ErrorIs(t, err, db.SnapshotNotFound{})
+
+	snapshots, err := database.GetTeamSnapshotBuilds(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+
+	assert.True(t, o.GetNode("node").buildCache.Has(build.ID.String()))
+}
+
+func TestCheckpointInstanceUnknownNode(t *testing.T) {
+	o, database := newTestOrchestrator(t, &fakeSandboxClient{})
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	sbx := instance.NewInstanceInfo(
+		&api.Sandbox{SandboxID: "sbx", TemplateID: "base", ClientID: "unknown"},
+		&team.ID, nil, nil, 0, time.Now(), time.Now(), 2, 1024, 512, "", "", "", nil, false,
+	)
+
+	_, err := o.CheckpointInstance(context.Background(), sbx, team.ID)
+	require.Error(t, err)
+
+	builds, err := database.Client.EnvBuild.Query().Where(envbuild.StatusNEQ(envbuild.StatusFailed)).All(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, builds)
+
+}
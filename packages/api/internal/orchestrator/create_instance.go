@@ -51,19 +51,10 @@ func (o *Orchestrator) CreateSandbox(
 	childCtx, childSpan := o.tracer.Start(ctx, "create-sandbox")
 	defer childSpan.End()
 
-	// Check if team has reached max instances
-	err, releaseTeamSandboxReservation := o.instanceCache.Reserve(sandboxID, team.Team.ID, team.Tier.ConcurrentInstances)
-	if err != nil {
-		errMsg := fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, team.Tier.ConcurrentInstances)
-		telemetry.ReportCriticalError(ctx, fmt.Errorf("%w (error: %w)", errMsg, err))
-
-		return nil, &api.APIError{
-			Code: http.StatusTooManyRequests,
-			ClientMsg: fmt.Sprintf(
-				"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
-					"please contact us at 'https://e2b.dev/docs/getting-help'", team.Tier.ConcurrentInstances),
-			Err: errMsg,
-		}
+	// Check if team has reached any of its limits
+	releaseTeamSandboxReservation, apiErr := o.reserveSandbox(childCtx, sandboxID, team, build.Vcpu, build.RAMMB)
+	if apiErr != nil {
+		return nil, apiErr
 	}
 
 	telemetry.ReportEvent(childCtx, "Reserved sandbox for team")
@@ -108,6 +99,12 @@ func (o *Orchestrator) CreateSandbox(
 
 	var node *Node
 
+	// Resumed sandboxes can't use the warm sandboxes.
+	var warmKey string
+	if !isResume {
+		warmKey = warmBuildKey(*build.EnvID, build.ID.String())
+	}
+
 	if isResume && clientID != nil {
 		telemetry.ReportEvent(childCtx, "Placing sandbox on the node where the snapshot was taken")
 
@@ -140,7 +137,7 @@ func (o *Orchestrator) CreateSandbox(
 		}
 
 		if node == nil {
-			node, err = o.getLeastBusyNode(childCtx, nodesExcluded)
+			node, err = o.getLeastBusyNode(childCtx, nodesExcluded, warmKey)
 			if err != nil {
 				errMsg := fmt.Errorf("failed to get least busy node: %w", err)
 				telemetry.ReportError(childCtx, errMsg)
@@ -159,10 +156,18 @@ func (o *Orchestrator) CreateSandbox(
 			CPUs:      build.Vcpu,
 		})
 
+		warm := warmKey != "" && node.hasWarmSandbox(warmKey)
+
 		_, err = node.Client.Sandbox.Create(childCtx, sbxRequest)
 		// The request is done, we will either add it to the cache or remove it from the node
 		if err == nil {
 			// The sandbox was created successfully
+			if warm {
+				node.takeWarmSandbox(warmKey)
+			}
+
+			telemetry.SetAttributes(childCtx, attribute.Bool("node.warm", warm))
+
 			break
 		}
 
@@ -216,6 +221,7 @@ func (o *Orchestrator) CreateSandbox(
 		node.Info,
 		autoPause,
 	)
+	instanceInfo.IsResume = isResume
 
 	cacheErr := o.instanceCache.Add(childCtx, instanceInfo, true)
 	if cacheErr != nil {
@@ -238,7 +244,7 @@ func (o *Orchestrator) CreateSandbox(
 }
 
 // getLeastBusyNode returns the least busy node, if there are no eligible nodes, it tries until one is available or the context timeouts
-func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded map[string]*Node) (leastBusyNode *Node, err error) {
+func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded map[string]*Node, warmKey string) (leastBusyNode *Node, err error) {
 	ctx, cancel := context.WithTimeout(parentCtx, leastBusyNodeTimeout)
 	defer cancel()
 
@@ -246,7 +252,7 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 	defer childSpan.End()
 
 	// Try to find a node without waiting
-	leastBusyNode, err = o.findLeastBusyNode(nodesExcluded)
+	leastBusyNode, err = o.findLeastBusyNode(nodesExcluded, warmKey)
 	if err == nil {
 		return leastBusyNode, nil
 	}
@@ -259,7 +265,7 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 			return nil, childCtx.Err()
 		case <-ticker.C:
 			// If no node is available, wait for a bit and try again
-			leastBusyNode, err = o.findLeastBusyNode(nodesExcluded)
+			leastBusyNode, err = o.findLeastBusyNode(nodesExcluded, warmKey)
 			if err == nil {
 				return leastBusyNode, nil
 			}
@@ -268,8 +274,11 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 }
 
 // findLeastBusyNode finds the least busy node that is ready and not in the excluded list
+// the nodes with a warm sandbox for the build key are preferred, if the key is not empty
 // if no node is available, returns an error
-func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node) (leastBusyNode *Node, err error) {
+func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node, warmKey string) (leastBusyNode *Node, err error) {
+	leastBusyWarm := false
+
 	for _, node := range o.nodes.Items() {
 		// The node might be nil if it was removed from the list while iterating
 		if node == nil {
@@ -296,8 +305,17 @@ func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node) (leastB
 			cpuUsage += sbx.CPUs
 		}
 
-		if leastBusyNode == nil || (node.CPUUsage.Load()+cpuUsage) < leastBusyNode.CPUUsage.Load() {
+		warm := warmKey != "" && node.hasWarmSandbox(warmKey)
+		if leastBusyWarm && !warm {
+			continue
+		}
+
+		// The warm sandboxes are paused, but they keep their resources allocated on the node.
+		cpuUsage += node.WarmCPUUsage.Load()
+
+		if leastBusyNode == nil || (warm && !leastBusyWarm) || (node.CPUUsage.Load()+cpuUsage) < leastBusyNode.CPUUsage.Load()+leastBusyNode.WarmCPUUsage.Load() {
 			leastBusyNode = node
+			leastBusyWarm = warm
 		}
 	}
 
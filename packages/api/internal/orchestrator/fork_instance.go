@@ -0,0 +1,214 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ForkInstance snapshots the running sandbox and starts new sandboxes with the given IDs from the snapshot.
+// The forked sandboxes are placed on the same node as the source sandbox, because the snapshot is available there before it is uploaded.
+func (o *Orchestrator) ForkInstance(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	team authcache.AuthTeamInfo,
+	sandboxIDs []string,
+	timeout time.Duration,
+	autoPause bool,
+) ([]api.Sandbox, *api.APIError) {
+	childCtx, childSpan := o.tracer.Start(ctx, "fork-sandbox")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("instance.id", sbx.Instance.SandboxID),
+		attribute.Int("fork.count", len(sandboxIDs)),
+	)
+
+	for _, sandboxID := range sandboxIDs {
+		releaseTeamSandboxReservation, apiErr := o.reserveSandbox(childCtx, sandboxID, team, sbx.VCpu, sbx.RamMB)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+
+		defer releaseTeamSandboxReservation()
+	}
+
+	telemetry.ReportEvent(childCtx, "Reserved sandboxes for team")
+
+	node := o.GetNode(sbx.GetClientID())
+	if node == nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("node '%s' of sandbox '%s' not found", sbx.GetClientID(), sbx.Instance.SandboxID),
+		}
+	}
+
+	features, err := sandbox.NewVersionInfo(sbx.FirecrackerVersion)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get build information for the sandbox",
+			Err:       fmt.Errorf("failed to get features for firecracker version '%s': %w", sbx.FirecrackerVersion, err),
+		}
+	}
+
+	build, err := o.dbClient.NewForkBuild(
+		childCtx,
+		&db.SnapshotInfo{
+			BaseTemplateID:     sbx.Instance.TemplateID,
+			SandboxID:          sbx.Instance.SandboxID,
+			VCPU:               sbx.VCpu,
+			RAMMB:              sbx.RamMB,
+			TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+			Metadata:           sbx.Metadata,
+			KernelVersion:      sbx.KernelVersion,
+			FirecrackerVersion: sbx.FirecrackerVersion,
+			EnvdVersion:        sbx.Instance.EnvdVersion,
+		},
+		team.Team.ID,
+	)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("error creating fork build: %w", err),
+		}
+	}
+
+	telemetry.ReportEvent(childCtx, "Created fork build")
+
+	// The fork build is only needed while the forked sandboxes run from it or while their snapshots are layered on top of it.
+	// It is marked as deleted in both cases, the garbage collection keeps its files as long as any of them references it.
+	defer func() {
+		deleteErr := o.dbClient.MarkBuildDeleted(context.WithoutCancel(childCtx), build.ID)
+		if deleteErr != nil {
+			telemetry.ReportError(childCtx, fmt.Errorf("error marking fork build as deleted: %w", deleteErr))
+		}
+	}()
+
+	startTime := time.Now()
+	endTime := startTime.Add(timeout)
+
+	children := make([]*orchestrator.SandboxCreateRequest, 0, len(sandboxIDs))
+	for _, sandboxID := range sandboxIDs {
+		children = append(children, &orchestrator.SandboxCreateRequest{
+			Sandbox: &orchestrator.SandboxConfig{
+				BaseTemplateId:     sbx.Instance.TemplateID,
+				TemplateId:         *build.EnvID,
+				Alias:              sbx.Instance.Alias,
+				TeamId:             team.Team.ID.String(),
+				BuildId:            build.ID.String(),
+				SandboxId:          sandboxID,
+				KernelVersion:      build.KernelVersion,
+				FirecrackerVersion: build.FirecrackerVersion,
+				EnvdVersion:        *build.EnvdVersion,
+				Metadata:           sbx.Metadata,
+				MaxSandboxLength:   team.Tier.MaxLengthHours,
+				HugePages:          features.HasHugePages(),
+				RamMb:              build.RAMMB,
+				Vcpu:               build.Vcpu,
+				Snapshot:           true,
+				AutoPause:          &autoPause,
+			},
+			StartTime: timestamppb.New(startTime),
+			EndTime:   timestamppb.New(endTime),
+		})
+
+		node.sbxsInProgress.Insert(sandboxID, &sbxInProgress{
+			MiBMemory: build.RAMMB,
+			CPUs:      build.Vcpu,
+		})
+
+		defer node.sbxsInProgress.Remove(sandboxID)
+	}
+
+	_, err = node.Client.Sandbox.Fork(childCtx, &orchestrator.SandboxForkRequest{
+		SandboxId:  sbx.Instance.SandboxID,
+		TemplateId: *build.EnvID,
+		BuildId:    build.ID.String(),
+		Children:   children,
+	})
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("failed to fork sandbox '%s': %w", sbx.Instance.SandboxID, utils.UnwrapGRPCError(err)),
+		}
+	}
+
+	telemetry.ReportEvent(childCtx, "Forked sandbox")
+
+	// The build should be cached on the node now
+	node.InsertBuild(build.ID.String())
+
+	// This is to compensate for the time it takes to start the instances
+	startTime = time.Now()
+	endTime = startTime.Add(timeout)
+
+	sandboxes := make([]api.Sandbox, 0, len(sandboxIDs))
+	for _, sandboxID := range sandboxIDs {
+		forked := api.Sandbox{
+			ClientID:    node.Info.ID,
+			SandboxID:   sandboxID,
+			TemplateID:  *build.EnvID,
+			Alias:       sbx.Instance.Alias,
+			EnvdVersion: *build.EnvdVersion,
+		}
+
+		instanceInfo := instance.NewInstanceInfo(
+			&forked,
+			&team.Team.ID,
+			&build.ID,
+			sbx.Metadata,
+			time.Duration(team.Tier.MaxLengthHours)*time.Hour,
+			startTime,
+			endTime,
+			build.Vcpu,
+			*build.TotalDiskSizeMB,
+			build.RAMMB,
+			build.KernelVersion,
+			build.FirecrackerVersion,
+			*build.EnvdVersion,
+			node.Info,
+			autoPause,
+		)
+
+		cacheErr := o.instanceCache.Add(childCtx, instanceInfo, true)
+		if cacheErr != nil {
+			telemetry.ReportError(ctx, fmt.Errorf("error when adding forked instance '%s' to cache: %w", sandboxID, cacheErr))
+
+			deleted := o.DeleteInstance(childCtx, sandboxID, false)
+			if !deleted {
+				telemetry.ReportEvent(ctx, "instance wasn't found in cache when deleting")
+			}
+
+			continue
+		}
+
+		sandboxes = append(sandboxes, forked)
+	}
+
+	if len(sandboxes) == 0 {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("none of the forked sandboxes could be added to the cache"),
+		}
+	}
+
+	return sandboxes, nil
+}
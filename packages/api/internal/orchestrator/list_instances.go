@@ -57,31 +57,31 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) (
 			autoPause = *config.AutoPause
 		}
 
-		sandboxesInfo = append(
-			sandboxesInfo,
-			instance.NewInstanceInfo(
-				&api.Sandbox{
-					SandboxID:  config.SandboxId,
-					TemplateID: config.TemplateId,
-					Alias:      config.Alias,
-					ClientID:   sbx.ClientId,
-				},
-				&teamID,
-				&buildID,
-				config.Metadata,
-				time.Duration(config.MaxSandboxLength)*time.Hour,
-				sbx.StartTime.AsTime(),
-				sbx.EndTime.AsTime(),
-				config.Vcpu,
-				config.TotalDiskSizeMb,
-				config.RamMb,
-				config.KernelVersion,
-				config.FirecrackerVersion,
-				config.EnvdVersion,
-				node,
-				autoPause,
-			),
+		sandboxInfo := instance.NewInstanceInfo(
+			&api.Sandbox{
+				SandboxID:  config.SandboxId,
+				TemplateID: config.TemplateId,
+				Alias:      config.Alias,
+				ClientID:   sbx.ClientId,
+			},
+			&teamID,
+			&buildID,
+			config.Metadata,
+			time.Duration(config.MaxSandboxLength)*time.Hour,
+			sbx.StartTime.AsTime(),
+			sbx.EndTime.AsTime(),
+			config.Vcpu,
+			config.TotalDiskSizeMb,
+			config.RamMb,
+			config.KernelVersion,
+			config.FirecrackerVersion,
+			config.EnvdVersion,
+			node,
+			autoPause,
 		)
+		sandboxInfo.Unhealthy.Store(sbx.Unhealthy)
+
+		sandboxesInfo = append(sandboxesInfo, sandboxInfo)
 	}
 
 	return sandboxesInfo, nil
@@ -95,6 +95,14 @@ func (o *Orchestrator) GetSandboxes(ctx context.Context, teamID *uuid.UUID) []*i
 	return o.instanceCache.GetInstances(teamID)
 }
 
+// GetPausingSandboxes returns the sandboxes that are being paused, their snapshots may not be uploaded yet.
+func (o *Orchestrator) GetPausingSandboxes(ctx context.Context) []*instance.InstanceInfo {
+	_, childSpan := o.tracer.Start(ctx, "get-pausing-sandboxes")
+	defer childSpan.End()
+
+	return o.instanceCache.GetPausingInstances()
+}
+
 func (o *Orchestrator) GetInstance(ctx context.Context, id string) (*instance.InstanceInfo, error) {
 	return o.instanceCache.Get(id)
 }
@@ -0,0 +1,234 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// migrationTimeout matches the timeout of the migration in the orchestrator.
+	migrationTimeout = 10 * time.Minute
+
+	drainConcurrency   = 4
+	drainRetryInterval = 10 * time.Second
+)
+
+// MigrateInstance moves the running sandbox to the least busy node, the sandbox keeps its ID.
+func (o *Orchestrator) MigrateInstance(ctx context.Context, sbx *instance.InstanceInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, migrationTimeout)
+	defer cancel()
+
+	childCtx, childSpan := o.tracer.Start(ctx, "migrate-sandbox")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(attribute.String("instance.id", sbx.Instance.SandboxID))
+
+	if !sbx.StartMigration() {
+		return fmt.Errorf("sandbox '%s' is already migrating", sbx.Instance.SandboxID)
+	}
+
+	source := o.GetNode(sbx.GetClientID())
+	if source == nil {
+		sbx.FinishMigration(nil, nil)
+
+		return fmt.Errorf("node '%s' of sandbox '%s' not found", sbx.GetClientID(), sbx.Instance.SandboxID)
+	}
+
+	target, err := o.findLeastBusyNode(map[string]*Node{source.Info.ID: source}, "")
+	if err != nil {
+		sbx.FinishMigration(nil, nil)
+
+		return fmt.Errorf("failed to find target node: %w", err)
+	}
+
+	childSpan.SetAttributes(
+		attribute.String("node.source", source.Info.ID),
+		attribute.String("node.target", target.Info.ID),
+	)
+
+	if sbx.TeamID == nil {
+		sbx.FinishMigration(nil, nil)
+
+		return fmt.Errorf("sandbox '%s' has no team", sbx.Instance.SandboxID)
+	}
+
+	// The build has no snapshot record, so the sandbox is not considered paused.
+	build, err := o.dbClient.NewForkBuild(childCtx, &db.SnapshotInfo{
+		BaseTemplateID:     sbx.Instance.TemplateID,
+		SandboxID:          sbx.Instance.SandboxID,
+		VCPU:               sbx.VCpu,
+		RAMMB:              sbx.RamMB,
+		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+		Metadata:           sbx.Metadata,
+		KernelVersion:      sbx.KernelVersion,
+		FirecrackerVersion: sbx.FirecrackerVersion,
+		EnvdVersion:        sbx.Instance.EnvdVersion,
+	}, *sbx.TeamID)
+	if err != nil {
+		sbx.FinishMigration(nil, nil)
+
+		return fmt.Errorf("error creating migration build: %w", err)
+	}
+
+	// The migration build is only needed while the sandbox runs from it or while the later snapshots are layered on top of it.
+	// It is marked as deleted in both cases, the garbage collection keeps its files as long as any of them references it.
+	defer func() {
+		deleteErr := o.dbClient.MarkBuildDeleted(context.WithoutCancel(childCtx), build.ID)
+		if deleteErr != nil {
+			telemetry.ReportError(childCtx, fmt.Errorf("error marking migration build as deleted: %w", deleteErr))
+		}
+	}()
+
+	target.sbxsInProgress.Insert(sbx.Instance.SandboxID, &sbxInProgress{
+		MiBMemory: sbx.RamMB,
+		CPUs:      sbx.VCpu,
+	})
+	defer target.sbxsInProgress.Remove(sbx.Instance.SandboxID)
+
+	_, err = source.Client.Sandbox.Migrate(childCtx, &orchestrator.SandboxMigrateRequest{
+		SandboxId:     sbx.Instance.SandboxID,
+		TargetAddress: target.Info.OrchestratorAddress,
+		BuildId:       build.ID.String(),
+	})
+	if err != nil {
+		sbx.FinishMigration(nil, nil)
+
+		return fmt.Errorf("failed to migrate sandbox '%s' to node '%s': %w", sbx.Instance.SandboxID, target.Info.ID, utils.UnwrapGRPCError(err))
+	}
+
+	source.CPUUsage.Add(-sbx.VCpu)
+	source.RamUsage.Add(-sbx.RamMB)
+	target.CPUUsage.Add(sbx.VCpu)
+	target.RamUsage.Add(sbx.RamMB)
+
+	o.dns.Remove(childCtx, sbx.Instance.SandboxID, source.Info.IPAddress)
+	o.dns.Add(childCtx, sbx.Instance.SandboxID, target.Info.IPAddress)
+
+	sbx.FinishMigration(target.Info, &build.ID)
+
+	telemetry.ReportEvent(childCtx, "Migrated sandbox")
+
+	return nil
+}
+
+// SetNodeStatus changes the status of the node and starts migrating its sandboxes away if it is draining.
+// The draining is stored by the orchestrator on the node, so all API instances see it and it survives their restarts.
+func (o *Orchestrator) SetNodeStatus(ctx context.Context, n *Node, status api.NodeStatus) error {
+	_, err := n.Client.Sandbox.SetDraining(ctx, &orchestrator.NodeDrainingRequest{
+		Draining: status == api.NodeStatusDraining,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set draining of node '%s': %w", n.Info.ID, utils.UnwrapGRPCError(err))
+	}
+
+	n.SetStatus(status)
+
+	if status == api.NodeStatusDraining {
+		// The request context is cancelled after the response.
+		go o.DrainNode(context.WithoutCancel(ctx), n.Info.ID)
+	}
+
+	return nil
+}
+
+// syncNodeStatus picks up the draining set on the node through another API instance or before the restart of this one.
+func (o *Orchestrator) syncNodeStatus(ctx context.Context, n *Node) error {
+	res, err := n.Client.Sandbox.Status(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("failed to get status of node '%s': %w", n.Info.ID, utils.UnwrapGRPCError(err))
+	}
+
+	n.WarmCPUUsage.Store(res.WarmVcpu)
+	n.WarmRamUsage.Store(res.WarmRamMb)
+
+	draining := n.Status() == api.NodeStatusDraining
+
+	switch {
+	case res.Draining && !draining:
+		n.SetStatus(api.NodeStatusDraining)
+
+		go o.DrainNode(context.WithoutCancel(ctx), n.Info.ID)
+	case !res.Draining && draining:
+		n.SetStatus(api.NodeStatusReady)
+	}
+
+	return nil
+}
+
+// DrainNode migrates all sandboxes off the node while it is draining.
+// The sandboxes that fail to migrate are retried until the node is empty or its status changes.
+func (o *Orchestrator) DrainNode(ctx context.Context, nodeID string) {
+	n := o.GetNode(nodeID)
+	if n == nil {
+		return
+	}
+
+	if !n.draining.CompareAndSwap(false, true) {
+		return
+	}
+
+	defer n.draining.Store(false)
+
+	zap.L().Info("Draining node", zap.String("node_id", nodeID))
+
+	ticker := time.NewTicker(drainRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		if n.Status() != api.NodeStatusDraining {
+			zap.L().Info("Node is not draining anymore", zap.String("node_id", nodeID))
+
+			return
+		}
+
+		var sandboxes []*instance.InstanceInfo
+		for _, sbx := range o.instanceCache.Items() {
+			if sbx.GetClientID() == nodeID {
+				sandboxes = append(sandboxes, sbx)
+			}
+		}
+
+		if len(sandboxes) == 0 {
+			zap.L().Info("Node drained", zap.String("node_id", nodeID))
+
+			return
+		}
+
+		eg, egCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(drainConcurrency)
+
+		for _, sbx := range sandboxes {
+			eg.Go(func() error {
+				err := o.MigrateInstance(egCtx, sbx)
+				if err != nil {
+					sbxlogger.I(sbx).Error("failed to migrate sandbox from draining node", zap.Error(err))
+				}
+
+				// The other sandboxes are still migrated.
+				return nil
+			})
+		}
+
+		_ = eg.Wait()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/dns"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	e2bgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+type readyConnection struct {
+	e2bgrpc.ClientConnInterface
+}
+
+func (readyConnection) GetState() connectivity.State {
+	return connectivity.Ready
+}
+
+type fakeMigrationClient struct {
+	orchestrator.SandboxServiceClient
+
+	migrateErr error
+	migrations []*orchestrator.SandboxMigrateRequest
+
+	draining    bool
+	setDraining []bool
+
+	warmVcpu  int64
+	warmRamMB int64
+}
+
+func (f *fakeMigrationClient) Migrate(_ context.Context, in *orchestrator.SandboxMigrateRequest, _ ...grpc.CallOption) (*orchestrator.SandboxMigrateResponse, error) {
+	f.migrations = append(f.migrations, in)
+
+	if f.migrateErr != nil {
+		return nil, f.migrateErr
+	}
+
+	return &orchestrator.SandboxMigrateResponse{ClientId: "target"}, nil
+}
+
+func (f *fakeMigrationClient) SetDraining(_ context.Context, in *orchestrator.NodeDrainingRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	f.setDraining = append(f.setDraining, in.Draining)
+	f.draining = in.Draining
+
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeMigrationClient) Status(context.Context, *empty.Empty, ...grpc.CallOption) (*orchestrator.NodeStatusResponse, error) {
+	return &orchestrator.NodeStatusResponse{Draining: f.draining, WarmVcpu: f.warmVcpu, WarmRamMb: f.warmRamMB}, nil
+}
+
+func newMigrationTestNode(id string, client orchestrator.SandboxServiceClient) *Node {
+	return &Node{
+		Client:         &GRPCClient{Sandbox: client, connection: readyConnection{}},
+		Info:           &node.NodeInfo{ID: id, OrchestratorAddress: id + ":5008", IPAddress: id},
+		status:         api.NodeStatusReady,
+		sbxsInProgress: smap.New[*sbxInProgress](),
+		buildCache:     ttlcache.New[string, interface{}](),
+	}
+}
+
+func newMigrationTestInstance(teamID uuid.UUID, buildID uuid.UUID) *instance.InstanceInfo {
+	return instance.NewInstanceInfo(
+		&api.Sandbox{SandboxID: "sbx", TemplateID: "base", ClientID: "source", EnvdVersion: "0.1.5"},
+		&teamID,
+		&buildID,
+		nil,
+		0,
+		time.Now(),
+		time.Now().Add(time.Hour),
+		2,
+		1024,
+		512,
+		"vmlinux",
+		"v1.10.1",
+		"0.1.5",
+		&node.NodeInfo{ID: "source"},
+		false,
+	)
+}
+
+func TestMigrateInstanceRegistersBuild(t *testing.T) {
+	ctx := context.Background()
+
+	source := &fakeMigrationClient{}
+	o, database := newTestOrchestrator(t, source)
+	o.dns = dns.New(ctx, nil)
+	o.nodes.Insert("source", newMigrationTestNode("source", source))
+	o.nodes.Insert("target", newMigrationTestNode("target", &fakeMigrationClient{}))
+	o.nodes.Remove("node")
+
+	team, _ := dbtest.CreateTeam(t, database, 10)
+	sbx := newMigrationTestInstance(team.ID, uuid.New())
+
+	err := o.MigrateInstance(ctx, sbx)
+	require.NoError(t, err)
+
+	require.Len(t, source.migrations, 1)
+	assert.Equal(t, "target:5008", source.migrations[0].TargetAddress)
+
+	buildID, err := uuid.Parse(source.migrations[0].BuildId)
+	require.NoError(t, err)
+
+	// The sandbox runs from the migration build, so the garbage collection keeps it while the sandbox is running.
+	assert.Equal(t, "target", sbx.GetClientID())
+	require.NotNil(t, sbx.GetBuildID())
+	assert.Equal(t, buildID, *sbx.GetBuildID())
+	assert.False(t, sbx.IsMigrating())
+
+	build, err := database.Client.EnvBuild.Get(ctx, buildID)
+	require.NoError(t, err)
+	assert.Equal(t, envbuild.StatusDeleted, build.Status)
+
+	// The running sandbox must not look paused.
+	snapshots, err := database.GetTeamSnapshotBuilds(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+}
+
+func TestMigrateInstanceFailureKeepsSandbox(t *testing.T) {
+	ctx := context.Background()
+
+	source := &fakeMigrationClient{migrateErr: status.Error(codes.Internal, "migration failed")}
+	o, database := newTestOrchestrator(t, source)
+	o.dns = dns.New(ctx, nil)
+	o.nodes.Insert("source", newMigrationTestNode("source", source))
+	o.nodes.Insert("target", newMigrationTestNode("target", &fakeMigrationClient{}))
+	o.nodes.Remove("node")
+
+	team, _ := dbtest.CreateTeam(t, database, 10)
+	originalBuildID := uuid.New()
+	sbx := newMigrationTestInstance(team.ID, originalBuildID)
+
+	err := o.MigrateInstance(ctx, sbx)
+	require.Error(t, err)
+
+	assert.Equal(t, "source", sbx.GetClientID())
+	assert.Equal(t, originalBuildID, *sbx.GetBuildID())
+	assert.False(t, sbx.IsMigrating())
+
+	// The files possibly uploaded by the target are garbage collected.
+	require.Len(t, source.migrations, 1)
+	build, err := database.Client.EnvBuild.Get(ctx, uuid.MustParse(source.migrations[0].BuildId))
+	require.NoError(t, err)
+	assert.Equal(t, envbuild.StatusDeleted, build.Status)
+}
+
+func TestSyncNodeStatus(t *testing.T) {
+	ctx := context.Background()
+
+	client := &fakeMigrationClient{}
+	o, _ := newTestOrchestrator(t, client)
+	n := newMigrationTestNode("source", client)
+	o.nodes.Insert("source", n)
+
+	// The node is already being drained, so no migrations are started by the test.
+	n.draining.Store(true)
+
+	err := o.SetNodeStatus(ctx, n, api.NodeStatusDraining)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true}, client.setDraining)
+
+	// Another API instance or this one after a restart picks up the status from the node.
+	other := newMigrationTestNode("source", client)
+	other.draining.Store(true)
+
+	err = o.syncNodeStatus(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, api.NodeStatusDraining, other.Status())
+
+	client.draining = false
+
+	err = o.syncNodeStatus(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, api.NodeStatusReady, other.Status())
+}
+
+func TestSyncNodeStatusWarmUsage(t *testing.T) {
+	ctx := context.Background()
+
+	warmClient := &fakeMigrationClient{warmVcpu: 8, warmRamMB: 4096}
+	o, _ := newTestOrchestrator(t, warmClient)
+	o.nodes = smap.New[*Node]()
+
+	warmNode := newMigrationTestNode("warm", warmClient)
+	otherNode := newMigrationTestNode("other", &fakeMigrationClient{})
+	otherNode.CPUUsage.Store(4)
+
+	o.nodes.Insert("warm", warmNode)
+	o.nodes.Insert("other", otherNode)
+
+	for _, n := range []*Node{warmNode, otherNode} {
+		require.NoError(t, o.syncNodeStatus(ctx, n))
+	}
+
+	assert.Equal(t, int64(8), warmNode.WarmCPUUsage.Load())
+	assert.Equal(t, int64(4096), warmNode.WarmRamUsage.Load())
+
+	// The resources of the warm sandboxes count towards the load of the node.
+	n, err := o.findLeastBusyNode(map[string]*Node{}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "other", n.Info.ID)
+}
@@ -29,7 +29,10 @@ type sbxInProgress struct {
 type Node struct {
 	CPUUsage atomic.Int64
 	RamUsage atomic.Int64
-	Client   *GRPCClient
+	// WarmCPUUsage and WarmRamUsage are the resources of the warm sandboxes reported by the node, they are not in the instance cache.
+	WarmCPUUsage atomic.Int64
+	WarmRamUsage atomic.Int64
+	Client       *GRPCClient
 
 	Info *node.NodeInfo
 
@@ -40,7 +43,14 @@ type Node struct {
 
 	buildCache *ttlcache.Cache[string, interface{}]
 
+	// warmSandboxes are the numbers of the paused sandboxes ready on the node, keyed by warmBuildKey.
+	warmSandboxes   map[string]int32
+	warmSandboxesMu sync.RWMutex
+
 	createFails atomic.Uint64
+
+	// draining is set while the sandboxes are being migrated off the node.
+	draining atomic.Bool
 }
 
 func (n *Node) Status() api.NodeStatus {
@@ -113,13 +123,15 @@ func (o *Orchestrator) GetNodes() []*api.Node {
 			Status:               n.Status(),
 			CreateFails:          n.createFails.Load(),
 			SandboxStartingCount: n.sbxsInProgress.Count(),
+			AllocatedCPU:         int32(n.WarmCPUUsage.Load()),
+			AllocatedMemoryMiB:   int32(n.WarmRamUsage.Load()),
 		}
 	}
 
 	for _, sbx := range o.instanceCache.Items() {
-		n, ok := nodes[sbx.Instance.ClientID]
+		n, ok := nodes[sbx.GetClientID()]
 		if !ok {
-			fmt.Fprintf(os.Stderr, "node [%s] for sandbox [%s] wasn't found \n", sbx.Instance.ClientID, sbx.Instance.SandboxID)
+			fmt.Fprintf(os.Stderr, "node [%s] for sandbox [%s] wasn't found \n", sbx.GetClientID(), sbx.Instance.SandboxID)
 			continue
 		}
 
@@ -156,7 +168,7 @@ func (o *Orchestrator) GetNodeDetail(nodeID string) *api.NodeDetail {
 	}
 
 	for _, sbx := range o.instanceCache.Items() {
-		if sbx.Instance.ClientID == nodeID {
+		if sbx.GetClientID() == nodeID {
 			var metadata *api.SandboxMetadata
 			if sbx.Metadata != nil {
 				meta := api.SandboxMetadata(sbx.Metadata)
@@ -180,8 +192,41 @@ func (o *Orchestrator) GetNodeDetail(nodeID string) *api.NodeDetail {
 }
 
 func (n *Node) SyncBuilds(builds []*orchestrator.CachedBuildInfo) {
+	warmSandboxes := make(map[string]int32)
+
 	for _, build := range builds {
 		n.buildCache.Set(build.BuildId, struct{}{}, build.ExpirationTime.AsTime().Sub(time.Now()))
+
+		if build.WarmSandboxes > 0 {
+			warmSandboxes[build.BuildId] = build.WarmSandboxes
+		}
+	}
+
+	n.warmSandboxesMu.Lock()
+	defer n.warmSandboxesMu.Unlock()
+
+	n.warmSandboxes = warmSandboxes
+}
+
+// warmBuildKey is the key of the build in the orchestrator template cache, the warm sandboxes are reported under it.
+func warmBuildKey(templateID, buildID string) string {
+	return fmt.Sprintf("%s-%s", templateID, buildID)
+}
+
+func (n *Node) hasWarmSandbox(key string) bool {
+	n.warmSandboxesMu.RLock()
+	defer n.warmSandboxesMu.RUnlock()
+
+	return n.warmSandboxes[key] > 0
+}
+
+// takeWarmSandbox counts the warm sandbox as used until the next sync, so the following requests are spread over the other nodes.
+func (n *Node) takeWarmSandbox(key string) {
+	n.warmSandboxesMu.Lock()
+	defer n.warmSandboxesMu.Unlock()
+
+	if n.warmSandboxes[key] > 0 {
+		n.warmSandboxes[key]--
 	}
 }
 
@@ -15,7 +15,9 @@ import (
 	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/dns"
+	"github.com/e2b-dev/infra/packages/api/internal/events"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
@@ -37,6 +39,8 @@ type Orchestrator struct {
 	analytics     *analyticscollector.Analytics
 	dns           *dns.DNS
 	dbClient      *db.DB
+	webhooks      *webhooks.Dispatcher
+	sandboxEvents *events.Broker
 }
 
 func New(
@@ -46,6 +50,8 @@ func New(
 	posthogClient *analyticscollector.PosthogClient,
 	redisClient *redis.Client,
 	dbClient *db.DB,
+	webhooksDispatcher *webhooks.Dispatcher,
+	sandboxEvents *events.Broker,
 ) (*Orchestrator, error) {
 	analyticsInstance, err := analyticscollector.NewAnalytics()
 	if err != nil {
@@ -62,12 +68,14 @@ func New(
 	}
 
 	o := Orchestrator{
-		analytics:   analyticsInstance,
-		nomadClient: nomadClient,
-		tracer:      tracer,
-		nodes:       smap.New[*Node](),
-		dns:         dnsServer,
-		dbClient:    dbClient,
+		analytics:     analyticsInstance,
+		nomadClient:   nomadClient,
+		tracer:        tracer,
+		nodes:         smap.New[*Node](),
+		dns:           dnsServer,
+		dbClient:      dbClient,
+		webhooks:      webhooksDispatcher,
+		sandboxEvents: sandboxEvents,
 	}
 
 	cache := instance.NewCache(
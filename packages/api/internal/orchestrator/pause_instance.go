@@ -86,9 +86,9 @@ func snapshotInstance(ctx context.Context, orch *Orchestrator, sbx *instance.Ins
 	_, childSpan := orch.tracer.Start(ctx, "snapshot-instance")
 	defer childSpan.End()
 
-	client, err := orch.GetClient(sbx.Instance.ClientID)
+	client, err := orch.GetClient(sbx.GetClientID())
 	if err != nil {
-		return fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+		return fmt.Errorf("failed to get client '%s': %w", sbx.GetClientID(), err)
 	}
 
 	_, err = client.Sandbox.Pause(ctx, &orchestrator.SandboxPauseRequest{
@@ -0,0 +1,70 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// reserveSandbox reserves the sandbox with the given resources for the team if it doesn't exceed any of the tier limits.
+func (o *Orchestrator) reserveSandbox(ctx context.Context, sandboxID string, team authcache.AuthTeamInfo, vcpu, ramMB int64) (func(), *api.APIError) {
+	limits := instance.ReservationLimits{
+		ConcurrentInstances: team.Tier.ConcurrentInstances,
+		VCpu:                team.Tier.MaxVcpu,
+		RamMB:               team.Tier.MaxRAMMB,
+		StartsPerMinute:     team.Tier.MaxSandboxStartsPerMinute,
+	}
+
+	err, release := o.instanceCache.Reserve(sandboxID, team.Team.ID, vcpu, ramMB, limits)
+	if err == nil {
+		return release, nil
+	}
+
+	var reservedErr *instance.AlreadyReservedError
+	if errors.As(err, &reservedErr) {
+		errMsg := fmt.Errorf("sandbox '%s' is already being started: %w", sandboxID, err)
+		telemetry.ReportError(ctx, errMsg)
+
+		return nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: fmt.Sprintf("Sandbox '%s' is already being started", sandboxID),
+			Err:       errMsg,
+		}
+	}
+
+	var limitErr *instance.LimitExceededError
+	if !errors.As(err, &limitErr) {
+		errMsg := fmt.Errorf("failed to reserve sandbox for team '%s': %w", team.Team.ID, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to reserve the sandbox",
+			Err:       errMsg,
+		}
+	}
+
+	errMsg := fmt.Errorf("team '%s' has reached the limit of %s (%d)", team.Team.ID, limitErr.Resource, limitErr.Limit)
+	telemetry.ReportError(ctx, errMsg)
+
+	return nil, &api.APIError{
+		Code: http.StatusTooManyRequests,
+		ClientMsg: fmt.Sprintf(
+			"you have reached the limit of %s (%d). If you need more, "+
+				"please contact us at 'https://e2b.dev/docs/getting-help'", limitErr.Resource, limitErr.Limit),
+		Err: errMsg,
+	}
+}
+
+// GetTeamUsage returns the current usage of the running and reserved sandboxes of the team.
+func (o *Orchestrator) GetTeamUsage(teamID uuid.UUID) instance.TeamUsage {
+	return o.instanceCache.TeamUsage(teamID)
+}
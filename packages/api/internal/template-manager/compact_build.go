@@ -0,0 +1,29 @@
+package template_manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+// CompactBuild rewrites the build into a new single layer build with the given ID if its header chain exceeds the limits.
+// It returns false if the build didn't need compaction.
+func (tm *TemplateManager) CompactBuild(ctx context.Context, buildID, newBuildID uuid.UUID, maxGeneration, maxMappings uint64) (bool, error) {
+	res, err := tm.grpc.Client.TemplateBuildCompact(ctx, &template_manager.TemplateBuildCompactRequest{
+		BuildID:       buildID.String(),
+		NewBuildID:    newBuildID.String(),
+		MaxGeneration: maxGeneration,
+		MaxMappings:   maxMappings,
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return false, fmt.Errorf("failed to compact env build '%s': %w", buildID.String(), err)
+	}
+
+	return res.Compacted, nil
+}
@@ -16,11 +16,15 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
+// templateCompression is the compression of the files of new template builds, see header.ParseCompression.
+var templateCompression = env.GetEnv("TEMPLATE_BUILD_COMPRESSION", "none")
+
 func (tm *TemplateManager) CreateTemplate(
 	t trace.Tracer,
 	ctx context.Context,
@@ -62,6 +66,7 @@ func (tm *TemplateManager) CreateTemplate(
 			FirecrackerVersion: firecrackerVersion,
 			HugePages:          features.HasHugePages(),
 			StartCommand:       startCommand,
+			Compression:        templateCompression,
 		},
 	})
 	err = utils.UnwrapGRPCError(err)
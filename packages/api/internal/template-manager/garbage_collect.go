@@ -0,0 +1,117 @@
+package template_manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+// garbageCollectPageSize is the number of the live or used builds sent in a single message.
+const garbageCollectPageSize = 10_000
+
+// GarbageCollection is a session of the garbage collection in the template manager.
+// The template manager reads the headers of the live builds once for all the candidates of the session.
+type GarbageCollection struct {
+	stream template_manager.TemplateService_TemplateBuildGarbageCollectClient
+}
+
+// StartGarbageCollection sends the live and used builds to the template manager, the candidates are sent with GarbageCollection.Collect.
+// Live builds are the snapshots that can be started, used builds are referenced even if they don't have a header, e.g. the templates of the running sandboxes.
+func (tm *TemplateManager) StartGarbageCollection(ctx context.Context, liveBuildIDs, usedBuildIDs []uuid.UUID) (*GarbageCollection, error) {
+	stream, err := tm.grpc.Client.TemplateBuildGarbageCollect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start garbage collection: %w", utils.UnwrapGRPCError(err))
+	}
+
+	gc := &GarbageCollection{stream: stream}
+
+	for start := 0; start < len(liveBuildIDs); start += garbageCollectPageSize {
+		page := liveBuildIDs[start:min(start+garbageCollectPageSize, len(liveBuildIDs))]
+
+		err = gc.send(&template_manager.TemplateBuildGarbageCollectRequest{LiveBuildIDs: toStrings(page)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to send live builds: %w", err)
+		}
+	}
+
+	for start := 0; start < len(usedBuildIDs); start += garbageCollectPageSize {
+		page := usedBuildIDs[start:min(start+garbageCollectPageSize, len(usedBuildIDs))]
+
+		err = gc.send(&template_manager.TemplateBuildGarbageCollectRequest{UsedBuildIDs: toStrings(page)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to send used builds: %w", err)
+		}
+	}
+
+	return gc, nil
+}
+
+// Collect removes the files of the candidate builds from the storage unless they are referenced by any of the live or used builds.
+// It returns the builds whose files were removed.
+func (gc *GarbageCollection) Collect(candidateBuildIDs []uuid.UUID) ([]uuid.UUID, error) {
+	err := gc.send(&template_manager.TemplateBuildGarbageCollectRequest{CandidateBuildIDs: toStrings(candidateBuildIDs)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send candidate builds: %w", err)
+	}
+
+	res, err := gc.stream.Recv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect garbage: %w", utils.UnwrapGRPCError(err))
+	}
+
+	deleted := make([]uuid.UUID, 0, len(res.DeletedBuildIDs))
+	for _, buildID := range res.DeletedBuildIDs {
+		id, parseErr := uuid.Parse(buildID)
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse deleted build ID '%s': %w", buildID, parseErr)
+		}
+
+		deleted = append(deleted, id)
+	}
+
+	return deleted, nil
+}
+
+// Close ends the session and waits for the template manager to finish it.
+func (gc *GarbageCollection) Close() error {
+	err := gc.stream.CloseSend()
+	if err != nil {
+		return fmt.Errorf("failed to close garbage collection: %w", err)
+	}
+
+	for {
+		_, err = gc.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to finish garbage collection: %w", utils.UnwrapGRPCError(err))
+		}
+	}
+}
+
+func (gc *GarbageCollection) send(req *template_manager.TemplateBuildGarbageCollectRequest) error {
+	err := gc.stream.Send(req)
+	if errors.Is(err, io.EOF) {
+		// The server closed the stream, the actual error is returned by Recv.
+		_, err = gc.stream.Recv()
+	}
+
+	return utils.UnwrapGRPCError(err)
+}
+
+func toStrings(buildIDs []uuid.UUID) []string {
+	ids := make([]string, 0, len(buildIDs))
+	for _, buildID := range buildIDs {
+		ids = append(ids, buildID.String())
+	}
+
+	return ids
+}
@@ -0,0 +1,122 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var errPrivateAddress = errors.New("webhook address is not public")
+
+// reservedNetworks are the special purpose networks that are not covered by the netip.Addr checks.
+var reservedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// isPublicAddress reports whether the webhooks can be delivered to the address.
+// The loopback, private and link-local addresses (including the metadata server at 169.254.169.254) are reachable only from the inside of the cluster.
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return false
+	}
+
+	for _, network := range reservedNetworks {
+		if network.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateURL checks that the webhook URL uses https and that all addresses of its host are public.
+// The plain http and the private addresses are allowed for the local development.
+func ValidateURL(ctx context.Context, rawURL string, allowPrivate bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if u.Hostname() == "" {
+		return fmt.Errorf("URL must contain a host")
+	}
+
+	if u.Scheme != "https" && (u.Scheme != "http" || !allowPrivate) {
+		return fmt.Errorf("URL must use https scheme")
+	}
+
+	if allowPrivate {
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("failed to resolve host '%s': %w", u.Hostname(), err)
+	}
+
+	for _, addr := range addrs {
+		if !isPublicAddress(addr) {
+			return fmt.Errorf("host '%s' resolves to %s: %w", u.Hostname(), addr, errPrivateAddress)
+		}
+	}
+
+	return nil
+}
+
+// newHTTPClient returns the client for delivering the webhooks.
+// The address is checked again when connecting, the host may resolve to a different address than when the webhook was created.
+func newHTTPClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			if allowPrivate {
+				return nil
+			}
+
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("invalid address '%s': %w", address, err)
+			}
+
+			if !isPublicAddress(addrPort.Addr()) {
+				return fmt.Errorf("connecting to %s: %w", addrPort.Addr(), errPrivateAddress)
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// The requests would be checked against the address of the proxy instead of the webhook.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	transport.IdleConnTimeout = 30 * time.Second
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+		// The webhooks should respond directly, redirects are not followed.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
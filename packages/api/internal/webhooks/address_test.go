@@ -0,0 +1,74 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddress(t *testing.T) {
+	blocked := []string{
+		"127.0.0.1",
+		"10.1.2.3",
+		"172.16.0.1",
+		"192.168.1.1",
+		"169.254.169.254",
+		"100.64.0.1",
+		"0.0.0.0",
+		"::1",
+		"fd00::1",
+		"fe80::1",
+		"::ffff:127.0.0.1",
+		"::ffff:169.254.169.254",
+	}
+
+	for _, addr := range blocked {
+		assert.False(t, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+
+	for _, addr := range []string{"8.8.8.8", "2001:4860:4860::8888"} {
+		assert.True(t, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, ValidateURL(ctx, "https://8.8.8.8/webhook", false))
+
+	assert.Error(t, ValidateURL(ctx, "http://8.8.8.8/webhook", false))
+	assert.Error(t, ValidateURL(ctx, "https:///webhook", false))
+
+	for _, rawURL := range []string{
+		"https://127.0.0.1/webhook",
+		"https://localhost/webhook",
+		"https://169.254.169.254/computeMetadata/v1/",
+		"https://10.0.0.1:8443/webhook",
+		"https://[::1]/webhook",
+	} {
+		assert.ErrorIs(t, ValidateURL(ctx, rawURL, false), errPrivateAddress, rawURL)
+	}
+
+	assert.NoError(t, ValidateURL(ctx, "http://localhost:3000/webhook", true))
+}
+
+func TestHTTPClientBlocksPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := newHTTPClient(false).Post(server.URL, "application/json", nil)
+	require.ErrorIs(t, err, errPrivateAddress)
+
+	resp, err := newHTTPClient(true).Post(server.URL, "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
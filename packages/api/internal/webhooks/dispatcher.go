@@ -0,0 +1,328 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+)
+
+const (
+	SignatureHeader = "E2B-Signature"
+	EventHeader     = "E2B-Event"
+	DeliveryHeader  = "E2B-Delivery"
+
+	maxAttempts    = 5
+	initialBackoff = 2 * time.Second
+	requestTimeout = 10 * time.Second
+	// maxConcurrentDeliveries limits the number of requests to the webhooks in flight.
+	maxConcurrentDeliveries = 100
+	// The response body is read only to be stored in the delivery log in case of failure.
+	maxResponseBodySize = 1024
+
+	webhooksCacheExpiration = time.Minute
+
+	// eventsQueueSize limits the number of emitted events waiting for the dispatch, the events are dropped when the queue is full.
+	eventsQueueSize = 10_000
+	dispatchWorkers = 4
+
+	// The pending deliveries are stored in the database, so the retries survive the restarts and are picked up by any replica.
+	// The delivery is claimed for the time of one attempt, if the replica stops during the attempt the delivery is retried after the lease.
+	deliveryLease      = 2 * requestTimeout
+	retryCheckInterval = time.Second
+	retryBatchSize     = 100
+)
+
+// Dispatcher delivers the events to the webhooks registered by the teams.
+// The events are delivered asynchronously, every delivery is logged in the database.
+type Dispatcher struct {
+	ctx    context.Context
+	db     *db.DB
+	client *http.Client
+	cache  *ttlcache.Cache[uuid.UUID, []*models.TeamWebhook]
+	sem    chan struct{}
+	events chan Event
+
+	initialBackoff     time.Duration
+	retryCheckInterval time.Duration
+}
+
+func NewDispatcher(ctx context.Context, dbClient *db.DB) *Dispatcher {
+	d := newDispatcher(ctx, dbClient, newHTTPClient(env.IsLocal()))
+	d.start()
+
+	return d
+}
+
+func newDispatcher(ctx context.Context, dbClient *db.DB, client *http.Client) *Dispatcher {
+	return &Dispatcher{
+		ctx:                ctx,
+		db:                 dbClient,
+		client:             client,
+		cache:              ttlcache.New(ttlcache.WithTTL[uuid.UUID, []*models.TeamWebhook](webhooksCacheExpiration)),
+		sem:                make(chan struct{}, maxConcurrentDeliveries),
+		events:             make(chan Event, eventsQueueSize),
+		initialBackoff:     initialBackoff,
+		retryCheckInterval: retryCheckInterval,
+	}
+}
+
+func (d *Dispatcher) start() {
+	go d.cache.Start()
+
+	go func() {
+		<-d.ctx.Done()
+		d.cache.Stop()
+	}()
+
+	for range dispatchWorkers {
+		go d.dispatchEvents()
+	}
+
+	go d.retryDeliveries()
+}
+
+// Sign returns the value of the signature header for the payload sent at the time.
+// The signature is HMAC-SHA256 of "<unix timestamp>.<payload>" with the webhook secret.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// Invalidate drops the cached webhooks of the team, it should be called when the webhooks are changed.
+func (d *Dispatcher) Invalidate(teamID uuid.UUID) {
+	if d == nil {
+		return
+	}
+
+	d.cache.Delete(teamID)
+}
+
+// Emit queues the event for all webhooks of the team subscribed to the event type, it doesn't block.
+func (d *Dispatcher) Emit(teamID uuid.UUID, eventType EventType, data any) {
+	if d == nil {
+		return
+	}
+
+	event := Event{
+		ID:        uuid.New(),
+		Type:      eventType,
+		TeamID:    teamID,
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	}
+
+	select {
+	case d.events <- event:
+	default:
+		zap.L().Error("webhook events queue is full, dropping the event",
+			zap.String("team_id", teamID.String()),
+			zap.String("event_type", string(eventType)),
+		)
+	}
+}
+
+func (d *Dispatcher) dispatchEvents() {
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case event := <-d.events:
+			d.dispatch(event)
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(event Event) {
+	webhooks, err := d.getWebhooks(event.TeamID)
+	if err != nil {
+		zap.L().Error("error getting team webhooks", zap.String("team_id", event.TeamID.String()), zap.Error(err))
+
+		return
+	}
+
+	var payload []byte
+
+	for _, webhook := range webhooks {
+		if len(webhook.Events) > 0 && !slices.Contains(webhook.Events, string(event.Type)) {
+			continue
+		}
+
+		if payload == nil {
+			payload, err = json.Marshal(event)
+			if err != nil {
+				zap.L().Error("error marshalling webhook event", zap.String("event_type", string(event.Type)), zap.Error(err))
+
+				return
+			}
+		}
+
+		// The delivery is created as claimed by this replica, so the first attempt is made right away.
+		delivery, err := d.db.CreateWebhookDelivery(d.ctx, webhook.ID, event.ID, string(event.Type), string(payload), time.Now().Add(deliveryLease))
+		if err != nil {
+			zap.L().Error("error creating webhook delivery", zap.String("webhook_id", webhook.ID.String()), zap.Error(err))
+
+			continue
+		}
+
+		d.deliverAsync(webhook, delivery)
+	}
+}
+
+// retryDeliveries periodically claims the pending deliveries that are due and attempts them again.
+func (d *Dispatcher) retryDeliveries() {
+	ticker := time.NewTicker(d.retryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Claim only the deliveries that can be attempted right away, so their lease doesn't expire while waiting.
+		limit := min(retryBatchSize, cap(d.sem)-len(d.sem))
+		if limit == 0 {
+			continue
+		}
+
+		deliveries, err := d.db.ClaimWebhookDeliveries(d.ctx, time.Now(), deliveryLease, limit)
+		if err != nil {
+			zap.L().Error("error claiming pending webhook deliveries", zap.Error(err))
+
+			continue
+		}
+
+		for _, delivery := range deliveries {
+			d.deliverAsync(delivery.Edges.Webhook, delivery)
+		}
+	}
+}
+
+func (d *Dispatcher) getWebhooks(teamID uuid.UUID) ([]*models.TeamWebhook, error) {
+	item := d.cache.Get(teamID)
+	if item != nil {
+		return item.Value(), nil
+	}
+
+	webhooks, err := d.db.GetTeamWebhooks(d.ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.cache.Set(teamID, webhooks, webhooksCacheExpiration)
+
+	return webhooks, nil
+}
+
+// deliverAsync makes the delivery attempt in the background, it blocks while there are too many requests in flight.
+func (d *Dispatcher) deliverAsync(webhook *models.TeamWebhook, delivery *models.WebhookDelivery) {
+	select {
+	case d.sem <- struct{}{}:
+	case <-d.ctx.Done():
+		return
+	}
+
+	go func() {
+		defer func() { <-d.sem }()
+
+		d.deliver(webhook, delivery)
+	}()
+}
+
+// deliver makes one attempt to send the payload to the webhook and records the result.
+// The failed delivery is scheduled for the next attempt with exponential backoff.
+func (d *Dispatcher) deliver(webhook *models.TeamWebhook, delivery *models.WebhookDelivery) {
+	logger := zap.L().With(
+		zap.String("webhook_id", webhook.ID.String()),
+		zap.String("delivery_id", delivery.ID.String()),
+		zap.String("event_type", delivery.EventType),
+	)
+
+	attempt := delivery.Attempts + 1
+
+	responseStatus, err := d.send(webhook, delivery)
+
+	status := webhookdelivery.StatusSucceeded
+
+	var (
+		errMsg        *string
+		nextAttemptAt *time.Time
+	)
+
+	if err != nil {
+		msg := err.Error()
+		errMsg = &msg
+
+		status = webhookdelivery.StatusFailed
+		if attempt < maxAttempts {
+			status = webhookdelivery.StatusPending
+
+			next := time.Now().Add(d.initialBackoff << (attempt - 1))
+			nextAttemptAt = &next
+		}
+
+		logger.Warn("webhook delivery failed", zap.Int32("attempt", attempt), zap.Error(err))
+	}
+
+	dbErr := d.db.UpdateWebhookDelivery(d.ctx, delivery.ID, status, attempt, responseStatus, errMsg, nextAttemptAt)
+	if dbErr != nil {
+		logger.Error("error updating webhook delivery", zap.Error(dbErr))
+	}
+}
+
+// send makes one delivery attempt, it returns the status code of the response if there was any.
+func (d *Dispatcher) send(webhook *models.TeamWebhook, delivery *models.WebhookDelivery) (*int32, error) {
+	payload := []byte(delivery.Payload)
+
+	req, err := http.NewRequestWithContext(d.ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "E2B-Webhooks")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, time.Now(), payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	status := int32(resp.StatusCode)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+
+		return &status, fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, body)
+	}
+
+	return &status, nil
+}
@@ -0,0 +1,265 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+)
+
+func TestSign(t *testing.T) {
+	signature := Sign("whsec_test", time.Unix(1700000000, 0), []byte(`{"id":"1"}`))
+
+	expected := "t=1700000000,v1=11bf4466ea17c3df3fd743af0b435368e16b7a05eb8eced85e8c4670767bdec5"
+	if signature != expected {
+		t.Fatalf("expected %s, got %s", expected, signature)
+	}
+}
+
+func TestSignDifferentSecret(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0)
+	payload := []byte(`{"id":"1"}`)
+
+	if Sign("whsec_a", timestamp, payload) == Sign("whsec_b", timestamp, payload) {
+		t.Fatalf("expected different signatures for different secrets")
+	}
+}
+
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newWebhookServer returns the server that responds with the given statuses in order and then with 200.
+func newWebhookServer(t *testing.T, statuses ...int) (*httptest.Server, chan webhookRequest) {
+	t.Helper()
+
+	requests := make(chan webhookRequest, 10)
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- webhookRequest{header: r.Header.Clone(), body: body}
+
+		call := int(calls.Add(1)) - 1
+		if call < len(statuses) {
+			w.WriteHeader(statuses[call])
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+func newTestDispatcher(t *testing.T, database *db.DB, client *http.Client) *Dispatcher {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	d := newDispatcher(ctx, database, client)
+	d.initialBackoff = 10 * time.Millisecond
+	d.retryCheckInterval = 10 * time.Millisecond
+	d.start()
+
+	return d
+}
+
+func waitForDelivery(t *testing.T, database *db.DB, webhookID uuid.UUID, status webhookdelivery.Status) *models.WebhookDelivery {
+	t.Helper()
+
+	var delivery *models.WebhookDelivery
+
+	require.Eventually(t, func() bool {
+		deliveries, err := database.GetWebhookDeliveries(context.Background(), webhookID, 10)
+		require.NoError(t, err)
+
+		if len(deliveries) != 1 || deliveries[0].Status != status {
+			return false
+		}
+
+		delivery = deliveries[0]
+
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return delivery
+}
+
+func TestDispatcherDeliversEvent(t *testing.T) {
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	server, requests := newWebhookServer(t)
+
+	webhook, err := database.CreateTeamWebhook(context.Background(), team.ID, server.URL, "whsec_test", nil)
+	require.NoError(t, err)
+
+	d := newTestDispatcher(t, database, server.Client())
+	d.Emit(team.ID, SandboxCreated, SandboxEventData{SandboxID: "sbx", TemplateID: "template"})
+
+	var request webhookRequest
+	select {
+	case request = <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not called")
+	}
+
+	delivery := waitForDelivery(t, database, webhook.ID, webhookdelivery.StatusSucceeded)
+
+	assert.Equal(t, string(SandboxCreated), request.header.Get(EventHeader))
+	assert.Equal(t, delivery.ID.String(), request.header.Get(DeliveryHeader))
+	assert.Equal(t, delivery.Payload, string(request.body))
+	assert.Contains(t, string(request.body), `"sandboxID":"sbx"`)
+
+	var timestamp int64
+	_, err = fmt.Sscanf(request.header.Get(SignatureHeader), "t=%d,", &timestamp)
+	require.NoError(t, err)
+	assert.Equal(t, Sign("whsec_test", time.Unix(timestamp, 0), request.body), request.header.Get(SignatureHeader))
+
+	assert.Equal(t, int32(1), delivery.Attempts)
+	assert.Equal(t, int32(http.StatusOK), *delivery.ResponseStatus)
+	assert.NotNil(t, delivery.DeliveredAt)
+	assert.Nil(t, delivery.NextAttemptAt)
+}
+
+func TestDispatcherFiltersEvents(t *testing.T) {
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	server, requests := newWebhookServer(t)
+
+	webhook, err := database.CreateTeamWebhook(context.Background(), team.ID, server.URL, "whsec_test", []string{string(SandboxKilled)})
+	require.NoError(t, err)
+
+	d := newTestDispatcher(t, database, server.Client())
+	d.Emit(team.ID, SandboxCreated, SandboxEventData{SandboxID: "sbx"})
+	d.Emit(team.ID, SandboxKilled, SandboxEventData{SandboxID: "sbx"})
+
+	select {
+	case request := <-requests:
+		assert.Equal(t, string(SandboxKilled), request.header.Get(EventHeader))
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not called")
+	}
+
+	delivery := waitForDelivery(t, database, webhook.ID, webhookdelivery.StatusSucceeded)
+	assert.Equal(t, string(SandboxKilled), delivery.EventType)
+}
+
+func TestDispatcherRetriesFailedDelivery(t *testing.T) {
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	server, requests := newWebhookServer(t, http.StatusInternalServerError, http.StatusBadGateway)
+
+	webhook, err := database.CreateTeamWebhook(context.Background(), team.ID, server.URL, "whsec_test", nil)
+	require.NoError(t, err)
+
+	d := newTestDispatcher(t, database, server.Client())
+	d.Emit(team.ID, SandboxPaused, SandboxEventData{SandboxID: "sbx"})
+
+	delivery := waitForDelivery(t, database, webhook.ID, webhookdelivery.StatusSucceeded)
+
+	assert.Equal(t, int32(3), delivery.Attempts)
+	assert.Len(t, requests, 3)
+}
+
+func TestDispatcherGivesUpAfterMaxAttempts(t *testing.T) {
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	statuses := make([]int, maxAttempts+1)
+	for i := range statuses {
+		statuses[i] = http.StatusServiceUnavailable
+	}
+
+	server, requests := newWebhookServer(t, statuses...)
+
+	webhook, err := database.CreateTeamWebhook(context.Background(), team.ID, server.URL, "whsec_test", nil)
+	require.NoError(t, err)
+
+	d := newTestDispatcher(t, database, server.Client())
+	d.Emit(team.ID, SandboxPaused, SandboxEventData{SandboxID: "sbx"})
+
+	delivery := waitForDelivery(t, database, webhook.ID, webhookdelivery.StatusFailed)
+
+	assert.Equal(t, int32(maxAttempts), delivery.Attempts)
+	assert.Equal(t, int32(http.StatusServiceUnavailable), *delivery.ResponseStatus)
+	assert.Contains(t, *delivery.Error, "503")
+	assert.Nil(t, delivery.NextAttemptAt)
+	assert.Len(t, requests, maxAttempts)
+}
+
+func TestDispatcherResumesPendingDeliveries(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	server, requests := newWebhookServer(t)
+
+	webhook, err := database.CreateTeamWebhook(ctx, team.ID, server.URL, "whsec_test", nil)
+	require.NoError(t, err)
+
+	// The delivery was claimed by a replica that stopped before the attempt finished.
+	_, err = database.CreateWebhookDelivery(ctx, webhook.ID, uuid.New(), string(SandboxKilled), `{"type":"sandbox.killed"}`, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+
+	newTestDispatcher(t, database, server.Client())
+
+	delivery := waitForDelivery(t, database, webhook.ID, webhookdelivery.StatusSucceeded)
+	assert.Equal(t, int32(1), delivery.Attempts)
+
+	request := <-requests
+	assert.JSONEq(t, `{"type":"sandbox.killed"}`, string(request.body))
+}
+
+func TestClaimWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	webhook, err := database.CreateTeamWebhook(ctx, team.ID, "https://8.8.8.8/webhook", "whsec_test", nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	due, err := database.CreateWebhookDelivery(ctx, webhook.ID, uuid.New(), string(SandboxKilled), "{}", now.Add(-time.Second))
+	require.NoError(t, err)
+
+	_, err = database.CreateWebhookDelivery(ctx, webhook.ID, uuid.New(), string(SandboxKilled), "{}", now.Add(time.Minute))
+	require.NoError(t, err)
+
+	claimed, err := database.ClaimWebhookDeliveries(ctx, now, deliveryLease, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, due.ID, claimed[0].ID)
+	assert.Equal(t, webhook.ID, claimed[0].Edges.Webhook.ID)
+
+	// The claimed delivery is not returned again until the lease expires.
+	claimed, err = database.ClaimWebhookDeliveries(ctx, now, deliveryLease, 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	claimed, err = database.ClaimWebhookDeliveries(ctx, now.Add(deliveryLease), deliveryLease, 10)
+	require.NoError(t, err)
+	assert.Len(t, claimed, 1)
+}
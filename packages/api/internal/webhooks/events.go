@@ -0,0 +1,56 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	SandboxCreated           EventType = "sandbox.created"
+	SandboxPaused            EventType = "sandbox.paused"
+	SandboxResumed           EventType = "sandbox.resumed"
+	SandboxKilled            EventType = "sandbox.killed"
+	SandboxTimeout           EventType = "sandbox.timeout"
+	SandboxHealthcheckFailed EventType = "sandbox.healthcheck_failed"
+	TemplateBuildFinished    EventType = "template.build_finished"
+)
+
+const (
+	TemplateBuildStatusReady = "ready"
+	TemplateBuildStatusError = "error"
+)
+
+var EventTypes = []EventType{
+	SandboxCreated,
+	SandboxPaused,
+	SandboxResumed,
+	SandboxKilled,
+	SandboxTimeout,
+	SandboxHealthcheckFailed,
+	TemplateBuildFinished,
+}
+
+// Event is the payload delivered to the webhooks.
+type Event struct {
+	ID        uuid.UUID `json:"id"`
+	Type      EventType `json:"type"`
+	TeamID    uuid.UUID `json:"teamID"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      any       `json:"data"`
+}
+
+type SandboxEventData struct {
+	SandboxID  string            `json:"sandboxID"`
+	TemplateID string            `json:"templateID"`
+	Alias      *string           `json:"alias,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+type TemplateBuildEventData struct {
+	TemplateID string `json:"templateID"`
+	BuildID    string `json:"buildID"`
+	Status     string `json:"status"`
+}
@@ -65,6 +65,7 @@ func NewGinServer(ctx context.Context, logger *zap.Logger, apiStore *handlers.AP
 		customMiddleware.ExcludeRoutes(tracingMiddleware.Middleware(serviceName),
 			"/health",
 			"/sandboxes/:sandboxID/refreshes",
+			"/sandboxes/events",
 			"/templates/:templateID/builds/:buildID/logs",
 			"/templates/:templateID/builds/:buildID/status",
 		),
@@ -72,6 +73,7 @@ func NewGinServer(ctx context.Context, logger *zap.Logger, apiStore *handlers.AP
 		customMiddleware.ExcludeRoutes(ginzap.Ginzap(logger, time.RFC3339Nano, true),
 			"/health",
 			"/sandboxes/:sandboxID/refreshes",
+			"/sandboxes/events",
 			"/templates/:templateID/builds/:buildID/logs",
 			"/templates/:templateID/builds/:buildID/status",
 		),
@@ -102,6 +104,8 @@ func NewGinServer(ctx context.Context, logger *zap.Logger, apiStore *handlers.AP
 		"sdk_runtime",
 		"system",
 	}
+	// Allow reading the pagination header of the sandboxes list
+	config.ExposeHeaders = []string{"X-Next-Token"}
 	r.Use(cors.New(config))
 
 	// Create a team API Key auth validator
@@ -6,6 +6,7 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
@@ -14,22 +15,58 @@ import (
 
 // Defines values for EntryInfoType.
 const (
-	File EntryInfoType = "file"
+	Directory EntryInfoType = "directory"
+	File      EntryInfoType = "file"
+	Symlink   EntryInfoType = "symlink"
+)
+
+// Defines values for GetFilesParamsFormat.
+const (
+	GetFilesParamsFormatTarGz GetFilesParamsFormat = "tar.gz"
+	GetFilesParamsFormatZip   GetFilesParamsFormat = "zip"
+)
+
+// Defines values for PostFilesArchiveParamsFormat.
+const (
+	PostFilesArchiveParamsFormatTar   PostFilesArchiveParamsFormat = "tar"
+	PostFilesArchiveParamsFormatTarGz PostFilesArchiveParamsFormat = "tar.gz"
+	PostFilesArchiveParamsFormatZip   PostFilesArchiveParamsFormat = "zip"
 )
 
 // EntryInfo defines model for EntryInfo.
 type EntryInfo struct {
+	// Group Group of the file
+	Group string `json:"group"`
+
+	// Mode File mode as st_mode of stat(2), including the file type bits, e.g. 0100644 for a regular file
+	Mode uint32 `json:"mode"`
+
+	// ModifiedTime Last modification time of the file
+	ModifiedTime time.Time `json:"modified_time"`
+
 	// Name Name of the file
 	Name string `json:"name"`
 
+	// Owner Owner of the file
+	Owner string `json:"owner"`
+
 	// Path Path to the file
 	Path string `json:"path"`
 
-	// Type Type of the file
+	// Permissions Permissions in the symbolic notation, e.g. "-rw-r--r--"
+	Permissions string `json:"permissions"`
+
+	// Size Size of the file in bytes
+	Size int64 `json:"size"`
+
+	// SymlinkTarget Target of the symlink, set only if the path is a symlink. The other fields describe the target
+	SymlinkTarget *string `json:"symlink_target,omitempty"`
+
+	// Type Type of the file, symlinks are followed. The symlink type is used only for the symlinks whose target doesn't exist
 	Type EntryInfoType `json:"type"`
 }
 
-// EntryInfoType Type of the file
+// EntryInfoType Type of the file, symlinks are followed. The symlink type is used only for the symlinks whose target doesn't exist
 type EntryInfoType string
 
 // EnvVars Environment variables to set
@@ -44,15 +81,39 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// Metrics Resource usage metrics
+type Metrics struct {
+	// CpuUsedPct CPU usage percentage
+	CpuUsedPct *float32 `json:"cpu_used_pct,omitempty"`
+
+	// MemBytes Total virtual memory usage in bytes
+	MemBytes *int `json:"mem_bytes,omitempty"`
+}
+
+// UploadStatus defines model for UploadStatus.
+type UploadStatus struct {
+	// Offset Number of bytes already stored
+	Offset int64 `json:"offset"`
+
+	// Path Path to the file
+	Path string `json:"path"`
+}
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
 // User defines model for User.
 type User = string
 
+// ArchiveUploadSuccess defines model for ArchiveUploadSuccess.
+type ArchiveUploadSuccess = EntryInfo
+
 // FileNotFound defines model for FileNotFound.
 type FileNotFound = Error
 
+// FileUploadSuccess defines model for FileUploadSuccess.
+type FileUploadSuccess = EntryInfo
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
@@ -65,6 +126,12 @@ type InvalidUser = Error
 // NotEnoughDiskSpace defines model for NotEnoughDiskSpace.
 type NotEnoughDiskSpace = Error
 
+// UploadConflict defines model for UploadConflict.
+type UploadConflict = Error
+
+// UploadInProgress defines model for UploadInProgress.
+type UploadInProgress = UploadStatus
+
 // UploadSuccess defines model for UploadSuccess.
 type UploadSuccess = []EntryInfo
 
@@ -75,8 +142,14 @@ type GetFilesParams struct {
 
 	// Username User used for setting the owner, or resolving relative paths.
 	Username User `form:"username" json:"username"`
+
+	// Format Format of the archive the directory is downloaded as, the contents of the directory are at the root of the archive.
+	Format *GetFilesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
+// GetFilesParamsFormat defines parameters for GetFiles.
+type GetFilesParamsFormat string
+
 // PostFilesMultipartBody defines parameters for PostFiles.
 type PostFilesMultipartBody struct {
 	File *openapi_types.File `json:"file,omitempty"`
@@ -91,6 +164,45 @@ type PostFilesParams struct {
 	Username User `form:"username" json:"username"`
 }
 
+// PutFilesParams defines parameters for PutFiles.
+type PutFilesParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Sha256 Hex encoded SHA-256 of the whole file, the file is not replaced if it doesn't match.
+	Sha256 *string `form:"sha256,omitempty" json:"sha256,omitempty"`
+
+	// ContentRange Range of the uploaded part in the "bytes start-end/size" format, the whole file is uploaded if not specified.
+	ContentRange *string `json:"Content-Range,omitempty"`
+}
+
+// PostFilesArchiveParams defines parameters for PostFilesArchive.
+type PostFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Format Format of the archive, it is detected from the content if not specified.
+	Format *PostFilesArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PostFilesArchiveParamsFormat defines parameters for PostFilesArchive.
+type PostFilesArchiveParamsFormat string
+
+// GetFilesUploadParams defines parameters for GetFilesUpload.
+type GetFilesUploadParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+}
+
 // PostInitJSONBody defines parameters for PostInit.
 type PostInitJSONBody struct {
 	// EnvVars Environment variables to set
@@ -108,12 +220,21 @@ type ServerInterface interface {
 	// Get the environment variables
 	// (GET /envs)
 	GetEnvs(w http.ResponseWriter, r *http.Request)
-	// Download a file
+	// Download a file, or a directory as an archive if the format is specified
 	// (GET /files)
 	GetFiles(w http.ResponseWriter, r *http.Request, params GetFilesParams)
 	// Upload a file and ensure the parent directories exist. If the file exists, it will be overwritten.
 	// (POST /files)
 	PostFiles(w http.ResponseWriter, r *http.Request, params PostFilesParams)
+	// Upload a file or its part specified by the Content-Range header. The parts are stored aside and the file is replaced atomically after the last part is uploaded. If the file exists, it will be overwritten. The stored parts are removed if no part is uploaded for 24 hours.
+	// (PUT /files)
+	PutFiles(w http.ResponseWriter, r *http.Request, params PutFilesParams)
+	// Upload a tar, tar.gz or zip archive and extract it into the directory. The existing files will be overwritten.
+	// (POST /files/archive)
+	PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams)
+	// Get the number of bytes already stored for the resumable upload of the file
+	// (GET /files/upload)
+	GetFilesUpload(w http.ResponseWriter, r *http.Request, params GetFilesUploadParams)
 	// Check the health of the service
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
@@ -135,7 +256,7 @@ func (_ Unimplemented) GetEnvs(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Download a file
+// Download a file, or a directory as an archive if the format is specified
 // (GET /files)
 func (_ Unimplemented) GetFiles(w http.ResponseWriter, r *http.Request, params GetFilesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -147,6 +268,24 @@ func (_ Unimplemented) PostFiles(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Upload a file or its part specified by the Content-Range header. The parts are stored aside and the file is replaced atomically after the last part is uploaded. If the file exists, it will be overwritten. The stored parts are removed if no part is uploaded for 24 hours.
+// (PUT /files)
+func (_ Unimplemented) PutFiles(w http.ResponseWriter, r *http.Request, params PutFilesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a tar, tar.gz or zip archive and extract it into the directory. The existing files will be overwritten.
+// (POST /files/archive)
+func (_ Unimplemented) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the number of bytes already stored for the resumable upload of the file
+// (GET /files/upload)
+func (_ Unimplemented) GetFilesUpload(w http.ResponseWriter, r *http.Request, params GetFilesUploadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Check the health of the service
 // (GET /health)
 func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
@@ -219,6 +358,14 @@ func (siw *ServerInterfaceWrapper) GetFiles(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetFiles(w, r, params)
 	}))
@@ -272,6 +419,169 @@ func (siw *ServerInterfaceWrapper) PostFiles(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// PutFiles operation middleware
+func (siw *ServerInterfaceWrapper) PutFiles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutFilesParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sha256" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sha256", r.URL.Query(), &params.Sha256)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sha256", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Content-Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Content-Range")]; found {
+		var ContentRange string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Content-Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Content-Range", valueList[0], &ContentRange, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Content-Range", Err: err})
+			return
+		}
+
+		params.ContentRange = &ContentRange
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutFiles(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFilesArchiveParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFilesArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFilesUpload operation middleware
+func (siw *ServerInterfaceWrapper) GetFilesUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFilesUploadParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFilesUpload(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetHealth operation middleware
 func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
@@ -436,6 +746,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/files", wrapper.PostFiles)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/files", wrapper.PutFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/archive", wrapper.PostFilesArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/files/upload", wrapper.GetFilesUpload)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
 	})
@@ -0,0 +1,738 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+const (
+	// maxArchiveEntries is the maximum number of the entries extracted from one archive.
+	maxArchiveEntries = 100_000
+	// maxSymlinkTargetSize is the maximum length of the symlink target stored in the zip entry.
+	maxSymlinkTargetSize = 4096
+)
+
+var (
+	errIllegalPath     = errors.New("illegal path in archive")
+	errTooManyEntries  = fmt.Errorf("archive has more than %d entries", maxArchiveEntries)
+	errArchiveTooLarge = errors.New("archive is larger than the free disk space")
+)
+
+// writeArchive streams the contents of the directory as an archive, symlinks are stored as links and not followed.
+func writeArchive(w http.ResponseWriter, dir string, format GetFilesParamsFormat) error {
+	name := filepath.Base(dir)
+
+	switch format {
+	case GetFilesParamsFormatTarGz:
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".tar.gz"))
+
+		return writeTarGz(w, dir)
+	case GetFilesParamsFormatZip:
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".zip"))
+
+		return writeZip(w, dir)
+	default:
+		return fmt.Errorf("unsupported archive format '%s'", format)
+	}
+}
+
+// walkArchive calls fn for every file in the directory with its path relative to the directory.
+// The sockets, devices and pipes are skipped.
+func walkArchive(dir string, fn func(path, name string, info fs.FileInfo) error) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("error getting file info: %w", err)
+		}
+
+		if !info.Mode().IsRegular() && !info.IsDir() && info.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return fmt.Errorf("error getting relative path: %w", err)
+		}
+
+		name := filepath.ToSlash(rel)
+		if info.IsDir() {
+			name += "/"
+		}
+
+		return fn(path, name, info)
+	})
+}
+
+func copyFile(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening file: %w", err)
+	}
+	defer file.Close()
+
+	_, err = io.Copy(w, file)
+	if err != nil {
+		return fmt.Errorf("error copying file: %w", err)
+	}
+
+	return nil
+}
+
+func writeTarGz(w io.Writer, dir string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	err := walkArchive(dir, func(path, name string, info fs.FileInfo) error {
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			var err error
+
+			link, err = os.Readlink(path)
+			if err != nil {
+				return fmt.Errorf("error reading symlink: %w", err)
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return fmt.Errorf("error creating tar header: %w", err)
+		}
+
+		header.Name = name
+
+		err = tw.WriteHeader(header)
+		if err != nil {
+			return fmt.Errorf("error writing tar header: %w", err)
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFile(tw, path)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return fmt.Errorf("error closing tar writer: %w", err)
+	}
+
+	err = gw.Close()
+	if err != nil {
+		return fmt.Errorf("error closing gzip writer: %w", err)
+	}
+
+	return nil
+}
+
+func writeZip(w io.Writer, dir string) error {
+	zw := zip.NewWriter(w)
+
+	err := walkArchive(dir, func(path, name string, info fs.FileInfo) error {
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return fmt.Errorf("error creating zip header: %w", err)
+		}
+
+		header.Name = name
+		if info.Mode().IsRegular() {
+			header.Method = zip.Deflate
+		}
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return fmt.Errorf("error writing zip header: %w", err)
+		}
+
+		switch {
+		case info.Mode().IsRegular():
+			return copyFile(fw, path)
+		case info.Mode()&os.ModeSymlink != 0:
+			// The zip stores the symlink target as the content of the entry.
+			link, err := os.Readlink(path)
+			if err != nil {
+				return fmt.Errorf("error reading symlink: %w", err)
+			}
+
+			_, err = io.WriteString(fw, link)
+
+			return err
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return fmt.Errorf("error closing zip writer: %w", err)
+	}
+
+	return nil
+}
+
+// extractor writes the archive entries into the root directory, owned by the user.
+// The entries can't be written outside the root, not even through the symlinks from the archive.
+type extractor struct {
+	root string
+	uid  int
+	gid  int
+
+	// maxSize is the maximum total size of the extracted files, the size of the files is not known before they are decompressed.
+	maxSize    int64
+	maxEntries int
+
+	size    int64
+	entries int
+}
+
+func newExtractor(root string, uid, gid int, maxSize int64) *extractor {
+	return &extractor{
+		root:       root,
+		uid:        uid,
+		gid:        gid,
+		maxSize:    maxSize,
+		maxEntries: maxArchiveEntries,
+	}
+}
+
+// next counts the extracted entry.
+func (e *extractor) next() error {
+	e.entries++
+	if e.entries > e.maxEntries {
+		return errTooManyEntries
+	}
+
+	return nil
+}
+
+// copy writes the file content while the total extracted size is under the limit.
+func (e *extractor) copy(w io.Writer, r io.Reader) error {
+	n, err := io.Copy(w, io.LimitReader(r, e.maxSize-e.size+1))
+	e.size += n
+
+	if err != nil {
+		return fmt.Errorf("error writing file: %w", err)
+	}
+
+	if e.size > e.maxSize {
+		return errArchiveTooLarge
+	}
+
+	return nil
+}
+
+// target returns the path of the archive entry in the root directory, it returns an empty path for the root itself.
+func (e *extractor) target(name string) (string, error) {
+	path := filepath.Join(e.root, filepath.FromSlash(name))
+
+	rel, err := filepath.Rel(e.root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %s", errIllegalPath, name)
+	}
+
+	if rel == "." {
+		return "", nil
+	}
+
+	// The closest existing parent must resolve inside the root, the missing parents are created as regular directories.
+	for parent := filepath.Dir(path); ; parent = filepath.Dir(parent) {
+		_, err := os.Lstat(parent)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("error getting file info: %w", err)
+		}
+
+		resolved, err := filepath.EvalSymlinks(parent)
+		if err != nil {
+			return "", fmt.Errorf("error resolving path: %w", err)
+		}
+
+		if resolved != e.root && !strings.HasPrefix(resolved, e.root+string(filepath.Separator)) {
+			return "", fmt.Errorf("%w: %s", errIllegalPath, name)
+		}
+
+		break
+	}
+
+	return path, nil
+}
+
+// prepare creates the parent directories and removes the existing file at the path,
+// so the symlinks are replaced instead of written through.
+func (e *extractor) prepare(path string) error {
+	err := permissions.EnsureDirs(filepath.Dir(path), e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error ensuring directories: %w", err)
+	}
+
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting file info: %w", err)
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("path is a directory: %s", path)
+	}
+
+	err = os.Remove(path)
+	if err != nil {
+		return fmt.Errorf("error removing existing file: %w", err)
+	}
+
+	return nil
+}
+
+func (e *extractor) dir(path string, mode fs.FileMode) error {
+	info, err := os.Lstat(path)
+	if err == nil && !info.IsDir() {
+		err = e.prepare(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = permissions.EnsureDirs(path, e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error ensuring directories: %w", err)
+	}
+
+	if mode.Perm() == 0 {
+		return nil
+	}
+
+	err = os.Chmod(path, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("error changing directory mode: %w", err)
+	}
+
+	return nil
+}
+
+func (e *extractor) file(path string, mode fs.FileMode, r io.Reader) error {
+	err := e.prepare(path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("error creating file: %w", err)
+	}
+	defer file.Close()
+
+	err = file.Chown(e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error changing file ownership: %w", err)
+	}
+
+	if mode.Perm() != 0 {
+		err = file.Chmod(mode.Perm())
+		if err != nil {
+			return fmt.Errorf("error changing file mode: %w", err)
+		}
+	}
+
+	return e.copy(file, r)
+}
+
+func (e *extractor) symlink(path, target string) error {
+	err := e.prepare(path)
+	if err != nil {
+		return err
+	}
+
+	err = os.Symlink(target, path)
+	if err != nil {
+		return fmt.Errorf("error creating symlink: %w", err)
+	}
+
+	err = os.Lchown(path, e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error changing symlink ownership: %w", err)
+	}
+
+	return nil
+}
+
+func (e *extractor) link(path, name string) error {
+	source, err := e.target(name)
+	if err != nil {
+		return err
+	}
+
+	if source == "" {
+		return fmt.Errorf("%w: %s", errIllegalPath, name)
+	}
+
+	resolved, err := filepath.EvalSymlinks(source)
+	if err != nil {
+		return fmt.Errorf("error resolving link source: %w", err)
+	}
+
+	if !strings.HasPrefix(resolved, e.root+string(filepath.Separator)) {
+		return fmt.Errorf("%w: %s", errIllegalPath, name)
+	}
+
+	err = e.prepare(path)
+	if err != nil {
+		return err
+	}
+
+	err = os.Link(resolved, path)
+	if err != nil {
+		return fmt.Errorf("error creating hard link: %w", err)
+	}
+
+	return nil
+}
+
+func (e *extractor) extractTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading tar: %w", err)
+		}
+
+		err = e.next()
+		if err != nil {
+			return err
+		}
+
+		path, err := e.target(header.Name)
+		if err != nil {
+			return err
+		}
+
+		if path == "" {
+			continue
+		}
+
+		mode := header.FileInfo().Mode()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = e.dir(path, mode)
+		case tar.TypeReg:
+			err = e.file(path, mode, tr)
+		case tar.TypeSymlink:
+			err = e.symlink(path, header.Linkname)
+		case tar.TypeLink:
+			err = e.link(path, header.Linkname)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (e *extractor) extractZipFile(f *zip.File) error {
+	path, err := e.target(f.Name)
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		return nil
+	}
+
+	mode := f.Mode()
+
+	if mode.IsDir() || strings.HasSuffix(f.Name, "/") {
+		return e.dir(path, mode)
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("error opening zip entry: %w", err)
+	}
+	defer r.Close()
+
+	switch {
+	case mode&os.ModeSymlink != 0:
+		target, err := io.ReadAll(io.LimitReader(r, maxSymlinkTargetSize))
+		if err != nil {
+			return fmt.Errorf("error reading symlink target: %w", err)
+		}
+
+		return e.symlink(path, string(target))
+	case mode.IsRegular():
+		return e.file(path, mode, r)
+	default:
+		return nil
+	}
+}
+
+func (e *extractor) extractZip(r io.Reader) error {
+	// The zip directory is at the end of the archive, so the archive is stored first.
+	// The stored archive is limited by the free space of the temporary directory and the size of the extracted files,
+	// the compressed files are not larger than the extracted ones.
+	tmpFree, err := freeDiskSpace(os.TempDir())
+	if err != nil {
+		return fmt.Errorf("error checking free disk space: %w", err)
+	}
+
+	spoolLimit := min(int64(min(tmpFree, math.MaxInt64)), e.maxSize)
+
+	tmp, err := os.CreateTemp("", "envd-archive-*.zip")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, io.LimitReader(r, spoolLimit+1))
+	if err != nil {
+		return fmt.Errorf("error storing archive: %w", err)
+	}
+
+	if size > spoolLimit {
+		return errArchiveTooLarge
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return fmt.Errorf("error reading zip: %w", err)
+	}
+
+	if len(zr.File) > e.maxEntries {
+		return errTooManyEntries
+	}
+
+	for _, f := range zr.File {
+		err = e.next()
+		if err != nil {
+			return err
+		}
+
+		err = e.extractZipFile(f)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *extractor) extract(r io.Reader, format *PostFilesArchiveParamsFormat) error {
+	br := bufio.NewReader(r)
+
+	var f PostFilesArchiveParamsFormat
+	if format != nil {
+		f = *format
+	} else {
+		magic, _ := br.Peek(len(zipMagic))
+
+		switch {
+		case bytes.HasPrefix(magic, gzipMagic):
+			f = PostFilesArchiveParamsFormatTarGz
+		case bytes.HasPrefix(magic, zipMagic):
+			f = PostFilesArchiveParamsFormatZip
+		default:
+			f = PostFilesArchiveParamsFormatTar
+		}
+	}
+
+	switch f {
+	case PostFilesArchiveParamsFormatTar:
+		return e.extractTar(br)
+	case PostFilesArchiveParamsFormatTarGz:
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("error reading gzip: %w", err)
+		}
+		defer gr.Close()
+
+		return e.extractTar(gr)
+	case PostFilesArchiveParamsFormatZip:
+		return e.extractZip(br)
+	default:
+		return fmt.Errorf("unsupported archive format '%s'", f)
+	}
+}
+
+func (a *API) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), logs.AssignOperationID()).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Archive extract")
+	}()
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	uid, gid, err := permissions.GetUserIds(u)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting user ids: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = permissions.EnsureDirs(resolvedPath, int(uid), int(gid))
+	if err != nil {
+		errMsg = fmt.Errorf("error ensuring directories: %w", err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	freeSpace, err := freeDiskSpace(resolvedPath)
+	if err != nil {
+		errMsg = fmt.Errorf("error checking free disk space: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	// The extracted size is not known upfront, so the size of the archive is checked first
+	// and the size of the extracted files is limited during the extraction.
+	if int64(freeSpace) < r.ContentLength {
+		errMsg = fmt.Errorf("not enough disk space on '%s': %d bytes required, %d bytes free", resolvedPath, r.ContentLength, freeSpace)
+		errorCode = http.StatusInsufficientStorage
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	root, err := filepath.EvalSymlinks(resolvedPath)
+	if err != nil {
+		errMsg = fmt.Errorf("error resolving path '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	e := newExtractor(root, int(uid), int(gid), int64(min(freeSpace, math.MaxInt64)))
+
+	err = e.extract(r.Body, params.Format)
+	if err != nil {
+		errMsg = fmt.Errorf("error extracting archive: %w", err)
+
+		switch {
+		case errors.Is(err, errIllegalPath), errors.Is(err, errTooManyEntries):
+			errorCode = http.StatusBadRequest
+		case errors.Is(err, errArchiveTooLarge):
+			errorCode = http.StatusInsufficientStorage
+		default:
+			errorCode = http.StatusInternalServerError
+		}
+
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	entry, err := entryInfo(resolvedPath)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting file info: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		errMsg = fmt.Errorf("error marshaling response: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type archiveEntry struct {
+	name     string
+	typeflag byte
+	content  string
+	linkname string
+}
+
+func tarArchive(t *testing.T, entries ...archiveEntry) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Linkname: entry.linkname,
+			Size:     int64(len(entry.content)),
+			Mode:     0o644,
+		}
+
+		if entry.typeflag == tar.TypeDir {
+			header.Mode = 0o755
+		}
+
+		if entry.typeflag != tar.TypeReg {
+			header.Size = 0
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatalf("error writing tar header: %v", err)
+		}
+
+		if header.Size > 0 {
+			if _, err := tw.Write([]byte(entry.content)); err != nil {
+				t.Fatalf("error writing tar entry: %v", err)
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("error closing tar writer: %v", err)
+	}
+
+	return &buf
+}
+
+// newTestExtractor returns the extractor of the new root directory and the directory outside of the root.
+func newTestExtractor(t *testing.T) (*extractor, string) {
+	t.Helper()
+
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatalf("error resolving temporary directory: %v", err)
+	}
+
+	root := filepath.Join(dir, "root")
+	outside := filepath.Join(dir, "outside")
+
+	for _, path := range []string{root, outside} {
+		if err := os.Mkdir(path, 0o755); err != nil {
+			t.Fatalf("error creating directory: %v", err)
+		}
+	}
+
+	return newExtractor(root, os.Getuid(), os.Getgid(), 1<<20), outside
+}
+
+func extractTar(t *testing.T, e *extractor, entries ...archiveEntry) error {
+	t.Helper()
+
+	format := PostFilesArchiveParamsFormatTar
+
+	return e.extract(tarArchive(t, entries...), &format)
+}
+
+func assertNotExist(t *testing.T, path string) {
+	t.Helper()
+
+	if _, err := os.Lstat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected %s to not exist, got %v", path, err)
+	}
+}
+
+func assertContent(t *testing.T, path, expected string) {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading %s: %v", path, err)
+	}
+
+	if string(content) != expected {
+		t.Errorf("expected %s to contain %q, got %q", path, expected, content)
+	}
+}
+
+func TestExtractTar(t *testing.T) {
+	e, _ := newTestExtractor(t)
+
+	err := extractTar(t, e,
+		archiveEntry{name: "dir/", typeflag: tar.TypeDir},
+		archiveEntry{name: "dir/file", typeflag: tar.TypeReg, content: "content"},
+		archiveEntry{name: "dir/link", typeflag: tar.TypeSymlink, linkname: "file"},
+		archiveEntry{name: "hardlink", typeflag: tar.TypeLink, linkname: "dir/file"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertContent(t, filepath.Join(e.root, "dir", "file"), "content")
+	assertContent(t, filepath.Join(e.root, "dir", "link"), "content")
+	assertContent(t, filepath.Join(e.root, "hardlink"), "content")
+}
+
+func TestExtractTarPathTraversal(t *testing.T) {
+	for _, name := range []string{"../escaped", "dir/../../escaped", "/../escaped"} {
+		t.Run(name, func(t *testing.T) {
+			e, outside := newTestExtractor(t)
+
+			err := extractTar(t, e, archiveEntry{name: name, typeflag: tar.TypeReg, content: "content"})
+			if !errors.Is(err, errIllegalPath) {
+				t.Fatalf("expected illegal path error, got %v", err)
+			}
+
+			assertNotExist(t, filepath.Join(filepath.Dir(outside), "escaped"))
+		})
+	}
+}
+
+func TestExtractTarSymlinkEscape(t *testing.T) {
+	e, outside := newTestExtractor(t)
+
+	// The file can't be written through the symlink from the archive.
+	err := extractTar(t, e,
+		archiveEntry{name: "link", typeflag: tar.TypeSymlink, linkname: outside},
+		archiveEntry{name: "link/file", typeflag: tar.TypeReg, content: "content"},
+	)
+	if !errors.Is(err, errIllegalPath) {
+		t.Fatalf("expected illegal path error, got %v", err)
+	}
+
+	assertNotExist(t, filepath.Join(outside, "file"))
+}
+
+func TestExtractTarSymlinkReplaced(t *testing.T) {
+	e, outside := newTestExtractor(t)
+
+	target := filepath.Join(outside, "target")
+	if err := os.WriteFile(target, []byte("original"), 0o644); err != nil {
+		t.Fatalf("error writing file: %v", err)
+	}
+
+	// The regular file with the name of the symlink replaces the symlink instead of writing to its target.
+	err := extractTar(t, e,
+		archiveEntry{name: "link", typeflag: tar.TypeSymlink, linkname: target},
+		archiveEntry{name: "link", typeflag: tar.TypeReg, content: "content"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertContent(t, target, "original")
+
+	info, err := os.Lstat(filepath.Join(e.root, "link"))
+	if err != nil {
+		t.Fatalf("error getting file info: %v", err)
+	}
+
+	if !info.Mode().IsRegular() {
+		t.Errorf("expected a regular file, got %s", info.Mode())
+	}
+}
+
+func TestExtractTarHardLinkEscape(t *testing.T) {
+	e, outside := newTestExtractor(t)
+
+	target := filepath.Join(outside, "target")
+	if err := os.WriteFile(target, []byte("secret"), 0o644); err != nil {
+		t.Fatalf("error writing file: %v", err)
+	}
+
+	for _, entries := range [][]archiveEntry{
+		{{name: "hardlink", typeflag: tar.TypeLink, linkname: "../outside/target"}},
+		// The hard link to the symlink would link the target outside of the root.
+		{
+			{name: "link", typeflag: tar.TypeSymlink, linkname: target},
+			{name: "hardlink", typeflag: tar.TypeLink, linkname: "link"},
+		},
+	} {
+		err := extractTar(t, e, entries...)
+		if !errors.Is(err, errIllegalPath) {
+			t.Fatalf("expected illegal path error, got %v", err)
+		}
+
+		assertNotExist(t, filepath.Join(e.root, "hardlink"))
+	}
+}
+
+func TestExtractZipPathTraversal(t *testing.T) {
+	e, outside := newTestExtractor(t)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	fw, err := zw.Create("../outside/escaped")
+	if err != nil {
+		t.Fatalf("error creating zip entry: %v", err)
+	}
+
+	if _, err := fw.Write([]byte("content")); err != nil {
+		t.Fatalf("error writing zip entry: %v", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatalf("error closing zip writer: %v", err)
+	}
+
+	format := PostFilesArchiveParamsFormatZip
+
+	err = e.extract(&buf, &format)
+	if !errors.Is(err, errIllegalPath) {
+		t.Fatalf("expected illegal path error, got %v", err)
+	}
+
+	assertNotExist(t, filepath.Join(outside, "escaped"))
+}
+
+func TestExtractLimits(t *testing.T) {
+	e, _ := newTestExtractor(t)
+	e.maxEntries = 2
+
+	err := extractTar(t, e,
+		archiveEntry{name: "a", typeflag: tar.TypeReg},
+		archiveEntry{name: "b", typeflag: tar.TypeReg},
+		archiveEntry{name: "c", typeflag: tar.TypeReg},
+	)
+	if !errors.Is(err, errTooManyEntries) {
+		t.Fatalf("expected too many entries error, got %v", err)
+	}
+
+	e, _ = newTestExtractor(t)
+	e.maxSize = 10
+
+	err = extractTar(t, e,
+		archiveEntry{name: "a", typeflag: tar.TypeReg, content: "123456"},
+		archiveEntry{name: "b", typeflag: tar.TypeReg, content: "123456"},
+	)
+	if !errors.Is(err, errArchiveTooLarge) {
+		t.Fatalf("expected archive too large error, got %v", err)
+	}
+}
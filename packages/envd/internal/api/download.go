@@ -74,8 +74,34 @@ func (a *API) GetFiles(w http.ResponseWriter, r *http.Request, params GetFilesPa
 		return
 	}
 
+	if params.Format != nil {
+		if !stat.IsDir() {
+			errMsg = fmt.Errorf("path '%s' is not a directory", resolvedPath)
+			errorCode = http.StatusBadRequest
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+
+		if *params.Format != GetFilesParamsFormatTarGz && *params.Format != GetFilesParamsFormatZip {
+			errMsg = fmt.Errorf("unsupported archive format '%s'", *params.Format)
+			errorCode = http.StatusBadRequest
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+
+		// The response is already streamed, so the error can only be logged.
+		errMsg = writeArchive(w, resolvedPath, *params.Format)
+		if errMsg != nil {
+			errorCode = http.StatusInternalServerError
+		}
+
+		return
+	}
+
 	if stat.IsDir() {
-		errMsg = fmt.Errorf("path '%s' is a directory", resolvedPath)
+		errMsg = fmt.Errorf("path '%s' is a directory, specify the archive format to download it", resolvedPath)
 		errorCode = http.StatusBadRequest
 		jsonError(w, errorCode, errMsg)
 
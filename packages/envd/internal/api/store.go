@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -13,10 +14,23 @@ import (
 type API struct {
 	logger  *zerolog.Logger
 	envVars *utils.Map[string, string]
+	// uploads are the paths of the files with a part being uploaded.
+	uploads *utils.Map[string, struct{}]
+	// uploadParts are the paths of the files with the stored parts of an unfinished upload.
+	uploadParts *utils.Map[string, struct{}]
 }
 
-func New(l *zerolog.Logger, envVars *utils.Map[string, string]) *API {
-	return &API{logger: l, envVars: envVars}
+func New(ctx context.Context, l *zerolog.Logger, envVars *utils.Map[string, string]) *API {
+	a := &API{
+		logger:      l,
+		envVars:     envVars,
+		uploads:     utils.NewMap[string, struct{}](),
+		uploadParts: utils.NewMap[string, struct{}](),
+	}
+
+	go a.cleanupUploadParts(ctx)
+
+	return a
 }
 
 func (a *API) GetHealth(w http.ResponseWriter, r *http.Request) {
@@ -232,11 +232,16 @@ func (a *API) PostFiles(w http.ResponseWriter, r *http.Request, params PostFiles
 				return
 			}
 
-			paths = append(paths, EntryInfo{
-				Path: filePath,
-				Name: filepath.Base(filePath),
-				Type: File,
-			})
+			entry, err := entryInfo(filePath)
+			if err != nil {
+				errorCode = http.StatusInternalServerError
+				errMsg = fmt.Errorf("error getting file info: %w", err)
+				jsonError(w, errorCode, errMsg)
+
+				return
+			}
+
+			paths = append(paths, entry)
 		}
 
 		part.Close()
@@ -254,3 +259,56 @@ func (a *API) PostFiles(w http.ResponseWriter, r *http.Request, params PostFiles
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write(data)
 }
+
+// entryInfo returns the info about the uploaded file, symlinks are followed.
+// The target of the symlink is set in a separate field, the dangling symlinks are returned with the symlink type and the info about the link itself.
+func entryInfo(path string) (EntryInfo, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return EntryInfo{}, err
+	}
+
+	var symlinkTarget *string
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(path)
+		if err != nil {
+			return EntryInfo{}, fmt.Errorf("error reading symlink: %w", err)
+		}
+
+		symlinkTarget = &target
+
+		targetInfo, err := os.Stat(path)
+		if err != nil && !os.IsNotExist(err) {
+			return EntryInfo{}, err
+		}
+
+		if err == nil {
+			info = targetInfo
+		}
+	}
+
+	owner, group := permissions.GetFileOwner(info)
+
+	entry := EntryInfo{
+		Path:          path,
+		Name:          filepath.Base(path),
+		Type:          File,
+		Size:          info.Size(),
+		Mode:          permissions.GetFileMode(info),
+		Permissions:   info.Mode().String(),
+		Owner:         owner,
+		Group:         group,
+		ModifiedTime:  info.ModTime(),
+		SymlinkTarget: symlinkTarget,
+	}
+
+	switch {
+	case info.Mode()&os.ModeSymlink != 0:
+		entry.Type = Symlink
+	case info.IsDir():
+		entry.Type = Directory
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,587 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+)
+
+// contentRange is the parsed "bytes start-end/size" Content-Range header.
+type contentRange struct {
+	start int64
+	end   int64
+	size  int64
+}
+
+func parseContentRange(header string) (*contentRange, error) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return nil, fmt.Errorf("invalid content range '%s': unsupported unit", header)
+	}
+
+	byteRange, size, ok := strings.Cut(spec, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid content range '%s': missing size", header)
+	}
+
+	start, end, ok := strings.Cut(byteRange, "-")
+	if !ok {
+		return nil, fmt.Errorf("invalid content range '%s': missing range", header)
+	}
+
+	var r contentRange
+	var err error
+
+	r.start, err = strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content range '%s': %w", header, err)
+	}
+
+	r.end, err = strconv.ParseInt(end, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content range '%s': %w", header, err)
+	}
+
+	r.size, err = strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content range '%s': %w", header, err)
+	}
+
+	if r.start < 0 || r.end < r.start || r.end >= r.size {
+		return nil, fmt.Errorf("invalid content range '%s'", header)
+	}
+
+	return &r, nil
+}
+
+const (
+	// uploadPartTTL is how long the stored parts of the unfinished upload are kept after the last part was written.
+	uploadPartTTL = 24 * time.Hour
+
+	uploadPartsCleanupInterval = 10 * time.Minute
+)
+
+// uploadPath returns the path the parts of the file are stored at until the upload is complete.
+func uploadPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".upload")
+}
+
+// openUploadPart opens the stored parts of the upload, the symlinks are not followed,
+// so the parts can't be redirected to another file.
+func openUploadPart(path string, flag int) (*os.File, error) {
+	file, err := os.OpenFile(path, flag|syscall.O_NOFOLLOW, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return nil, fmt.Errorf("error getting upload file info: %w", err)
+	}
+
+	if !stat.Mode().IsRegular() {
+		file.Close()
+
+		return nil, fmt.Errorf("upload file is not a regular file: %s", path)
+	}
+
+	return file, nil
+}
+
+// expireUploadPart removes the stored parts of the upload that was not continued for the TTL.
+func expireUploadPart(path string, now time.Time) error {
+	stat, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting upload file info: %w", err)
+	}
+
+	if now.Sub(stat.ModTime()) < uploadPartTTL {
+		return nil
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing expired upload file: %w", err)
+	}
+
+	return nil
+}
+
+// cleanupUploadParts periodically removes the expired parts of the uploads started since envd was started,
+// the parts from before are removed when the upload is continued or its status is checked.
+func (a *API) cleanupUploadParts(ctx context.Context) {
+	ticker := time.NewTicker(uploadPartsCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.uploadParts.Range(func(path string, _ struct{}) bool {
+				// The part being uploaded is not expired.
+				if _, ok := a.uploads.LoadOrStore(path, struct{}{}); ok {
+					return true
+				}
+				defer a.uploads.Delete(path)
+
+				partPath := uploadPath(path)
+
+				err := expireUploadPart(partPath, now)
+				if err != nil {
+					a.logger.Error().Err(err).Str("path", partPath).Msg("Failed to remove expired upload part")
+
+					return true
+				}
+
+				if _, err := os.Lstat(partPath); errors.Is(err, os.ErrNotExist) {
+					a.uploadParts.Delete(path)
+				}
+
+				return true
+			})
+		}
+	}
+}
+
+func verifySHA256(path, expected string) error {
+	file, err := openUploadPart(path, os.O_RDONLY)
+	if err != nil {
+		return fmt.Errorf("error opening file: %w", err)
+	}
+	defer file.Close()
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, file)
+	if err != nil {
+		return fmt.Errorf("error computing checksum: %w", err)
+	}
+
+	actual := hex.EncodeToString(h.Sum(nil))
+	if actual != expected {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", expected, actual)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshaling response: %w", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+
+	return nil
+}
+
+func (a *API) PutFiles(w http.ResponseWriter, r *http.Request, params PutFilesParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), logs.AssignOperationID()).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if params.ContentRange != nil {
+			l = l.Str("content_range", *params.ContentRange)
+		}
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("File part write")
+	}()
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	var rng *contentRange
+	if params.ContentRange != nil {
+		rng, err = parseContentRange(*params.ContentRange)
+		if err != nil {
+			errMsg = err
+			errorCode = http.StatusBadRequest
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+	}
+
+	uid, gid, err := permissions.GetUserIds(u)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting user ids: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = permissions.EnsureDirs(filepath.Dir(resolvedPath), int(uid), int(gid))
+	if err != nil {
+		errMsg = fmt.Errorf("error ensuring directories: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	stat, err := os.Stat(resolvedPath)
+	if err == nil && stat.IsDir() {
+		errMsg = fmt.Errorf("path is a directory: %s", resolvedPath)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if _, loaded := a.uploads.LoadOrStore(resolvedPath, struct{}{}); loaded {
+		errMsg = fmt.Errorf("another part of '%s' is being uploaded", resolvedPath)
+		errorCode = http.StatusConflict
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+	defer a.uploads.Delete(resolvedPath)
+
+	partPath := uploadPath(resolvedPath)
+
+	err = expireUploadPart(partPath, time.Now())
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	file, err := openUploadPart(partPath, os.O_WRONLY|os.O_CREATE)
+	if err != nil {
+		errMsg = fmt.Errorf("error opening upload file: %w", err)
+		errorCode = http.StatusInternalServerError
+		if errors.Is(err, syscall.ELOOP) {
+			errorCode = http.StatusBadRequest
+		}
+
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+	defer file.Close()
+
+	a.uploadParts.Store(resolvedPath, struct{}{})
+
+	partStat, err := file.Stat()
+	if err != nil {
+		errMsg = fmt.Errorf("error getting upload file info: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	var start int64
+	var length int64 = -1
+	if rng != nil {
+		start = rng.start
+		length = rng.end - rng.start + 1
+	}
+
+	// The parts can overlap the stored data, but they can't leave a gap.
+	if start > partStat.Size() {
+		errMsg = fmt.Errorf("part starts at %d, but only %d bytes are stored", start, partStat.Size())
+		errorCode = http.StatusRequestedRangeNotSatisfiable
+
+		encodeErr := writeJSON(w, errorCode, UploadStatus{Path: resolvedPath, Offset: partStat.Size()})
+		if encodeErr != nil {
+			jsonError(w, http.StatusInternalServerError, encodeErr)
+		}
+
+		return
+	}
+
+	required := r.ContentLength
+	if rng != nil {
+		required = rng.size - partStat.Size()
+	}
+
+	freeSpace, err := freeDiskSpace(filepath.Dir(resolvedPath))
+	if err != nil {
+		errMsg = fmt.Errorf("error checking free disk space: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if int64(freeSpace) < required {
+		errMsg = fmt.Errorf("not enough disk space on '%s': %d bytes required, %d bytes free", filepath.Dir(resolvedPath), required, freeSpace)
+		errorCode = http.StatusInsufficientStorage
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = file.Truncate(start)
+	if err != nil {
+		errMsg = fmt.Errorf("error truncating upload file: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	_, err = file.Seek(start, io.SeekStart)
+	if err != nil {
+		errMsg = fmt.Errorf("error seeking upload file: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	var body io.Reader = r.Body
+	if length >= 0 {
+		body = io.LimitReader(r.Body, length)
+	}
+
+	// The data written before a failure are kept, so the upload can continue from the stored offset.
+	n, err := io.Copy(file, body)
+	if err != nil {
+		errMsg = fmt.Errorf("error writing upload file: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if length >= 0 && n != length {
+		errMsg = fmt.Errorf("part has %d bytes, but the content range specifies %d bytes", n, length)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	offset := start + n
+
+	if rng != nil && offset < rng.size {
+		errMsg = writeJSON(w, http.StatusAccepted, UploadStatus{Path: resolvedPath, Offset: offset})
+		if errMsg != nil {
+			errorCode = http.StatusInternalServerError
+			jsonError(w, errorCode, errMsg)
+		}
+
+		return
+	}
+
+	err = file.Close()
+	if err != nil {
+		errMsg = fmt.Errorf("error closing upload file: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if params.Sha256 != nil {
+		err = verifySHA256(partPath, strings.ToLower(*params.Sha256))
+		if err != nil {
+			// The stored data are corrupted, so the upload has to start over.
+			_ = os.Remove(partPath)
+
+			errMsg = err
+			errorCode = http.StatusBadRequest
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+	}
+
+	err = replaceFile(partPath, resolvedPath, int(uid), int(gid))
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	a.uploadParts.Delete(resolvedPath)
+
+	entry, err := entryInfo(resolvedPath)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting file info: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	errMsg = writeJSON(w, http.StatusOK, entry)
+	if errMsg != nil {
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+	}
+}
+
+// replaceFile moves the completed upload to the path, it keeps the permissions of the replaced file.
+// Unlike the plain upload that writes into the existing file, the file is replaced, so the readers never see a partially written file.
+func replaceFile(uploadPath, path string, uid, gid int) error {
+	perm := os.FileMode(0o644)
+
+	stat, err := os.Stat(path)
+	if err == nil {
+		perm = stat.Mode().Perm()
+	}
+
+	err = os.Chmod(uploadPath, perm)
+	if err != nil {
+		return fmt.Errorf("error changing file mode: %w", err)
+	}
+
+	err = os.Chown(uploadPath, uid, gid)
+	if err != nil {
+		return fmt.Errorf("error changing file ownership: %w", err)
+	}
+
+	err = os.Rename(uploadPath, path)
+	if err != nil {
+		return fmt.Errorf("error replacing file: %w", err)
+	}
+
+	return nil
+}
+
+func (a *API) GetFilesUpload(w http.ResponseWriter, r *http.Request, params GetFilesUploadParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), logs.AssignOperationID()).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("File upload status")
+	}()
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	partPath := uploadPath(resolvedPath)
+
+	err = expireUploadPart(partPath, time.Now())
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	stat, err := os.Lstat(partPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			errMsg = fmt.Errorf("no upload in progress for '%s'", resolvedPath)
+			errorCode = http.StatusNotFound
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+
+		errMsg = fmt.Errorf("error getting upload file info: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	errMsg = writeJSON(w, http.StatusOK, UploadStatus{Path: resolvedPath, Offset: stat.Size()})
+	if errMsg != nil {
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+	}
+}
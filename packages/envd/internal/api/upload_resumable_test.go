@@ -0,0 +1,295 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/utils"
+)
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected *contentRange
+	}{
+		{header: "bytes 0-9/10", expected: &contentRange{start: 0, end: 9, size: 10}},
+		{header: "bytes 5-5/10", expected: &contentRange{start: 5, end: 5, size: 10}},
+		{header: "bytes 4-7/100", expected: &contentRange{start: 4, end: 7, size: 100}},
+		{header: "0-9/10"},
+		{header: "items 0-9/10"},
+		{header: "bytes 0-9"},
+		{header: "bytes 0/10"},
+		{header: "bytes a-9/10"},
+		{header: "bytes 0-b/10"},
+		{header: "bytes 0-9/*"},
+		{header: "bytes -1-9/10"},
+		{header: "bytes 5-4/10"},
+		{header: "bytes 0-10/10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			r, err := parseContentRange(tt.header)
+
+			if tt.expected == nil {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", r)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if *r != *tt.expected {
+				t.Errorf("expected %+v, got %+v", *tt.expected, *r)
+			}
+		})
+	}
+}
+
+func newTestUploadAPI(t *testing.T) (*API, string) {
+	t.Helper()
+
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatalf("error resolving temporary directory: %v", err)
+	}
+
+	logger := zerolog.Nop()
+
+	return &API{
+		logger:      &logger,
+		uploads:     utils.NewMap[string, struct{}](),
+		uploadParts: utils.NewMap[string, struct{}](),
+	}, filepath.Join(dir, "file")
+}
+
+type uploadPart struct {
+	contentRange string
+	sha256       string
+	content      string
+}
+
+func putFile(t *testing.T, a *API, path string, part uploadPart) *httptest.ResponseRecorder {
+	t.Helper()
+
+	u, err := user.Current()
+	if err != nil {
+		t.Fatalf("error getting current user: %v", err)
+	}
+
+	params := PutFilesParams{Path: &path, Username: u.Username}
+	if part.contentRange != "" {
+		params.ContentRange = &part.contentRange
+	}
+
+	if part.sha256 != "" {
+		params.Sha256 = &part.sha256
+	}
+
+	r := httptest.NewRequest(http.MethodPut, "/files", strings.NewReader(part.content))
+	w := httptest.NewRecorder()
+
+	a.PutFiles(w, r, params)
+
+	return w
+}
+
+func decodeUploadStatus(t *testing.T, w *httptest.ResponseRecorder) UploadStatus {
+	t.Helper()
+
+	var status UploadStatus
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatalf("error decoding upload status %q: %v", w.Body.String(), err)
+	}
+
+	return status
+}
+
+func checksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func TestPutFilesResumable(t *testing.T) {
+	tests := []struct {
+		name string
+		// parts are uploaded in order, the last one is expected to return the code.
+		parts   []uploadPart
+		code    int
+		offset  int64
+		content string
+		// partExists is set if the stored parts of the upload are expected to be kept.
+		partExists bool
+	}{
+		{
+			name:    "whole file",
+			parts:   []uploadPart{{content: "content"}},
+			code:    http.StatusOK,
+			content: "content",
+		},
+		{
+			name:       "first part",
+			parts:      []uploadPart{{contentRange: "bytes 0-3/7", content: "cont"}},
+			code:       http.StatusAccepted,
+			offset:     4,
+			partExists: true,
+		},
+		{
+			name: "all parts",
+			parts: []uploadPart{
+				{contentRange: "bytes 0-3/7", content: "cont"},
+				{contentRange: "bytes 4-6/7", content: "ent", sha256: checksum("content")},
+			},
+			code:    http.StatusOK,
+			content: "content",
+		},
+		{
+			name: "overlapping parts",
+			parts: []uploadPart{
+				{contentRange: "bytes 0-3/7", content: "cont"},
+				{contentRange: "bytes 2-6/7", content: "NTENT"},
+			},
+			code:    http.StatusOK,
+			content: "coNTENT",
+		},
+		{
+			name: "gap",
+			parts: []uploadPart{
+				{contentRange: "bytes 0-1/7", content: "co"},
+				{contentRange: "bytes 4-6/7", content: "ent"},
+			},
+			code:       http.StatusRequestedRangeNotSatisfiable,
+			offset:     2,
+			partExists: true,
+		},
+		{
+			name:       "short part",
+			parts:      []uploadPart{{contentRange: "bytes 0-3/7", content: "co"}},
+			code:       http.StatusBadRequest,
+			partExists: true,
+		},
+		{
+			name:  "invalid range",
+			parts: []uploadPart{{contentRange: "bytes 3-0/7", content: "cont"}},
+			code:  http.StatusBadRequest,
+		},
+		{
+			name: "checksum mismatch",
+			parts: []uploadPart{
+				{contentRange: "bytes 0-3/7", content: "cont"},
+				{contentRange: "bytes 4-6/7", content: "ent", sha256: checksum("other")},
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name:    "uppercase checksum",
+			parts:   []uploadPart{{content: "content", sha256: strings.ToUpper(checksum("content"))}},
+			code:    http.StatusOK,
+			content: "content",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, path := newTestUploadAPI(t)
+
+			var w *httptest.ResponseRecorder
+			for i, part := range tt.parts {
+				w = putFile(t, a, path, part)
+
+				if i < len(tt.parts)-1 && w.Code != http.StatusAccepted {
+					t.Fatalf("expected part %d to be accepted, got %d: %s", i, w.Code, w.Body.String())
+				}
+			}
+
+			if w.Code != tt.code {
+				t.Fatalf("expected status %d, got %d: %s", tt.code, w.Code, w.Body.String())
+			}
+
+			if tt.code == http.StatusAccepted || tt.code == http.StatusRequestedRangeNotSatisfiable {
+				status := decodeUploadStatus(t, w)
+				if status.Offset != tt.offset {
+					t.Errorf("expected offset %d, got %d", tt.offset, status.Offset)
+				}
+
+				if status.Path != path {
+					t.Errorf("expected path %s, got %s", path, status.Path)
+				}
+			}
+
+			if tt.content != "" {
+				assertContent(t, path, tt.content)
+			} else {
+				assertNotExist(t, path)
+			}
+
+			if tt.partExists {
+				if _, err := os.Lstat(uploadPath(path)); err != nil {
+					t.Errorf("expected the upload part to be kept, got %v", err)
+				}
+			} else {
+				assertNotExist(t, uploadPath(path))
+			}
+		})
+	}
+}
+
+func TestPutFilesResumableKeepsMode(t *testing.T) {
+	a, path := newTestUploadAPI(t)
+
+	if err := os.WriteFile(path, []byte("old"), 0o600); err != nil {
+		t.Fatalf("error writing file: %v", err)
+	}
+
+	w := putFile(t, a, path, uploadPart{contentRange: "bytes 0-6/7", content: "content"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	assertContent(t, path, "content")
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("error getting file info: %v", err)
+	}
+
+	if stat.Mode().Perm() != 0o600 {
+		t.Errorf("expected mode %o of the replaced file, got %o", 0o600, stat.Mode().Perm())
+	}
+}
+
+func TestPutFilesResumableSymlinkedPart(t *testing.T) {
+	a, path := newTestUploadAPI(t)
+
+	target := filepath.Join(filepath.Dir(path), "target")
+	if err := os.WriteFile(target, []byte("target"), 0o644); err != nil {
+		t.Fatalf("error writing file: %v", err)
+	}
+
+	if err := os.Symlink(target, uploadPath(path)); err != nil {
+		t.Fatalf("error creating symlink: %v", err)
+	}
+
+	w := putFile(t, a, path, uploadPart{contentRange: "bytes 0-6/7", content: "content"})
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+
+	assertContent(t, target, "target")
+	assertNotExist(t, path)
+}
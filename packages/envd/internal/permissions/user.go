@@ -2,8 +2,10 @@ package permissions
 
 import (
 	"fmt"
+	"os"
 	"os/user"
 	"strconv"
+	"syscall"
 )
 
 func GetUserIds(u *user.User) (uid, gid uint32, err error) {
@@ -28,3 +30,34 @@ func GetUser(username string) (u *user.User, err error) {
 
 	return u, nil
 }
+
+// GetFileMode returns the mode of the file as st_mode of stat(2), the file type bits included.
+// Only the permission bits are returned if the raw stat data is not available.
+func GetFileMode(info os.FileInfo) uint32 {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return uint32(info.Mode().Perm())
+	}
+
+	return stat.Mode
+}
+
+// GetFileOwner returns the names of the file owner and group, the numeric ids are used if the names can't be resolved.
+func GetFileOwner(info os.FileInfo) (owner, group string) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "", ""
+	}
+
+	owner = strconv.FormatUint(uint64(stat.Uid), 10)
+	if u, err := user.LookupId(owner); err == nil {
+		owner = u.Username
+	}
+
+	group = strconv.FormatUint(uint64(stat.Gid), 10)
+	if g, err := user.LookupGroupId(group); err == nil {
+		group = g.Name
+	}
+
+	return owner, group
+}
@@ -0,0 +1,183 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+
+	"connectrpc.com/connect"
+)
+
+func (Service) Chmod(ctx context.Context, req *connect.Request[rpc.ChmodRequest]) (*connect.Response[rpc.ChmodResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.GetMode() > 0o7777 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid mode %o", req.Msg.GetMode()))
+	}
+
+	mode := permissionBits(req.Msg.GetMode())
+
+	err = walkPath(path, req.Msg.GetRecursive(), func(p string, d fs.DirEntry) error {
+		// The mode of the symlinks can't be changed on Linux, chmod would change the target.
+		if d != nil && d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+
+		return os.Chmod(p, mode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	return connect.NewResponse(&rpc.ChmodResponse{
+		Entry: entry,
+	}), nil
+}
+
+func (Service) Chown(ctx context.Context, req *connect.Request[rpc.ChownRequest]) (*connect.Response[rpc.ChownResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	uid, err := lookupID(req.Msg.GetOwner(), func(name string) (string, error) {
+		owner, err := user.Lookup(name)
+		if err != nil {
+			return "", err
+		}
+
+		return owner.Uid, nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid owner '%s': %w", req.Msg.GetOwner(), err))
+	}
+
+	gid, err := lookupID(req.Msg.GetGroup(), func(name string) (string, error) {
+		group, err := user.LookupGroup(name)
+		if err != nil {
+			return "", err
+		}
+
+		return group.Gid, nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid group '%s': %w", req.Msg.GetGroup(), err))
+	}
+
+	err = walkPath(path, req.Msg.GetRecursive(), func(p string, _ fs.DirEntry) error {
+		return os.Lchown(p, uid, gid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	return connect.NewResponse(&rpc.ChownResponse{
+		Entry: entry,
+	}), nil
+}
+
+// permissionBits converts the Unix permission bits to the file mode.
+func permissionBits(mode uint32) fs.FileMode {
+	m := fs.FileMode(mode & 0o777)
+
+	if mode&0o4000 != 0 {
+		m |= fs.ModeSetuid
+	}
+
+	if mode&0o2000 != 0 {
+		m |= fs.ModeSetgid
+	}
+
+	if mode&0o1000 != 0 {
+		m |= fs.ModeSticky
+	}
+
+	return m
+}
+
+// lookupID resolves the name or the numeric id, it returns -1 for an empty value so the id is not changed.
+func lookupID(value string, lookup func(name string) (string, error)) (int, error) {
+	if value == "" {
+		return -1, nil
+	}
+
+	if id, err := strconv.Atoi(value); err == nil {
+		return id, nil
+	}
+
+	id, err := lookup(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(id)
+}
+
+// walkPath calls fn for the path and, if recursive, for all entries under it.
+// The entry is nil for the path itself, which is always followed, the symlinks under it are not.
+func walkPath(path string, recursive bool, fn func(path string, d fs.DirEntry) error) error {
+	_, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("path not found: %w", err))
+		}
+
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error statting path: %w", err))
+	}
+
+	if !recursive {
+		err = fn(path, nil)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+
+		return nil
+	}
+
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == path {
+			return fn(p, nil)
+		}
+
+		return fn(p, d)
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return nil
+}
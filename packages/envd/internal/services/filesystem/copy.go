@@ -0,0 +1,229 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+
+	"connectrpc.com/connect"
+)
+
+func (Service) Copy(ctx context.Context, req *connect.Request[rpc.CopyRequest]) (*connect.Response[rpc.CopyResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := permissions.ExpandAndResolve(req.Msg.GetSource(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	destination, err := permissions.ExpandAndResolve(req.Msg.GetDestination(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	info, err := os.Lstat(source)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("source path not found: %w", err))
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error statting source: %w", err))
+	}
+
+	if info.IsDir() && (destination == source || strings.HasPrefix(destination, source+string(filepath.Separator))) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot copy directory '%s' into itself", source))
+	}
+
+	_, err = os.Lstat(destination)
+	if err == nil && !req.Msg.GetOverwrite() {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("destination already exists: %s", destination))
+	}
+
+	if err != nil && !os.IsNotExist(err) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error statting destination: %w", err))
+	}
+
+	uid, gid, userErr := permissions.GetUserIds(u)
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	userErr = permissions.EnsureDirs(filepath.Dir(destination), int(uid), int(gid))
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	err = copyEntry(ctx, source, destination, info)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	entry, err := entryInfo(destination)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	return connect.NewResponse(&rpc.CopyResponse{
+		Entry: entry,
+	}), nil
+}
+
+// copyEntry copies the entry with its mode and ownership, the directories are copied recursively.
+// The sockets, devices and pipes are skipped.
+func copyEntry(ctx context.Context, source, destination string, info os.FileInfo) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	var err error
+
+	switch {
+	case info.Mode()&os.ModeSymlink != 0:
+		err = copySymlink(source, destination)
+	case info.IsDir():
+		err = copyDir(ctx, source, destination, info)
+	case info.Mode().IsRegular():
+		err = copyFile(source, destination, info)
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		err = os.Lchown(destination, int(stat.Uid), int(stat.Gid))
+		if err != nil {
+			return fmt.Errorf("error changing ownership of '%s': %w", destination, err)
+		}
+	}
+
+	return nil
+}
+
+// removeNonDir removes the existing destination unless it is a directory, so it can be replaced.
+func removeNonDir(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error statting '%s': %w", path, err)
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("destination is a directory: %s", path)
+	}
+
+	err = os.Remove(path)
+	if err != nil {
+		return fmt.Errorf("error removing '%s': %w", path, err)
+	}
+
+	return nil
+}
+
+func copySymlink(source, destination string) error {
+	target, err := os.Readlink(source)
+	if err != nil {
+		return fmt.Errorf("error reading symlink '%s': %w", source, err)
+	}
+
+	err = removeNonDir(destination)
+	if err != nil {
+		return err
+	}
+
+	err = os.Symlink(target, destination)
+	if err != nil {
+		return fmt.Errorf("error creating symlink '%s': %w", destination, err)
+	}
+
+	return nil
+}
+
+func copyDir(ctx context.Context, source, destination string, info os.FileInfo) error {
+	existing, err := os.Lstat(destination)
+	switch {
+	case os.IsNotExist(err):
+		err = os.Mkdir(destination, info.Mode().Perm())
+		if err != nil {
+			return fmt.Errorf("error creating directory '%s': %w", destination, err)
+		}
+	case err != nil:
+		return fmt.Errorf("error statting '%s': %w", destination, err)
+	case !existing.IsDir():
+		return fmt.Errorf("destination is not a directory: %s", destination)
+	}
+
+	err = os.Chmod(destination, info.Mode().Perm())
+	if err != nil {
+		return fmt.Errorf("error changing mode of '%s': %w", destination, err)
+	}
+
+	entries, err := os.ReadDir(source)
+	if err != nil {
+		return fmt.Errorf("error reading directory '%s': %w", source, err)
+	}
+
+	for _, entry := range entries {
+		childInfo, err := entry.Info()
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting file info: %w", err)
+		}
+
+		err = copyEntry(ctx, filepath.Join(source, entry.Name()), filepath.Join(destination, entry.Name()), childInfo)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyFile(source, destination string, info os.FileInfo) error {
+	err := removeNonDir(destination)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("error opening '%s': %w", source, err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(destination, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+	if err != nil {
+		return fmt.Errorf("error creating '%s': %w", destination, err)
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		return fmt.Errorf("error copying '%s': %w", source, err)
+	}
+
+	err = dst.Chmod(info.Mode().Perm())
+	if err != nil {
+		return fmt.Errorf("error changing mode of '%s': %w", destination, err)
+	}
+
+	return dst.Close()
+}
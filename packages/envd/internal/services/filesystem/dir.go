@@ -2,9 +2,9 @@ package filesystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
-	"path"
 
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
@@ -13,53 +13,46 @@ import (
 )
 
 func (Service) ListDir(ctx context.Context, req *connect.Request[rpc.ListDirRequest]) (*connect.Response[rpc.ListDirResponse], error) {
-	u, err := permissions.GetAuthUser(ctx)
+	dirPath, err := resolveDir(ctx, req.Msg.GetPath())
 	if err != nil {
 		return nil, err
 	}
 
-	dirPath, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	w, err := newWalker(max(req.Msg.GetDepth(), 1), req.Msg.GetInclude(), req.Msg.GetExclude(), req.Msg.GetPageToken())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	stat, err := os.Stat(dirPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("directory not found: %w", err))
-		}
+	// The clients that don't paginate get the whole listing.
+	pageSize := min(int(req.Msg.GetPageSize()), maxListDirPageSize)
 
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
-	}
+	var e []*rpc.EntryInfo
+	var lastRel, nextPageToken string
 
-	if !stat.IsDir() {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is not a directory: %s", dirPath))
-	}
+	err = w.walk(ctx, dirPath, func(rel string, entry *rpc.EntryInfo) error {
+		if pageSize > 0 && len(e) == pageSize {
+			nextPageToken = pageToken(lastRel)
 
-	entries, err := os.ReadDir(dirPath)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error reading directory: %w", err))
-	}
+			return errStopWalk
+		}
 
-	e := make([]*rpc.EntryInfo, len(entries))
+		e = append(e, entry)
+		lastRel = rel
 
-	for i, entry := range entries {
-		var t rpc.FileType
-		if entry.IsDir() {
-			t = rpc.FileType_FILE_TYPE_DIRECTORY
-		} else {
-			t = rpc.FileType_FILE_TYPE_FILE
+		return nil
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
 		}
 
-		e[i] = &rpc.EntryInfo{
-			Name: entry.Name(),
-			Type: t,
-			Path: path.Join(dirPath, entry.Name()),
-		}
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	return connect.NewResponse(&rpc.ListDirResponse{
-		Entries: e,
+		Entries:       e,
+		NextPageToken: nextPageToken,
 	}), nil
 }
 
@@ -97,11 +90,12 @@ func (Service) MakeDir(ctx context.Context, req *connect.Request[rpc.MakeDirRequ
 		return nil, connect.NewError(connect.CodeInternal, userErr)
 	}
 
+	entry, err := entryInfo(dirPath)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
 	return connect.NewResponse(&rpc.MakeDirResponse{
-		Entry: &rpc.EntryInfo{
-			Name: path.Base(dirPath),
-			Type: rpc.FileType_FILE_TYPE_DIRECTORY,
-			Path: dirPath,
-		},
+		Entry: entry,
 	}), nil
 }
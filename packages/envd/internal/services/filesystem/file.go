@@ -0,0 +1,238 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+
+	"connectrpc.com/connect"
+)
+
+const readFileChunkSize = 64 << 10
+
+func (s Service) ReadFile(ctx context.Context, req *connect.Request[rpc.ReadFileRequest], stream *connect.ServerStream[rpc.ReadFileResponse]) error {
+	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.readFileHandler)
+}
+
+func (s Service) readFileHandler(ctx context.Context, req *connect.Request[rpc.ReadFileRequest], stream *connect.ServerStream[rpc.ReadFileResponse]) error {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.GetOffset() < 0 || req.Msg.GetLength() < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("offset and length must not be negative"))
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found: %w", err))
+		}
+
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error opening file: %w", err))
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error statting file: %w", err))
+	}
+
+	if stat.IsDir() {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is a directory: %s", path))
+	}
+
+	var r io.Reader = io.NewSectionReader(file, req.Msg.GetOffset(), stat.Size()-req.Msg.GetOffset())
+	if req.Msg.GetLength() > 0 {
+		r = io.LimitReader(r, req.Msg.GetLength())
+	}
+
+	buf := make([]byte, readFileChunkSize)
+
+	for {
+		if ctx.Err() != nil {
+			return connect.NewError(connect.CodeCanceled, ctx.Err())
+		}
+
+		n, err := r.Read(buf)
+		if n > 0 {
+			streamErr := stream.Send(&rpc.ReadFileResponse{Data: buf[:n]})
+			if streamErr != nil {
+				return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending data: %w", streamErr))
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("error reading file: %w", err))
+		}
+	}
+}
+
+func (s Service) WriteFile(ctx context.Context, stream *connect.ClientStream[rpc.WriteFileRequest]) (*connect.Response[rpc.WriteFileResponse], error) {
+	return logs.LogClientStreamWithoutEvents(ctx, s.logger, stream, s.writeFileHandler)
+}
+
+// openForWrite opens the file for the write mode, the created file and its parent directories are owned by the user.
+func openForWrite(ctx context.Context, start *rpc.WriteFileRequest_StartEvent) (*os.File, error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(start.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if start.GetOffset() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("offset must not be negative"))
+	}
+
+	uid, gid, err := permissions.GetUserIds(u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = permissions.EnsureDirs(filepath.Dir(path), int(uid), int(gid))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	flag := os.O_WRONLY
+	switch start.GetMode() {
+	case rpc.WriteMode_WRITE_MODE_UNSPECIFIED:
+		flag |= os.O_CREATE
+	case rpc.WriteMode_WRITE_MODE_TRUNCATE:
+		flag |= os.O_CREATE | os.O_TRUNC
+	case rpc.WriteMode_WRITE_MODE_APPEND:
+		flag |= os.O_CREATE | os.O_APPEND
+	case rpc.WriteMode_WRITE_MODE_CREATE_EXCLUSIVE:
+		flag |= os.O_CREATE | os.O_EXCL
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid write mode %s", start.GetMode()))
+	}
+
+	_, err = os.Stat(path)
+	created := os.IsNotExist(err)
+
+	file, err := os.OpenFile(path, flag, 0o644)
+	if err != nil {
+		switch {
+		case os.IsExist(err):
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("file already exists: %s", path))
+		case errors.Is(err, os.ErrNotExist):
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found: %w", err))
+		default:
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error opening file: %w", err))
+		}
+	}
+
+	if created {
+		err = file.Chown(int(uid), int(gid))
+		if err != nil {
+			file.Close()
+
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error changing file ownership: %w", err))
+		}
+	}
+
+	if start.GetMode() != rpc.WriteMode_WRITE_MODE_APPEND && start.GetOffset() > 0 {
+		_, err = file.Seek(start.GetOffset(), io.SeekStart)
+		if err != nil {
+			file.Close()
+
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error seeking file: %w", err))
+		}
+	}
+
+	return file, nil
+}
+
+func (s Service) writeFileHandler(ctx context.Context, stream *connect.ClientStream[rpc.WriteFileRequest]) (*connect.Response[rpc.WriteFileResponse], error) {
+	var file *os.File
+	var written int64
+
+	defer func() {
+		if file != nil {
+			file.Close()
+		}
+	}()
+
+	for stream.Receive() {
+		req := stream.Msg()
+
+		switch req.GetEvent().(type) {
+		case *rpc.WriteFileRequest_Start:
+			if file != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start event already received"))
+			}
+
+			f, err := openForWrite(ctx, req.GetStart())
+			if err != nil {
+				return nil, err
+			}
+
+			file = f
+		case *rpc.WriteFileRequest_Data:
+			if file == nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("data received before the start event"))
+			}
+
+			n, err := file.Write(req.GetData().GetData())
+			written += int64(n)
+
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error writing file: %w", err))
+			}
+		case *rpc.WriteFileRequest_Keepalive:
+			break
+		default:
+			return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("invalid event type %T", req.Event))
+		}
+	}
+
+	err := stream.Err()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, err)
+	}
+
+	if file == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no start event received"))
+	}
+
+	path := file.Name()
+
+	err = file.Close()
+	file = nil
+
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error closing file: %w", err))
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	return connect.NewResponse(&rpc.WriteFileResponse{
+		Entry:        entry,
+		BytesWritten: written,
+	}), nil
+}
@@ -28,7 +28,7 @@ func (Service) Move(ctx context.Context, req *connect.Request[rpc.MoveRequest])
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	entry, err := os.Stat(source)
+	_, err = os.Lstat(source)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("source path not found: %w", err))
@@ -52,18 +52,12 @@ func (Service) Move(ctx context.Context, req *connect.Request[rpc.MoveRequest])
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error renaming: %w", err))
 	}
 
-	var t rpc.FileType
-	if entry.IsDir() {
-		t = rpc.FileType_FILE_TYPE_DIRECTORY
-	} else {
-		t = rpc.FileType_FILE_TYPE_FILE
+	entry, err := entryInfo(destination)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
 	}
 
 	return connect.NewResponse(&rpc.MoveResponse{
-		Entry: &rpc.EntryInfo{
-			Name: filepath.Base(destination),
-			Type: t,
-			Path: destination,
-		},
+		Entry: entry,
 	}), nil
 }
@@ -22,7 +22,7 @@ func (Service) Stat(ctx context.Context, req *connect.Request[rpc.StatRequest])
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	fileInfo, err := os.Stat(path)
+	entry, err := entryInfo(path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found: %w", err))
@@ -31,19 +31,10 @@ func (Service) Stat(ctx context.Context, req *connect.Request[rpc.StatRequest])
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error statting file: %w", err))
 	}
 
-	var t rpc.FileType
-	if fileInfo.IsDir() {
-		t = rpc.FileType_FILE_TYPE_DIRECTORY
-	} else {
-		t = rpc.FileType_FILE_TYPE_FILE
+	// The symlinks are followed like by stat(2), so the dangling symlink is not found.
+	if entry.Type == rpc.FileType_FILE_TYPE_SYMLINK {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("symlink target not found: %s", entry.GetSymlinkTarget()))
 	}
 
-	return connect.NewResponse(
-		&rpc.StatResponse{
-			Entry: &rpc.EntryInfo{
-				Name: fileInfo.Name(),
-				Type: t,
-			},
-		},
-	), nil
+	return connect.NewResponse(&rpc.StatResponse{Entry: entry}), nil
 }
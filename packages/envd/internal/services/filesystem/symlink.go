@@ -0,0 +1,62 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+
+	"connectrpc.com/connect"
+)
+
+func (Service) Symlink(ctx context.Context, req *connect.Request[rpc.SymlinkRequest]) (*connect.Response[rpc.SymlinkResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.GetTarget() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("symlink target is empty"))
+	}
+
+	uid, gid, userErr := permissions.GetUserIds(u)
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	userErr = permissions.EnsureDirs(filepath.Dir(path), int(uid), int(gid))
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	err = os.Symlink(req.Msg.GetTarget(), path)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("path already exists: %s", path))
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error creating symlink: %w", err))
+	}
+
+	err = os.Lchown(path, int(uid), int(gid))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error changing symlink ownership: %w", err))
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	return connect.NewResponse(&rpc.SymlinkResponse{
+		Entry: entry,
+	}), nil
+}
@@ -0,0 +1,55 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+
+	"connectrpc.com/connect"
+)
+
+func (Service) Truncate(ctx context.Context, req *connect.Request[rpc.TruncateRequest]) (*connect.Response[rpc.TruncateResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.GetSize() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("size must not be negative"))
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found: %w", err))
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error statting file: %w", err))
+	}
+
+	if stat.IsDir() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is a directory: %s", path))
+	}
+
+	err = os.Truncate(path, req.Msg.GetSize())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error truncating file: %w", err))
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	return connect.NewResponse(&rpc.TruncateResponse{
+		Entry: entry,
+	}), nil
+}
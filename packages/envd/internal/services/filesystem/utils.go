@@ -0,0 +1,66 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// entryInfo returns the info about the file at the path, symlinks are followed.
+// The target of the symlink is set in a separate field, the dangling symlinks are returned with the symlink type and the info about the link itself.
+func entryInfo(path string) (*rpc.EntryInfo, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var symlinkTarget *string
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading symlink: %w", err)
+		}
+
+		symlinkTarget = &target
+
+		targetInfo, err := os.Stat(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		if err == nil {
+			info = targetInfo
+		}
+	}
+
+	owner, group := permissions.GetFileOwner(info)
+
+	entry := &rpc.EntryInfo{
+		Name:          filepath.Base(path),
+		Path:          path,
+		Size:          info.Size(),
+		Mode:          permissions.GetFileMode(info),
+		Permissions:   info.Mode().String(),
+		Owner:         owner,
+		Group:         group,
+		ModifiedTime:  timestamppb.New(info.ModTime()),
+		SymlinkTarget: symlinkTarget,
+	}
+
+	switch {
+	case info.Mode()&os.ModeSymlink != 0:
+		entry.Type = rpc.FileType_FILE_TYPE_SYMLINK
+	case info.IsDir():
+		entry.Type = rpc.FileType_FILE_TYPE_DIRECTORY
+	default:
+		entry.Type = rpc.FileType_FILE_TYPE_FILE
+	}
+
+	return entry, nil
+}
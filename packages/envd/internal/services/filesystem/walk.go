@@ -0,0 +1,247 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	maxListDirPageSize = 10000
+
+	walkDirBatchSize = 100
+)
+
+// errStopWalk stops the walk without an error.
+var errStopWalk = errors.New("stop walk")
+
+// walker lists the directory tree in a stable order, the entries of each directory are sorted by name
+// and every directory is followed by its own entries.
+type walker struct {
+	// depth is the number of the directory levels to walk, 0 walks the whole tree.
+	depth   int
+	include []string
+	exclude []string
+	// after are the path components of the last entry of the previous page relative to the root.
+	after []string
+}
+
+func newWalker(depth uint32, include, exclude []string, pageToken string) (*walker, error) {
+	for _, pattern := range slices.Concat(include, exclude) {
+		_, err := filepath.Match(pattern, "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+		}
+	}
+
+	w := &walker{
+		depth:   int(depth),
+		include: include,
+		exclude: exclude,
+	}
+
+	if pageToken != "" {
+		after, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil || len(after) == 0 {
+			return nil, fmt.Errorf("invalid page token")
+		}
+
+		w.after = strings.Split(string(after), "/")
+	}
+
+	return w, nil
+}
+
+func pageToken(rel string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(rel))
+}
+
+// comparePaths compares the path components in the walk order.
+func comparePaths(a, b []string) int {
+	for i := range min(len(a), len(b)) {
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+func isParentPath(parent, child []string) bool {
+	return len(parent) <= len(child) && slices.Equal(parent, child[:len(parent)])
+}
+
+func matchAny(patterns []string, rel, name string) bool {
+	for _, pattern := range patterns {
+		target := name
+		if strings.Contains(pattern, "/") {
+			target = rel
+		}
+
+		if ok, _ := filepath.Match(pattern, target); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// walk calls fn for every entry under the root that comes after the page token, symlinks are not followed.
+// The unreadable subdirectories and the entries removed during the walk are skipped.
+func (w *walker) walk(ctx context.Context, root string, fn func(rel string, entry *rpc.EntryInfo) error) error {
+	err := w.walkDir(ctx, root, nil, fn)
+	if errors.Is(err, errStopWalk) {
+		return nil
+	}
+
+	return err
+}
+
+func (w *walker) walkDir(ctx context.Context, dir string, parts []string, fn func(rel string, entry *rpc.EntryInfo) error) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if len(parts) == 0 {
+			return fmt.Errorf("error reading directory: %w", err)
+		}
+
+		return nil
+	}
+
+	for _, d := range entries {
+		if ctx.Err() != nil {
+			return connect.NewError(connect.CodeCanceled, ctx.Err())
+		}
+
+		childParts := append(slices.Clone(parts), d.Name())
+		rel := strings.Join(childParts, "/")
+		childPath := filepath.Join(dir, d.Name())
+
+		if matchAny(w.exclude, rel, d.Name()) {
+			continue
+		}
+
+		afterToken := w.after == nil || comparePaths(childParts, w.after) > 0
+
+		if afterToken && (len(w.include) == 0 || matchAny(w.include, rel, d.Name())) {
+			entry, err := entryInfo(childPath)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+
+				return fmt.Errorf("error getting file info: %w", err)
+			}
+
+			err = fn(rel, entry)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !d.IsDir() || (w.depth > 0 && len(childParts) >= w.depth) {
+			continue
+		}
+
+		// The whole subtree was already returned on the previous pages.
+		if !afterToken && !isParentPath(childParts, w.after) {
+			continue
+		}
+
+		err = w.walkDir(ctx, childPath, childParts, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resolveDir(ctx context.Context, path string) (string, error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	dirPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	stat, err := os.Stat(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("directory not found: %w", err))
+		}
+
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	if !stat.IsDir() {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is not a directory: %s", dirPath))
+	}
+
+	return dirPath, nil
+}
+
+func (s Service) WalkDir(ctx context.Context, req *connect.Request[rpc.WalkDirRequest], stream *connect.ServerStream[rpc.WalkDirResponse]) error {
+	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.walkHandler)
+}
+
+func (s Service) walkHandler(ctx context.Context, req *connect.Request[rpc.WalkDirRequest], stream *connect.ServerStream[rpc.WalkDirResponse]) error {
+	dirPath, err := resolveDir(ctx, req.Msg.GetPath())
+	if err != nil {
+		return err
+	}
+
+	w, err := newWalker(req.Msg.GetDepth(), req.Msg.GetInclude(), req.Msg.GetExclude(), "")
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	batch := make([]*rpc.EntryInfo, 0, walkDirBatchSize)
+
+	send := func() error {
+		err := stream.Send(&rpc.WalkDirResponse{Entries: batch})
+		if err != nil {
+			return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending entries: %w", err))
+		}
+
+		batch = batch[:0]
+
+		return nil
+	}
+
+	err = w.walk(ctx, dirPath, func(_ string, entry *rpc.EntryInfo) error {
+		batch = append(batch, entry)
+		if len(batch) < walkDirBatchSize {
+			return nil
+		}
+
+		return send()
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return connectErr
+		}
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	if len(batch) > 0 {
+		return send()
+	}
+
+	return nil
+}
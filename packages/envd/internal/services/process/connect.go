@@ -32,6 +32,15 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 	end, endCancel := proc.EndEvent.Fork()
 	defer endCancel()
 
+	// The replay is taken after forking the data channel, so no output is lost between them.
+	// The output already replayed is skipped when it also arrives through the channel.
+	var replay []*rpc.ProcessEvent_DataEvent
+	var replayEnd uint64
+
+	if req.Msg.ReplayOffset != nil {
+		replay, replayEnd = proc.ReplayOutput(req.Msg.GetReplayOffset())
+	}
+
 	streamErr := stream.Send(&rpc.ConnectResponse{
 		Event: &rpc.ProcessEvent{
 			Event: &rpc.ProcessEvent_Start{
@@ -45,6 +54,19 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 		return connect.NewError(connect.CodeUnknown, streamErr)
 	}
 
+	for _, event := range replay {
+		streamErr = stream.Send(&rpc.ConnectResponse{
+			Event: &rpc.ProcessEvent{
+				Event: &rpc.ProcessEvent_Data{
+					Data: event,
+				},
+			},
+		})
+		if streamErr != nil {
+			return connect.NewError(connect.CodeUnknown, streamErr)
+		}
+	}
+
 	go func() {
 		defer close(exitChan)
 
@@ -76,6 +98,10 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 					break dataLoop
 				}
 
+				if event.Data.GetOffset() < replayEnd {
+					continue
+				}
+
 				streamErr := stream.Send(&rpc.ConnectResponse{
 					Event: &rpc.ProcessEvent{
 						Event: &event,
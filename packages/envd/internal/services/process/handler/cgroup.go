@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	cgroupRoot      = "/sys/fs/cgroup"
+	cgroupCpuPeriod = 100000
+	maxCpuWeight    = 10000
+)
+
+var (
+	envdCgroup = filepath.Join(cgroupRoot, "envd")
+
+	cgroupInitOnce sync.Once
+	cgroupInitErr  error
+)
+
+type cgroup struct {
+	path string
+	dir  *os.File
+}
+
+// initCgroups creates the parent cgroup for the processes and enables the controllers used for the limits.
+func initCgroups() error {
+	cgroupInitOnce.Do(func() {
+		_, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers"))
+		if err != nil {
+			cgroupInitErr = fmt.Errorf("cgroup v2 is not available: %w", err)
+
+			return
+		}
+
+		err = os.Mkdir(envdCgroup, 0o755)
+		if err != nil && !os.IsExist(err) {
+			cgroupInitErr = fmt.Errorf("error creating cgroup '%s': %w", envdCgroup, err)
+
+			return
+		}
+
+		for _, path := range []string{cgroupRoot, envdCgroup} {
+			err = os.WriteFile(filepath.Join(path, "cgroup.subtree_control"), []byte("+cpu +memory +pids"), 0)
+			if err != nil {
+				cgroupInitErr = fmt.Errorf("error enabling controllers in cgroup '%s': %w", path, err)
+
+				return
+			}
+		}
+	})
+
+	return cgroupInitErr
+}
+
+func validateLimits(limits *rpc.ResourceLimits) error {
+	if limits.CpuWeight != nil && (limits.GetCpuWeight() < 1 || limits.GetCpuWeight() > maxCpuWeight) {
+		return fmt.Errorf("cpu weight must be between 1 and %d", maxCpuWeight)
+	}
+
+	if limits.CpuMax != nil && (limits.GetCpuMax() <= 0 || math.IsInf(limits.GetCpuMax(), 0) || math.IsNaN(limits.GetCpuMax())) {
+		return fmt.Errorf("cpu max must be positive")
+	}
+
+	if limits.MemoryMax != nil && limits.GetMemoryMax() == 0 {
+		return fmt.Errorf("memory max must be positive")
+	}
+
+	if limits.PidsMax != nil && limits.GetPidsMax() == 0 {
+		return fmt.Errorf("pids max must be positive")
+	}
+
+	return nil
+}
+
+// newCgroup creates a cgroup with the limits, the process is started directly in it.
+func newCgroup(limits *rpc.ResourceLimits) (*cgroup, error) {
+	err := initCgroups()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := os.MkdirTemp(envdCgroup, "process-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating cgroup: %w", err)
+	}
+
+	c := &cgroup{path: path}
+
+	settings := map[string]string{}
+
+	if limits.CpuWeight != nil {
+		settings["cpu.weight"] = strconv.FormatUint(uint64(limits.GetCpuWeight()), 10)
+	}
+
+	if limits.CpuMax != nil {
+		quota := max(int64(limits.GetCpuMax()*cgroupCpuPeriod), 1000)
+		settings["cpu.max"] = fmt.Sprintf("%d %d", quota, cgroupCpuPeriod)
+	}
+
+	if limits.MemoryMax != nil {
+		settings["memory.max"] = strconv.FormatUint(limits.GetMemoryMax(), 10)
+		// Without the swap limit the process would be swapped out instead of being OOM killed.
+		settings["memory.swap.max"] = "0"
+	}
+
+	if limits.PidsMax != nil {
+		settings["pids.max"] = strconv.FormatUint(uint64(limits.GetPidsMax()), 10)
+	}
+
+	for file, value := range settings {
+		err = os.WriteFile(filepath.Join(path, file), []byte(value), 0)
+		// The swap controller is not present when the swap is disabled.
+		if err != nil && file == "memory.swap.max" && os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			c.remove()
+
+			return nil, fmt.Errorf("error setting '%s' of cgroup '%s': %w", file, path, err)
+		}
+	}
+
+	c.dir, err = os.Open(path)
+	if err != nil {
+		c.remove()
+
+		return nil, fmt.Errorf("error opening cgroup '%s': %w", path, err)
+	}
+
+	return c, nil
+}
+
+func (c *cgroup) fd() int {
+	return int(c.dir.Fd())
+}
+
+// oomKilled reports whether any process in the cgroup was killed by the OOM killer because of the memory limit.
+func (c *cgroup) oomKilled() (bool, error) {
+	file, err := os.Open(filepath.Join(c.path, "memory.events"))
+	if err != nil {
+		return false, fmt.Errorf("error opening memory events of cgroup '%s': %w", c.path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok || key != "oom_kill" {
+			continue
+		}
+
+		count, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("error parsing oom kill count '%s': %w", value, err)
+		}
+
+		return count > 0, nil
+	}
+
+	return false, scanner.Err()
+}
+
+// remove deletes the cgroup, it can be removed only after all processes in it exited.
+func (c *cgroup) remove() error {
+	if c.dir != nil {
+		c.dir.Close()
+	}
+
+	err := os.Remove(c.path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing cgroup '%s': %w", c.path, err)
+	}
+
+	return nil
+}
@@ -43,8 +43,11 @@ type Handler struct {
 	cmd *exec.Cmd
 	tty *os.File
 
-	outWg *sync.WaitGroup
-	stdin io.WriteCloser
+	cgroup *cgroup
+
+	outWg  *sync.WaitGroup
+	stdin  io.WriteCloser
+	output *outputBuffer
 
 	DataEvent *MultiplexedChannel[rpc.ProcessEvent_Data]
 	EndEvent  *MultiplexedChannel[rpc.ProcessEvent_End]
@@ -55,7 +58,7 @@ func (p *Handler) Pid() uint32 {
 	return uint32(p.cmd.Process.Pid)
 }
 
-func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars *utils.Map[string, string]) (*Handler, error) {
+func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars *utils.Map[string, string], replayBufferSize int64) (*Handler, error) {
 	cmd := exec.Command(req.GetProcess().GetCmd(), req.GetProcess().GetArgs()...)
 
 	uid, gid, err := permissions.GetUserIds(user)
@@ -63,7 +66,8 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	cmd.SysProcAttr = &syscall.SysProcAttr{}
+	// The process leads its own session and process group, so the signals can be sent to its children too.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
 	cmd.SysProcAttr.Credential = &syscall.Credential{
 		Uid:         uid,
 		Gid:         gid,
@@ -102,7 +106,36 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 
 	cmd.Env = formattedVars
 
+	var cg *cgroup
+
+	if limits := req.GetProcess().GetLimits(); limits != nil {
+		err = validateLimits(limits)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		cg, err = newCgroup(limits)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("error creating cgroup for the process limits: %w", err))
+		}
+
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = cg.fd()
+	}
+
+	// removeCgroup cleans up the cgroup when the process could not be started.
+	removeCgroup := func() {
+		if cg == nil {
+			return
+		}
+
+		if removeErr := cg.remove(); removeErr != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", removeErr)
+		}
+	}
+
 	outMultiplex := NewMultiplexedChannel[rpc.ProcessEvent_Data](outputBufferSize)
+	output := newOutputBuffer(replayBufferSize)
 	var outWg sync.WaitGroup
 
 	if req.GetPty() != nil {
@@ -113,6 +146,8 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 			Rows: uint16(req.GetPty().GetSize().Rows),
 		})
 		if err != nil {
+			removeCgroup()
+
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("error starting pty with command '%s' in dir '%s' with '%d' cols and '%d' rows: %w", cmd, cmd.Dir, req.GetPty().GetSize().Cols, req.GetPty().GetSize().Rows, err))
 		}
 
@@ -127,13 +162,17 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 				n, readErr := tty.Read(buf)
 
 				if n > 0 {
-					outMultiplex.Source <- rpc.ProcessEvent_Data{
-						Data: &rpc.ProcessEvent_DataEvent{
-							Output: &rpc.ProcessEvent_DataEvent_Pty{
-								Pty: buf[:n],
-							},
+					event := &rpc.ProcessEvent_DataEvent{
+						Output: &rpc.ProcessEvent_DataEvent_Pty{
+							Pty: buf[:n],
 						},
 					}
+
+					output.add(event)
+
+					outMultiplex.Source <- rpc.ProcessEvent_Data{
+						Data: event,
+					}
 				}
 
 				if errors.Is(readErr, io.EOF) {
@@ -152,6 +191,8 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 			Config:    req.GetProcess(),
 			cmd:       cmd,
 			tty:       tty,
+			cgroup:    cg,
+			output:    output,
 			Tag:       req.Tag,
 			DataEvent: outMultiplex,
 			outWg:     &outWg,
@@ -162,11 +203,15 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
+		removeCgroup()
+
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error creating stdin pipe for command '%s': %w", cmd, err))
 	}
 
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
+		removeCgroup()
+
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error creating stdout pipe for command '%s': %w", cmd, err))
 	}
 
@@ -187,14 +232,18 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 			n, readErr := stdout.Read(buf)
 
 			if n > 0 {
-				outMultiplex.Source <- rpc.ProcessEvent_Data{
-					Data: &rpc.ProcessEvent_DataEvent{
-						Output: &rpc.ProcessEvent_DataEvent_Stdout{
-							Stdout: buf[:n],
-						},
+				event := &rpc.ProcessEvent_DataEvent{
+					Output: &rpc.ProcessEvent_DataEvent_Stdout{
+						Stdout: buf[:n],
 					},
 				}
 
+				output.add(event)
+
+				outMultiplex.Source <- rpc.ProcessEvent_Data{
+					Data: event,
+				}
+
 				stdoutLogs <- buf[:n]
 			}
 
@@ -212,6 +261,8 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 
 	stderr, err := cmd.StderrPipe()
 	if err != nil {
+		removeCgroup()
+
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error creating stderr pipe for command '%s': %w", cmd, err))
 	}
 
@@ -232,14 +283,18 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 			n, readErr := stderr.Read(buf)
 
 			if n > 0 {
-				outMultiplex.Source <- rpc.ProcessEvent_Data{
-					Data: &rpc.ProcessEvent_DataEvent{
-						Output: &rpc.ProcessEvent_DataEvent_Stderr{
-							Stderr: buf[:n],
-						},
+				event := &rpc.ProcessEvent_DataEvent{
+					Output: &rpc.ProcessEvent_DataEvent_Stderr{
+						Stderr: buf[:n],
 					},
 				}
 
+				output.add(event)
+
+				outMultiplex.Source <- rpc.ProcessEvent_Data{
+					Data: event,
+				}
+
 				stderrLogs <- buf[:n]
 			}
 
@@ -259,6 +314,8 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 		Config:    req.GetProcess(),
 		cmd:       cmd,
 		stdin:     stdin,
+		cgroup:    cg,
+		output:    output,
 		Tag:       req.Tag,
 		DataEvent: outMultiplex,
 		outWg:     &outWg,
@@ -267,12 +324,29 @@ func New(user *user.User, req *rpc.StartRequest, logger *zerolog.Logger, envVars
 	}, nil
 }
 
-func (p *Handler) SendSignal(signal syscall.Signal) error {
+// ReplayOutput returns the buffered output from the offset and the offset from which the new output continues.
+func (p *Handler) ReplayOutput(offset uint64) ([]*rpc.ProcessEvent_DataEvent, uint64) {
+	return p.output.replay(offset)
+}
+
+func (p *Handler) SendSignal(signal syscall.Signal, scope rpc.SignalScope) error {
 	if p.cmd.Process == nil {
 		return fmt.Errorf("process not started")
 	}
 
-	return p.cmd.Process.Signal(signal)
+	pid := p.cmd.Process.Pid
+
+	switch scope {
+	case rpc.SignalScope_SIGNAL_SCOPE_UNSPECIFIED:
+		return p.cmd.Process.Signal(signal)
+	case rpc.SignalScope_SIGNAL_SCOPE_PROCESS_GROUP:
+		// The process is the leader of its group, so the group id is the same as the pid.
+		return syscall.Kill(-pid, signal)
+	case rpc.SignalScope_SIGNAL_SCOPE_SESSION:
+		return signalSession(pid, signal)
+	default:
+		return fmt.Errorf("invalid signal scope: %s", scope)
+	}
 }
 
 func (p *Handler) ResizeTty(size *pty.Winsize) error {
@@ -314,6 +388,12 @@ func (p *Handler) Start() (uint32, error) {
 	if p.tty == nil {
 		err := p.cmd.Start()
 		if err != nil {
+			if p.cgroup != nil {
+				if removeErr := p.cgroup.remove(); removeErr != nil {
+					fmt.Fprintf(os.Stderr, "%s\n", removeErr)
+				}
+			}
+
 			return 0, fmt.Errorf("error starting process '%s': %w", p.cmd, err)
 		}
 	}
@@ -356,6 +436,21 @@ func (p *Handler) Wait() {
 		Status:   p.cmd.ProcessState.String(),
 	}
 
+	if p.cgroup != nil {
+		oomKilled, oomErr := p.cgroup.oomKilled()
+		if oomErr != nil {
+			fmt.Fprintf(os.Stderr, "error checking oom kills of process '%s': %s\n", p.cmd, oomErr)
+		}
+
+		endEvent.OomKilled = oomKilled
+
+		// The cgroup can't be removed while the orphaned children of the process are still running in it.
+		removeErr := p.cgroup.remove()
+		if removeErr != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", removeErr)
+		}
+	}
+
 	event := rpc.ProcessEvent_End{
 		End: endEvent,
 	}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"sync"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const DefaultReplayBufferSize = 1 << 20
+
+// outputBuffer keeps the latest output of the process up to the size in bytes, so it can be replayed to the reconnecting clients.
+type outputBuffer struct {
+	mu sync.Mutex
+
+	events  []*rpc.ProcessEvent_DataEvent
+	size    int64
+	maxSize int64
+	// offset is the total number of bytes of the output of the process.
+	offset uint64
+}
+
+func newOutputBuffer(maxSize int64) *outputBuffer {
+	return &outputBuffer{
+		maxSize: maxSize,
+	}
+}
+
+func outputData(event *rpc.ProcessEvent_DataEvent) []byte {
+	switch output := event.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		return output.Stdout
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		return output.Stderr
+	case *rpc.ProcessEvent_DataEvent_Pty:
+		return output.Pty
+	default:
+		return nil
+	}
+}
+
+// add assigns the offset to the event and stores it, the oldest events are dropped when the buffer is full.
+func (b *outputBuffer) add(event *rpc.ProcessEvent_DataEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	size := len(outputData(event))
+
+	event.Offset = b.offset
+	b.offset += uint64(size)
+
+	if b.maxSize <= 0 {
+		return
+	}
+
+	b.events = append(b.events, event)
+	b.size += int64(size)
+
+	dropped := 0
+	for b.size > b.maxSize && dropped < len(b.events) {
+		b.size -= int64(len(outputData(b.events[dropped])))
+		b.events[dropped] = nil
+		dropped++
+	}
+
+	b.events = b.events[dropped:]
+}
+
+// replay returns the buffered output from the offset and the offset of the end of the returned output.
+// The first event is trimmed if the offset points in the middle of it.
+func (b *outputBuffer) replay(from uint64) ([]*rpc.ProcessEvent_DataEvent, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var events []*rpc.ProcessEvent_DataEvent
+
+	for _, event := range b.events {
+		data := outputData(event)
+		end := event.GetOffset() + uint64(len(data))
+
+		if end <= from {
+			continue
+		}
+
+		if event.GetOffset() >= from {
+			events = append(events, event)
+
+			continue
+		}
+
+		skip := from - event.GetOffset()
+
+		trimmed := &rpc.ProcessEvent_DataEvent{
+			Offset: from,
+		}
+
+		switch event.GetOutput().(type) {
+		case *rpc.ProcessEvent_DataEvent_Stdout:
+			trimmed.Output = &rpc.ProcessEvent_DataEvent_Stdout{Stdout: data[skip:]}
+		case *rpc.ProcessEvent_DataEvent_Stderr:
+			trimmed.Output = &rpc.ProcessEvent_DataEvent_Stderr{Stderr: data[skip:]}
+		case *rpc.ProcessEvent_DataEvent_Pty:
+			trimmed.Output = &rpc.ProcessEvent_DataEvent_Pty{Pty: data[skip:]}
+		}
+
+		events = append(events, trimmed)
+	}
+
+	return events, b.offset
+}
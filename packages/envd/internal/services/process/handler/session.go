@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// signalSession sends the signal to all processes in the session, the processes that already exited are skipped.
+func signalSession(sid int, signal syscall.Signal) error {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return fmt.Errorf("error listing processes: %w", err)
+	}
+
+	var errs []error
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		processSid, err := unix.Getsid(pid)
+		if err != nil || processSid != sid {
+			continue
+		}
+
+		err = syscall.Kill(pid, signal)
+		if err != nil && !errors.Is(err, syscall.ESRCH) {
+			errs = append(errs, fmt.Errorf("error sending signal to process '%d': %w", pid, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -18,18 +18,21 @@ type Service struct {
 	processes *utils.Map[uint32, *handler.Handler]
 	logger    *zerolog.Logger
 	envs      *utils.Map[string, string]
+
+	replayBufferSize int64
 }
 
-func newService(l *zerolog.Logger, envs *utils.Map[string, string]) *Service {
+func newService(l *zerolog.Logger, envs *utils.Map[string, string], replayBufferSize int64) *Service {
 	return &Service{
-		logger:    l,
-		processes: utils.NewMap[uint32, *handler.Handler](),
-		envs:      envs,
+		logger:           l,
+		processes:        utils.NewMap[uint32, *handler.Handler](),
+		envs:             envs,
+		replayBufferSize: replayBufferSize,
 	}
 }
 
-func Handle(server *chi.Mux, l *zerolog.Logger, envs *utils.Map[string, string]) *Service {
-	service := newService(l, envs)
+func Handle(server *chi.Mux, l *zerolog.Logger, envs *utils.Map[string, string], replayBufferSize int64) *Service {
+	service := newService(l, envs, replayBufferSize)
 
 	interceptors := connect.WithInterceptors(logs.NewUnaryLogInterceptor(l))
 
@@ -16,17 +16,14 @@ func (s *Service) SendSignal(ctx context.Context, req *connect.Request[rpc.SendS
 		return nil, err
 	}
 
-	var signal syscall.Signal
-	switch req.Msg.GetSignal() {
-	case rpc.Signal_SIGNAL_SIGKILL:
-		signal = syscall.SIGKILL
-	case rpc.Signal_SIGNAL_SIGTERM:
-		signal = syscall.SIGTERM
-	default:
+	// The values of the signals are the same as the Linux signal numbers.
+	if _, ok := rpc.Signal_name[int32(req.Msg.GetSignal())]; !ok || req.Msg.GetSignal() == rpc.Signal_SIGNAL_UNSPECIFIED {
 		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("invalid signal: %s", req.Msg.GetSignal()))
 	}
 
-	err = proc.SendSignal(signal)
+	signal := syscall.Signal(req.Msg.GetSignal())
+
+	err = proc.SendSignal(signal, req.Msg.GetScope())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error sending signal: %w", err))
 	}
@@ -0,0 +1,146 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+
+	"connectrpc.com/connect"
+	"github.com/shirou/gopsutil/v4/process"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultStatsInterval = time.Second
+	minStatsInterval     = 100 * time.Millisecond
+)
+
+func (s *Service) Stats(ctx context.Context, req *connect.Request[rpc.StatsRequest], stream *connect.ServerStream[rpc.StatsResponse]) error {
+	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.handleStats)
+}
+
+func (s *Service) handleStats(ctx context.Context, req *connect.Request[rpc.StatsRequest], stream *connect.ServerStream[rpc.StatsResponse]) error {
+	interval := defaultStatsInterval
+	if req.Msg.GetInterval() > 0 {
+		interval = max(time.Duration(req.Msg.GetInterval())*time.Millisecond, minStatsInterval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for first := true; ; first = false {
+		var processes []*rpc.ProcessInfo
+
+		if req.Msg.Process != nil {
+			proc, err := s.getProcess(req.Msg.GetProcess())
+			if err != nil {
+				// The stream ends when the selected process exits.
+				if !first {
+					return nil
+				}
+
+				return err
+			}
+
+			processes = append(processes, processInfo(ctx, proc))
+		} else {
+			processes = s.listProcesses(ctx)
+		}
+
+		err := stream.Send(&rpc.StatsResponse{
+			Processes: processes,
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeUnknown, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) listProcesses(ctx context.Context) []*rpc.ProcessInfo {
+	processes := make([]*rpc.ProcessInfo, 0)
+
+	s.processes.Range(func(_ uint32, value *handler.Handler) bool {
+		processes = append(processes, processInfo(ctx, value))
+
+		return true
+	})
+
+	return processes
+}
+
+func processInfo(ctx context.Context, proc *handler.Handler) *rpc.ProcessInfo {
+	info := &rpc.ProcessInfo{
+		Pid:    proc.Pid(),
+		Tag:    proc.Tag,
+		Config: proc.Config,
+	}
+
+	stats, err := processStats(ctx, proc.Pid())
+	if err == nil {
+		info.Stats = stats
+	}
+
+	return info
+}
+
+// processStats collects the stats of the process from /proc.
+func processStats(ctx context.Context, pid uint32) (*rpc.ProcessStats, error) {
+	p, err := process.NewProcessWithContext(ctx, int32(pid))
+	if err != nil {
+		return nil, fmt.Errorf("error getting process %d: %w", pid, err)
+	}
+
+	times, err := p.TimesWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cpu times of process %d: %w", pid, err)
+	}
+
+	memory, err := p.MemoryInfoWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting memory of process %d: %w", pid, err)
+	}
+
+	createTime, err := p.CreateTimeWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting start time of process %d: %w", pid, err)
+	}
+
+	status, err := p.StatusWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting state of process %d: %w", pid, err)
+	}
+
+	children, err := p.ChildrenWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting children of process %d: %w", pid, err)
+	}
+
+	childPids := make([]uint32, 0, len(children))
+	for _, child := range children {
+		childPids = append(childPids, uint32(child.Pid))
+	}
+
+	var state string
+	if len(status) > 0 {
+		state = status[0]
+	}
+
+	return &rpc.ProcessStats{
+		CpuUser:   times.User,
+		CpuSystem: times.System,
+		MemoryRss: memory.RSS,
+		StartTime: timestamppb.New(time.UnixMilli(createTime)),
+		State:     state,
+		Children:  childPids,
+	}, nil
+}
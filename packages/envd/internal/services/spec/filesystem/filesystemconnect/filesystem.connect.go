@@ -41,8 +41,24 @@ const (
 	FilesystemMoveProcedure = "/filesystem.Filesystem/Move"
 	// FilesystemListDirProcedure is the fully-qualified name of the Filesystem's ListDir RPC.
 	FilesystemListDirProcedure = "/filesystem.Filesystem/ListDir"
+	// FilesystemWalkDirProcedure is the fully-qualified name of the Filesystem's WalkDir RPC.
+	FilesystemWalkDirProcedure = "/filesystem.Filesystem/WalkDir"
 	// FilesystemRemoveProcedure is the fully-qualified name of the Filesystem's Remove RPC.
 	FilesystemRemoveProcedure = "/filesystem.Filesystem/Remove"
+	// FilesystemReadFileProcedure is the fully-qualified name of the Filesystem's ReadFile RPC.
+	FilesystemReadFileProcedure = "/filesystem.Filesystem/ReadFile"
+	// FilesystemWriteFileProcedure is the fully-qualified name of the Filesystem's WriteFile RPC.
+	FilesystemWriteFileProcedure = "/filesystem.Filesystem/WriteFile"
+	// FilesystemCopyProcedure is the fully-qualified name of the Filesystem's Copy RPC.
+	FilesystemCopyProcedure = "/filesystem.Filesystem/Copy"
+	// FilesystemChmodProcedure is the fully-qualified name of the Filesystem's Chmod RPC.
+	FilesystemChmodProcedure = "/filesystem.Filesystem/Chmod"
+	// FilesystemChownProcedure is the fully-qualified name of the Filesystem's Chown RPC.
+	FilesystemChownProcedure = "/filesystem.Filesystem/Chown"
+	// FilesystemSymlinkProcedure is the fully-qualified name of the Filesystem's Symlink RPC.
+	FilesystemSymlinkProcedure = "/filesystem.Filesystem/Symlink"
+	// FilesystemTruncateProcedure is the fully-qualified name of the Filesystem's Truncate RPC.
+	FilesystemTruncateProcedure = "/filesystem.Filesystem/Truncate"
 	// FilesystemWatchDirProcedure is the fully-qualified name of the Filesystem's WatchDir RPC.
 	FilesystemWatchDirProcedure = "/filesystem.Filesystem/WatchDir"
 	// FilesystemCreateWatcherProcedure is the fully-qualified name of the Filesystem's CreateWatcher
@@ -63,7 +79,15 @@ var (
 	filesystemMakeDirMethodDescriptor          = filesystemServiceDescriptor.Methods().ByName("MakeDir")
 	filesystemMoveMethodDescriptor             = filesystemServiceDescriptor.Methods().ByName("Move")
 	filesystemListDirMethodDescriptor          = filesystemServiceDescriptor.Methods().ByName("ListDir")
+	filesystemWalkDirMethodDescriptor          = filesystemServiceDescriptor.Methods().ByName("WalkDir")
 	filesystemRemoveMethodDescriptor           = filesystemServiceDescriptor.Methods().ByName("Remove")
+	filesystemReadFileMethodDescriptor         = filesystemServiceDescriptor.Methods().ByName("ReadFile")
+	filesystemWriteFileMethodDescriptor        = filesystemServiceDescriptor.Methods().ByName("WriteFile")
+	filesystemCopyMethodDescriptor             = filesystemServiceDescriptor.Methods().ByName("Copy")
+	filesystemChmodMethodDescriptor            = filesystemServiceDescriptor.Methods().ByName("Chmod")
+	filesystemChownMethodDescriptor            = filesystemServiceDescriptor.Methods().ByName("Chown")
+	filesystemSymlinkMethodDescriptor          = filesystemServiceDescriptor.Methods().ByName("Symlink")
+	filesystemTruncateMethodDescriptor         = filesystemServiceDescriptor.Methods().ByName("Truncate")
 	filesystemWatchDirMethodDescriptor         = filesystemServiceDescriptor.Methods().ByName("WatchDir")
 	filesystemCreateWatcherMethodDescriptor    = filesystemServiceDescriptor.Methods().ByName("CreateWatcher")
 	filesystemGetWatcherEventsMethodDescriptor = filesystemServiceDescriptor.Methods().ByName("GetWatcherEvents")
@@ -76,7 +100,16 @@ type FilesystemClient interface {
 	MakeDir(context.Context, *connect.Request[filesystem.MakeDirRequest]) (*connect.Response[filesystem.MakeDirResponse], error)
 	Move(context.Context, *connect.Request[filesystem.MoveRequest]) (*connect.Response[filesystem.MoveResponse], error)
 	ListDir(context.Context, *connect.Request[filesystem.ListDirRequest]) (*connect.Response[filesystem.ListDirResponse], error)
+	// Streaming version of ListDir without the pagination, for indexing the whole directory tree
+	WalkDir(context.Context, *connect.Request[filesystem.WalkDirRequest]) (*connect.ServerStreamForClient[filesystem.WalkDirResponse], error)
 	Remove(context.Context, *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error)
+	ReadFile(context.Context, *connect.Request[filesystem.ReadFileRequest]) (*connect.ServerStreamForClient[filesystem.ReadFileResponse], error)
+	WriteFile(context.Context) *connect.ClientStreamForClient[filesystem.WriteFileRequest, filesystem.WriteFileResponse]
+	Copy(context.Context, *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error)
+	Chmod(context.Context, *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error)
+	Chown(context.Context, *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error)
+	Symlink(context.Context, *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error)
+	Truncate(context.Context, *connect.Request[filesystem.TruncateRequest]) (*connect.Response[filesystem.TruncateResponse], error)
 	WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest]) (*connect.ServerStreamForClient[filesystem.WatchDirResponse], error)
 	// Non-streaming versions of WatchDir
 	CreateWatcher(context.Context, *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error)
@@ -118,12 +151,60 @@ func NewFilesystemClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(filesystemListDirMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		walkDir: connect.NewClient[filesystem.WalkDirRequest, filesystem.WalkDirResponse](
+			httpClient,
+			baseURL+FilesystemWalkDirProcedure,
+			connect.WithSchema(filesystemWalkDirMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		remove: connect.NewClient[filesystem.RemoveRequest, filesystem.RemoveResponse](
 			httpClient,
 			baseURL+FilesystemRemoveProcedure,
 			connect.WithSchema(filesystemRemoveMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		readFile: connect.NewClient[filesystem.ReadFileRequest, filesystem.ReadFileResponse](
+			httpClient,
+			baseURL+FilesystemReadFileProcedure,
+			connect.WithSchema(filesystemReadFileMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		writeFile: connect.NewClient[filesystem.WriteFileRequest, filesystem.WriteFileResponse](
+			httpClient,
+			baseURL+FilesystemWriteFileProcedure,
+			connect.WithSchema(filesystemWriteFileMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		copy: connect.NewClient[filesystem.CopyRequest, filesystem.CopyResponse](
+			httpClient,
+			baseURL+FilesystemCopyProcedure,
+			connect.WithSchema(filesystemCopyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		chmod: connect.NewClient[filesystem.ChmodRequest, filesystem.ChmodResponse](
+			httpClient,
+			baseURL+FilesystemChmodProcedure,
+			connect.WithSchema(filesystemChmodMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		chown: connect.NewClient[filesystem.ChownRequest, filesystem.ChownResponse](
+			httpClient,
+			baseURL+FilesystemChownProcedure,
+			connect.WithSchema(filesystemChownMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		symlink: connect.NewClient[filesystem.SymlinkRequest, filesystem.SymlinkResponse](
+			httpClient,
+			baseURL+FilesystemSymlinkProcedure,
+			connect.WithSchema(filesystemSymlinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		truncate: connect.NewClient[filesystem.TruncateRequest, filesystem.TruncateResponse](
+			httpClient,
+			baseURL+FilesystemTruncateProcedure,
+			connect.WithSchema(filesystemTruncateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		watchDir: connect.NewClient[filesystem.WatchDirRequest, filesystem.WatchDirResponse](
 			httpClient,
 			baseURL+FilesystemWatchDirProcedure,
@@ -157,7 +238,15 @@ type filesystemClient struct {
 	makeDir          *connect.Client[filesystem.MakeDirRequest, filesystem.MakeDirResponse]
 	move             *connect.Client[filesystem.MoveRequest, filesystem.MoveResponse]
 	listDir          *connect.Client[filesystem.ListDirRequest, filesystem.ListDirResponse]
+	walkDir          *connect.Client[filesystem.WalkDirRequest, filesystem.WalkDirResponse]
 	remove           *connect.Client[filesystem.RemoveRequest, filesystem.RemoveResponse]
+	readFile         *connect.Client[filesystem.ReadFileRequest, filesystem.ReadFileResponse]
+	writeFile        *connect.Client[filesystem.WriteFileRequest, filesystem.WriteFileResponse]
+	copy             *connect.Client[filesystem.CopyRequest, filesystem.CopyResponse]
+	chmod            *connect.Client[filesystem.ChmodRequest, filesystem.ChmodResponse]
+	chown            *connect.Client[filesystem.ChownRequest, filesystem.ChownResponse]
+	symlink          *connect.Client[filesystem.SymlinkRequest, filesystem.SymlinkResponse]
+	truncate         *connect.Client[filesystem.TruncateRequest, filesystem.TruncateResponse]
 	watchDir         *connect.Client[filesystem.WatchDirRequest, filesystem.WatchDirResponse]
 	createWatcher    *connect.Client[filesystem.CreateWatcherRequest, filesystem.CreateWatcherResponse]
 	getWatcherEvents *connect.Client[filesystem.GetWatcherEventsRequest, filesystem.GetWatcherEventsResponse]
@@ -184,11 +273,51 @@ func (c *filesystemClient) ListDir(ctx context.Context, req *connect.Request[fil
 	return c.listDir.CallUnary(ctx, req)
 }
 
+// WalkDir calls filesystem.Filesystem.WalkDir.
+func (c *filesystemClient) WalkDir(ctx context.Context, req *connect.Request[filesystem.WalkDirRequest]) (*connect.ServerStreamForClient[filesystem.WalkDirResponse], error) {
+	return c.walkDir.CallServerStream(ctx, req)
+}
+
 // Remove calls filesystem.Filesystem.Remove.
 func (c *filesystemClient) Remove(ctx context.Context, req *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error) {
 	return c.remove.CallUnary(ctx, req)
 }
 
+// ReadFile calls filesystem.Filesystem.ReadFile.
+func (c *filesystemClient) ReadFile(ctx context.Context, req *connect.Request[filesystem.ReadFileRequest]) (*connect.ServerStreamForClient[filesystem.ReadFileResponse], error) {
+	return c.readFile.CallServerStream(ctx, req)
+}
+
+// WriteFile calls filesystem.Filesystem.WriteFile.
+func (c *filesystemClient) WriteFile(ctx context.Context) *connect.ClientStreamForClient[filesystem.WriteFileRequest, filesystem.WriteFileResponse] {
+	return c.writeFile.CallClientStream(ctx)
+}
+
+// Copy calls filesystem.Filesystem.Copy.
+func (c *filesystemClient) Copy(ctx context.Context, req *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error) {
+	return c.copy.CallUnary(ctx, req)
+}
+
+// Chmod calls filesystem.Filesystem.Chmod.
+func (c *filesystemClient) Chmod(ctx context.Context, req *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error) {
+	return c.chmod.CallUnary(ctx, req)
+}
+
+// Chown calls filesystem.Filesystem.Chown.
+func (c *filesystemClient) Chown(ctx context.Context, req *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error) {
+	return c.chown.CallUnary(ctx, req)
+}
+
+// Symlink calls filesystem.Filesystem.Symlink.
+func (c *filesystemClient) Symlink(ctx context.Context, req *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error) {
+	return c.symlink.CallUnary(ctx, req)
+}
+
+// Truncate calls filesystem.Filesystem.Truncate.
+func (c *filesystemClient) Truncate(ctx context.Context, req *connect.Request[filesystem.TruncateRequest]) (*connect.Response[filesystem.TruncateResponse], error) {
+	return c.truncate.CallUnary(ctx, req)
+}
+
 // WatchDir calls filesystem.Filesystem.WatchDir.
 func (c *filesystemClient) WatchDir(ctx context.Context, req *connect.Request[filesystem.WatchDirRequest]) (*connect.ServerStreamForClient[filesystem.WatchDirResponse], error) {
 	return c.watchDir.CallServerStream(ctx, req)
@@ -215,7 +344,16 @@ type FilesystemHandler interface {
 	MakeDir(context.Context, *connect.Request[filesystem.MakeDirRequest]) (*connect.Response[filesystem.MakeDirResponse], error)
 	Move(context.Context, *connect.Request[filesystem.MoveRequest]) (*connect.Response[filesystem.MoveResponse], error)
 	ListDir(context.Context, *connect.Request[filesystem.ListDirRequest]) (*connect.Response[filesystem.ListDirResponse], error)
+	// Streaming version of ListDir without the pagination, for indexing the whole directory tree
+	WalkDir(context.Context, *connect.Request[filesystem.WalkDirRequest], *connect.ServerStream[filesystem.WalkDirResponse]) error
 	Remove(context.Context, *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error)
+	ReadFile(context.Context, *connect.Request[filesystem.ReadFileRequest], *connect.ServerStream[filesystem.ReadFileResponse]) error
+	WriteFile(context.Context, *connect.ClientStream[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error)
+	Copy(context.Context, *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error)
+	Chmod(context.Context, *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error)
+	Chown(context.Context, *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error)
+	Symlink(context.Context, *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error)
+	Truncate(context.Context, *connect.Request[filesystem.TruncateRequest]) (*connect.Response[filesystem.TruncateResponse], error)
 	WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest], *connect.ServerStream[filesystem.WatchDirResponse]) error
 	// Non-streaming versions of WatchDir
 	CreateWatcher(context.Context, *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error)
@@ -253,12 +391,60 @@ func NewFilesystemHandler(svc FilesystemHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(filesystemListDirMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	filesystemWalkDirHandler := connect.NewServerStreamHandler(
+		FilesystemWalkDirProcedure,
+		svc.WalkDir,
+		connect.WithSchema(filesystemWalkDirMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	filesystemRemoveHandler := connect.NewUnaryHandler(
 		FilesystemRemoveProcedure,
 		svc.Remove,
 		connect.WithSchema(filesystemRemoveMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	filesystemReadFileHandler := connect.NewServerStreamHandler(
+		FilesystemReadFileProcedure,
+		svc.ReadFile,
+		connect.WithSchema(filesystemReadFileMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemWriteFileHandler := connect.NewClientStreamHandler(
+		FilesystemWriteFileProcedure,
+		svc.WriteFile,
+		connect.WithSchema(filesystemWriteFileMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemCopyHandler := connect.NewUnaryHandler(
+		FilesystemCopyProcedure,
+		svc.Copy,
+		connect.WithSchema(filesystemCopyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemChmodHandler := connect.NewUnaryHandler(
+		FilesystemChmodProcedure,
+		svc.Chmod,
+		connect.WithSchema(filesystemChmodMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemChownHandler := connect.NewUnaryHandler(
+		FilesystemChownProcedure,
+		svc.Chown,
+		connect.WithSchema(filesystemChownMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemSymlinkHandler := connect.NewUnaryHandler(
+		FilesystemSymlinkProcedure,
+		svc.Symlink,
+		connect.WithSchema(filesystemSymlinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemTruncateHandler := connect.NewUnaryHandler(
+		FilesystemTruncateProcedure,
+		svc.Truncate,
+		connect.WithSchema(filesystemTruncateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	filesystemWatchDirHandler := connect.NewServerStreamHandler(
 		FilesystemWatchDirProcedure,
 		svc.WatchDir,
@@ -293,8 +479,24 @@ func NewFilesystemHandler(svc FilesystemHandler, opts ...connect.HandlerOption)
 			filesystemMoveHandler.ServeHTTP(w, r)
 		case FilesystemListDirProcedure:
 			filesystemListDirHandler.ServeHTTP(w, r)
+		case FilesystemWalkDirProcedure:
+			filesystemWalkDirHandler.ServeHTTP(w, r)
 		case FilesystemRemoveProcedure:
 			filesystemRemoveHandler.ServeHTTP(w, r)
+		case FilesystemReadFileProcedure:
+			filesystemReadFileHandler.ServeHTTP(w, r)
+		case FilesystemWriteFileProcedure:
+			filesystemWriteFileHandler.ServeHTTP(w, r)
+		case FilesystemCopyProcedure:
+			filesystemCopyHandler.ServeHTTP(w, r)
+		case FilesystemChmodProcedure:
+			filesystemChmodHandler.ServeHTTP(w, r)
+		case FilesystemChownProcedure:
+			filesystemChownHandler.ServeHTTP(w, r)
+		case FilesystemSymlinkProcedure:
+			filesystemSymlinkHandler.ServeHTTP(w, r)
+		case FilesystemTruncateProcedure:
+			filesystemTruncateHandler.ServeHTTP(w, r)
 		case FilesystemWatchDirProcedure:
 			filesystemWatchDirHandler.ServeHTTP(w, r)
 		case FilesystemCreateWatcherProcedure:
@@ -328,10 +530,42 @@ func (UnimplementedFilesystemHandler) ListDir(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.ListDir is not implemented"))
 }
 
+func (UnimplementedFilesystemHandler) WalkDir(context.Context, *connect.Request[filesystem.WalkDirRequest], *connect.ServerStream[filesystem.WalkDirResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.WalkDir is not implemented"))
+}
+
 func (UnimplementedFilesystemHandler) Remove(context.Context, *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Remove is not implemented"))
 }
 
+func (UnimplementedFilesystemHandler) ReadFile(context.Context, *connect.Request[filesystem.ReadFileRequest], *connect.ServerStream[filesystem.ReadFileResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.ReadFile is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) WriteFile(context.Context, *connect.ClientStream[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.WriteFile is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Copy(context.Context, *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Copy is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Chmod(context.Context, *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Chmod is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Chown(context.Context, *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Chown is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Symlink(context.Context, *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Symlink is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Truncate(context.Context, *connect.Request[filesystem.TruncateRequest]) (*connect.Response[filesystem.TruncateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Truncate is not implemented"))
+}
+
 func (UnimplementedFilesystemHandler) WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest], *connect.ServerStream[filesystem.WatchDirResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.WatchDir is not implemented"))
 }
@@ -35,6 +35,8 @@ const (
 const (
 	// ProcessListProcedure is the fully-qualified name of the Process's List RPC.
 	ProcessListProcedure = "/process.Process/List"
+	// ProcessStatsProcedure is the fully-qualified name of the Process's Stats RPC.
+	ProcessStatsProcedure = "/process.Process/Stats"
 	// ProcessConnectProcedure is the fully-qualified name of the Process's Connect RPC.
 	ProcessConnectProcedure = "/process.Process/Connect"
 	// ProcessStartProcedure is the fully-qualified name of the Process's Start RPC.
@@ -53,6 +55,7 @@ const (
 var (
 	processServiceDescriptor           = process.File_process_process_proto.Services().ByName("Process")
 	processListMethodDescriptor        = processServiceDescriptor.Methods().ByName("List")
+	processStatsMethodDescriptor       = processServiceDescriptor.Methods().ByName("Stats")
 	processConnectMethodDescriptor     = processServiceDescriptor.Methods().ByName("Connect")
 	processStartMethodDescriptor       = processServiceDescriptor.Methods().ByName("Start")
 	processUpdateMethodDescriptor      = processServiceDescriptor.Methods().ByName("Update")
@@ -64,6 +67,7 @@ var (
 // ProcessClient is a client for the process.Process service.
 type ProcessClient interface {
 	List(context.Context, *connect.Request[process.ListRequest]) (*connect.Response[process.ListResponse], error)
+	Stats(context.Context, *connect.Request[process.StatsRequest]) (*connect.ServerStreamForClient[process.StatsResponse], error)
 	Connect(context.Context, *connect.Request[process.ConnectRequest]) (*connect.ServerStreamForClient[process.ConnectResponse], error)
 	Start(context.Context, *connect.Request[process.StartRequest]) (*connect.ServerStreamForClient[process.StartResponse], error)
 	Update(context.Context, *connect.Request[process.UpdateRequest]) (*connect.Response[process.UpdateResponse], error)
@@ -89,6 +93,12 @@ func NewProcessClient(httpClient connect.HTTPClient, baseURL string, opts ...con
 			connect.WithSchema(processListMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		stats: connect.NewClient[process.StatsRequest, process.StatsResponse](
+			httpClient,
+			baseURL+ProcessStatsProcedure,
+			connect.WithSchema(processStatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		connect: connect.NewClient[process.ConnectRequest, process.ConnectResponse](
 			httpClient,
 			baseURL+ProcessConnectProcedure,
@@ -131,6 +141,7 @@ func NewProcessClient(httpClient connect.HTTPClient, baseURL string, opts ...con
 // processClient implements ProcessClient.
 type processClient struct {
 	list        *connect.Client[process.ListRequest, process.ListResponse]
+	stats       *connect.Client[process.StatsRequest, process.StatsResponse]
 	connect     *connect.Client[process.ConnectRequest, process.ConnectResponse]
 	start       *connect.Client[process.StartRequest, process.StartResponse]
 	update      *connect.Client[process.UpdateRequest, process.UpdateResponse]
@@ -144,6 +155,11 @@ func (c *processClient) List(ctx context.Context, req *connect.Request[process.L
 	return c.list.CallUnary(ctx, req)
 }
 
+// Stats calls process.Process.Stats.
+func (c *processClient) Stats(ctx context.Context, req *connect.Request[process.StatsRequest]) (*connect.ServerStreamForClient[process.StatsResponse], error) {
+	return c.stats.CallServerStream(ctx, req)
+}
+
 // Connect calls process.Process.Connect.
 func (c *processClient) Connect(ctx context.Context, req *connect.Request[process.ConnectRequest]) (*connect.ServerStreamForClient[process.ConnectResponse], error) {
 	return c.connect.CallServerStream(ctx, req)
@@ -177,6 +193,7 @@ func (c *processClient) SendSignal(ctx context.Context, req *connect.Request[pro
 // ProcessHandler is an implementation of the process.Process service.
 type ProcessHandler interface {
 	List(context.Context, *connect.Request[process.ListRequest]) (*connect.Response[process.ListResponse], error)
+	Stats(context.Context, *connect.Request[process.StatsRequest], *connect.ServerStream[process.StatsResponse]) error
 	Connect(context.Context, *connect.Request[process.ConnectRequest], *connect.ServerStream[process.ConnectResponse]) error
 	Start(context.Context, *connect.Request[process.StartRequest], *connect.ServerStream[process.StartResponse]) error
 	Update(context.Context, *connect.Request[process.UpdateRequest]) (*connect.Response[process.UpdateResponse], error)
@@ -198,6 +215,12 @@ func NewProcessHandler(svc ProcessHandler, opts ...connect.HandlerOption) (strin
 		connect.WithSchema(processListMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	processStatsHandler := connect.NewServerStreamHandler(
+		ProcessStatsProcedure,
+		svc.Stats,
+		connect.WithSchema(processStatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	processConnectHandler := connect.NewServerStreamHandler(
 		ProcessConnectProcedure,
 		svc.Connect,
@@ -238,6 +261,8 @@ func NewProcessHandler(svc ProcessHandler, opts ...connect.HandlerOption) (strin
 		switch r.URL.Path {
 		case ProcessListProcedure:
 			processListHandler.ServeHTTP(w, r)
+		case ProcessStatsProcedure:
+			processStatsHandler.ServeHTTP(w, r)
 		case ProcessConnectProcedure:
 			processConnectHandler.ServeHTTP(w, r)
 		case ProcessStartProcedure:
@@ -263,6 +288,10 @@ func (UnimplementedProcessHandler) List(context.Context, *connect.Request[proces
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("process.Process.List is not implemented"))
 }
 
+func (UnimplementedProcessHandler) Stats(context.Context, *connect.Request[process.StatsRequest], *connect.ServerStream[process.StatsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("process.Process.Stats is not implemented"))
+}
+
 func (UnimplementedProcessHandler) Connect(context.Context, *connect.Request[process.ConnectRequest], *connect.ServerStream[process.ConnectResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("process.Process.Connect is not implemented"))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
 	filesystemRpc "github.com/e2b-dev/infra/packages/envd/internal/services/filesystem"
 	processRpc "github.com/e2b-dev/infra/packages/envd/internal/services/process"
+	processHandler "github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
 	processSpec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
 
@@ -32,7 +33,7 @@ const (
 
 var (
 	// These vars are automatically set by goreleaser.
-	Version = "0.1.5"
+	Version = "0.1.6"
 
 	commitSHA string
 
@@ -42,6 +43,8 @@ var (
 	versionFlag  bool
 	commitFlag   bool
 	startCmdFlag string
+
+	replayBufferSize int64
 )
 
 func parseFlags() {
@@ -80,6 +83,13 @@ func parseFlags() {
 		"a command to run on the daemon start",
 	)
 
+	flag.Int64Var(
+		&replayBufferSize,
+		"process-output-buffer",
+		processHandler.DefaultReplayBufferSize,
+		"the number of bytes of the output kept for each process to be replayed on reconnect",
+	)
+
 	flag.Parse()
 }
 
@@ -145,9 +155,9 @@ func main() {
 	envVars.Store("E2B_SANDBOX", "true")
 
 	processLogger := l.With().Str("logger", "process").Logger()
-	processService := processRpc.Handle(m, &processLogger, envVars)
+	processService := processRpc.Handle(m, &processLogger, envVars, replayBufferSize)
 
-	handler := api.HandlerFromMux(api.New(&envLogger, envVars), m)
+	handler := api.HandlerFromMux(api.New(ctx, &envLogger, envVars), m)
 
 	middleware := authn.NewMiddleware(permissions.AuthenticateUsername)
 
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func main() {
+	buildId := flag.String("build", "", "build id")
+	newBuildId := flag.String("new-build", "", "id of the compacted build, generated if empty")
+	maxGeneration := flag.Uint64("max-generation", 0, "compact only if a header generation is greater than this")
+	maxMappings := flag.Int("max-mappings", math.MaxInt, "compact only if a header has more mappings than this")
+	dryRun := flag.Bool("dry-run", false, "only check if the build needs compaction")
+
+	flag.Parse()
+
+	if _, err := uuid.Parse(*buildId); err != nil {
+		log.Fatalf("invalid build id: %s", err)
+	}
+
+	if *newBuildId == "" {
+		*newBuildId = uuid.New().String()
+	}
+
+	ctx := context.Background()
+
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
+
+	needed, err := storage.NeedsCompaction(ctx, persistence, *buildId, storage.CompactionThreshold{
+		MaxGeneration: *maxGeneration,
+		MaxMappings:   *maxMappings,
+	})
+	if err != nil {
+		log.Fatalf("failed to check build: %s", err)
+	}
+
+	if !needed {
+		fmt.Printf("Build %s does not need compaction\n", *buildId)
+
+		return
+	}
+
+	if *dryRun {
+		fmt.Printf("Build %s needs compaction\n", *buildId)
+
+		return
+	}
+
+	err = storage.CompactBuild(ctx, persistence, *buildId, *newBuildId)
+	if err != nil {
+		log.Fatalf("failed to compact build: %s", err)
+	}
+
+	template := storage.NewTemplateFiles(
+		"",
+		*newBuildId,
+		"",
+		"",
+		false,
+	)
+
+	fmt.Printf("\nCOMPACTED\n")
+	fmt.Printf("=========\n")
+	fmt.Printf("Storage            %s\n", persistence.GetDetails())
+	fmt.Printf("Build ID           %s\n", *buildId)
+	fmt.Printf("New build ID       %s\n", *newBuildId)
+
+	for _, storagePath := range []string{template.StorageMemfileHeaderPath(), template.StorageRootfsHeaderPath()} {
+		obj, err := persistence.OpenObject(ctx, storagePath)
+		if err != nil {
+			log.Fatalf("failed to open object: %s", err)
+		}
+
+		h, err := header.Deserialize(obj)
+		if err != nil {
+			log.Fatalf("failed to deserialize header: %s", err)
+		}
+
+		fmt.Printf("%-18s %d maps, %d layers\n", storagePath, len(h.Mapping), len(*header.Layers(h.Mapping)))
+	}
+}
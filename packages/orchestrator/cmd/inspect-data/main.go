@@ -8,7 +8,7 @@ import (
 	"log"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 func main() {
@@ -16,9 +16,15 @@ func main() {
 	kind := flag.String("kind", "", "'memfile' or 'rootfs'")
 	start := flag.Int64("start", 0, "start block")
 	end := flag.Int64("end", 0, "end block")
+	compressionName := flag.String("compression", "", "'none', 'zstd' or 'lz4', as recorded in the header mapping of the build")
 
 	flag.Parse()
 
+	compression, err := header.ParseCompression(*compressionName)
+	if err != nil {
+		log.Fatalf("invalid compression: %s", err)
+	}
+
 	template := storage.NewTemplateFiles(
 		"",
 		*buildId,
@@ -46,11 +52,14 @@ func main() {
 
 	ctx := context.Background()
 
-	obj := gcs.NewObject(ctx, gcs.GetTemplateBucket(), storagePath)
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
 
-	size, err := obj.Size()
+	obj, size, err := storage.OpenData(ctx, persistence, storagePath, compression)
 	if err != nil {
-		log.Fatalf("failed to get object size: %s", err)
+		log.Fatalf("failed to open object: %s", err)
 	}
 
 	if *start > size/blockSize {
@@ -67,10 +76,11 @@ func main() {
 
 	fmt.Printf("\nMETADATA\n")
 	fmt.Printf("========\n")
-	fmt.Printf("Storage path       %s/%s\n", gcs.GetTemplateBucket().BucketName(), storagePath)
+	fmt.Printf("Storage            %s/%s\n", persistence.GetDetails(), storagePath)
 	fmt.Printf("Build ID           %s\n", *buildId)
 	fmt.Printf("Size               %d B (%d MiB)\n", size, size/1024/1024)
 	fmt.Printf("Block size         %d B\n", blockSize)
+	fmt.Printf("Compression        %s\n", compression)
 
 	b := make([]byte, blockSize)
 
@@ -8,7 +8,6 @@ import (
 	"unsafe"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
@@ -38,7 +37,15 @@ func main() {
 
 	ctx := context.Background()
 
-	obj := gcs.NewObject(ctx, gcs.GetTemplateBucket(), storagePath)
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
+
+	obj, err := persistence.OpenObject(ctx, storagePath)
+	if err != nil {
+		log.Fatalf("failed to open object: %s", err)
+	}
 
 	h, err := header.Deserialize(obj)
 	if err != nil {
@@ -47,13 +54,14 @@ func main() {
 
 	fmt.Printf("\nMETADATA\n")
 	fmt.Printf("========\n")
-	fmt.Printf("Storage path       %s/%s\n", gcs.GetTemplateBucket().BucketName(), storagePath)
+	fmt.Printf("Storage            %s/%s\n", persistence.GetDetails(), storagePath)
 	fmt.Printf("Version            %d\n", h.Metadata.Version)
 	fmt.Printf("Generation         %d\n", h.Metadata.Generation)
 	fmt.Printf("Build ID           %s\n", h.Metadata.BuildId)
 	fmt.Printf("Base build ID      %s\n", h.Metadata.BaseBuildId)
 	fmt.Printf("Size               %d B (%d MiB)\n", h.Metadata.Size, h.Metadata.Size/1024/1024)
 	fmt.Printf("Block size         %d B\n", h.Metadata.BlockSize)
+	fmt.Printf("Compression        %s\n", h.Metadata.Compression)
 	fmt.Printf("Blocks             %d\n", (h.Metadata.Size+h.Metadata.BlockSize-1)/h.Metadata.BlockSize)
 
 	totalSize := int64(unsafe.Sizeof(header.BuildMap{})) * int64(len(h.Mapping)) / 1024
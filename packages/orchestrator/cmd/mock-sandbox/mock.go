@@ -20,6 +20,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
 func main() {
@@ -60,7 +61,14 @@ func main() {
 		}
 	}()
 
-	templateCache, err := template.NewCache(ctx)
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create storage provider: %v\n", err)
+
+		return
+	}
+
+	templateCache, err := template.NewCache(ctx, persistence)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to create template cache: %v\n", err)
 
@@ -61,7 +61,14 @@ func main() {
 		}
 	}()
 
-	templateCache, err := template.NewCache(ctx)
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create storage provider: %v\n", err)
+
+		return
+	}
+
+	templateCache, err := template.NewCache(ctx, persistence)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to create template cache: %v\n", err)
 
@@ -291,6 +298,7 @@ func mockSnapshot(
 	// 	snapshot.MemfileDiffHeader,
 	// 	snapshot.RootfsDiffHeader,
 	// 	snapshotTemplateFiles.TemplateFiles,
+	// 	persistence,
 	// )
 
 	// err = <-b.Upload(
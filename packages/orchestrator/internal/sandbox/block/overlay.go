@@ -3,8 +3,11 @@ package block
 import (
 	"errors"
 	"fmt"
+	"io"
 	"sync/atomic"
 
+	"github.com/bits-and-blooms/bitset"
+
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
@@ -53,6 +56,15 @@ func (o *Overlay) EjectCache() (*Cache, error) {
 	return o.cache, nil
 }
 
+// ExportCache writes the dirty blocks of the cache to out without ejecting it, so the overlay can still be used afterwards.
+func (o *Overlay) ExportCache(out io.Writer) (*bitset.BitSet, error) {
+	if o.cacheEjected.Load() {
+		return nil, fmt.Errorf("cache already ejected")
+	}
+
+	return o.cache.Export(out)
+}
+
 // This method will not be very optimal if the length is not the same as the block size, because we cannot be just exposing the cache slice,
 // but creating and copying the bytes from the cache and device to the new slice.
 //
@@ -18,13 +18,29 @@ type TrackedSliceDevice struct {
 	dirty       *bitset.BitSet
 	dirtyMu     sync.Mutex
 	empty       []byte
+
+	// accessed tracks the blocks served to the VM before Disable.
+	accessed   *bitset.BitSet
+	accessedMu sync.Mutex
+
+	// faults are the offsets of the blocks in the order they were first served, recorded until StopFaultTrace.
+	faults          []int64
+	faultsRecording bool
 }
 
 func NewTrackedSliceDevice(blockSize int64, device ReadonlyDevice) (*TrackedSliceDevice, error) {
+	size, err := device.Size()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device size: %w", err)
+	}
+
 	return &TrackedSliceDevice{
 		data:      device,
 		empty:     make([]byte, blockSize),
 		blockSize: blockSize,
+		accessed:  bitset.New(uint(header.TotalBlocks(size, blockSize))),
+
+		faultsRecording: true,
 	}, nil
 }
 
@@ -52,9 +68,26 @@ func (t *TrackedSliceDevice) Slice(off int64, length int64) ([]byte, error) {
 		return t.empty, nil
 	}
 
+	t.accessedMu.Lock()
+	block := uint(header.BlockIdx(off, t.blockSize))
+	if t.faultsRecording && !t.accessed.Test(block) {
+		t.faults = append(t.faults, header.BlockOffset(int64(block), t.blockSize))
+	}
+	t.accessed.Set(block)
+	t.accessedMu.Unlock()
+
 	return t.data.Slice(off, length)
 }
 
+// Return which blocks were served to the VM so far.
+// Blocks that were never served are still the same as in the underlying device, so only the accessed blocks can be dirty.
+func (t *TrackedSliceDevice) Accessed() *bitset.BitSet {
+	t.accessedMu.Lock()
+	defer t.accessedMu.Unlock()
+
+	return t.accessed.Clone()
+}
+
 // Return which bytes were not read since Disable.
 // This effectively returns the bytes that have been requested after paused vm and are not dirty.
 func (t *TrackedSliceDevice) Dirty() *bitset.BitSet {
@@ -63,3 +96,16 @@ func (t *TrackedSliceDevice) Dirty() *bitset.BitSet {
 
 	return t.dirty.Clone()
 }
+
+// StopFaultTrace stops recording the order of the served blocks and returns the offsets recorded so far.
+func (t *TrackedSliceDevice) StopFaultTrace() []int64 {
+	t.accessedMu.Lock()
+	defer t.accessedMu.Unlock()
+
+	faults := t.faults
+
+	t.faults = nil
+	t.faultsRecording = false
+
+	return faults
+}
@@ -7,28 +7,28 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 type File struct {
-	header   *header.Header
-	store    *DiffStore
-	fileType DiffType
-	bucket   *gcs.BucketHandle
+	header      *header.Header
+	store       *DiffStore
+	fileType    DiffType
+	persistence storage.Provider
 }
 
 func NewFile(
 	header *header.Header,
 	store *DiffStore,
 	fileType DiffType,
-	bucket *gcs.BucketHandle,
+	persistence storage.Provider,
 ) *File {
 	return &File{
-		header:   header,
-		store:    store,
-		fileType: fileType,
-		bucket:   bucket,
+		header:      header,
+		store:       store,
+		fileType:    fileType,
+		persistence: persistence,
 	}
 }
 
@@ -42,7 +42,7 @@ func min(a, b int64) int64 {
 
 func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 	for n < len(p) {
-		mappedOffset, mappedLength, buildID, err := b.header.GetShiftedMapping(off + int64(n))
+		mappedOffset, mappedLength, mapping, err := b.header.GetShiftedMapping(off + int64(n))
 		if err != nil {
 			return 0, fmt.Errorf("failed to get mapping: %w", err)
 		}
@@ -57,7 +57,7 @@ func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 				len(p)-n,
 				off,
 				readLength,
-				buildID,
+				mapping.BuildId,
 				b.fileType,
 				mappedOffset,
 				n,
@@ -70,16 +70,16 @@ func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 			return n, io.EOF
 		}
 
-		// Skip reading when the uuid is nil.
-		// We will use this to handle base builds that are already diffs.
-		// The passed slice p must start as empty, otherwise we would need to copy the empty values there.
-		if *buildID == uuid.Nil {
+		// The nil uuid maps empty blocks that are not stored in any build.
+		if mapping.BuildId == uuid.Nil {
+			clear(p[n : int64(n)+readLength])
+
 			n += int(readLength)
 
 			continue
 		}
 
-		mappedBuild, err := b.getBuild(buildID)
+		mappedBuild, err := b.getBuild(mapping)
 		if err != nil {
 			return 0, fmt.Errorf("failed to get build: %w", err)
 		}
@@ -100,16 +100,16 @@ func (b *File) ReadAt(p []byte, off int64) (n int, err error) {
 
 // The slice access must be in the predefined blocksize of the build.
 func (b *File) Slice(off, length int64) ([]byte, error) {
-	mappedOffset, _, buildID, err := b.header.GetShiftedMapping(off)
+	mappedOffset, _, mapping, err := b.header.GetShiftedMapping(off)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get mapping: %w", err)
 	}
 
-	if *buildID == uuid.Nil {
-		return header.EmptyHugePage, nil
+	if mapping.BuildId == uuid.Nil {
+		return header.EmptyHugePage[:b.header.Metadata.BlockSize], nil
 	}
 
-	build, err := b.getBuild(buildID)
+	build, err := b.getBuild(mapping)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get build: %w", err)
 	}
@@ -117,13 +117,14 @@ func (b *File) Slice(off, length int64) ([]byte, error) {
 	return build.Slice(mappedOffset, int64(b.header.Metadata.BlockSize))
 }
 
-func (b *File) getBuild(buildID *uuid.UUID) (Diff, error) {
+func (b *File) getBuild(mapping *header.BuildMap) (Diff, error) {
 	storageDiff := newStorageDiff(
 		b.store.cachePath,
-		buildID.String(),
+		mapping.BuildId.String(),
 		b.fileType,
 		int64(b.header.Metadata.BlockSize),
-		b.bucket,
+		mapping.Compression,
+		b.persistence,
 	)
 
 	source, err := b.store.Get(storageDiff)
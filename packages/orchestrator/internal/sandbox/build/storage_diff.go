@@ -8,7 +8,8 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
@@ -22,7 +23,8 @@ type StorageDiff struct {
 	cacheKey    DiffStoreKey
 	storagePath string
 	blockSize   int64
-	bucket      *gcs.BucketHandle
+	compression header.Compression
+	persistence storage.Provider
 }
 
 func newStorageDiff(
@@ -30,7 +32,8 @@ func newStorageDiff(
 	buildId string,
 	diffType DiffType,
 	blockSize int64,
-	bucket *gcs.BucketHandle,
+	compression header.Compression,
+	persistence storage.Provider,
 ) *StorageDiff {
 	cachePathSuffix := id.Generate()
 
@@ -43,7 +46,8 @@ func newStorageDiff(
 		cachePath:   cachePath,
 		chunker:     utils.NewSetOnce[*block.Chunker](),
 		blockSize:   blockSize,
-		bucket:      bucket,
+		compression: compression,
+		persistence: persistence,
 		cacheKey:    GetDiffStoreKey(buildId, diffType),
 	}
 }
@@ -53,11 +57,10 @@ func (b *StorageDiff) CacheKey() DiffStoreKey {
 }
 
 func (b *StorageDiff) Init(ctx context.Context) error {
-	obj := gcs.NewObject(ctx, b.bucket, b.storagePath)
-
-	size, err := obj.Size()
+	// Compressed diffs are decompressed frame by frame, so the chunker can still fetch arbitrary ranges.
+	obj, size, err := storage.OpenData(ctx, b.persistence, b.storagePath, b.compression)
 	if err != nil {
-		errMsg := fmt.Errorf("failed to get object size: %w", err)
+		errMsg := fmt.Errorf("failed to open object: %w", err)
 
 		b.chunker.SetError(errMsg)
 
@@ -55,6 +55,11 @@ func (s *Sandbox) logHeathAndUsage(ctx *utils.LockableCancelableContext) {
 	for {
 		select {
 		case <-healthTicker.C:
+			// The paused VM can't respond, it would be reported as unhealthy.
+			if s.paused.Load() {
+				continue
+			}
+
 			childCtx, cancel := context.WithTimeout(ctx, time.Second)
 
 			ctx.Lock()
@@ -63,6 +68,10 @@ func (s *Sandbox) logHeathAndUsage(ctx *utils.LockableCancelableContext) {
 
 			cancel()
 		case <-metricsTicker.C:
+			if s.paused.Load() {
+				continue
+			}
+
 			go s.logMetricsBasedOnConfig(ctx, s)
 		case <-ctx.Done():
 			return
@@ -70,6 +79,11 @@ func (s *Sandbox) logHeathAndUsage(ctx *utils.LockableCancelableContext) {
 	}
 }
 
+// Healthy returns the result of the last healthcheck of the sandbox.
+func (s *Sandbox) Healthy() bool {
+	return s.healthy.Load()
+}
+
 func (s *Sandbox) Healthcheck(ctx context.Context, alwaysReport bool) {
 	var err error
 	defer func() {
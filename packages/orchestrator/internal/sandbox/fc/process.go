@@ -320,6 +320,28 @@ func (p *Process) Pause(ctx context.Context, tracer trace.Tracer) error {
 	return p.client.pauseVM(ctx)
 }
 
+func (p *Process) Resume(ctx context.Context, tracer trace.Tracer) error {
+	ctx, childSpan := tracer.Start(ctx, "resume-fc")
+	defer childSpan.End()
+
+	return p.client.resumeVM(ctx)
+}
+
+// SetMetadata replaces the metadata exposed to the VM via MMDS.
+func (p *Process) SetMetadata(ctx context.Context, tracer trace.Tracer, metadata *MmdsMetadata) error {
+	ctx, childSpan := tracer.Start(ctx, "set-metadata-fc")
+	defer childSpan.End()
+
+	err := p.client.setMmds(ctx, metadata)
+	if err != nil {
+		return err
+	}
+
+	p.metadata = metadata
+
+	return nil
+}
+
 // VM needs to be paused before creating a snapshot.
 func (p *Process) CreateSnapshot(ctx context.Context, tracer trace.Tracer, snapfilePath string, memfilePath string) error {
 	ctx, childSpan := tracer.Start(ctx, "create-snapshot-fc")
@@ -51,7 +51,7 @@ func (s *Sandbox) GetMetrics(ctx context.Context) (SandboxMetrics, error) {
 }
 
 func (s *Sandbox) LogMetrics(ctx context.Context) {
-	if isGTEVersion(s.Config.EnvdVersion, minEnvdVersionForMetrcis) {
+	if isGTEVersion(s.currentConfig().EnvdVersion, minEnvdVersionForMetrcis) {
 		metrics, err := s.GetMetrics(ctx)
 		if err != nil {
 			sbxlogger.E(s).Warn("failed to get metrics", zap.Error(err))
@@ -68,15 +68,17 @@ func (s *Sandbox) LogMetrics(ctx context.Context) {
 }
 
 func (s *Sandbox) SendMetrics(ctx context.Context) {
-	if isGTEVersion(s.Config.EnvdVersion, minEnvdVersionForMetrcis) {
+	config := s.currentConfig()
+
+	if isGTEVersion(config.EnvdVersion, minEnvdVersionForMetrcis) {
 		envdMetrics, err := s.GetMetrics(ctx)
 		if err != nil {
 			sbxlogger.E(s).Warn("failed to get metrics from envd", zap.Error(err))
 		} else {
 			// XXX update upstream types to avoid this conversion
 			metrics := chmodels.Metrics{
-				SandboxID:      s.Config.SandboxId,
-				TeamID:         s.Config.TeamId,
+				SandboxID:      config.SandboxId,
+				TeamID:         config.TeamId,
 				Timestamp:      time.Unix(envdMetrics.Timestamp, 0),
 				MemTotalMiB:    envdMetrics.MemTotalMiB,
 				MemUsedMiB:     envdMetrics.MemUsedMiB,
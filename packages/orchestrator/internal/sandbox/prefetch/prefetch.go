@@ -0,0 +1,95 @@
+package prefetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	// traceWindow is how long after the start the faults are recorded.
+	traceWindow = 10 * time.Second
+
+	prefetchConcurrency = 16
+)
+
+// FaultRecorder records the order of the faulted pages, implemented by the uffd handler.
+type FaultRecorder interface {
+	StopFaultTrace() []int64
+}
+
+// Run prefetches the memfile pages from the trace of the build, so they are already in the cache when the VM faults them in.
+// If the build doesn't have a trace yet, Run records the faults during the trace window and uploads them as the trace instead.
+// The recording is dropped if the context is canceled before the window ends.
+func Run(
+	ctx context.Context,
+	persistence storage.Provider,
+	files *storage.TemplateFiles,
+	memfile block.ReadonlyDevice,
+	blockSize int64,
+	recorder FaultRecorder,
+) error {
+	trace, err := LoadTrace(ctx, persistence, files)
+	if err == nil {
+		recorder.StopFaultTrace()
+
+		return Prefetch(ctx, memfile, trace)
+	}
+
+	if !errors.Is(err, storage.ErrObjectNotExist) {
+		recorder.StopFaultTrace()
+
+		return fmt.Errorf("failed to load trace: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		recorder.StopFaultTrace()
+
+		return nil
+	case <-time.After(traceWindow):
+	}
+
+	offsets := recorder.StopFaultTrace()
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	err = UploadTrace(ctx, persistence, files, NewTrace(blockSize, offsets))
+	if err != nil {
+		return fmt.Errorf("failed to upload trace: %w", err)
+	}
+
+	return nil
+}
+
+// Prefetch reads the pages from the trace into the cache of the memfile.
+// The pages are requested in the order of the trace, the earliest faults are fetched first.
+func Prefetch(ctx context.Context, memfile block.ReadonlyDevice, trace *Trace) error {
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(prefetchConcurrency)
+
+	for _, offset := range trace.Offsets {
+		// Stop when the sandbox stopped or a fetch failed.
+		if egCtx.Err() != nil {
+			break
+		}
+
+		eg.Go(func() error {
+			_, err := memfile.Slice(offset, trace.BlockSize)
+			if err != nil {
+				return fmt.Errorf("failed to prefetch page at %d: %w", offset, err)
+			}
+
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
@@ -0,0 +1,112 @@
+package prefetch
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	traceVersion = 1
+
+	// maxTraceLength limits the number of recorded pages, the pages faulted in later are not worth prefetching.
+	maxTraceLength = 1 << 16
+)
+
+// Trace is the order in which the pages of the memfile were faulted in when a sandbox was started from the build.
+type Trace struct {
+	BlockSize int64
+	// Offsets of the faulted pages in the memfile, in the order of the faults.
+	Offsets []int64
+}
+
+type tracePreamble struct {
+	Version   uint64
+	BlockSize uint64
+	Count     uint64
+}
+
+func NewTrace(blockSize int64, offsets []int64) *Trace {
+	return &Trace{
+		BlockSize: blockSize,
+		Offsets:   offsets[:min(len(offsets), maxTraceLength)],
+	}
+}
+
+func (t *Trace) Serialize() io.Reader {
+	var buf bytes.Buffer
+
+	// Writing to a buffer can't fail.
+	_ = binary.Write(&buf, binary.LittleEndian, &tracePreamble{
+		Version:   traceVersion,
+		BlockSize: uint64(t.BlockSize),
+		Count:     uint64(len(t.Offsets)),
+	})
+	_ = binary.Write(&buf, binary.LittleEndian, t.Offsets)
+
+	return &buf
+}
+
+func DeserializeTrace(in io.WriterTo) (*Trace, error) {
+	var buf bytes.Buffer
+
+	_, err := in.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+	}
+
+	var preamble tracePreamble
+
+	err = binary.Read(&buf, binary.LittleEndian, &preamble)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace: %w", err)
+	}
+
+	if preamble.Version != traceVersion {
+		return nil, fmt.Errorf("unsupported trace version: %d", preamble.Version)
+	}
+
+	if preamble.Count > maxTraceLength || uint64(buf.Len()) != preamble.Count*8 {
+		return nil, fmt.Errorf("trace has %d B of offsets, expected %d offsets", buf.Len(), preamble.Count)
+	}
+
+	offsets := make([]int64, preamble.Count)
+
+	err = binary.Read(&buf, binary.LittleEndian, offsets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace offsets: %w", err)
+	}
+
+	return &Trace{
+		BlockSize: int64(preamble.BlockSize),
+		Offsets:   offsets,
+	}, nil
+}
+
+// LoadTrace reads the trace of the build, returns storage.ErrObjectNotExist if it wasn't recorded yet.
+func LoadTrace(ctx context.Context, persistence storage.Provider, files *storage.TemplateFiles) (*Trace, error) {
+	object, err := persistence.OpenObject(ctx, files.StorageMemfileTracePath())
+	if err != nil {
+		return nil, err
+	}
+
+	return DeserializeTrace(object)
+}
+
+func UploadTrace(ctx context.Context, persistence storage.Provider, files *storage.TemplateFiles, trace *Trace) error {
+	object, err := persistence.OpenObject(ctx, files.StorageMemfileTracePath())
+	if err != nil {
+		return err
+	}
+
+	_, err = object.ReadFrom(trace.Serialize())
+	if err != nil {
+		return fmt.Errorf("failed to upload trace: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package prefetch
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func TestTraceRoundTrip(t *testing.T) {
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	files := &storage.TemplateFiles{BuildId: "build"}
+
+	_, err = LoadTrace(ctx, persistence, files)
+	require.ErrorIs(t, err, storage.ErrObjectNotExist)
+
+	trace := NewTrace(4096, []int64{8192, 0, 4096 * 100})
+	require.NoError(t, UploadTrace(ctx, persistence, files, trace))
+
+	loaded, err := LoadTrace(ctx, persistence, files)
+	require.NoError(t, err)
+	assert.Equal(t, trace, loaded)
+}
+
+type testDevice struct {
+	mu     sync.Mutex
+	sliced []int64
+}
+
+func (d *testDevice) ReadAt(p []byte, _ int64) (int, error) {
+	return len(p), nil
+}
+
+func (d *testDevice) Slice(off, length int64) ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sliced = append(d.sliced, off)
+
+	return make([]byte, length), nil
+}
+
+func (d *testDevice) Size() (int64, error) {
+	return 1 << 30, nil
+}
+
+func TestPrefetch(t *testing.T) {
+	device := &testDevice{}
+	trace := NewTrace(4096, []int64{8192, 0, 4096 * 100})
+
+	require.NoError(t, Prefetch(context.Background(), device, trace))
+	assert.ElementsMatch(t, trace.Offsets, device.sliced)
+}
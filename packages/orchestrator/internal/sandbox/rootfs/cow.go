@@ -93,6 +93,17 @@ func (o *CowDevice) Export(ctx context.Context, out io.Writer, stopSandbox func(
 	return dirty, nil
 }
 
+// ExportLive exports the changes of the device while it is still in use.
+// The device must be flushed and the writes to it must be stopped before calling this.
+func (o *CowDevice) ExportLive(out io.Writer) (*bitset.BitSet, error) {
+	dirty, err := o.overlay.ExportCache(out)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting cache: %w", err)
+	}
+
+	return dirty, nil
+}
+
 func (o *CowDevice) Close() error {
 	var errs []error
 
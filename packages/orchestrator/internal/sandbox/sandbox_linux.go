@@ -9,10 +9,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/bits-and-blooms/bitset"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -25,6 +27,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/prefetch"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/rootfs"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
@@ -55,6 +58,8 @@ type Sandbox struct {
 	Config    *orchestrator.SandboxConfig
 	StartedAt time.Time
 	EndAt     time.Time
+	// configMu guards the config replaced by the assignment of a warm sandbox, the monitoring goroutines read it meanwhile.
+	configMu sync.RWMutex
 
 	Slot network.Slot
 
@@ -64,6 +69,7 @@ type Sandbox struct {
 
 	healthcheckCtx *utils.LockableCancelableContext
 	healthy        atomic.Bool
+	paused         atomic.Bool
 
 	ClickhouseStore chdb.Store
 
@@ -74,13 +80,23 @@ type Sandbox struct {
 }
 
 func (s *Sandbox) LoggerMetadata() sbxlogger.SandboxMetadata {
+	config := s.currentConfig()
+
 	return sbxlogger.SandboxMetadata{
-		SandboxID:  s.Config.SandboxId,
-		TemplateID: s.Config.TemplateId,
-		TeamID:     s.Config.TeamId,
+		SandboxID:  config.SandboxId,
+		TemplateID: config.TemplateId,
+		TeamID:     config.TeamId,
 	}
 }
 
+// currentConfig returns the config of the sandbox, it is safe to call while the sandbox is being assigned.
+func (s *Sandbox) currentConfig() *orchestrator.SandboxConfig {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+
+	return s.Config
+}
+
 // Run cleanup functions for the already initialized resources if there is any error or after you are done with the started sandbox.
 func NewSandbox(
 	ctx context.Context,
@@ -202,6 +218,28 @@ func NewSandbox(
 		return nil
 	})
 
+	prefetchCtx, cancelPrefetch := context.WithCancel(context.Background())
+	cleanup.Add(func() error {
+		cancelPrefetch()
+
+		return nil
+	})
+
+	// Warm the memfile cache while the VM is starting, the first starts record the trace for the later ones.
+	go func() {
+		prefetchErr := prefetch.Run(
+			prefetchCtx,
+			templateCache.Persistence(),
+			t.Files().TemplateFiles,
+			memfile,
+			sandboxFiles.MemfilePageSize(),
+			fcUffd,
+		)
+		if prefetchErr != nil && !errors.Is(prefetchErr, context.Canceled) {
+			zap.L().Warn("failed to prefetch memfile", zap.String("sandbox_id", config.SandboxId), zap.Error(prefetchErr))
+		}
+	}()
+
 	uffdExit := make(chan error, 1)
 
 	uffdStartCtx, cancelUffdStartCtx := context.WithCancelCause(childCtx)
@@ -328,6 +366,89 @@ func NewSandbox(
 	return sbx, cleanup, nil
 }
 
+// Pause pauses the VM while keeping its memory, the sandbox can be resumed later without snapshotting.
+func (s *Sandbox) Pause(ctx context.Context, tracer trace.Tracer) error {
+	err := s.process.Pause(ctx, tracer)
+	if err != nil {
+		return fmt.Errorf("error pausing vm: %w", err)
+	}
+
+	s.paused.Store(true)
+
+	return nil
+}
+
+func (s *Sandbox) Resume(ctx context.Context, tracer trace.Tracer) error {
+	err := s.process.Resume(ctx, tracer)
+	if err != nil {
+		return fmt.Errorf("error resuming vm: %w", err)
+	}
+
+	s.paused.Store(false)
+
+	return nil
+}
+
+// Assign hands a paused warm sandbox over to a new owner.
+// The sandbox is resumed under the identity from the config and envd is initialized with its env vars.
+func (s *Sandbox) Assign(
+	ctx context.Context,
+	tracer trace.Tracer,
+	dns *dns.DNS,
+	config *orchestrator.SandboxConfig,
+	traceID string,
+	endAt time.Time,
+) error {
+	childCtx, childSpan := tracer.Start(ctx, "assign-sandbox")
+	defer childSpan.End()
+
+	err := s.Resume(childCtx, tracer)
+	if err != nil {
+		return err
+	}
+
+	err = s.process.SetMetadata(childCtx, tracer, &fc.MmdsMetadata{
+		SandboxId:            config.SandboxId,
+		TemplateId:           config.TemplateId,
+		LogsCollectorAddress: os.Getenv("LOGS_COLLECTOR_PUBLIC_IP"),
+		TraceId:              traceID,
+		TeamId:               config.TeamId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set mmds: %w", err)
+	}
+
+	initCtx, initCancel := context.WithTimeoutCause(childCtx, envdTimeout, fmt.Errorf("syncing took too long"))
+	defer initCancel()
+
+	// Envd also syncs the clock that stopped while the sandbox was paused.
+	err = s.initEnvd(initCtx, tracer, config.EnvVars)
+	if err != nil {
+		return fmt.Errorf("failed to init envd: %w", err)
+	}
+
+	telemetry.ReportEvent(childCtx, fmt.Sprintf("[sandbox %s]: initialized warm envd", config.SandboxId))
+
+	warmID := s.Config.SandboxId
+
+	s.configMu.Lock()
+	s.Config = config
+	s.StartedAt = time.Now()
+	s.EndAt = endAt
+	s.configMu.Unlock()
+
+	dns.Remove(warmID, s.Slot.HostIP())
+	dns.Add(config.SandboxId, s.Slot.HostIP())
+
+	s.cleanup.Add(func() error {
+		dns.Remove(config.SandboxId, s.Slot.HostIP())
+
+		return nil
+	})
+
+	return nil
+}
+
 func (s *Sandbox) Wait() error {
 	select {
 	case fcErr := <-s.process.Exit:
@@ -366,21 +487,6 @@ func (s *Sandbox) Snapshot(
 		return nil, fmt.Errorf("failed to parse build id: %w", err)
 	}
 
-	// MEMFILE & SNAPFILE
-	originalMemfile, err := s.template.Memfile()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get original memfile: %w", err)
-	}
-
-	memfileMetadata := &header.Metadata{
-		Version:     1,
-		Generation:  originalMemfile.Header().Metadata.Generation + 1,
-		BlockSize:   originalMemfile.Header().Metadata.BlockSize,
-		Size:        originalMemfile.Header().Metadata.Size,
-		BuildId:     buildId,
-		BaseBuildId: originalMemfile.Header().Metadata.BaseBuildId,
-	}
-
 	s.healthcheckCtx.Lock()
 	s.healthcheckCtx.Cancel()
 	s.healthcheckCtx.Unlock()
@@ -409,104 +515,266 @@ func (s *Sandbox) Snapshot(
 
 	memfileDirtyPages := s.uffd.Dirty()
 
-	sourceFile, err := os.Open(snapshotTemplateFiles.CacheMemfileFullSnapshotPath())
+	memfileDiffFile, memfileDirtyPages, memfileEmptyPages, err := s.createMemfileDiff(ctx, buildId, snapshotTemplateFiles, memfileDirtyPages)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open memfile: %w", err)
+		return nil, err
 	}
 
-	memfileDiffFile, err := build.NewLocalDiffFile(
-		build.DefaultCachePath,
-		buildId.String(),
-		build.Memfile,
-	)
+	os.RemoveAll(snapshotTemplateFiles.CacheMemfileFullSnapshotPath())
+
+	releaseLock()
+
+	err = s.flushRootfs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create memfile diff file: %w", err)
+		return nil, err
 	}
 
-	err = header.CreateDiff(sourceFile, s.files.MemfilePageSize(), memfileDirtyPages, memfileDiffFile)
+	rootfsDiffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, buildId.String(), build.Rootfs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create memfile diff: %w", err)
+		return nil, fmt.Errorf("failed to create rootfs diff: %w", err)
 	}
 
-	telemetry.ReportEvent(ctx, "created memfile diff")
+	rootfsDirtyBlocks, err := s.rootfs.Export(ctx, rootfsDiffFile, s.Stop)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export rootfs: %w", err)
+	}
 
-	os.RemoveAll(snapshotTemplateFiles.CacheMemfileFullSnapshotPath())
+	telemetry.ReportEvent(ctx, "exported rootfs")
 
-	releaseLock()
+	return s.newSnapshot(ctx, buildId, snapshotTemplateFiles, memfileDiffFile, memfileDirtyPages, memfileEmptyPages, rootfsDiffFile, rootfsDirtyBlocks)
+}
 
-	memfileMapping := header.CreateMapping(
-		memfileMetadata,
-		&buildId,
-		memfileDirtyPages,
-	)
+// Fork creates a snapshot of the sandbox without stopping it.
+// The VM is paused only for the time needed to capture the memory and rootfs changes and is resumed afterwards.
+func (s *Sandbox) Fork(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (snapshot *Snapshot, err error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-fork")
+	defer childSpan.End()
 
-	telemetry.ReportEvent(ctx, "created memfile mapping")
+	snapshot, err = s.Checkpoint(ctx, tracer, snapshotTemplateFiles)
+	if err != nil {
+		return nil, err
+	}
 
-	memfileMappings := header.MergeMappings(
-		originalMemfile.Header().Mapping,
-		memfileMapping,
+	// The VM must be resumed even if the request was canceled during the checkpoint.
+	err = s.Resume(context.WithoutCancel(ctx), tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Checkpoint creates a snapshot of the sandbox and leaves the VM paused.
+// The sandbox can be resumed if the snapshot is not used, or stopped when the snapshot replaces it.
+// If the checkpoint fails, the VM is resumed.
+func (s *Sandbox) Checkpoint(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (snapshot *Snapshot, err error) {
+	buildId, err := uuid.Parse(snapshotTemplateFiles.BuildId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	err = s.Pause(ctx, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		resumeErr := s.Resume(context.WithoutCancel(ctx), tracer)
+		if resumeErr != nil {
+			err = errors.Join(err, resumeErr)
+		}
+	}()
+
+	// The pages that were not served to the VM yet are the same as in the original memfile.
+	// We need to capture them before creating the snapshot, because creating the snapshot reads the whole memory.
+	memfileDirtyPages := s.uffd.Accessed()
+
+	defer os.RemoveAll(snapshotTemplateFiles.CacheMemfileFullSnapshotPath())
+
+	err = s.process.CreateSnapshot(
+		ctx,
+		tracer,
+		snapshotTemplateFiles.CacheSnapfilePath(),
+		snapshotTemplateFiles.CacheMemfileFullSnapshotPath(),
 	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating snapshot: %w", err)
+	}
 
-	telemetry.ReportEvent(ctx, "merged memfile mappings")
+	memfileDiffFile, memfileDirtyPages, memfileEmptyPages, err := s.createMemfileDiff(ctx, buildId, snapshotTemplateFiles, memfileDirtyPages)
+	if err != nil {
+		return nil, err
+	}
 
-	snapfile, err := template.NewLocalFile(snapshotTemplateFiles.CacheSnapfilePath())
+	err = s.flushRootfs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create local snapfile: %w", err)
+		return nil, err
 	}
 
-	// ROOTFS
-	originalRootfs, err := s.template.Rootfs()
+	rootfsDiffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, buildId.String(), build.Rootfs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get original rootfs: %w", err)
+		return nil, fmt.Errorf("failed to create rootfs diff: %w", err)
 	}
 
-	rootfsMetadata := &header.Metadata{
-		Version:     1,
-		Generation:  originalRootfs.Header().Metadata.Generation + 1,
-		BlockSize:   originalRootfs.Header().Metadata.BlockSize,
-		Size:        originalRootfs.Header().Metadata.Size,
-		BuildId:     buildId,
-		BaseBuildId: originalRootfs.Header().Metadata.BaseBuildId,
+	rootfsDirtyBlocks, err := s.rootfs.ExportLive(rootfsDiffFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export rootfs: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "exported rootfs")
+
+	return s.newSnapshot(ctx, buildId, snapshotTemplateFiles, memfileDiffFile, memfileDirtyPages, memfileEmptyPages, rootfsDiffFile, rootfsDirtyBlocks)
+}
+
+// createMemfileDiff writes the dirty pages of the memfile snapshot to the diff file.
+// It returns the pages written to the diff and the dirty pages that are empty, which are not stored.
+func (s *Sandbox) createMemfileDiff(
+	ctx context.Context,
+	buildId uuid.UUID,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+	memfileDirtyPages *bitset.BitSet,
+) (*build.LocalDiffFile, *bitset.BitSet, *bitset.BitSet, error) {
+	sourceFile, err := os.Open(snapshotTemplateFiles.CacheMemfileFullSnapshotPath())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to open memfile: %w", err)
+	}
+
+	defer sourceFile.Close()
+
+	memfileDiffFile, err := build.NewLocalDiffFile(
+		build.DefaultCachePath,
+		buildId.String(),
+		build.Memfile,
+	)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create memfile diff file: %w", err)
+	}
+
+	emptyPages, err := header.CreateDiff(sourceFile, s.files.MemfilePageSize(), memfileDirtyPages, memfileDiffFile)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create memfile diff: %w", err)
 	}
 
+	telemetry.ReportEvent(ctx, "created memfile diff")
+
+	return memfileDiffFile, memfileDirtyPages.Difference(emptyPages), emptyPages, nil
+}
+
+// flushRootfs flushes the data written to the rootfs device to the overlay cache.
+func (s *Sandbox) flushRootfs(ctx context.Context) error {
 	nbdPath, err := s.rootfs.Path()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rootfs path: %w", err)
+		return fmt.Errorf("failed to get rootfs path: %w", err)
 	}
 
 	// Flush the data to the operating system's buffer
 	file, err := os.Open(nbdPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open rootfs path: %w", err)
+		return fmt.Errorf("failed to open rootfs path: %w", err)
 	}
 
+	defer file.Close()
+
 	if err := unix.IoctlSetInt(int(file.Fd()), unix.BLKFLSBUF, 0); err != nil {
-		return nil, fmt.Errorf("ioctl BLKFLSBUF failed: %w", err)
+		return fmt.Errorf("ioctl BLKFLSBUF failed: %w", err)
 	}
 
 	err = syscall.Fsync(int(file.Fd()))
 	if err != nil {
-		return nil, fmt.Errorf("failed to fsync rootfs path: %w", err)
+		return fmt.Errorf("failed to fsync rootfs path: %w", err)
 	}
 
 	err = file.Sync()
 	if err != nil {
-		return nil, fmt.Errorf("failed to sync rootfs path: %w", err)
+		return fmt.Errorf("failed to sync rootfs path: %w", err)
 	}
 
 	telemetry.ReportEvent(ctx, "synced rootfs")
 
-	rootfsDiffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, buildId.String(), build.Rootfs)
+	return nil
+}
+
+func (s *Sandbox) newSnapshot(
+	ctx context.Context,
+	buildId uuid.UUID,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+	memfileDiffFile *build.LocalDiffFile,
+	memfileDirtyPages *bitset.BitSet,
+	memfileEmptyPages *bitset.BitSet,
+	rootfsDiffFile *build.LocalDiffFile,
+	rootfsDirtyBlocks *bitset.BitSet,
+) (*Snapshot, error) {
+	// MEMFILE & SNAPFILE
+	originalMemfile, err := s.template.Memfile()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create rootfs diff: %w", err)
+		return nil, fmt.Errorf("failed to get original memfile: %w", err)
 	}
 
-	rootfsDirtyBlocks, err := s.rootfs.Export(ctx, rootfsDiffFile, s.Stop)
+	memfileMetadata := &header.Metadata{
+		Version:     header.CurrentVersion,
+		Generation:  originalMemfile.Header().Metadata.Generation + 1,
+		BlockSize:   originalMemfile.Header().Metadata.BlockSize,
+		Size:        originalMemfile.Header().Metadata.Size,
+		BuildId:     buildId,
+		BaseBuildId: originalMemfile.Header().Metadata.BaseBuildId,
+		Compression: originalMemfile.Header().Metadata.Compression,
+	}
+
+	memfileMapping := header.CreateMapping(
+		memfileMetadata,
+		&buildId,
+		memfileDirtyPages,
+	)
+
+	// The empty pages are not stored in the diff, they are read as zeros from the nil build.
+	memfileEmptyMapping := header.CreateMapping(
+		memfileMetadata,
+		&uuid.Nil,
+		memfileEmptyPages,
+	)
+
+	telemetry.ReportEvent(ctx, "created memfile mapping")
+
+	memfileMappings := header.MergeMappings(
+		header.MergeMappings(originalMemfile.Header().Mapping, memfileEmptyMapping),
+		memfileMapping,
+	)
+
+	telemetry.ReportEvent(ctx, "merged memfile mappings")
+
+	snapfile, err := template.NewLocalFile(snapshotTemplateFiles.CacheSnapfilePath())
 	if err != nil {
-		return nil, fmt.Errorf("failed to export rootfs: %w", err)
+		return nil, fmt.Errorf("failed to create local snapfile: %w", err)
 	}
 
-	telemetry.ReportEvent(ctx, "exported rootfs")
+	// ROOTFS
+	originalRootfs, err := s.template.Rootfs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original rootfs: %w", err)
+	}
+
+	rootfsMetadata := &header.Metadata{
+		Version:     header.CurrentVersion,
+		Generation:  originalRootfs.Header().Metadata.Generation + 1,
+		BlockSize:   originalRootfs.Header().Metadata.BlockSize,
+		Size:        originalRootfs.Header().Metadata.Size,
+		BuildId:     buildId,
+		BaseBuildId: originalRootfs.Header().Metadata.BaseBuildId,
+		// The snapshot diffs use the compression selected for the template, the memfile and rootfs have the same one.
+		Compression: originalMemfile.Header().Metadata.Compression,
+	}
 
 	rootfsMapping := header.CreateMapping(
 		rootfsMetadata,
@@ -541,12 +809,14 @@ func (s *Sandbox) Snapshot(
 		attribute.Int64("snapshot.memfile.header.mappings.length", int64(len(memfileMappings))),
 		attribute.Int64("snapshot.rootfs.header.mappings.length", int64(len(rootfsMappings))),
 		attribute.Int64("snapshot.memfile.diff.size", int64(memfileDirtyPages.Count()*uint(originalMemfile.Header().Metadata.BlockSize))),
+		attribute.Int64("snapshot.memfile.empty.size", int64(memfileEmptyPages.Count()*uint(originalMemfile.Header().Metadata.BlockSize))),
 		attribute.Int64("snapshot.memfile.mapped_size", int64(memfileMetadata.Size)),
 		attribute.Int64("snapshot.memfile.block_size", int64(memfileMetadata.BlockSize)),
 		attribute.Int64("snapshot.rootfs.diff.size", int64(rootfsDirtyBlocks.Count()*uint(originalRootfs.Header().Metadata.BlockSize))),
 		attribute.Int64("snapshot.rootfs.mapped_size", int64(rootfsMetadata.Size)),
 		attribute.Int64("snapshot.rootfs.block_size", int64(rootfsMetadata.BlockSize)),
 		attribute.Int64("snapshot.metadata.version", int64(memfileMetadata.Version)),
+		attribute.String("snapshot.metadata.compression", memfileMetadata.Compression.String()),
 		attribute.Int64("snapshot.metadata.generation", int64(memfileMetadata.Generation)),
 		attribute.String("snapshot.metadata.build_id", memfileMetadata.BuildId.String()),
 		attribute.String("snapshot.metadata.base_build_id", memfileMetadata.BaseBuildId.String()),
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -46,10 +47,12 @@ type Sandbox struct {
 	// YOU PROBABLY WANT TO BE IN SANDBOX_LINUX.GO
 
 	Config          *orchestrator.SandboxConfig
+	configMu        sync.RWMutex
 	process         NoOpProcess
 	uffdExit        chan error
 	cleanup         NoOpCleanup
 	healthy         atomic.Bool
+	paused          atomic.Bool
 	Slot            network.Slot
 	EndAt           time.Time
 	StartedAt       time.Time
@@ -65,6 +68,13 @@ func (s *Sandbox) LoggerMetadata() sbxlogger.SandboxMetadata {
 	panic("platform does not support sandbox")
 }
 
+func (s *Sandbox) currentConfig() *orchestrator.SandboxConfig {
+	s.configMu.RLock()
+	defer s.configMu.RUnlock()
+
+	return s.Config
+}
+
 // Run cleanup functions for the already initialized resources if there is any error or after you are done with the started sandbox.
 func NewSandbox(
 
@@ -91,6 +101,25 @@ func NewSandbox(
 	return nil, nil, errors.New("platform does not support sandbox")
 }
 
+func (s *Sandbox) Pause(ctx context.Context, tracer trace.Tracer) error {
+	return errors.New("platform does not support pause")
+}
+
+func (s *Sandbox) Resume(ctx context.Context, tracer trace.Tracer) error {
+	return errors.New("platform does not support resume")
+}
+
+func (s *Sandbox) Assign(
+	ctx context.Context,
+	tracer trace.Tracer,
+	dns *dns.DNS,
+	config *orchestrator.SandboxConfig,
+	traceID string,
+	endAt time.Time,
+) error {
+	return errors.New("platform does not support sandbox")
+}
+
 func (s *Sandbox) Wait() error {
 	return errors.New("platform does not support sandbox")
 }
@@ -113,6 +142,22 @@ func (s *Sandbox) Snapshot(
 	return nil, errors.New("platform does not support snapshot")
 }
 
+func (s *Sandbox) Fork(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (*Snapshot, error) {
+	return nil, errors.New("platform does not support fork")
+}
+
+func (s *Sandbox) Checkpoint(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (*Snapshot, error) {
+	return nil, errors.New("platform does not support checkpoint")
+}
+
 type Snapshot struct {
 	MemfileDiff       build.Diff
 	MemfileDiffHeader *header.Header
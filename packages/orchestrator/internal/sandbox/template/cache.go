@@ -9,7 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
@@ -27,13 +27,13 @@ const (
 )
 
 type Cache struct {
-	cache      *ttlcache.Cache[string, Template]
-	bucket     *gcs.BucketHandle
-	ctx        context.Context
-	buildStore *build.DiffStore
+	cache       *ttlcache.Cache[string, Template]
+	persistence storage.Provider
+	ctx         context.Context
+	buildStore  *build.DiffStore
 }
 
-func NewCache(ctx context.Context) (*Cache, error) {
+func NewCache(ctx context.Context, persistence storage.Provider) (*Cache, error) {
 	cache := ttlcache.New(
 		ttlcache.WithTTL[string, Template](templateExpiration),
 	)
@@ -61,13 +61,18 @@ func NewCache(ctx context.Context) (*Cache, error) {
 	}
 
 	return &Cache{
-		bucket:     gcs.GetTemplateBucket(),
-		buildStore: buildStore,
-		cache:      cache,
-		ctx:        ctx,
+		persistence: persistence,
+		buildStore:  buildStore,
+		cache:       cache,
+		ctx:         ctx,
 	}, nil
 }
 
+// Persistence returns the storage the templates are fetched from.
+func (c *Cache) Persistence() storage.Provider {
+	return c.persistence
+}
+
 func (c *Cache) Items() map[string]*ttlcache.Item[string, Template] {
 	return c.cache.Items()
 }
@@ -89,7 +94,7 @@ func (c *Cache) GetTemplate(
 		isSnapshot,
 		nil,
 		nil,
-		c.bucket,
+		c.persistence,
 		nil,
 	)
 	if err != nil {
@@ -144,7 +149,7 @@ func (c *Cache) AddSnapshot(
 		true,
 		memfileHeader,
 		rootfsHeader,
-		c.bucket,
+		c.persistence,
 		localSnapfile,
 	)
 	if err != nil {
@@ -2,13 +2,13 @@ package template
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
@@ -25,41 +25,28 @@ func NewStorage(
 	blockSize int64,
 	isSnapshot bool,
 	h *header.Header,
-	bucket *gcs.BucketHandle,
+	persistence storage.Provider,
 ) (*Storage, error) {
-	if isSnapshot && h == nil {
-		headerObject := gcs.NewObject(ctx, bucket, buildId+"/"+string(fileType)+storage.HeaderSuffix)
-
-		diffHeader, err := header.Deserialize(headerObject)
+	if h == nil {
+		headerObject, err := persistence.OpenObject(ctx, buildId+"/"+string(fileType)+storage.HeaderSuffix)
 		if err != nil {
-			return nil, fmt.Errorf("failed to deserialize header: %w", err)
+			return nil, err
 		}
 
-		h = diffHeader
-	} else if h == nil {
-		object := gcs.NewObject(ctx, bucket, buildId+"/"+string(fileType))
-
-		size, err := object.Size()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get object size: %w", err)
+		diffHeader, err := header.Deserialize(headerObject)
+		// Templates have a header only when they are stored deduplicated.
+		if errors.Is(err, storage.ErrObjectNotExist) && !isSnapshot {
+			diffHeader, err = newTemplateHeader(ctx, buildId, fileType, blockSize, persistence)
 		}
 
-		id, err := uuid.Parse(buildId)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse build id: %w", err)
+			return nil, fmt.Errorf("failed to deserialize header: %w", err)
 		}
 
-		h = header.NewHeader(&header.Metadata{
-			BuildId:     id,
-			BaseBuildId: id,
-			Size:        uint64(size),
-			Version:     1,
-			BlockSize:   uint64(blockSize),
-			Generation:  1,
-		}, nil)
+		h = diffHeader
 	}
 
-	b := build.NewFile(h, store, fileType, bucket)
+	b := build.NewFile(h, store, fileType, persistence)
 
 	return &Storage{
 		source: b,
@@ -67,6 +54,39 @@ func NewStorage(
 	}, nil
 }
 
+// newTemplateHeader creates a header for a template stored as a single file, mapping the whole file to the template build.
+func newTemplateHeader(
+	ctx context.Context,
+	buildId string,
+	fileType build.DiffType,
+	blockSize int64,
+	persistence storage.Provider,
+) (*header.Header, error) {
+	object, err := persistence.OpenObject(ctx, buildId+"/"+string(fileType))
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := object.Size()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object size: %w", err)
+	}
+
+	id, err := uuid.Parse(buildId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	return header.NewHeader(&header.Metadata{
+		BuildId:     id,
+		BaseBuildId: id,
+		Size:        uint64(size),
+		Version:     1,
+		BlockSize:   uint64(blockSize),
+		Generation:  1,
+	}, nil), nil
+}
+
 func (d *Storage) ReadAt(p []byte, off int64) (int, error) {
 	return d.source.ReadAt(p, off)
 }
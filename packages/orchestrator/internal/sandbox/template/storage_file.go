@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
 type storageFile struct {
@@ -15,8 +15,8 @@ type storageFile struct {
 
 func newStorageFile(
 	ctx context.Context,
-	bucket *gcs.BucketHandle,
-	bucketObjectPath string,
+	persistence storage.Provider,
+	objectPath string,
 	path string,
 ) (*storageFile, error) {
 	f, err := os.Create(path)
@@ -26,7 +26,10 @@ func newStorageFile(
 
 	defer f.Close()
 
-	object := gcs.NewObject(ctx, bucket, bucketObjectPath)
+	object, err := persistence.OpenObject(ctx, objectPath)
+	if err != nil {
+		return nil, errors.Join(err, os.Remove(path))
+	}
 
 	_, err = object.WriteTo(f)
 	if err != nil {
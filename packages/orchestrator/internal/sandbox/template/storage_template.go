@@ -8,7 +8,6 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -26,7 +25,7 @@ type storageTemplate struct {
 	rootfsHeader  *header.Header
 	localSnapfile *LocalFile
 
-	bucket *gcs.BucketHandle
+	persistence storage.Provider
 }
 
 func newTemplateFromStorage(
@@ -38,7 +37,7 @@ func newTemplateFromStorage(
 	isSnapshot bool,
 	memfileHeader *header.Header,
 	rootfsHeader *header.Header,
-	bucket *gcs.BucketHandle,
+	persistence storage.Provider,
 	localSnapfile *LocalFile,
 ) (*storageTemplate, error) {
 	files, err := storage.NewTemplateFiles(
@@ -58,7 +57,7 @@ func newTemplateFromStorage(
 		isSnapshot:    isSnapshot,
 		memfileHeader: memfileHeader,
 		rootfsHeader:  rootfsHeader,
-		bucket:        bucket,
+		persistence:   persistence,
 		memfile:       utils.NewSetOnce[*Storage](),
 		rootfs:        utils.NewSetOnce[*Storage](),
 		snapfile:      utils.NewSetOnce[File](),
@@ -88,7 +87,7 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 
 		snapfile, snapfileErr := newStorageFile(
 			ctx,
-			t.bucket,
+			t.persistence,
 			t.files.StorageSnapfilePath(),
 			t.files.CacheSnapfilePath(),
 		)
@@ -113,7 +112,7 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 			t.files.MemfilePageSize(),
 			t.isSnapshot,
 			t.memfileHeader,
-			t.bucket,
+			t.persistence,
 		)
 		if memfileErr != nil {
 			errMsg := fmt.Errorf("failed to create memfile storage: %w", memfileErr)
@@ -136,7 +135,7 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 			t.files.RootfsBlockSize(),
 			t.isSnapshot,
 			t.rootfsHeader,
-			t.bucket,
+			t.persistence,
 		)
 		if rootfsErr != nil {
 			errMsg := fmt.Errorf("failed to create rootfs storage: %w", rootfsErr)
@@ -55,6 +55,15 @@ func (u *Uffd) Dirty() *bitset.BitSet {
 	return u.memfile.Dirty()
 }
 
+func (u *Uffd) Accessed() *bitset.BitSet {
+	return u.memfile.Accessed()
+}
+
+// StopFaultTrace returns the memfile offsets of the pages in the order they were faulted in and stops recording them.
+func (u *Uffd) StopFaultTrace() []int64 {
+	return u.memfile.StopFaultTrace()
+}
+
 func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64, clientID string) (*Uffd, error) {
 	pRead, pWrite, err := os.Pipe()
 	if err != nil {
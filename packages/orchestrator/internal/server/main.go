@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"sync"
+	"sync/atomic"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
@@ -30,6 +31,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
 const ServiceName = "orchestrator"
@@ -45,6 +47,13 @@ type server struct {
 	clientID        string // nomad node id
 	devicePool      *nbd.DevicePool
 	clickhouseStore chdb.Store
+	persistence     storage.Provider
+	warmPool        *warmPool
+	// migrating are the sandboxes being migrated from this node.
+	migrating *smap.Map[struct{}]
+	// receivingMigrations are closed when the migration of the sandbox to this node finishes.
+	receivingMigrations *smap.Map[chan struct{}]
+	draining            atomic.Bool
 
 	useLokiMetrics       string
 	useClickhouseMetrics string
@@ -79,7 +88,12 @@ func New(ctx context.Context, port uint, clientID string) (*Service, error) {
 
 	srv := &Service{port: uint16(port)}
 
-	templateCache, err := template.NewCache(ctx)
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage provider: %w", err)
+	}
+
+	templateCache, err := template.NewCache(ctx, persistence)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create template cache: %w", err)
 	}
@@ -149,11 +163,19 @@ func New(ctx context.Context, port uint, clientID string) (*Service, error) {
 			clientID:             clientID,
 			devicePool:           devicePool,
 			clickhouseStore:      clickhouseStore,
+			persistence:          persistence,
+			warmPool:             newWarmPool(warmPoolSize, warmPoolBuilds, warmPoolMinHits),
+			migrating:            smap.New[struct{}](),
+			receivingMigrations:  smap.New[chan struct{}](),
 			useLokiMetrics:       useLokiMetrics,
 			useClickhouseMetrics: useClickhouseMetrics,
 		}
 	}
 
+	if srv.server.warmPool.enabled() {
+		go srv.server.runWarmPool(ctx)
+	}
+
 	orchestrator.RegisterSandboxServiceServer(srv.grpc, srv.server)
 	grpc_health_v1.RegisterHealthServer(srv.grpc, health.NewServer())
 
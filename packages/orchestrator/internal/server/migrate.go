@@ -0,0 +1,620 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	e2bgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// The whole memory of the sandbox can be transferred, so the migration can take longer than the other requests.
+	migrationTimeout = 10 * time.Minute
+
+	// migrationChunkSize is below the default maximum gRPC message size.
+	migrationChunkSize = 2 << 20
+
+	migrationAbortAttempts      = 5
+	migrationAbortRetryInterval = 500 * time.Millisecond
+)
+
+// errMigrationUnconfirmed is returned when the whole snapshot was sent, but the response of the target was not received,
+// so the sandbox could already be running on the target.
+var errMigrationUnconfirmed = errors.New("migration was not confirmed by the target")
+
+// Migrate moves the sandbox to another node.
+// The VM is paused and checkpointed, the snapshot is streamed to the target orchestrator that resumes the sandbox with the same ID.
+// The copy on this node is stopped only after the target confirms it started the sandbox.
+// If the migration fails, the sandbox is resumed on this node, after the copy possibly started on the target is aborted.
+func (s *server) Migrate(ctxConn context.Context, in *orchestrator.SandboxMigrateRequest) (*orchestrator.SandboxMigrateResponse, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, migrationTimeout, fmt.Errorf("migration timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-migrate")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", s.clientID),
+		attribute.String("migration.target", in.TargetAddress),
+	)
+
+	if in.BuildId == "" {
+		return nil, status.New(codes.InvalidArgument, "migration build is required").Err()
+	}
+
+	if !s.migrating.InsertIfAbsent(in.SandboxId, struct{}{}) {
+		return nil, status.New(codes.AlreadyExists, fmt.Sprintf("sandbox '%s' is already migrating", in.SandboxId)).Err()
+	}
+
+	defer s.migrating.Remove(in.SandboxId)
+
+	err := pauseQueue.Acquire(ctx, 1)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, status.New(codes.ResourceExhausted, err.Error()).Err()
+	}
+
+	releaseOnce := sync.OnceFunc(func() {
+		pauseQueue.Release(1)
+	})
+
+	defer releaseOnce()
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox '%s' not found", in.SandboxId)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.NotFound, errMsg.Error()).Err()
+	}
+
+	snapshotTemplateFiles, err := storage.NewTemplateFiles(
+		sbx.Config.TemplateId,
+		in.BuildId,
+		sbx.Config.KernelVersion,
+		sbx.Config.FirecrackerVersion,
+		sbx.Config.HugePages,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		errMsg := fmt.Errorf("error creating template files: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	err = os.MkdirAll(snapshotTemplateFiles.CacheDir(), 0o755)
+	if err != nil {
+		errMsg := fmt.Errorf("error creating sandbox cache dir '%s': %w", snapshotTemplateFiles.CacheDir(), err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	defer os.RemoveAll(snapshotTemplateFiles.CacheDir())
+
+	snapshot, err := sbx.Checkpoint(ctx, s.tracer, snapshotTemplateFiles)
+	if err != nil {
+		errMsg := fmt.Errorf("error checkpointing sandbox '%s': %w", in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	releaseOnce()
+
+	defer closeSnapshot(snapshot)
+
+	conn, err := e2bgrpc.GetConnection(in.TargetAddress, false)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to connect to '%s': %w", in.TargetAddress, err)
+
+		resumeErr := sbx.Resume(context.WithoutCancel(ctx), s.tracer)
+		if resumeErr != nil {
+			errMsg = errors.Join(errMsg, resumeErr)
+		}
+
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	defer conn.Close()
+
+	target := orchestrator.NewSandboxServiceClient(conn)
+
+	clientID, err := s.sendMigration(ctx, target, sbx, snapshot, snapshotTemplateFiles)
+	if err != nil {
+		errMsg := fmt.Errorf("error migrating sandbox '%s': %w", in.SandboxId, err)
+
+		if errors.Is(err, errMigrationUnconfirmed) {
+			abortCtx, abortCancel := context.WithTimeout(context.WithoutCancel(ctx), migrationTimeout)
+			abortErr := abortMigration(abortCtx, target, in.SandboxId, in.BuildId)
+			abortCancel()
+
+			if abortErr != nil {
+				// The sandbox could be running on the target, resuming it here could leave two copies running.
+				errMsg = errors.Join(errMsg, abortErr)
+				telemetry.ReportCriticalError(ctx, errMsg)
+
+				return nil, status.New(codes.Unknown, errMsg.Error()).Err()
+			}
+		}
+
+		// The sandbox keeps running on this node.
+		resumeErr := sbx.Resume(context.WithoutCancel(ctx), s.tracer)
+		if resumeErr != nil {
+			errMsg = errors.Join(errMsg, resumeErr)
+		}
+
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	telemetry.ReportEvent(ctx, "migrated sandbox")
+
+	s.removeSandbox(sbx, "migration")
+
+	return &orchestrator.SandboxMigrateResponse{
+		ClientId: clientID,
+	}, nil
+}
+
+// abortMigration stops the copy of the sandbox possibly started on the target, it is retried as the source can't resume its copy until it succeeds.
+func abortMigration(ctx context.Context, target orchestrator.SandboxServiceClient, sandboxID, buildID string) error {
+	var err error
+
+	for attempt := 0; attempt < migrationAbortAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("failed to abort migration on the target: %w", errors.Join(err, ctx.Err()))
+			case <-time.After(migrationAbortRetryInterval):
+			}
+		}
+
+		_, err = target.AbortMigration(ctx, &orchestrator.SandboxAbortMigrationRequest{
+			SandboxId: sandboxID,
+			BuildId:   buildID,
+		})
+		if err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to abort migration on the target: %w", err)
+}
+
+// removeSandbox stops the sandbox whose other copy is running elsewhere, so it isn't reported by this node anymore.
+func (s *server) removeSandbox(sbx *sandbox.Sandbox, reason string) {
+	s.dns.Remove(sbx.Config.SandboxId, sbx.Slot.HostIP())
+
+	s.sandboxes.RemoveCb(sbx.Config.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		return exists && v != nil && v.CleanupID == sbx.CleanupID
+	})
+
+	go func() {
+		err := sbx.Stop()
+		if err != nil {
+			sbxlogger.I(sbx).Error("error stopping sandbox after "+reason, zap.Error(err))
+		}
+	}()
+}
+
+func closeSnapshot(snapshot *sandbox.Snapshot) {
+	for _, f := range []io.Closer{snapshot.MemfileDiff, snapshot.RootfsDiff, snapshot.Snapfile} {
+		err := f.Close()
+		if err != nil {
+			zap.L().Warn("failed to close migration snapshot file", zap.Error(err))
+		}
+	}
+}
+
+// sendMigration streams the snapshot to the target orchestrator and returns its client ID.
+// The target starts the sandbox only after the whole snapshot is received, so only the errors of the final response are wrapped in errMigrationUnconfirmed.
+func (s *server) sendMigration(
+	ctx context.Context,
+	target orchestrator.SandboxServiceClient,
+	sbx *sandbox.Sandbox,
+	snapshot *sandbox.Snapshot,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (string, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "send-migration")
+	defer childSpan.End()
+
+	stream, err := target.ReceiveMigration(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to open migration stream: %w", err)
+	}
+
+	memfileHeader, err := serializeHeader(snapshot.MemfileDiffHeader)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize memfile header: %w", err)
+	}
+
+	rootfsHeader, err := serializeHeader(snapshot.RootfsDiffHeader)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize rootfs header: %w", err)
+	}
+
+	config := proto.Clone(sbx.Config).(*orchestrator.SandboxConfig)
+	config.BuildId = snapshotTemplateFiles.BuildId
+	config.Snapshot = true
+
+	err = stream.Send(&orchestrator.SandboxMigrationData{
+		Data: &orchestrator.SandboxMigrationData_Start{
+			Start: &orchestrator.SandboxMigrationStart{
+				Sandbox: &orchestrator.SandboxCreateRequest{
+					Sandbox:   config,
+					StartTime: timestamppb.New(sbx.StartedAt),
+					EndTime:   timestamppb.New(sbx.EndAt),
+				},
+				MemfileHeader: memfileHeader,
+				RootfsHeader:  rootfsHeader,
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to send migration start: %w", err)
+	}
+
+	err = sendMigrationFile(stream, orchestrator.MigrationFile_MIGRATION_FILE_SNAPFILE, snapshot.Snapfile.Path())
+	if err != nil {
+		return "", err
+	}
+
+	for file, diff := range map[orchestrator.MigrationFile]build.Diff{
+		orchestrator.MigrationFile_MIGRATION_FILE_MEMFILE_DIFF: snapshot.MemfileDiff,
+		orchestrator.MigrationFile_MIGRATION_FILE_ROOTFS_DIFF:  snapshot.RootfsDiff,
+	} {
+		if _, ok := diff.(*build.NoDiff); ok {
+			continue
+		}
+
+		path, err := diff.CachePath()
+		if err != nil {
+			return "", fmt.Errorf("failed to get %s path: %w", file, err)
+		}
+
+		err = sendMigrationFile(stream, file, path)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to resume sandbox on the target: %w", errMigrationUnconfirmed, err)
+	}
+
+	return resp.ClientId, nil
+}
+
+func sendMigrationFile(stream orchestrator.SandboxService_ReceiveMigrationClient, file orchestrator.MigrationFile, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", file, err)
+	}
+
+	defer f.Close()
+
+	buf := make([]byte, migrationChunkSize)
+
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&orchestrator.SandboxMigrationData{
+				Data: &orchestrator.SandboxMigrationData_Chunk{
+					Chunk: &orchestrator.SandboxMigrationChunk{
+						File: file,
+						Data: buf[:n],
+					},
+				},
+			})
+			if sendErr != nil {
+				return fmt.Errorf("failed to send %s: %w", file, sendErr)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+	}
+}
+
+func serializeHeader(h *header.Header) ([]byte, error) {
+	r, err := header.Serialize(h.Metadata, h.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+// ReceiveMigration resumes the sandbox migrated from another node.
+// The snapshot is uploaded before the sandbox is started, because the later snapshots of the sandbox are layered on top of it.
+// If the response can't be sent, the sandbox is stopped, as the source resumes its own copy.
+func (s *server) ReceiveMigration(stream orchestrator.SandboxService_ReceiveMigrationServer) error {
+	ctx, cancel := context.WithTimeoutCause(stream.Context(), migrationTimeout, fmt.Errorf("migration timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-receive-migration")
+	defer childSpan.End()
+
+	data, err := stream.Recv()
+	if err != nil {
+		errMsg := fmt.Errorf("error receiving migration start: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	start := data.GetStart()
+	if start == nil || start.Sandbox == nil || start.Sandbox.Sandbox == nil {
+		return status.New(codes.InvalidArgument, "migration must start with the sandbox config").Err()
+	}
+
+	req := start.Sandbox
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", req.Sandbox.SandboxId),
+		attribute.String("client.id", s.clientID),
+	)
+
+	done := make(chan struct{})
+	if !s.receivingMigrations.InsertIfAbsent(req.Sandbox.SandboxId, done) {
+		return status.New(codes.AlreadyExists, fmt.Sprintf("sandbox '%s' is already being migrated to this node", req.Sandbox.SandboxId)).Err()
+	}
+
+	defer func() {
+		s.receivingMigrations.Remove(req.Sandbox.SandboxId)
+		close(done)
+	}()
+
+	if _, ok := s.sandboxes.Get(req.Sandbox.SandboxId); ok {
+		return status.New(codes.AlreadyExists, fmt.Sprintf("sandbox '%s' is already running on this node", req.Sandbox.SandboxId)).Err()
+	}
+
+	snapshot, snapshotTemplateFiles, err := s.receiveSnapshot(stream, start)
+	if err != nil {
+		errMsg := fmt.Errorf("error receiving migration of sandbox '%s': %w", req.Sandbox.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	err = s.templateCache.AddSnapshot(
+		snapshotTemplateFiles.TemplateId,
+		snapshotTemplateFiles.BuildId,
+		snapshotTemplateFiles.KernelVersion,
+		snapshotTemplateFiles.FirecrackerVersion,
+		snapshotTemplateFiles.Hugepages(),
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		snapshot.Snapfile,
+		snapshot.MemfileDiff,
+		snapshot.RootfsDiff,
+	)
+	if err != nil {
+		errMsg := fmt.Errorf("error adding migration snapshot to template cache: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	telemetry.ReportEvent(ctx, "added migration snapshot to template cache")
+
+	err = s.uploadSnapshot(ctx, snapshot, snapshotTemplateFiles)
+	if err != nil {
+		errMsg := fmt.Errorf("error uploading migration snapshot: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	telemetry.ReportEvent(ctx, "uploaded migration snapshot")
+
+	sbx, err := s.startSandbox(ctx, req)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+
+		return status.New(codes.Internal, err.Error()).Err()
+	}
+
+	telemetry.ReportEvent(ctx, "resumed migrated sandbox")
+
+	err = stream.SendAndClose(&orchestrator.SandboxCreateResponse{
+		ClientId: s.clientID,
+	})
+	if err != nil {
+		errMsg := fmt.Errorf("error confirming migration of sandbox '%s': %w", req.Sandbox.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		s.removeSandbox(sbx, "unconfirmed migration")
+
+		return status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	return nil
+}
+
+// AbortMigration stops the sandbox started by the migration whose response didn't reach the source.
+// The migration still being received is waited for, so the sandbox can't be started after the abort.
+func (s *server) AbortMigration(ctx context.Context, in *orchestrator.SandboxAbortMigrationRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-abort-migration")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", s.clientID),
+	)
+
+	if done, ok := s.receivingMigrations.Get(in.SandboxId); ok {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, status.New(codes.DeadlineExceeded, "migration is still being received").Err()
+		}
+	}
+
+	// Only the copy started from this migration is stopped, the build is unique for each migration.
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if ok && sbx.Config.BuildId == in.BuildId {
+		s.removeSandbox(sbx, "aborted migration")
+
+		telemetry.ReportEvent(ctx, "stopped sandbox of aborted migration")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// receiveSnapshot writes the snapshot files from the stream to the cache.
+func (s *server) receiveSnapshot(
+	stream orchestrator.SandboxService_ReceiveMigrationServer,
+	start *orchestrator.SandboxMigrationStart,
+) (*sandbox.Snapshot, *storage.TemplateCacheFiles, error) {
+	config := start.Sandbox.Sandbox
+
+	memfileHeader, err := header.Deserialize(bytes.NewBuffer(start.MemfileHeader))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize memfile header: %w", err)
+	}
+
+	rootfsHeader, err := header.Deserialize(bytes.NewBuffer(start.RootfsHeader))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize rootfs header: %w", err)
+	}
+
+	snapshotTemplateFiles, err := storage.NewTemplateFiles(
+		config.TemplateId,
+		config.BuildId,
+		config.KernelVersion,
+		config.FirecrackerVersion,
+		config.HugePages,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating template files: %w", err)
+	}
+
+	err = os.MkdirAll(snapshotTemplateFiles.CacheDir(), 0o755)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating sandbox cache dir '%s': %w", snapshotTemplateFiles.CacheDir(), err)
+	}
+
+	snapfile, err := os.Create(snapshotTemplateFiles.CacheSnapfilePath())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create snapfile: %w", err)
+	}
+
+	defer snapfile.Close()
+
+	memfileDiffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, config.BuildId, build.Memfile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create memfile diff: %w", err)
+	}
+
+	rootfsDiffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, config.BuildId, build.Rootfs)
+	if err != nil {
+		memfileDiffFile.Close()
+
+		return nil, nil, fmt.Errorf("failed to create rootfs diff: %w", err)
+	}
+
+	files := map[orchestrator.MigrationFile]io.Writer{
+		orchestrator.MigrationFile_MIGRATION_FILE_SNAPFILE:     snapfile,
+		orchestrator.MigrationFile_MIGRATION_FILE_MEMFILE_DIFF: memfileDiffFile,
+		orchestrator.MigrationFile_MIGRATION_FILE_ROOTFS_DIFF:  rootfsDiffFile,
+	}
+
+	for {
+		data, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			break
+		}
+
+		if recvErr != nil {
+			err = fmt.Errorf("failed to receive migration chunk: %w", recvErr)
+
+			break
+		}
+
+		chunk := data.GetChunk()
+		if chunk == nil {
+			err = fmt.Errorf("unexpected migration message")
+
+			break
+		}
+
+		w, ok := files[chunk.File]
+		if !ok {
+			err = fmt.Errorf("unknown migration file %s", chunk.File)
+
+			break
+		}
+
+		_, err = w.Write(chunk.Data)
+		if err != nil {
+			err = fmt.Errorf("failed to write %s: %w", chunk.File, err)
+
+			break
+		}
+	}
+
+	// The diffs are converted even on error, so the files are cleaned up.
+	memfileDiff, memfileErr := memfileDiffFile.ToDiff(int64(memfileHeader.Metadata.BlockSize))
+	rootfsDiff, rootfsErr := rootfsDiffFile.ToDiff(int64(rootfsHeader.Metadata.BlockSize))
+
+	err = errors.Join(err, memfileErr, rootfsErr)
+	if err != nil {
+		for _, diff := range []build.Diff{memfileDiff, rootfsDiff} {
+			if diff != nil {
+				diff.Close()
+			}
+		}
+
+		os.RemoveAll(snapshotTemplateFiles.CacheDir())
+
+		return nil, nil, err
+	}
+
+	localSnapfile, err := template.NewLocalFile(snapshotTemplateFiles.CacheSnapfilePath())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create local snapfile: %w", err)
+	}
+
+	return &sandbox.Snapshot{
+		MemfileDiff:       memfileDiff,
+		MemfileDiffHeader: memfileHeader,
+		RootfsDiff:        rootfsDiff,
+		RootfsDiffHeader:  rootfsHeader,
+		Snapfile:          localSnapfile,
+	}, snapshotTemplateFiles, nil
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+type fakeMigrationTarget struct {
+	orchestrator.SandboxServiceClient
+
+	failures int
+	aborts   []*orchestrator.SandboxAbortMigrationRequest
+}
+
+func (f *fakeMigrationTarget) AbortMigration(_ context.Context, in *orchestrator.SandboxAbortMigrationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	f.aborts = append(f.aborts, in)
+
+	if len(f.aborts) <= f.failures {
+		return nil, status.Error(codes.Unavailable, "target unavailable")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func newMigrationTestServer() *server {
+	return &server{
+		tracer:              noop.NewTracerProvider().Tracer("test"),
+		sandboxes:           smap.New[*sandbox.Sandbox](),
+		migrating:           smap.New[struct{}](),
+		receivingMigrations: smap.New[chan struct{}](),
+		warmPool:            newWarmPool(0, 0, 0),
+	}
+}
+
+func TestAbortMigrationRetries(t *testing.T) {
+	target := &fakeMigrationTarget{failures: 2}
+
+	err := abortMigration(context.Background(), target, "sandbox-id", "build-id")
+	require.NoError(t, err)
+
+	require.Len(t, target.aborts, 3)
+	assert.Equal(t, "sandbox-id", target.aborts[2].SandboxId)
+	assert.Equal(t, "build-id", target.aborts[2].BuildId)
+}
+
+func TestAbortMigrationGivesUp(t *testing.T) {
+	target := &fakeMigrationTarget{failures: migrationAbortAttempts}
+
+	err := abortMigration(context.Background(), target, "sandbox-id", "build-id")
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(errors.Unwrap(err)))
+	assert.Len(t, target.aborts, migrationAbortAttempts)
+}
+
+func TestServerAbortMigrationWaitsForReceive(t *testing.T) {
+	s := newMigrationTestServer()
+
+	done := make(chan struct{})
+	s.receivingMigrations.Insert("sandbox-id", done)
+
+	// The sandbox started by another migration is kept.
+	sbx := &sandbox.Sandbox{Config: &orchestrator.SandboxConfig{SandboxId: "sandbox-id", BuildId: "other-build-id"}}
+	s.sandboxes.Insert("sandbox-id", sbx)
+
+	aborted := make(chan error)
+	go func() {
+		_, err := s.AbortMigration(context.Background(), &orchestrator.SandboxAbortMigrationRequest{
+			SandboxId: "sandbox-id",
+			BuildId:   "build-id",
+		})
+		aborted <- err
+	}()
+
+	select {
+	case <-aborted:
+		t.Fatal("abort finished before the migration was received")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(done)
+
+	select {
+	case err := <-aborted:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("abort didn't finish after the migration was received")
+	}
+
+	_, ok := s.sandboxes.Get("sandbox-id")
+	assert.True(t, ok)
+}
+
+func TestServerAbortMigrationTimeout(t *testing.T) {
+	s := newMigrationTestServer()
+	s.receivingMigrations.Insert("sandbox-id", make(chan struct{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := s.AbortMigration(ctx, &orchestrator.SandboxAbortMigrationRequest{SandboxId: "sandbox-id", BuildId: "build-id"})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestServerMigrateValidation(t *testing.T) {
+	s := newMigrationTestServer()
+
+	_, err := s.Migrate(context.Background(), &orchestrator.SandboxMigrateRequest{SandboxId: "sandbox-id"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The concurrent migrations of the same sandbox, e.g. from multiple API instances, are rejected.
+	s.migrating.Insert("sandbox-id", struct{}{})
+
+	_, err = s.Migrate(context.Background(), &orchestrator.SandboxMigrateRequest{SandboxId: "sandbox-id", BuildId: "build-id"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestServerDraining(t *testing.T) {
+	s := newMigrationTestServer()
+
+	res, err := s.Status(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.False(t, res.Draining)
+
+	_, err = s.SetDraining(context.Background(), &orchestrator.NodeDrainingRequest{Draining: true})
+	require.NoError(t, err)
+
+	res, err = s.Status(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.True(t, res.Draining)
+}
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -45,14 +47,45 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		attribute.String("envd.version", req.Sandbox.EnvdVersion),
 	)
 
+	s.warmPool.record(req.Sandbox)
+
+	if sbx := s.warmPool.take(req.Sandbox); sbx != nil {
+		err := s.assignWarmSandbox(childCtx, sbx, req, childSpan.SpanContext().TraceID().String())
+		if err == nil {
+			childSpan.SetAttributes(attribute.Bool("sandbox.warm", true))
+
+			return &orchestrator.SandboxCreateResponse{
+				ClientId: s.clientID,
+			}, nil
+		}
+
+		// The sandbox is started the regular way instead.
+		telemetry.ReportError(childCtx, err)
+		go s.stopWarmSandbox(sbx)
+	}
+
+	_, err := s.startSandbox(childCtx, req)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	return &orchestrator.SandboxCreateResponse{
+		ClientId: s.clientID,
+	}, nil
+}
+
+// startSandbox starts the sandbox and registers it, the sandbox is removed from the cache after it exits.
+func (s *server) startSandbox(ctx context.Context, req *orchestrator.SandboxCreateRequest) (*sandbox.Sandbox, error) {
 	sbx, cleanup, err := sandbox.NewSandbox(
-		childCtx,
+		ctx,
 		s.tracer,
 		s.dns,
 		s.networkPool,
 		s.templateCache,
 		req.Sandbox,
-		childSpan.SpanContext().TraceID().String(),
+		trace.SpanFromContext(ctx).SpanContext().TraceID().String(),
 		req.StartTime.AsTime(),
 		req.EndTime.AsTime(),
 		req.Sandbox.Snapshot,
@@ -67,45 +100,45 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		zap.L().Error("failed to create sandbox, cleaning up", zap.Error(err))
 		cleanupErr := cleanup.Run()
 
-		errMsg := fmt.Errorf("failed to cleanup sandbox: %w", errors.Join(err, context.Cause(ctx), cleanupErr))
-		telemetry.ReportCriticalError(ctx, errMsg)
-
-		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+		return nil, fmt.Errorf("failed to cleanup sandbox: %w", errors.Join(err, context.Cause(ctx), cleanupErr))
 	}
 
 	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
-	go func() {
-		waitErr := sbx.Wait()
-		if waitErr != nil {
-			sbxlogger.I(sbx).Error("failed to wait for sandbox, cleaning up", zap.Error(waitErr))
-		}
+	go s.waitSandbox(sbx, cleanup)
 
-		cleanupErr := cleanup.Run()
-		if cleanupErr != nil {
-			sbxlogger.I(sbx).Error("failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
-		}
+	return sbx, nil
+}
 
-		// Remove the sandbox from cache only if the cleanup IDs match.
-		// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
-		// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
-		s.sandboxes.RemoveCb(req.Sandbox.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
-			if !exists {
-				return false
-			}
+// waitSandbox cleans up the sandbox after it exits and removes it from the cache and the warm pool.
+func (s *server) waitSandbox(sbx *sandbox.Sandbox, cleanup *sandbox.Cleanup) {
+	waitErr := sbx.Wait()
+	if waitErr != nil {
+		sbxlogger.I(sbx).Error("failed to wait for sandbox, cleaning up", zap.Error(waitErr))
+	}
 
-			if v == nil {
-				return false
-			}
+	cleanupErr := cleanup.Run()
+	if cleanupErr != nil {
+		sbxlogger.I(sbx).Error("failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
+	}
 
-			return sbx.CleanupID == v.CleanupID
-		})
+	s.warmPool.remove(sbx)
 
-		sbxlogger.E(sbx).Info("Sandbox killed")
-	}()
+	// Remove the sandbox from cache only if the cleanup IDs match.
+	// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
+	// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
+	s.sandboxes.RemoveCb(sbx.Config.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		if !exists {
+			return false
+		}
 
-	return &orchestrator.SandboxCreateResponse{
-		ClientId: s.clientID,
-	}, nil
+		if v == nil {
+			return false
+		}
+
+		return sbx.CleanupID == v.CleanupID
+	})
+
+	sbxlogger.E(sbx).Info("Sandbox killed")
 }
 
 func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequest) (*emptypb.Empty, error) {
@@ -152,6 +185,7 @@ func (s *server) List(ctx context.Context, _ *emptypb.Empty) (*orchestrator.Sand
 			ClientId:  s.clientID,
 			StartTime: timestamppb.New(sbx.StartedAt),
 			EndTime:   timestamppb.New(sbx.EndAt),
+			Unhealthy: !sbx.Healthy(),
 		})
 	}
 
@@ -302,57 +336,215 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 	telemetry.ReportEvent(ctx, "added snapshot to template cache")
 
-	go func() {
-		var memfilePath *string
+	s.uploadSnapshotInBackground(sbx, snapshot, snapshotTemplateFiles)
 
-		switch r := snapshot.MemfileDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			memfileLocalPath, err := r.CachePath()
-			if err != nil {
-				sbxlogger.I(sbx).Error("error getting memfile diff path", zap.Error(err))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) Fork(ctxConn context.Context, in *orchestrator.SandboxForkRequest) (*orchestrator.SandboxForkResponse, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-fork")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("sandbox.id", in.SandboxId),
+		attribute.String("client.id", s.clientID),
+		attribute.Int("fork.children", len(in.Children)),
+	)
+
+	err := pauseQueue.Acquire(ctx, 1)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, status.New(codes.ResourceExhausted, err.Error()).Err()
+	}
+
+	releaseOnce := sync.OnceFunc(func() {
+		pauseQueue.Release(1)
+	})
+
+	defer releaseOnce()
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		errMsg := fmt.Errorf("sandbox '%s' not found", in.SandboxId)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.NotFound, errMsg.Error()).Err()
+	}
+
+	snapshotTemplateFiles, err := storage.NewTemplateFiles(
+		in.TemplateId,
+		in.BuildId,
+		sbx.Config.KernelVersion,
+		sbx.Config.FirecrackerVersion,
+		sbx.Config.HugePages,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		errMsg := fmt.Errorf("error creating template files: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
 
-				return
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	err = os.MkdirAll(snapshotTemplateFiles.CacheDir(), 0o755)
+	if err != nil {
+		errMsg := fmt.Errorf("error creating sandbox cache dir '%s': %w", snapshotTemplateFiles.CacheDir(), err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	snapshot, err := sbx.Fork(ctx, s.tracer, snapshotTemplateFiles)
+	if err != nil {
+		errMsg := fmt.Errorf("error forking sandbox '%s': %w", in.SandboxId, err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	releaseOnce()
+
+	err = s.templateCache.AddSnapshot(
+		snapshotTemplateFiles.TemplateId,
+		snapshotTemplateFiles.BuildId,
+		snapshotTemplateFiles.KernelVersion,
+		snapshotTemplateFiles.FirecrackerVersion,
+		snapshotTemplateFiles.Hugepages(),
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		snapshot.Snapfile,
+		snapshot.MemfileDiff,
+		snapshot.RootfsDiff,
+	)
+	if err != nil {
+		errMsg := fmt.Errorf("error adding snapshot to template cache: %w", err)
+		telemetry.ReportCriticalError(ctx, errMsg)
+
+		return nil, status.New(codes.Internal, errMsg.Error()).Err()
+	}
+
+	telemetry.ReportEvent(ctx, "added fork snapshot to template cache")
+
+	s.uploadSnapshotInBackground(sbx, snapshot, snapshotTemplateFiles)
+
+	// The children are started from the local template cache, so they don't have to wait for the upload.
+	err = startForkedSandboxes(ctx, in.Children, s.startSandbox, func(sbx *sandbox.Sandbox) {
+		s.removeSandbox(sbx, "failed fork")
+	})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, err)
+
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	telemetry.ReportEvent(ctx, "started forked sandboxes")
+
+	return &orchestrator.SandboxForkResponse{
+		ClientId: s.clientID,
+	}, nil
+}
+
+// startForkedSandboxes starts the children of the fork concurrently.
+// If any of the children fails to start, the already started ones are removed, so the failed fork doesn't leave sandboxes the API doesn't know about.
+func startForkedSandboxes(
+	ctx context.Context,
+	children []*orchestrator.SandboxCreateRequest,
+	start func(ctx context.Context, req *orchestrator.SandboxCreateRequest) (*sandbox.Sandbox, error),
+	remove func(sbx *sandbox.Sandbox),
+) error {
+	var (
+		mu      sync.Mutex
+		started []*sandbox.Sandbox
+	)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, child := range children {
+		eg.Go(func() error {
+			sbx, startErr := start(egCtx, child)
+			if startErr != nil {
+				return fmt.Errorf("error starting forked sandbox '%s': %w", child.Sandbox.SandboxId, startErr)
 			}
 
-			memfilePath = &memfileLocalPath
+			mu.Lock()
+			started = append(started, sbx)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	err := eg.Wait()
+	if err != nil {
+		for _, sbx := range started {
+			remove(sbx)
 		}
 
-		var rootfsPath *string
+		return err
+	}
+
+	return nil
+}
 
-		switch r := snapshot.RootfsDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			rootfsLocalPath, err := r.CachePath()
-			if err != nil {
-				sbxlogger.I(sbx).Error("error getting rootfs diff path", zap.Error(err))
+// uploadSnapshot uploads the snapshot files to the storage, so the snapshot can be resumed on any node.
+func (s *server) uploadSnapshot(ctx context.Context, snapshot *sandbox.Snapshot, snapshotTemplateFiles *storage.TemplateCacheFiles) error {
+	var memfilePath *string
 
-				return
-			}
+	switch r := snapshot.MemfileDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		memfileLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("error getting memfile diff path: %w", err)
+		}
+
+		memfilePath = &memfileLocalPath
+	}
 
-			rootfsPath = &rootfsLocalPath
+	var rootfsPath *string
+
+	switch r := snapshot.RootfsDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		rootfsLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("error getting rootfs diff path: %w", err)
 		}
 
-		b := storage.NewTemplateBuild(
-			snapshot.MemfileDiffHeader,
-			snapshot.RootfsDiffHeader,
-			snapshotTemplateFiles.TemplateFiles,
-		)
-
-		err = <-b.Upload(
-			context.Background(),
-			snapshotTemplateFiles.CacheSnapfilePath(),
-			memfilePath,
-			rootfsPath,
-		)
+		rootfsPath = &rootfsLocalPath
+	}
+
+	b := storage.NewTemplateBuild(
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		snapshotTemplateFiles.TemplateFiles,
+		s.persistence,
+		snapshot.MemfileDiffHeader.Metadata.Compression,
+	)
+
+	err := <-b.Upload(
+		ctx,
+		snapshotTemplateFiles.CacheSnapfilePath(),
+		memfilePath,
+		rootfsPath,
+	)
+	if err != nil {
+		return fmt.Errorf("error uploading sandbox snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// uploadSnapshotInBackground uploads the snapshot after the request finishes, the snapshot is resumed from the template cache in the meantime.
+func (s *server) uploadSnapshotInBackground(sbx *sandbox.Sandbox, snapshot *sandbox.Snapshot, snapshotTemplateFiles *storage.TemplateCacheFiles) {
+	go func() {
+		err := s.uploadSnapshot(context.Background(), snapshot, snapshotTemplateFiles)
 		if err != nil {
 			sbxlogger.I(sbx).Error("error uploading sandbox snapshot", zap.Error(err))
-
-			return
 		}
 	}()
-
-	return &emptypb.Empty{}, nil
 }
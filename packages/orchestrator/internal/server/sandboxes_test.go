@@ -2,7 +2,11 @@ package server
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -54,6 +58,8 @@ func Test_server_List(t *testing.T) {
 						// ClientId:  "client-id",
 						StartTime: timestamppb.New(startTime),
 						EndTime:   timestamppb.New(endTime),
+						// The sandbox is healthy only after it is started.
+						Unhealthy: true,
 					},
 				},
 			},
@@ -80,3 +86,69 @@ func Test_server_List(t *testing.T) {
 		})
 	}
 }
+
+func forkChildren(ids ...string) []*orchestrator.SandboxCreateRequest {
+	children := make([]*orchestrator.SandboxCreateRequest, 0, len(ids))
+	for _, id := range ids {
+		children = append(children, &orchestrator.SandboxCreateRequest{
+			Sandbox: &orchestrator.SandboxConfig{SandboxId: id},
+		})
+	}
+
+	return children
+}
+
+func Test_startForkedSandboxes_RemovesStartedOnFailure(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		removed []string
+	)
+
+	start := func(ctx context.Context, req *orchestrator.SandboxCreateRequest) (*sandbox.Sandbox, error) {
+		if req.Sandbox.SandboxId == "failing" {
+			return nil, errors.New("failed to start")
+		}
+
+		return &sandbox.Sandbox{Config: req.Sandbox}, nil
+	}
+
+	remove := func(sbx *sandbox.Sandbox) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		removed = append(removed, sbx.Config.SandboxId)
+	}
+
+	err := startForkedSandboxes(context.Background(), forkChildren("a", "failing", "b"), start, remove)
+	if err == nil {
+		t.Fatal("expected error when one of the children fails")
+	}
+
+	slices.Sort(removed)
+	if !reflect.DeepEqual(removed, []string{"a", "b"}) {
+		t.Errorf("expected started children to be removed, got %v", removed)
+	}
+}
+
+func Test_startForkedSandboxes(t *testing.T) {
+	var started atomic.Int32
+
+	start := func(ctx context.Context, req *orchestrator.SandboxCreateRequest) (*sandbox.Sandbox, error) {
+		started.Add(1)
+
+		return &sandbox.Sandbox{Config: req.Sandbox}, nil
+	}
+
+	remove := func(sbx *sandbox.Sandbox) {
+		t.Errorf("sandbox '%s' should not be removed", sbx.Config.SandboxId)
+	}
+
+	err := startForkedSandboxes(context.Background(), forkChildren("a", "b", "c"), start, remove)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if started.Load() != 3 {
+		t.Errorf("expected 3 started children, got %d", started.Load())
+	}
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// SetDraining marks the node as draining, the API stops placing sandboxes on it and migrates the running ones away.
+// The status is kept here, so every API instance sees it and it survives their restarts.
+func (s *server) SetDraining(ctx context.Context, in *orchestrator.NodeDrainingRequest) (*emptypb.Empty, error) {
+	_, childSpan := s.tracer.Start(ctx, "set-draining")
+	defer childSpan.End()
+
+	if s.draining.Swap(in.Draining) != in.Draining {
+		zap.L().Info("Node draining changed", zap.String("client_id", s.clientID), zap.Bool("draining", in.Draining))
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) Status(ctx context.Context, _ *emptypb.Empty) (*orchestrator.NodeStatusResponse, error) {
+	_, childSpan := s.tracer.Start(ctx, "node-status")
+	defer childSpan.End()
+
+	warmVcpu, warmRamMB := s.warmPool.usage()
+
+	return &orchestrator.NodeStatusResponse{
+		Draining:  s.draining.Load(),
+		WarmVcpu:  warmVcpu,
+		WarmRamMb: warmRamMB,
+	}, nil
+}
@@ -19,6 +19,7 @@ func (s *server) ListCachedBuilds(ctx context.Context, _ *emptypb.Empty) (*orche
 		builds = append(builds, &orchestrator.CachedBuildInfo{
 			BuildId:        key,
 			ExpirationTime: timestamppb.New(item.ExpiresAt()),
+			WarmSandboxes:  int32(s.warmPool.count(key)),
 		})
 	}
 
@@ -0,0 +1,429 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/mod/semver"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+var (
+	// warmPoolSize is the number of paused sandboxes kept for each hot build, zero disables the pool.
+	warmPoolSize = utils.Must(strconv.Atoi(env.GetEnv("WARM_POOL_SIZE", "0")))
+	// warmPoolBuilds is the number of the most requested builds that are kept warm.
+	warmPoolBuilds = utils.Must(strconv.Atoi(env.GetEnv("WARM_POOL_BUILDS", "5")))
+	// warmPoolMinHits is the decayed number of the create requests the build needs to be kept warm.
+	// The counts are halved every minute, so a build requested n times per minute settles at about 2n hits.
+	warmPoolMinHits = utils.Must(strconv.ParseFloat(env.GetEnv("WARM_POOL_MIN_HITS", "10"), 64))
+)
+
+const (
+	warmPoolRefillInterval = 1 * time.Second
+	// The request counts are halved every interval, so the builds that are not requested anymore cool down.
+	warmPoolDecayInterval = 1 * time.Minute
+
+	warmSandboxIDPrefix = "warm-"
+
+	// Older envd versions can't be initialized with the env vars after the start.
+	minEnvdVersionForWarmPool = "v0.1.1"
+)
+
+type warmBuild struct {
+	// hits is the decayed number of the create requests for the build.
+	hits float64
+	// config is the config of the last create request for the build without the owner specific fields.
+	config   *orchestrator.SandboxConfig
+	ready    []*sandbox.Sandbox
+	starting int
+}
+
+// warmPool keeps already started sandboxes paused in memory for the most requested builds.
+// A create request for a hot build takes one of them instead of starting a new VM.
+type warmPool struct {
+	mu        sync.Mutex
+	size      int
+	maxBuilds int
+	// minHits is the number of the requests the build needs to be kept warm, the builds with fewer requests are started on demand.
+	minHits float64
+	builds  map[string]*warmBuild
+}
+
+func newWarmPool(size, maxBuilds int, minHits float64) *warmPool {
+	return &warmPool{
+		size:      size,
+		maxBuilds: maxBuilds,
+		minHits:   minHits,
+		builds:    make(map[string]*warmBuild),
+	}
+}
+
+// warmPoolKey is the same as the template cache key, so the counts can be reported with the cached builds.
+func warmPoolKey(config *orchestrator.SandboxConfig) string {
+	return fmt.Sprintf("%s-%s", config.TemplateId, config.BuildId)
+}
+
+func isWarmPoolEligible(config *orchestrator.SandboxConfig) bool {
+	// Resumed sandboxes have their own memory.
+	if config.Snapshot {
+		return false
+	}
+
+	return semver.Compare(fmt.Sprintf("v%s", config.EnvdVersion), minEnvdVersionForWarmPool) >= 0
+}
+
+// isWarmCompatible checks if a sandbox started with one config can be handed out for the other.
+func isWarmCompatible(a, b *orchestrator.SandboxConfig) bool {
+	return a.KernelVersion == b.KernelVersion &&
+		a.FirecrackerVersion == b.FirecrackerVersion &&
+		a.HugePages == b.HugePages &&
+		a.EnvdVersion == b.EnvdVersion &&
+		a.Vcpu == b.Vcpu &&
+		a.RamMb == b.RamMb &&
+		a.TotalDiskSizeMb == b.TotalDiskSizeMb &&
+		a.BaseTemplateId == b.BaseTemplateId
+}
+
+func (p *warmPool) enabled() bool {
+	return p.size > 0 && p.maxBuilds > 0
+}
+
+// record counts the create request for the build.
+func (p *warmPool) record(config *orchestrator.SandboxConfig) {
+	if !p.enabled() || !isWarmPoolEligible(config) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := warmPoolKey(config)
+
+	b, ok := p.builds[key]
+	if !ok {
+		b = &warmBuild{}
+		p.builds[key] = b
+	}
+
+	b.hits++
+
+	if b.config == nil || !isWarmCompatible(b.config, config) {
+		warmConfig := proto.Clone(config).(*orchestrator.SandboxConfig)
+		warmConfig.SandboxId = ""
+		warmConfig.EnvVars = nil
+		warmConfig.Metadata = nil
+		warmConfig.Alias = nil
+		warmConfig.TeamId = ""
+		warmConfig.AutoPause = nil
+
+		b.config = warmConfig
+	}
+}
+
+// take removes a warm sandbox that can be used for the config from the pool, it returns nil if there is none.
+func (p *warmPool) take(config *orchestrator.SandboxConfig) *sandbox.Sandbox {
+	if !p.enabled() || !isWarmPoolEligible(config) {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b, ok := p.builds[warmPoolKey(config)]
+	if !ok {
+		return nil
+	}
+
+	for i := len(b.ready) - 1; i >= 0; i-- {
+		w := b.ready[i]
+		if isWarmCompatible(w.Config, config) {
+			b.ready = slices.Delete(b.ready, i, i+1)
+
+			return w
+		}
+	}
+
+	return nil
+}
+
+// count returns the number of warm sandboxes ready for the build.
+func (p *warmPool) count(key string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b, ok := p.builds[key]
+	if !ok {
+		return 0
+	}
+
+	return len(b.ready)
+}
+
+// usage returns the resources allocated by the ready and starting warm sandboxes.
+func (p *warmPool) usage() (vcpu, ramMB int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, b := range p.builds {
+		for _, w := range b.ready {
+			vcpu += w.Config.Vcpu
+			ramMB += w.Config.RamMb
+		}
+
+		if b.starting > 0 {
+			vcpu += int64(b.starting) * b.config.Vcpu
+			ramMB += int64(b.starting) * b.config.RamMb
+		}
+	}
+
+	return vcpu, ramMB
+}
+
+// hot returns the keys of the builds that should be kept warm.
+func (p *warmPool) hot() map[string]bool {
+	keys := make([]string, 0, len(p.builds))
+	for key, b := range p.builds {
+		if b.hits >= p.minHits {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		switch {
+		case p.builds[a].hits > p.builds[b].hits:
+			return -1
+		case p.builds[a].hits < p.builds[b].hits:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	hot := make(map[string]bool, p.maxBuilds)
+	for _, key := range keys[:min(len(keys), p.maxBuilds)] {
+		hot[key] = true
+	}
+
+	return hot
+}
+
+// plan returns the configs of the sandboxes to start to fill the pool and the warm sandboxes to stop.
+// The sandboxes to start are counted as starting until they are added or failed.
+func (p *warmPool) plan() (start map[string][]*orchestrator.SandboxConfig, stop []*sandbox.Sandbox) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	start = make(map[string][]*orchestrator.SandboxConfig)
+	hot := p.hot()
+
+	for key, b := range p.builds {
+		if !hot[key] {
+			stop = append(stop, b.ready...)
+			b.ready = nil
+
+			continue
+		}
+
+		// Drain the sandboxes started with an outdated config.
+		b.ready = slices.DeleteFunc(b.ready, func(w *sandbox.Sandbox) bool {
+			if isWarmCompatible(w.Config, b.config) {
+				return false
+			}
+
+			stop = append(stop, w)
+
+			return true
+		})
+
+		for range p.size - len(b.ready) - b.starting {
+			start[key] = append(start[key], b.config)
+			b.starting++
+		}
+	}
+
+	return start, stop
+}
+
+// decay halves the request counts and forgets the builds that are not used anymore.
+func (p *warmPool) decay() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, b := range p.builds {
+		b.hits /= 2
+
+		if b.hits < p.minHits && len(b.ready) == 0 && b.starting == 0 {
+			delete(p.builds, key)
+		}
+	}
+}
+
+func (p *warmPool) add(key string, w *sandbox.Sandbox) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b := p.builds[key]
+	b.starting--
+	b.ready = append(b.ready, w)
+}
+
+func (p *warmPool) failed(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.builds[key].starting--
+}
+
+// remove removes the sandbox from the pool if it is still there.
+func (p *warmPool) remove(sbx *sandbox.Sandbox) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, b := range p.builds {
+		b.ready = slices.DeleteFunc(b.ready, func(w *sandbox.Sandbox) bool {
+			return w == sbx
+		})
+	}
+}
+
+// drain removes all warm sandboxes from the pool.
+func (p *warmPool) drain() []*sandbox.Sandbox {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var drained []*sandbox.Sandbox
+	for _, b := range p.builds {
+		drained = append(drained, b.ready...)
+		b.ready = nil
+	}
+
+	return drained
+}
+
+// runWarmPool keeps the pool filled until the context is cancelled, then it stops the warm sandboxes.
+func (s *server) runWarmPool(ctx context.Context) {
+	refillTicker := time.NewTicker(warmPoolRefillInterval)
+	decayTicker := time.NewTicker(warmPoolDecayInterval)
+	defer func() {
+		refillTicker.Stop()
+		decayTicker.Stop()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			for _, sbx := range s.warmPool.drain() {
+				go s.stopWarmSandbox(sbx)
+			}
+
+			return
+		case <-decayTicker.C:
+			s.warmPool.decay()
+		case <-refillTicker.C:
+			start, stop := s.warmPool.plan()
+
+			for _, sbx := range stop {
+				go s.stopWarmSandbox(sbx)
+			}
+
+			for key, configs := range start {
+				for _, config := range configs {
+					go s.startWarmSandbox(ctx, key, config)
+				}
+			}
+		}
+	}
+}
+
+// startWarmSandbox starts a sandbox for the build under a placeholder ID, pauses it and adds it to the pool.
+func (s *server) startWarmSandbox(ctx context.Context, key string, config *orchestrator.SandboxConfig) {
+	ctx, cancel := context.WithTimeoutCause(ctx, requestTimeout, fmt.Errorf("warm sandbox start timed out"))
+	defer cancel()
+
+	childCtx, childSpan := s.tracer.Start(ctx, "warm-sandbox-start")
+	defer childSpan.End()
+
+	config = proto.Clone(config).(*orchestrator.SandboxConfig)
+	config.SandboxId = warmSandboxIDPrefix + id.Generate()
+
+	now := time.Now()
+
+	sbx, cleanup, err := sandbox.NewSandbox(
+		childCtx,
+		s.tracer,
+		s.dns,
+		s.networkPool,
+		s.templateCache,
+		config,
+		childSpan.SpanContext().TraceID().String(),
+		now,
+		now,
+		false,
+		config.BaseTemplateId,
+		s.clientID,
+		s.devicePool,
+		s.clickhouseStore,
+		s.useLokiMetrics,
+		s.useClickhouseMetrics,
+	)
+	if err != nil {
+		zap.L().Error("failed to create warm sandbox, cleaning up", zap.String("build_id", config.BuildId), zap.Error(err))
+
+		cleanupErr := cleanup.Run()
+		if cleanupErr != nil {
+			zap.L().Error("failed to cleanup warm sandbox", zap.String("build_id", config.BuildId), zap.Error(cleanupErr))
+		}
+
+		s.warmPool.failed(key)
+
+		return
+	}
+
+	go s.waitSandbox(sbx, cleanup)
+
+	err = sbx.Pause(childCtx, s.tracer)
+	if err != nil {
+		zap.L().Error("failed to pause warm sandbox", zap.String("sandbox_id", config.SandboxId), zap.Error(err))
+
+		s.warmPool.failed(key)
+
+		stopErr := sbx.Stop()
+		if stopErr != nil {
+			zap.L().Error("failed to stop warm sandbox", zap.String("sandbox_id", config.SandboxId), zap.Error(stopErr))
+		}
+
+		return
+	}
+
+	s.warmPool.add(key, sbx)
+}
+
+func (s *server) stopWarmSandbox(sbx *sandbox.Sandbox) {
+	err := sbx.Stop()
+	if err != nil {
+		zap.L().Error("failed to stop warm sandbox", zap.String("sandbox_id", sbx.Config.SandboxId), zap.Error(err))
+	}
+}
+
+// assignWarmSandbox hands the warm sandbox over to the create request and registers it.
+func (s *server) assignWarmSandbox(ctx context.Context, sbx *sandbox.Sandbox, req *orchestrator.SandboxCreateRequest, traceID string) error {
+	err := sbx.Assign(ctx, s.tracer, s.dns, req.Sandbox, traceID, req.EndTime.AsTime())
+	if err != nil {
+		return fmt.Errorf("failed to assign warm sandbox: %w", err)
+	}
+
+	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
+
+	return nil
+}
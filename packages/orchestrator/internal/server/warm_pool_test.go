@@ -0,0 +1,122 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func newWarmTestConfig(buildID string) *orchestrator.SandboxConfig {
+	return &orchestrator.SandboxConfig{
+		TemplateId:  "template-id",
+		BuildId:     buildID,
+		SandboxId:   "sandbox-id",
+		EnvdVersion: "0.1.5",
+		Vcpu:        2,
+		RamMb:       512,
+		EnvVars:     map[string]string{"KEY": "value"},
+	}
+}
+
+func TestWarmPool(t *testing.T) {
+	p := newWarmPool(2, 1, 1)
+
+	hot := newWarmTestConfig("hot")
+	cold := newWarmTestConfig("cold")
+
+	p.record(hot)
+	p.record(hot)
+	p.record(cold)
+
+	start, stop := p.plan()
+	assert.Empty(t, stop)
+
+	// Only the most requested build is kept warm.
+	require.Len(t, start, 1)
+	require.Len(t, start[warmPoolKey(hot)], 2)
+
+	warmConfig := start[warmPoolKey(hot)][0]
+	assert.Empty(t, warmConfig.SandboxId)
+	assert.Empty(t, warmConfig.EnvVars)
+
+	// The starting sandboxes are not planned again.
+	start, _ = p.plan()
+	assert.Empty(t, start)
+
+	sbx := &sandbox.Sandbox{Config: warmConfig}
+	p.add(warmPoolKey(hot), sbx)
+	p.failed(warmPoolKey(hot))
+
+	assert.Equal(t, 1, p.count(warmPoolKey(hot)))
+	assert.Nil(t, p.take(cold))
+
+	vcpu, ramMB := p.usage()
+	assert.Equal(t, int64(2), vcpu)
+	assert.Equal(t, int64(512), ramMB)
+
+	// The sandbox can't be used with different resources.
+	bigger := newWarmTestConfig("hot")
+	bigger.RamMb = 1024
+	assert.Nil(t, p.take(bigger))
+
+	// Resumed sandboxes are never taken from the pool.
+	resumed := newWarmTestConfig("hot")
+	resumed.Snapshot = true
+	assert.Nil(t, p.take(resumed))
+
+	assert.Same(t, sbx, p.take(hot))
+	assert.Equal(t, 0, p.count(warmPoolKey(hot)))
+
+	// The taken sandbox is replaced.
+	start, _ = p.plan()
+	require.Len(t, start[warmPoolKey(hot)], 2)
+
+	p.add(warmPoolKey(hot), sbx)
+	p.failed(warmPoolKey(hot))
+
+	// The build cools down and its warm sandboxes are drained.
+	p.decay()
+	p.decay()
+
+	start, stop = p.plan()
+	assert.Empty(t, start)
+	assert.Equal(t, []*sandbox.Sandbox{sbx}, stop)
+}
+
+func TestWarmPoolDisabled(t *testing.T) {
+	p := newWarmPool(0, 1, 1)
+
+	config := newWarmTestConfig("build")
+	p.record(config)
+
+	start, stop := p.plan()
+	assert.Empty(t, start)
+	assert.Empty(t, stop)
+	assert.Nil(t, p.take(config))
+}
+
+func TestWarmPoolMinHits(t *testing.T) {
+	p := newWarmPool(1, 1, 3)
+
+	config := newWarmTestConfig("build")
+	p.record(config)
+	p.record(config)
+
+	// The build is not requested often enough to be kept warm.
+	start, _ := p.plan()
+	assert.Empty(t, start)
+
+	p.record(config)
+
+	start, _ = p.plan()
+	require.Len(t, start[warmPoolKey(config)], 1)
+
+	// The starting sandbox is counted before it is ready.
+	vcpu, ramMB := p.usage()
+	assert.Equal(t, int64(2), vcpu)
+	assert.Equal(t, int64(512), ramMB)
+}
@@ -0,0 +1,17 @@
+-- Create "checkpoints" table
+CREATE TABLE "public"."checkpoints"
+(
+    id uuid not null default gen_random_uuid (),
+    created_at timestamp with time zone null default CURRENT_TIMESTAMP,
+    name text null,
+    sandbox_id text not null,
+    base_env_id text not null,
+    env_id text not null,
+    build_id uuid not null,
+    metadata jsonb null,
+    constraint checkpoints_pkey primary key (id),
+    constraint checkpoints_envs_checkpoints FOREIGN KEY ("env_id") REFERENCES "public"."envs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    constraint checkpoints_env_builds_checkpoints FOREIGN KEY ("build_id") REFERENCES "public"."env_builds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."checkpoints" ENABLE ROW LEVEL SECURITY;
+CREATE INDEX idx_checkpoints_sandbox_id ON public.checkpoints (sandbox_id);
@@ -0,0 +1,5 @@
+-- Modify "team_api_keys" table
+ALTER TABLE "public"."team_api_keys"
+    ADD COLUMN "scopes" jsonb NULL,
+    ADD COLUMN "expires_at" timestamp with time zone NULL,
+    ADD COLUMN "template_id" text NULL;
@@ -0,0 +1,34 @@
+-- Create "team_webhooks" table
+CREATE TABLE "public"."team_webhooks"
+(
+    id uuid not null default gen_random_uuid (),
+    created_at timestamp with time zone null default CURRENT_TIMESTAMP,
+    team_id uuid not null,
+    url text not null,
+    secret text not null,
+    events jsonb null,
+    constraint team_webhooks_pkey primary key (id),
+    constraint team_webhooks_teams_team_webhooks FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."team_webhooks" ENABLE ROW LEVEL SECURITY;
+CREATE INDEX idx_team_webhooks_team_id ON public.team_webhooks (team_id);
+
+-- Create "webhook_deliveries" table
+CREATE TABLE "public"."webhook_deliveries"
+(
+    id uuid not null default gen_random_uuid (),
+    created_at timestamp with time zone null default CURRENT_TIMESTAMP,
+    webhook_id uuid not null,
+    event_id uuid not null,
+    event_type text not null,
+    payload text not null,
+    status text not null default 'pending',
+    attempts integer not null default 0,
+    response_status integer null,
+    error text null,
+    delivered_at timestamp with time zone null,
+    constraint webhook_deliveries_pkey primary key (id),
+    constraint webhook_deliveries_team_webhooks_deliveries FOREIGN KEY ("webhook_id") REFERENCES "public"."team_webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."webhook_deliveries" ENABLE ROW LEVEL SECURITY;
+CREATE INDEX idx_webhook_deliveries_webhook_id_created_at ON public.webhook_deliveries (webhook_id, created_at);
@@ -0,0 +1,17 @@
+-- Modify "tiers" table
+ALTER TABLE "public"."tiers"
+    ADD COLUMN "max_vcpu" bigint NULL,
+    ADD COLUMN "max_ram_mb" bigint NULL,
+    ADD COLUMN "max_sandbox_starts_per_minute" bigint NULL,
+    ADD COLUMN "max_concurrent_builds" bigint NULL,
+    ADD COLUMN "max_snapshot_storage_mb" bigint NULL;
+-- Set comment to column: "max_vcpu" on table: "tiers"
+COMMENT ON COLUMN "public"."tiers"."max_vcpu" IS 'The total number of vCPUs the running sandboxes of the team can use, unlimited if null';
+-- Set comment to column: "max_ram_mb" on table: "tiers"
+COMMENT ON COLUMN "public"."tiers"."max_ram_mb" IS 'The total RAM in MB the running sandboxes of the team can use, unlimited if null';
+-- Set comment to column: "max_sandbox_starts_per_minute" on table: "tiers"
+COMMENT ON COLUMN "public"."tiers"."max_sandbox_starts_per_minute" IS 'The number of sandboxes the team can start per minute, unlimited if null';
+-- Set comment to column: "max_concurrent_builds" on table: "tiers"
+COMMENT ON COLUMN "public"."tiers"."max_concurrent_builds" IS 'The number of template builds the team can run concurrently, unlimited if null';
+-- Set comment to column: "max_snapshot_storage_mb" on table: "tiers"
+COMMENT ON COLUMN "public"."tiers"."max_snapshot_storage_mb" IS 'The total size in MB of the stored snapshots of the team, unlimited if null';
@@ -0,0 +1,6 @@
+-- Modify "webhook_deliveries" table
+ALTER TABLE "public"."webhook_deliveries"
+    ADD COLUMN "next_attempt_at" timestamp with time zone NULL;
+-- Set comment to column: "next_attempt_at" on table: "webhook_deliveries"
+COMMENT ON COLUMN "public"."webhook_deliveries"."next_attempt_at" IS 'The time of the next delivery attempt of the pending delivery';
+CREATE INDEX idx_webhook_deliveries_pending_next_attempt_at ON public.webhook_deliveries (next_attempt_at) WHERE status = 'pending';
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
@@ -34,29 +35,128 @@ func validateTeamUsage(team *models.Team) error {
 	return nil
 }
 
-func (db *DB) GetTeamAuth(ctx context.Context, apiKey string) (*models.Team, *models.Tier, error) {
+func (db *DB) GetTeamAuth(ctx context.Context, apiKey string) (*models.Team, *models.Tier, *models.TeamAPIKey, error) {
 	result, err := db.
 		Client.
 		TeamAPIKey.
 		Query().
-		WithTeam().
 		Where(teamapikey.APIKey(apiKey)).
-		QueryTeam().
-		WithTeamTier().
+		WithTeam(func(query *models.TeamQuery) {
+			query.WithTeamTier()
+		}).
 		Only(ctx)
 
 	if err != nil {
 		errMsg := fmt.Errorf("failed to get team from API key: %w", err)
 
-		return nil, nil, errMsg
+		return nil, nil, nil, errMsg
+	}
+
+	team := result.Edges.Team
+
+	err = validateTeamUsage(team)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return team, team.Edges.TeamTier, result, nil
+}
+
+func (db *DB) GetTeamAPIKeys(ctx context.Context, teamID uuid.UUID) ([]*models.TeamAPIKey, error) {
+	keys, err := db.
+		Client.
+		TeamAPIKey.
+		Query().
+		Where(teamapikey.TeamID(teamID)).
+		Order(models.Desc(teamapikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API keys for team '%s': %w", teamID, err)
+	}
+
+	return keys, nil
+}
+
+func (db *DB) GetTeamAPIKey(ctx context.Context, teamID uuid.UUID, keyID uuid.UUID) (*models.TeamAPIKey, error) {
+	key, err := db.
+		Client.
+		TeamAPIKey.
+		Query().
+		Where(
+			teamapikey.ID(keyID),
+			teamapikey.TeamID(teamID),
+		).
+		Only(ctx)
+
+	notFound := models.IsNotFound(err)
+
+	if notFound {
+		return nil, TeamAPIKeyNotFound{}
 	}
 
-	err = validateTeamUsage(result)
 	if err != nil {
-		return nil, nil, err
+		return nil, fmt.Errorf("failed to get API key '%s': %w", keyID, err)
 	}
 
-	return result, result.Edges.TeamTier, nil
+	return key, nil
+}
+
+func (db *DB) CreateTeamAPIKey(
+	ctx context.Context,
+	teamID uuid.UUID,
+	createdBy uuid.UUID,
+	apiKey string,
+	name *string,
+	scopes []string,
+	expiresAt *time.Time,
+	templateID *string,
+) (*models.TeamAPIKey, error) {
+	key, err := db.
+		Client.
+		TeamAPIKey.
+		Create().
+		SetTeamID(teamID).
+		SetCreatedBy(createdBy).
+		SetAPIKey(apiKey).
+		SetNillableName(name).
+		SetScopes(scopes).
+		SetNillableExpiresAt(expiresAt).
+		SetNillableTemplateID(templateID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API key for team '%s': %w", teamID, err)
+	}
+
+	return key, nil
+}
+
+// RotateTeamAPIKey replaces the value of the key, the previous value stops working immediately.
+func (db *DB) RotateTeamAPIKey(ctx context.Context, keyID uuid.UUID, apiKey string) (*models.TeamAPIKey, error) {
+	key, err := db.
+		Client.
+		TeamAPIKey.
+		UpdateOneID(keyID).
+		SetAPIKey(apiKey).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate API key '%s': %w", keyID, err)
+	}
+
+	return key, nil
+}
+
+func (db *DB) DeleteTeamAPIKey(ctx context.Context, keyID uuid.UUID) error {
+	err := db.
+		Client.
+		TeamAPIKey.
+		DeleteOneID(keyID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete API key '%s': %w", keyID, err)
+	}
+
+	return nil
 }
 
 func (db *DB) GetUserID(ctx context.Context, token string) (*uuid.UUID, error) {
@@ -19,12 +19,27 @@ func (db *DB) GetTeamByIDAndUserIDAuth(ctx context.Context, teamID string, userI
 		return nil, nil, errMsg
 	}
 
+	result, err := db.GetTeamByIDAndUserID(ctx, teamIDParsed, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = validateTeamUsage(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, result.Edges.TeamTier, nil
+}
+
+// GetTeamByIDAndUserID returns the team if the user is its member, the team is returned even if it's blocked or banned.
+func (db *DB) GetTeamByIDAndUserID(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (*models.Team, error) {
 	result, err := db.
 		Client.
 		Team.
 		Query().
 		Where(
-			team.ID(teamIDParsed),
+			team.ID(teamID),
 			team.HasUsersTeamsWith(
 				usersteams.UserID(userID),
 			),
@@ -34,13 +49,8 @@ func (db *DB) GetTeamByIDAndUserIDAuth(ctx context.Context, teamID string, userI
 	if err != nil {
 		errMsg := fmt.Errorf("failed to get team from teamID and userID key: %w", err)
 
-		return nil, nil, errMsg
+		return nil, errMsg
 	}
 
-	err = validateTeamUsage(result)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return result, result.Edges.TeamTier, nil
+	return result, nil
 }
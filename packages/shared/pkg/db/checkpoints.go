@@ -0,0 +1,257 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func (db *DB) CreateCheckpoint(
+	ctx context.Context,
+	name *string,
+	sandboxID string,
+	baseTemplateID string,
+	metadata map[string]string,
+	build *models.EnvBuild,
+) (*models.Checkpoint, error) {
+	c, err := db.
+		Client.
+		Checkpoint.
+		Create().
+		SetNillableName(name).
+		SetSandboxID(sandboxID).
+		SetBaseEnvID(baseTemplateID).
+		SetEnvID(*build.EnvID).
+		SetBuildID(build.ID).
+		SetMetadata(metadata).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint for '%s': %w", sandboxID, err)
+	}
+
+	return c, nil
+}
+
+func (db *DB) GetCheckpoints(ctx context.Context, sandboxID string, teamID uuid.UUID) ([]*models.Checkpoint, error) {
+	checkpoints, err := db.
+		Client.
+		Checkpoint.
+		Query().
+		Where(
+			checkpoint.SandboxID(sandboxID),
+			checkpoint.HasEnvWith(env.TeamID(teamID)),
+		).
+		Order(models.Desc(checkpoint.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoints for '%s': %w", sandboxID, err)
+	}
+
+	return checkpoints, nil
+}
+
+func (db *DB) GetCheckpoint(ctx context.Context, checkpointID uuid.UUID, teamID uuid.UUID) (*models.Checkpoint, error) {
+	c, err := db.
+		Client.
+		Checkpoint.
+		Query().
+		Where(
+			checkpoint.ID(checkpointID),
+			checkpoint.HasEnvWith(env.TeamID(teamID)),
+		).
+		WithBuild().
+		Only(ctx)
+
+	notFound := models.IsNotFound(err)
+
+	if notFound {
+		return nil, CheckpointNotFound{}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint '%s': %w", checkpointID, err)
+	}
+
+	return c, nil
+}
+
+// DeleteCheckpoint deletes the checkpoint and marks its build as deleted if the build is not used anymore.
+// The build is still used if it is referenced by another checkpoint or if it is the last snapshot of the sandbox.
+// Files of the deleted builds are removed by the garbage collection.
+func (db *DB) DeleteCheckpoint(ctx context.Context, checkpointID uuid.UUID, teamID uuid.UUID) error {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	c, err := tx.
+		Checkpoint.
+		Query().
+		Where(
+			checkpoint.ID(checkpointID),
+			checkpoint.HasEnvWith(env.TeamID(teamID)),
+		).
+		Only(ctx)
+
+	notFound := models.IsNotFound(err)
+
+	if notFound {
+		return CheckpointNotFound{}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get checkpoint '%s': %w", checkpointID, err)
+	}
+
+	err = tx.Checkpoint.DeleteOne(c).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete checkpoint '%s': %w", checkpointID, err)
+	}
+
+	referenced, err := tx.
+		Checkpoint.
+		Query().
+		Where(checkpoint.BuildID(c.BuildID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check checkpoints of build '%s': %w", c.BuildID, err)
+	}
+
+	lastBuild, err := tx.
+		EnvBuild.
+		Query().
+		Where(envbuild.StatusEQ(envbuild.StatusSuccess), envbuild.EnvID(c.EnvID)).
+		Order(models.Desc(envbuild.FieldFinishedAt)).
+		First(ctx)
+	if err != nil && !models.IsNotFound(err) {
+		return fmt.Errorf("failed to get last build of '%s': %w", c.EnvID, err)
+	}
+
+	isLastBuild := lastBuild != nil && lastBuild.ID == c.BuildID
+
+	if !referenced && !isLastBuild {
+		err = tx.
+			EnvBuild.
+			UpdateOneID(c.BuildID).
+			SetStatus(envbuild.StatusDeleted).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to mark build '%s' as deleted: %w", c.BuildID, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeletedBuilds returns up to limit builds marked as deleted before the given time, ordered by ID.
+// Only the builds with ID greater than after are returned if it is set, so the builds can be processed in batches.
+func (db *DB) GetDeletedBuilds(ctx context.Context, deletedBefore time.Time, after *uuid.UUID, limit int) ([]uuid.UUID, error) {
+	query := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.StatusEQ(envbuild.StatusDeleted),
+			envbuild.UpdatedAtLT(deletedBefore),
+		)
+
+	if after != nil {
+		query = query.Where(envbuild.IDGT(*after))
+	}
+
+	ids, err := query.
+		Order(models.Asc(envbuild.FieldID)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted builds: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetLiveSnapshotBuilds returns the snapshot builds that can still be used to start a sandbox.
+// The template builds are skipped, they are not derived from any other build.
+func (db *DB) GetLiveSnapshotBuilds(ctx context.Context) ([]uuid.UUID, error) {
+	ids, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.StatusIn(envbuild.StatusSuccess, envbuild.StatusUploaded),
+			envbuild.DockerfileIsNil(),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get live builds: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetSnapshotBaseBuilds returns the deleted builds of the envs with a snapshot build created after the given time that is still building.
+// The sandbox being paused was started from one of them, so the header of the new snapshot can reference its layers.
+func (db *DB) GetSnapshotBaseBuilds(ctx context.Context, createdAfter time.Time) ([]uuid.UUID, error) {
+	ids, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.StatusEQ(envbuild.StatusDeleted),
+			envbuild.HasEnvWith(env.HasBuildsWith(
+				envbuild.StatusEQ(envbuild.StatusBuilding),
+				envbuild.DockerfileIsNil(),
+				envbuild.CreatedAtGT(createdAfter),
+			)),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot base builds: %w", err)
+	}
+
+	return ids, nil
+}
+
+// MarkBuildDeleted marks the build as deleted, its files are removed by the garbage collection once no live or running build references them.
+func (db *DB) MarkBuildDeleted(ctx context.Context, buildID uuid.UUID) error {
+	err := db.
+		Client.
+		EnvBuild.
+		UpdateOneID(buildID).
+		SetStatus(envbuild.StatusDeleted).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark build '%s' as deleted: %w", buildID, err)
+	}
+
+	return nil
+}
+
+func (db *DB) DeleteEnvBuilds(ctx context.Context, buildIDs []uuid.UUID) error {
+	_, err := db.
+		Client.
+		EnvBuild.
+		Delete().
+		Where(envbuild.IDIn(buildIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete builds: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func TestGarbageCollectionBuilds(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	template := database.Client.Env.Create().SetID("template").SetTeamID(team.ID).SetPublic(false).SaveX(ctx)
+	database.Client.EnvBuild.Create().
+		SetEnv(template).
+		SetStatus(envbuild.StatusSuccess).
+		SetDockerfile("FROM ubuntu").
+		SetVcpu(2).
+		SetRAMMB(512).
+		SetFreeDiskSizeMB(512).
+		SaveX(ctx)
+
+	snapshot, err := database.NewSnapshotBuild(ctx, &db.SnapshotInfo{SandboxID: "sbx", BaseTemplateID: "template"}, team.ID)
+	require.NoError(t, err)
+	require.NoError(t, database.EnvBuildSetStatus(ctx, *snapshot.EnvID, snapshot.ID, envbuild.StatusSuccess))
+
+	live, err := database.GetLiveSnapshotBuilds(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{snapshot.ID}, live)
+
+	deleted := make([]uuid.UUID, 0)
+	for range 5 {
+		build, err := database.NewForkBuild(ctx, &db.SnapshotInfo{SandboxID: "sbx", BaseTemplateID: "template"}, team.ID)
+		require.NoError(t, err)
+		require.NoError(t, database.EnvBuildSetStatus(ctx, *build.EnvID, build.ID, envbuild.StatusDeleted))
+
+		deleted = append(deleted, build.ID)
+	}
+
+	var (
+		after *uuid.UUID
+		seen  []uuid.UUID
+	)
+
+	for {
+		batch, err := database.GetDeletedBuilds(ctx, time.Now().Add(time.Minute), after, 2)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(batch), 2)
+
+		seen = append(seen, batch...)
+
+		if len(batch) < 2 {
+			break
+		}
+
+		after = &batch[len(batch)-1]
+	}
+
+	assert.ElementsMatch(t, deleted, seen)
+
+	// The builds deleted after the grace period are not candidates yet.
+	recent, err := database.GetDeletedBuilds(ctx, time.Now().Add(-time.Minute), nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, recent)
+}
+
+func TestSnapshotBaseBuilds(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	database.Client.Env.Create().SetID("template").SetTeamID(team.ID).SetPublic(false).SaveX(ctx)
+
+	config := &db.SnapshotInfo{SandboxID: "sbx", BaseTemplateID: "template"}
+
+	base, err := database.NewSnapshotBuild(ctx, config, team.ID)
+	require.NoError(t, err)
+	require.NoError(t, database.EnvBuildSetStatus(ctx, *base.EnvID, base.ID, envbuild.StatusDeleted))
+
+	bases, err := database.GetSnapshotBaseBuilds(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, bases)
+
+	// The sandbox resumed from the base build is being paused again.
+	building, err := database.NewSnapshotBuild(ctx, config, team.ID)
+	require.NoError(t, err)
+	require.Equal(t, *base.EnvID, *building.EnvID)
+
+	bases, err = database.GetSnapshotBaseBuilds(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{base.ID}, bases)
+
+	// The builds stuck in building are failed pauses.
+	bases, err = database.GetSnapshotBaseBuilds(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, bases)
+
+	require.NoError(t, database.EnvBuildSetStatus(ctx, *building.EnvID, building.ID, envbuild.StatusSuccess))
+
+	bases, err = database.GetSnapshotBaseBuilds(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, bases)
+}
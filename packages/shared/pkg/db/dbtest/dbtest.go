@@ -0,0 +1,118 @@
+// Package dbtest provides an in-memory database with the schema of the models for the tests.
+package dbtest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const driverName = "sqlite3_dbtest"
+
+var registerDriver sync.Once
+
+// New returns a client of a new empty database that is closed when the test finishes.
+func New(t testing.TB) *db.DB {
+	t.Helper()
+
+	// The schema uses the postgres function for generating the default IDs.
+	registerDriver.Do(func() {
+		sql.Register(driverName, &sqlite3.SQLiteDriver{
+			ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+				return conn.RegisterFunc("gen_random_uuid", uuid.NewString, false)
+			},
+		})
+	})
+
+	conn, err := sql.Open(driverName, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString()))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+
+	// The connections to the shared in-memory database fail on the locked tables instead of waiting, so the queries are serialized.
+	conn.SetMaxOpenConns(1)
+
+	client := models.NewClient(models.Driver(entsql.OpenDB(dialect.SQLite, conn)))
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	err = client.Schema.Create(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	return &db.DB{Client: client}
+}
+
+// CreateTeam creates a team with a tier that has the given concurrent instances limit.
+func CreateTeam(t testing.TB, database *db.DB, concurrentInstances int64) (*models.Team, *models.Tier) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	tier, err := database.Client.Tier.
+		Create().
+		SetID(uuid.NewString()).
+		SetName("test").
+		SetDiskMB(512).
+		SetConcurrentInstances(concurrentInstances).
+		SetMaxLengthHours(1).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create tier: %v", err)
+	}
+
+	team, err := database.Client.Team.
+		Create().
+		SetID(uuid.New()).
+		SetName("test").
+		SetEmail("test@e2b.dev").
+		SetTeamTier(tier).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create team: %v", err)
+	}
+
+	team.Edges.TeamTier = tier
+
+	return team, tier
+}
+
+// CreateUser creates a user that is a member of the team.
+func CreateUser(t testing.TB, database *db.DB, teamID uuid.UUID) *models.User {
+	t.Helper()
+
+	ctx := context.Background()
+
+	user, err := database.Client.User.
+		Create().
+		SetID(uuid.New()).
+		SetEmail("user@e2b.dev").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	err = database.Client.UsersTeams.
+		Create().
+		SetUserID(user.ID).
+		SetTeamID(teamID).
+		SetIsDefault(true).
+		Exec(ctx)
+	if err != nil {
+		t.Fatalf("failed to add user to team: %v", err)
+	}
+
+	return user
+}
@@ -42,6 +42,29 @@ func (db *DB) reserveEnvAlias(ctx context.Context, envID, alias string) error {
 	return nil
 }
 
+// GetEnvAliases returns the first alias of each of the envs, the envs without an alias are skipped.
+func (db *DB) GetEnvAliases(ctx context.Context, envIDs []string) (map[string]string, error) {
+	aliases, err := db.
+		Client.
+		EnvAlias.
+		Query().
+		Where(envalias.EnvIDIn(envIDs...)).
+		Order(models.Asc(envalias.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get env aliases: %w", err)
+	}
+
+	result := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		if _, ok := result[alias.EnvID]; !ok {
+			result[alias.EnvID] = alias.ID
+		}
+	}
+
+	return result, nil
+}
+
 // rollback calls to tx.Rollback and wraps the given error
 // with the rollback error if occurred.
 func rollback(tx *models.Tx, err error) error {
@@ -1,5 +1,7 @@
 package db
 
+import "fmt"
+
 type ErrNotFound error
 type TemplateNotFound struct{ ErrNotFound }
 
@@ -24,3 +26,27 @@ type EnvNotFound struct{ ErrNotFound }
 func (EnvNotFound) Error() string {
 	return "Env not found"
 }
+
+type CheckpointNotFound struct{ ErrNotFound }
+
+func (CheckpointNotFound) Error() string {
+	return "Checkpoint not found"
+}
+
+type TeamAPIKeyNotFound struct{ ErrNotFound }
+
+func (TeamAPIKeyNotFound) Error() string {
+	return "Team API key not found"
+}
+
+type TeamWebhookNotFound struct{ ErrNotFound }
+
+func (TeamWebhookNotFound) Error() string {
+	return "Team webhook not found"
+}
+
+type TemplateBuildLimitExceeded struct{ Limit int64 }
+
+func (e TemplateBuildLimitExceeded) Error() string {
+	return fmt.Sprintf("maximum number of concurrent template builds (%d) reached", e.Limit)
+}
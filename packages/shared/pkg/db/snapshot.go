@@ -3,12 +3,16 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 )
@@ -120,6 +124,56 @@ func (db *DB) NewSnapshotBuild(
 	return b, nil
 }
 
+// NewForkBuild creates a new env and env build for a snapshot of a running sandbox.
+// No snapshot record is created, so the source sandbox is not considered paused.
+func (db *DB) NewForkBuild(
+	ctx context.Context,
+	snapshotConfig *SnapshotInfo,
+	teamID uuid.UUID,
+) (*models.EnvBuild, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	e, err := tx.
+		Env.
+		Create().
+		SetPublic(false).
+		SetNillableCreatedBy(nil).
+		SetTeamID(teamID).
+		SetID(id.Generate()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create env for fork of '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	b, err := tx.
+		EnvBuild.
+		Create().
+		SetEnv(e).
+		SetVcpu(snapshotConfig.VCPU).
+		SetRAMMB(snapshotConfig.RAMMB).
+		SetFreeDiskSizeMB(0).
+		SetKernelVersion(snapshotConfig.KernelVersion).
+		SetFirecrackerVersion(snapshotConfig.FirecrackerVersion).
+		SetEnvdVersion(snapshotConfig.EnvdVersion).
+		SetStatus(envbuild.StatusBuilding).
+		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create env build for fork of '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return b, nil
+}
+
 func (db *DB) GetLastSnapshot(ctx context.Context, sandboxID string, teamID uuid.UUID) (
 	*models.Snapshot,
 	*models.EnvBuild,
@@ -197,3 +251,265 @@ func (db *DB) GetSnapshotBuilds(ctx context.Context, sandboxID string, teamID uu
 
 	return e, e.Edges.Builds, nil
 }
+
+type SnapshotBuild struct {
+	SandboxID string
+	Snapshot  *models.Snapshot
+	Build     *models.EnvBuild
+}
+
+// lastSnapshotBuilds returns the last successful build of every snapshot, the snapshots without one are skipped.
+// The snapshots have to be loaded with the env and its successful builds.
+func lastSnapshotBuilds(snapshots []*models.Snapshot) []SnapshotBuild {
+	builds := make([]SnapshotBuild, 0, len(snapshots))
+
+	for _, s := range snapshots {
+		if s.Edges.Env == nil {
+			continue
+		}
+
+		var last *models.EnvBuild
+
+		for _, b := range s.Edges.Env.Edges.Builds {
+			if b.FinishedAt == nil {
+				continue
+			}
+
+			if last == nil || b.FinishedAt.After(*last.FinishedAt) {
+				last = b
+			}
+		}
+
+		if last == nil {
+			continue
+		}
+
+		builds = append(builds, SnapshotBuild{
+			SandboxID: s.SandboxID,
+			Snapshot:  s,
+			Build:     last,
+		})
+	}
+
+	return builds
+}
+
+func withSuccessfulBuilds(query *models.EnvQuery) {
+	query.WithBuilds(func(query *models.EnvBuildQuery) {
+		query.Where(envbuild.StatusEQ(envbuild.StatusSuccess))
+	})
+}
+
+// GetLastSnapshotBuilds returns the last successful build of every snapshot.
+func (db *DB) GetLastSnapshotBuilds(ctx context.Context) ([]SnapshotBuild, error) {
+	snapshots, err := db.
+		Client.
+		Snapshot.
+		Query().
+		WithEnv(withSuccessfulBuilds).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshots: %w", err)
+	}
+
+	return lastSnapshotBuilds(snapshots), nil
+}
+
+// GetTeamSnapshotBuilds returns the last successful build of every snapshot of the team.
+func (db *DB) GetTeamSnapshotBuilds(ctx context.Context, teamID uuid.UUID) ([]SnapshotBuild, error) {
+	snapshots, err := db.
+		Client.
+		Snapshot.
+		Query().
+		Where(snapshot.HasEnvWith(env.TeamID(teamID))).
+		WithEnv(withSuccessfulBuilds).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshots for team '%s': %w", teamID, err)
+	}
+
+	return lastSnapshotBuilds(snapshots), nil
+}
+
+// TeamSnapshotBuildsFilter selects a page of the snapshots of the team ordered by the finish time of their last build and the sandbox ID.
+type TeamSnapshotBuildsFilter struct {
+	// ExcludeSandboxIDs are skipped, the snapshot is kept after the sandbox is resumed.
+	ExcludeSandboxIDs []string
+	// Template matches the base template ID or one of its aliases.
+	Template *string
+	Metadata map[string]string
+	// AfterFinishedAt and AfterSandboxID are the sort key of the last snapshot of the previous page.
+	AfterFinishedAt *time.Time
+	AfterSandboxID  string
+	Desc            bool
+	// Limit is the maximum number of the returned snapshots, 0 returns all of them.
+	Limit int
+}
+
+// lastBuildTable is the alias of the last successful build of the snapshot joined to the snapshots.
+const lastBuildTable = "last_build"
+
+// joinLastSuccessfulBuild joins the last successful build of the snapshot env, the snapshots without one are skipped.
+func joinLastSuccessfulBuild(s *sql.Selector) {
+	build := sql.Table(envbuild.Table).As(lastBuildTable)
+	newer := sql.Table(envbuild.Table).As("newer_build")
+
+	s.Join(build).On(s.C(snapshot.FieldEnvID), build.C(envbuild.FieldEnvID))
+	s.Where(sql.And(
+		sql.EQ(build.C(envbuild.FieldStatus), envbuild.StatusSuccess),
+		sql.NotNull(build.C(envbuild.FieldFinishedAt)),
+		sql.NotExists(
+			sql.Select(newer.C(envbuild.FieldID)).
+				From(newer).
+				Where(sql.And(
+					sql.ColumnsEQ(newer.C(envbuild.FieldEnvID), build.C(envbuild.FieldEnvID)),
+					sql.EQ(newer.C(envbuild.FieldStatus), envbuild.StatusSuccess),
+					sql.Or(
+						sql.ColumnsGT(newer.C(envbuild.FieldFinishedAt), build.C(envbuild.FieldFinishedAt)),
+						sql.And(
+							sql.ColumnsEQ(newer.C(envbuild.FieldFinishedAt), build.C(envbuild.FieldFinishedAt)),
+							sql.ColumnsGT(newer.C(envbuild.FieldID), build.C(envbuild.FieldID)),
+						),
+					),
+				)),
+		),
+	))
+}
+
+// GetTeamSnapshotBuildsPage returns the last successful build of the snapshots of the team selected by the filter.
+func (db *DB) GetTeamSnapshotBuildsPage(ctx context.Context, teamID uuid.UUID, filter TeamSnapshotBuildsFilter) ([]SnapshotBuild, error) {
+	finishedAt := sql.Table(lastBuildTable).C(envbuild.FieldFinishedAt)
+
+	query := db.
+		Client.
+		Snapshot.
+		Query().
+		Where(
+			snapshot.HasEnvWith(env.TeamID(teamID)),
+			snapshot.SandboxIDNotIn(filter.ExcludeSandboxIDs...),
+			joinLastSuccessfulBuild,
+		)
+
+	if filter.Template != nil {
+		query = query.Where(snapshot.Or(
+			snapshot.BaseEnvID(*filter.Template),
+			func(s *sql.Selector) {
+				aliases := sql.Table(envalias.Table)
+				s.Where(sql.In(
+					s.C(snapshot.FieldBaseEnvID),
+					sql.Select(aliases.C(envalias.FieldEnvID)).From(aliases).Where(sql.EQ(aliases.C(envalias.FieldID), *filter.Template)),
+				))
+			},
+		))
+	}
+
+	for key, value := range filter.Metadata {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(snapshot.FieldMetadata, value, sqljson.Path(key)))
+		})
+	}
+
+	if filter.AfterFinishedAt != nil {
+		after := *filter.AfterFinishedAt
+
+		query = query.Where(func(s *sql.Selector) {
+			timeAfter, idAfter := sql.GT(finishedAt, after), sql.GT(s.C(snapshot.FieldSandboxID), filter.AfterSandboxID)
+			if filter.Desc {
+				timeAfter, idAfter = sql.LT(finishedAt, after), sql.LT(s.C(snapshot.FieldSandboxID), filter.AfterSandboxID)
+			}
+
+			s.Where(sql.Or(timeAfter, sql.And(sql.EQ(finishedAt, after), idAfter)))
+		})
+	}
+
+	if filter.Desc {
+		query = query.Order(func(s *sql.Selector) { s.OrderBy(sql.Desc(finishedAt)) }, snapshot.BySandboxID(sql.OrderDesc()))
+	} else {
+		query = query.Order(func(s *sql.Selector) { s.OrderBy(sql.Asc(finishedAt)) }, snapshot.BySandboxID())
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	snapshots, err := query.WithEnv(withSuccessfulBuilds).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshots for team '%s': %w", teamID, err)
+	}
+
+	return lastSnapshotBuilds(snapshots), nil
+}
+
+// ReplaceSnapshotBuild creates a new successful build with the configuration of the given build, so it becomes the last snapshot of the sandbox.
+// The previous builds of the snapshot that are not used by checkpoints are marked as deleted.
+// It fails if the given build is not the last snapshot anymore, e.g. because the sandbox was paused again in the meantime.
+func (db *DB) ReplaceSnapshotBuild(ctx context.Context, build *models.EnvBuild, newBuildID uuid.UUID) error {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The builds are locked, so a concurrent compaction of the same snapshot waits for this one and fails the check.
+	builds, err := tx.
+		EnvBuild.
+		Query().
+		Where(envbuild.StatusEQ(envbuild.StatusSuccess), envbuild.EnvID(*build.EnvID)).
+		Order(models.Desc(envbuild.FieldFinishedAt)).
+		Modify(lockForUpdate).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get builds of '%s': %w", *build.EnvID, err)
+	}
+
+	if len(builds) == 0 || builds[0].ID != build.ID {
+		return fmt.Errorf("build '%s' is not the last build of '%s' anymore", build.ID, *build.EnvID)
+	}
+
+	// Only the builds seen by the check are replaced, a snapshot finished in the meantime is newer than the given build.
+	replaced := make([]uuid.UUID, 0, len(builds))
+	for _, b := range builds {
+		replaced = append(replaced, b.ID)
+	}
+
+	_, err = tx.
+		EnvBuild.
+		Create().
+		SetID(newBuildID).
+		SetEnvID(*build.EnvID).
+		SetVcpu(build.Vcpu).
+		SetRAMMB(build.RAMMB).
+		SetFreeDiskSizeMB(build.FreeDiskSizeMB).
+		SetNillableTotalDiskSizeMB(build.TotalDiskSizeMB).
+		SetKernelVersion(build.KernelVersion).
+		SetFirecrackerVersion(build.FirecrackerVersion).
+		SetNillableEnvdVersion(build.EnvdVersion).
+		SetStatus(envbuild.StatusSuccess).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create compacted build for '%s': %w", build.ID, err)
+	}
+
+	err = tx.
+		EnvBuild.
+		Update().
+		Where(
+			envbuild.IDIn(replaced...),
+			envbuild.StatusEQ(envbuild.StatusSuccess),
+			envbuild.Not(envbuild.HasCheckpoints()),
+		).
+		SetStatus(envbuild.StatusDeleted).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark replaced builds of '%s' as deleted: %w", *build.EnvID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func TestReplaceSnapshotBuild(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	template := database.Client.Env.Create().SetID("template").SetTeamID(team.ID).SetPublic(false).SaveX(ctx)
+	createTemplateBuild(t, database, template, envbuild.StatusSuccess)
+
+	config := &db.SnapshotInfo{SandboxID: "sbx", BaseTemplateID: "template", RAMMB: 512, TotalDiskSizeMB: 1024}
+
+	builds := make([]*models.EnvBuild, 0, 3)
+	for i := range 3 {
+		build, err := database.NewSnapshotBuild(ctx, config, team.ID)
+		require.NoError(t, err)
+
+		// The builds are finished in different seconds, the times are stored with the second precision in the tests.
+		build = database.Client.EnvBuild.UpdateOne(build).
+			SetStatus(envbuild.StatusSuccess).
+			SetFinishedAt(time.Now().Add(time.Duration(i-3) * time.Minute)).
+			SaveX(ctx)
+
+		builds = append(builds, build)
+	}
+
+	// The sandbox was paused again after the compaction of the older build started.
+	err := database.ReplaceSnapshotBuild(ctx, builds[1], uuid.New())
+	require.Error(t, err)
+
+	newBuildID := uuid.New()
+	err = database.ReplaceSnapshotBuild(ctx, builds[2], newBuildID)
+	require.NoError(t, err)
+
+	for _, build := range builds {
+		assert.Equal(t, envbuild.StatusDeleted, database.Client.EnvBuild.GetX(ctx, build.ID).Status)
+	}
+
+	replacement := database.Client.EnvBuild.GetX(ctx, newBuildID)
+	assert.Equal(t, envbuild.StatusSuccess, replacement.Status)
+	assert.Equal(t, *builds[2].EnvID, *replacement.EnvID)
+}
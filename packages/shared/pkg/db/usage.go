@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+)
+
+// GetTeamRunningTemplateBuildsCount returns the number of template builds of the team that are currently building.
+// The snapshot builds are not counted, they don't have a dockerfile.
+func (db *DB) GetTeamRunningTemplateBuildsCount(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	count, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.StatusEQ(envbuild.StatusBuilding),
+			envbuild.DockerfileNotNil(),
+			envbuild.HasEnvWith(env.TeamID(teamID)),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count running template builds of team '%s': %w", teamID, err)
+	}
+
+	return int64(count), nil
+}
+
+// StartTemplateBuild sets the status of the waiting template build to building if the team has less than maxBuilds builds running.
+// The team row is locked while checking the running builds, so the concurrent starts of the team's builds can't exceed the limit.
+func (db *DB) StartTemplateBuild(ctx context.Context, teamID uuid.UUID, envID string, buildID uuid.UUID, maxBuilds *int64) error {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if maxBuilds != nil {
+		_, err = tx.
+			Team.
+			Query().
+			Where(team.ID(teamID)).
+			Modify(lockForUpdate).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to lock team '%s': %w", teamID, err)
+		}
+
+		running, err := tx.
+			EnvBuild.
+			Query().
+			Where(
+				envbuild.StatusEQ(envbuild.StatusBuilding),
+				envbuild.DockerfileNotNil(),
+				envbuild.HasEnvWith(env.TeamID(teamID)),
+			).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count running template builds of team '%s': %w", teamID, err)
+		}
+
+		if int64(running) >= *maxBuilds {
+			return TemplateBuildLimitExceeded{Limit: *maxBuilds}
+		}
+	}
+
+	err = tx.
+		EnvBuild.
+		Update().
+		Where(
+			envbuild.ID(buildID),
+			envbuild.EnvID(envID),
+		).
+		SetStatus(envbuild.StatusBuilding).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set template build status %s for '%s': %w", envbuild.StatusBuilding, buildID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// lockForUpdate locks the selected rows until the end of the transaction, SQLite used in the tests locks the whole database on writes instead.
+func lockForUpdate(s *sql.Selector) {
+	if s.Dialect() == dialect.Postgres {
+		s.ForUpdate()
+	}
+}
+
+// GetTeamSnapshotStorageMB returns the size of the stored snapshots and checkpoints of the team.
+// Only the last build of every snapshot is counted, the sandbox is resumed from it and the older builds are replaced by it.
+// The builds of the checkpoints are kept until the checkpoint is deleted, so they are counted too.
+// The fork builds are counted until their files are collected, they are marked as deleted once the forked sandboxes start.
+// The size of each build is the size of its memory and its whole disk, so it's an upper bound of the stored diffs.
+func (db *DB) GetTeamSnapshotStorageMB(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	snapshots, err := db.GetTeamSnapshotBuilds(ctx, teamID)
+	if err != nil {
+		return 0, err
+	}
+
+	checkpointBuilds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.StatusIn(envbuild.StatusSuccess, envbuild.StatusUploaded),
+			envbuild.HasCheckpoints(),
+			envbuild.HasEnvWith(env.TeamID(teamID)),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get checkpoint builds of team '%s': %w", teamID, err)
+	}
+
+	// The fork builds belong to the envs without a snapshot, their rows are removed when the garbage collection deletes their files.
+	forkBuilds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.StatusIn(envbuild.StatusBuilding, envbuild.StatusSuccess, envbuild.StatusUploaded, envbuild.StatusDeleted),
+			envbuild.DockerfileIsNil(),
+			envbuild.HasEnvWith(env.TeamID(teamID), env.Not(env.HasSnapshots())),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get fork builds of team '%s': %w", teamID, err)
+	}
+
+	builds := make(map[uuid.UUID]int64, len(snapshots)+len(checkpointBuilds)+len(forkBuilds))
+	for _, s := range snapshots {
+		builds[s.Build.ID] = buildSizeMB(s.Build.RAMMB, s.Build.TotalDiskSizeMB)
+	}
+
+	for _, b := range checkpointBuilds {
+		builds[b.ID] = buildSizeMB(b.RAMMB, b.TotalDiskSizeMB)
+	}
+
+	for _, b := range forkBuilds {
+		builds[b.ID] = buildSizeMB(b.RAMMB, b.TotalDiskSizeMB)
+	}
+
+	var total int64
+	for _, size := range builds {
+		total += size
+	}
+
+	return total, nil
+}
+
+func buildSizeMB(ramMB int64, totalDiskSizeMB *int64) int64 {
+	if totalDiskSizeMB == nil {
+		return ramMB
+	}
+
+	return ramMB + *totalDiskSizeMB
+}
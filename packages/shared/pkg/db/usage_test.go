@@ -0,0 +1,114 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db/dbtest"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func createTemplateBuild(t *testing.T, database *db.DB, template *models.Env, status envbuild.Status) *models.EnvBuild {
+	t.Helper()
+
+	return database.Client.EnvBuild.Create().
+		SetEnv(template).
+		SetStatus(status).
+		SetDockerfile("FROM ubuntu").
+		SetVcpu(2).
+		SetRAMMB(512).
+		SetFreeDiskSizeMB(512).
+		SaveX(context.Background())
+}
+
+func TestStartTemplateBuildLimit(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	template := database.Client.Env.Create().SetID("template").SetTeamID(team.ID).SetPublic(false).SaveX(ctx)
+	first := createTemplateBuild(t, database, template, envbuild.StatusWaiting)
+	second := createTemplateBuild(t, database, template, envbuild.StatusWaiting)
+
+	maxBuilds := int64(1)
+	require.NoError(t, database.StartTemplateBuild(ctx, team.ID, template.ID, first.ID, &maxBuilds))
+
+	err := database.StartTemplateBuild(ctx, team.ID, template.ID, second.ID, &maxBuilds)
+	assert.ErrorAs(t, err, &db.TemplateBuildLimitExceeded{})
+	assert.Equal(t, envbuild.StatusWaiting, database.Client.EnvBuild.GetX(ctx, second.ID).Status)
+
+	require.NoError(t, database.EnvBuildSetStatus(ctx, template.ID, first.ID, envbuild.StatusFailed))
+	require.NoError(t, database.StartTemplateBuild(ctx, team.ID, template.ID, second.ID, &maxBuilds))
+	assert.Equal(t, envbuild.StatusBuilding, database.Client.EnvBuild.GetX(ctx, second.ID).Status)
+
+	third := createTemplateBuild(t, database, template, envbuild.StatusWaiting)
+	require.NoError(t, database.StartTemplateBuild(ctx, team.ID, template.ID, third.ID, nil))
+
+	running, err := database.GetTeamRunningTemplateBuildsCount(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), running)
+}
+
+func TestGetTeamSnapshotStorageMB(t *testing.T) {
+	ctx := context.Background()
+	database := dbtest.New(t)
+	team, _ := dbtest.CreateTeam(t, database, 10)
+
+	template := database.Client.Env.Create().SetID("template").SetTeamID(team.ID).SetPublic(false).SaveX(ctx)
+	createTemplateBuild(t, database, template, envbuild.StatusSuccess)
+
+	config := &db.SnapshotInfo{SandboxID: "sbx", BaseTemplateID: "template", RAMMB: 512, TotalDiskSizeMB: 1024}
+
+	// Pausing the sandbox again replaces the previous snapshot, only the last one is counted.
+	var last *models.EnvBuild
+	for range 3 {
+		build, err := database.NewSnapshotBuild(ctx, config, team.ID)
+		require.NoError(t, err)
+		require.NoError(t, database.EnvBuildSetStatus(ctx, *build.EnvID, build.ID, envbuild.StatusSuccess))
+
+		last = build
+	}
+
+	storage, err := database.GetTeamSnapshotStorageMB(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(512+1024), storage)
+
+	// The checkpoint of the last snapshot is not counted twice.
+	_, err = database.CreateCheckpoint(ctx, nil, "sbx", "template", nil, last)
+	require.NoError(t, err)
+
+	checkpoint, err := database.NewForkBuild(ctx, &db.SnapshotInfo{SandboxID: "sbx", BaseTemplateID: "template", RAMMB: 256, TotalDiskSizeMB: 512}, team.ID)
+	require.NoError(t, err)
+	require.NoError(t, database.EnvBuildSetStatus(ctx, *checkpoint.EnvID, checkpoint.ID, envbuild.StatusSuccess))
+	_, err = database.CreateCheckpoint(ctx, nil, "sbx", "template", nil, checkpoint)
+	require.NoError(t, err)
+
+	storage, err = database.GetTeamSnapshotStorageMB(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(512+1024+256+512), storage)
+
+	// The fork build is marked as deleted right after the fork, it's counted until its files are collected.
+	fork, err := database.NewForkBuild(ctx, &db.SnapshotInfo{SandboxID: "sbx", BaseTemplateID: "template", RAMMB: 128, TotalDiskSizeMB: 256}, team.ID)
+	require.NoError(t, err)
+	require.NoError(t, database.MarkBuildDeleted(ctx, fork.ID))
+
+	storage, err = database.GetTeamSnapshotStorageMB(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(512+1024+256+512+128+256), storage)
+
+	require.NoError(t, database.DeleteEnvBuilds(ctx, []uuid.UUID{fork.ID}))
+
+	storage, err = database.GetTeamSnapshotStorageMB(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(512+1024+256+512), storage)
+
+	other, err := database.GetTeamSnapshotStorageMB(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Zero(t, other)
+}
@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+)
+
+func (db *DB) GetTeamWebhooks(ctx context.Context, teamID uuid.UUID) ([]*models.TeamWebhook, error) {
+	webhooks, err := db.
+		Client.
+		TeamWebhook.
+		Query().
+		Where(teamwebhook.TeamID(teamID)).
+		Order(models.Desc(teamwebhook.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks for team '%s': %w", teamID, err)
+	}
+
+	return webhooks, nil
+}
+
+func (db *DB) GetTeamWebhook(ctx context.Context, teamID uuid.UUID, webhookID uuid.UUID) (*models.TeamWebhook, error) {
+	webhook, err := db.
+		Client.
+		TeamWebhook.
+		Query().
+		Where(
+			teamwebhook.ID(webhookID),
+			teamwebhook.TeamID(teamID),
+		).
+		Only(ctx)
+
+	notFound := models.IsNotFound(err)
+
+	if notFound {
+		return nil, TeamWebhookNotFound{}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook '%s': %w", webhookID, err)
+	}
+
+	return webhook, nil
+}
+
+func (db *DB) CreateTeamWebhook(ctx context.Context, teamID uuid.UUID, url string, secret string, events []string) (*models.TeamWebhook, error) {
+	webhook, err := db.
+		Client.
+		TeamWebhook.
+		Create().
+		SetTeamID(teamID).
+		SetURL(url).
+		SetSecret(secret).
+		SetEvents(events).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook for team '%s': %w", teamID, err)
+	}
+
+	return webhook, nil
+}
+
+func (db *DB) DeleteTeamWebhook(ctx context.Context, webhookID uuid.UUID) error {
+	err := db.
+		Client.
+		TeamWebhook.
+		DeleteOneID(webhookID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook '%s': %w", webhookID, err)
+	}
+
+	return nil
+}
+
+// CreateWebhookDelivery creates the pending delivery of the event, nextAttemptAt is the time when the delivery can be claimed by ClaimWebhookDeliveries.
+func (db *DB) CreateWebhookDelivery(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	eventID uuid.UUID,
+	eventType string,
+	payload string,
+	nextAttemptAt time.Time,
+) (*models.WebhookDelivery, error) {
+	delivery, err := db.
+		Client.
+		WebhookDelivery.
+		Create().
+		SetWebhookID(webhookID).
+		SetEventID(eventID).
+		SetEventType(eventType).
+		SetPayload(payload).
+		SetNextAttemptAt(nextAttemptAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delivery of event '%s' for webhook '%s': %w", eventID, webhookID, err)
+	}
+
+	return delivery, nil
+}
+
+// UpdateWebhookDelivery records the result of the last delivery attempt.
+// The pending delivery is attempted again at nextAttemptAt, it's cleared for the finished deliveries.
+func (db *DB) UpdateWebhookDelivery(
+	ctx context.Context,
+	deliveryID uuid.UUID,
+	status webhookdelivery.Status,
+	attempts int32,
+	responseStatus *int32,
+	deliveryErr *string,
+	nextAttemptAt *time.Time,
+) error {
+	update := db.
+		Client.
+		WebhookDelivery.
+		UpdateOneID(deliveryID).
+		SetStatus(status).
+		SetAttempts(attempts).
+		SetNillableResponseStatus(responseStatus).
+		SetNillableError(deliveryErr)
+
+	if status == webhookdelivery.StatusSucceeded {
+		update.SetDeliveredAt(time.Now())
+	}
+
+	if status == webhookdelivery.StatusPending && nextAttemptAt != nil {
+		update.SetNextAttemptAt(*nextAttemptAt)
+	} else {
+		update.ClearNextAttemptAt()
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery '%s': %w", deliveryID, err)
+	}
+
+	return nil
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries that are due, with their webhooks.
+// The next attempt of the returned deliveries is postponed by the lease, so other replicas don't claim them while they are being delivered.
+// If the replica stops before recording the result, the deliveries are claimed again after the lease expires.
+func (db *DB) ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.WebhookDelivery, error) {
+	due, err := db.
+		Client.
+		WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(models.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		WithWebhook().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending webhook deliveries: %w", err)
+	}
+
+	claimed := make([]*models.WebhookDelivery, 0, len(due))
+	for _, delivery := range due {
+		// The delivery is claimed only if no one else has changed its next attempt in the meantime.
+		n, err := db.
+			Client.
+			WebhookDelivery.
+			Update().
+			Where(
+				webhookdelivery.ID(delivery.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAt(*delivery.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim webhook delivery '%s': %w", delivery.ID, err)
+		}
+
+		if n == 1 {
+			claimed = append(claimed, delivery)
+		}
+	}
+
+	return claimed, nil
+}
+
+func (db *DB) GetWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*models.WebhookDelivery, error) {
+	deliveries, err := db.
+		Client.
+		WebhookDelivery.
+		Query().
+		Where(webhookdelivery.WebhookID(webhookID)).
+		Order(models.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliveries for webhook '%s': %w", webhookID, err)
+	}
+
+	return deliveries, nil
+}
@@ -28,7 +28,13 @@ type SandboxServiceClient interface {
 	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListResponse, error)
 	Delete(ctx context.Context, in *SandboxDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Pause(ctx context.Context, in *SandboxPauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error)
+	Migrate(ctx context.Context, in *SandboxMigrateRequest, opts ...grpc.CallOption) (*SandboxMigrateResponse, error)
+	ReceiveMigration(ctx context.Context, opts ...grpc.CallOption) (SandboxService_ReceiveMigrationClient, error)
+	AbortMigration(ctx context.Context, in *SandboxAbortMigrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error)
+	SetDraining(ctx context.Context, in *NodeDrainingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NodeStatusResponse, error)
 }
 
 type sandboxServiceClient struct {
@@ -84,6 +90,67 @@ func (c *sandboxServiceClient) Pause(ctx context.Context, in *SandboxPauseReques
 	return out, nil
 }
 
+func (c *sandboxServiceClient) Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error) {
+	out := new(SandboxForkResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Fork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Migrate(ctx context.Context, in *SandboxMigrateRequest, opts ...grpc.CallOption) (*SandboxMigrateResponse, error) {
+	out := new(SandboxMigrateResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ReceiveMigration(ctx context.Context, opts ...grpc.CallOption) (SandboxService_ReceiveMigrationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SandboxService_ServiceDesc.Streams[0], "/SandboxService/ReceiveMigration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sandboxServiceReceiveMigrationClient{stream}
+	return x, nil
+}
+
+type SandboxService_ReceiveMigrationClient interface {
+	Send(*SandboxMigrationData) error
+	CloseAndRecv() (*SandboxCreateResponse, error)
+	grpc.ClientStream
+}
+
+type sandboxServiceReceiveMigrationClient struct {
+	grpc.ClientStream
+}
+
+func (x *sandboxServiceReceiveMigrationClient) Send(m *SandboxMigrationData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sandboxServiceReceiveMigrationClient) CloseAndRecv() (*SandboxCreateResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SandboxCreateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sandboxServiceClient) AbortMigration(ctx context.Context, in *SandboxAbortMigrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/AbortMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error) {
 	out := new(SandboxListCachedBuildsResponse)
 	err := c.cc.Invoke(ctx, "/SandboxService/ListCachedBuilds", in, out, opts...)
@@ -93,6 +160,24 @@ func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *sandboxServiceClient) SetDraining(ctx context.Context, in *NodeDrainingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/SetDraining", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NodeStatusResponse, error) {
+	out := new(NodeStatusResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SandboxServiceServer is the server API for SandboxService service.
 // All implementations must embed UnimplementedSandboxServiceServer
 // for forward compatibility
@@ -102,7 +187,13 @@ type SandboxServiceServer interface {
 	List(context.Context, *emptypb.Empty) (*SandboxListResponse, error)
 	Delete(context.Context, *SandboxDeleteRequest) (*emptypb.Empty, error)
 	Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error)
+	Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error)
+	Migrate(context.Context, *SandboxMigrateRequest) (*SandboxMigrateResponse, error)
+	ReceiveMigration(SandboxService_ReceiveMigrationServer) error
+	AbortMigration(context.Context, *SandboxAbortMigrationRequest) (*emptypb.Empty, error)
 	ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error)
+	SetDraining(context.Context, *NodeDrainingRequest) (*emptypb.Empty, error)
+	Status(context.Context, *emptypb.Empty) (*NodeStatusResponse, error)
 	mustEmbedUnimplementedSandboxServiceServer()
 }
 
@@ -125,9 +216,27 @@ func (UnimplementedSandboxServiceServer) Delete(context.Context, *SandboxDeleteR
 func (UnimplementedSandboxServiceServer) Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
 }
+func (UnimplementedSandboxServiceServer) Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fork not implemented")
+}
+func (UnimplementedSandboxServiceServer) Migrate(context.Context, *SandboxMigrateRequest) (*SandboxMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (UnimplementedSandboxServiceServer) ReceiveMigration(SandboxService_ReceiveMigrationServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReceiveMigration not implemented")
+}
+func (UnimplementedSandboxServiceServer) AbortMigration(context.Context, *SandboxAbortMigrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortMigration not implemented")
+}
 func (UnimplementedSandboxServiceServer) ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCachedBuilds not implemented")
 }
+func (UnimplementedSandboxServiceServer) SetDraining(context.Context, *NodeDrainingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDraining not implemented")
+}
+func (UnimplementedSandboxServiceServer) Status(context.Context, *emptypb.Empty) (*NodeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
 func (UnimplementedSandboxServiceServer) mustEmbedUnimplementedSandboxServiceServer() {}
 
 // UnsafeSandboxServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -231,6 +340,86 @@ func _SandboxService_Pause_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_Fork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxForkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Fork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Fork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Fork(ctx, req.(*SandboxForkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Migrate(ctx, req.(*SandboxMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ReceiveMigration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SandboxServiceServer).ReceiveMigration(&sandboxServiceReceiveMigrationServer{stream})
+}
+
+type SandboxService_ReceiveMigrationServer interface {
+	SendAndClose(*SandboxCreateResponse) error
+	Recv() (*SandboxMigrationData, error)
+	grpc.ServerStream
+}
+
+type sandboxServiceReceiveMigrationServer struct {
+	grpc.ServerStream
+}
+
+func (x *sandboxServiceReceiveMigrationServer) SendAndClose(m *SandboxCreateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sandboxServiceReceiveMigrationServer) Recv() (*SandboxMigrationData, error) {
+	m := new(SandboxMigrationData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SandboxService_AbortMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxAbortMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).AbortMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/AbortMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).AbortMigration(ctx, req.(*SandboxAbortMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -249,6 +438,42 @@ func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_SetDraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeDrainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).SetDraining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/SetDraining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).SetDraining(ctx, req.(*NodeDrainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Status(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SandboxService_ServiceDesc is the grpc.ServiceDesc for SandboxService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -276,11 +501,37 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pause",
 			Handler:    _SandboxService_Pause_Handler,
 		},
+		{
+			MethodName: "Fork",
+			Handler:    _SandboxService_Fork_Handler,
+		},
+		{
+			MethodName: "Migrate",
+			Handler:    _SandboxService_Migrate_Handler,
+		},
+		{
+			MethodName: "AbortMigration",
+			Handler:    _SandboxService_AbortMigration_Handler,
+		},
 		{
 			MethodName: "ListCachedBuilds",
 			Handler:    _SandboxService_ListCachedBuilds_Handler,
 		},
+		{
+			MethodName: "SetDraining",
+			Handler:    _SandboxService_SetDraining_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _SandboxService_Status_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReceiveMigration",
+			Handler:       _SandboxService_ReceiveMigration_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "orchestrator.proto",
 }
@@ -25,8 +25,12 @@ const _ = grpc.SupportPackageIsVersion7
 type TemplateServiceClient interface {
 	// TemplateCreate is a gRPC service that creates a new template
 	TemplateCreate(ctx context.Context, in *TemplateCreateRequest, opts ...grpc.CallOption) (TemplateService_TemplateCreateClient, error)
-	// EnvBuildDelete is a gRPC service that deletes files associated with a template build
+	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(ctx context.Context, in *TemplateBuildDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildGarbageCollect is a gRPC service that deletes files of builds that are no longer referenced by any other build
+	TemplateBuildGarbageCollect(ctx context.Context, opts ...grpc.CallOption) (TemplateService_TemplateBuildGarbageCollectClient, error)
+	// TemplateBuildCompact is a gRPC service that rewrites the header chain of a build into a new build with a single layer
+	TemplateBuildCompact(ctx context.Context, in *TemplateBuildCompactRequest, opts ...grpc.CallOption) (*TemplateBuildCompactResponse, error)
 }
 
 type templateServiceClient struct {
@@ -78,14 +82,58 @@ func (c *templateServiceClient) TemplateBuildDelete(ctx context.Context, in *Tem
 	return out, nil
 }
 
+func (c *templateServiceClient) TemplateBuildGarbageCollect(ctx context.Context, opts ...grpc.CallOption) (TemplateService_TemplateBuildGarbageCollectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TemplateService_ServiceDesc.Streams[1], "/TemplateService/TemplateBuildGarbageCollect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &templateServiceTemplateBuildGarbageCollectClient{stream}
+	return x, nil
+}
+
+type TemplateService_TemplateBuildGarbageCollectClient interface {
+	Send(*TemplateBuildGarbageCollectRequest) error
+	Recv() (*TemplateBuildGarbageCollectResponse, error)
+	grpc.ClientStream
+}
+
+type templateServiceTemplateBuildGarbageCollectClient struct {
+	grpc.ClientStream
+}
+
+func (x *templateServiceTemplateBuildGarbageCollectClient) Send(m *TemplateBuildGarbageCollectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *templateServiceTemplateBuildGarbageCollectClient) Recv() (*TemplateBuildGarbageCollectResponse, error) {
+	m := new(TemplateBuildGarbageCollectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *templateServiceClient) TemplateBuildCompact(ctx context.Context, in *TemplateBuildCompactRequest, opts ...grpc.CallOption) (*TemplateBuildCompactResponse, error) {
+	out := new(TemplateBuildCompactResponse)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildCompact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TemplateServiceServer is the server API for TemplateService service.
 // All implementations must embed UnimplementedTemplateServiceServer
 // for forward compatibility
 type TemplateServiceServer interface {
 	// TemplateCreate is a gRPC service that creates a new template
 	TemplateCreate(*TemplateCreateRequest, TemplateService_TemplateCreateServer) error
-	// EnvBuildDelete is a gRPC service that deletes files associated with a template build
+	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error)
+	// TemplateBuildGarbageCollect is a gRPC service that deletes files of builds that are no longer referenced by any other build
+	TemplateBuildGarbageCollect(TemplateService_TemplateBuildGarbageCollectServer) error
+	// TemplateBuildCompact is a gRPC service that rewrites the header chain of a build into a new build with a single layer
+	TemplateBuildCompact(context.Context, *TemplateBuildCompactRequest) (*TemplateBuildCompactResponse, error)
 	mustEmbedUnimplementedTemplateServiceServer()
 }
 
@@ -99,6 +147,12 @@ func (UnimplementedTemplateServiceServer) TemplateCreate(*TemplateCreateRequest,
 func (UnimplementedTemplateServiceServer) TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildDelete not implemented")
 }
+func (UnimplementedTemplateServiceServer) TemplateBuildGarbageCollect(TemplateService_TemplateBuildGarbageCollectServer) error {
+	return status.Errorf(codes.Unimplemented, "method TemplateBuildGarbageCollect not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateBuildCompact(context.Context, *TemplateBuildCompactRequest) (*TemplateBuildCompactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildCompact not implemented")
+}
 func (UnimplementedTemplateServiceServer) mustEmbedUnimplementedTemplateServiceServer() {}
 
 // UnsafeTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -151,6 +205,50 @@ func _TemplateService_TemplateBuildDelete_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TemplateService_TemplateBuildGarbageCollect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TemplateServiceServer).TemplateBuildGarbageCollect(&templateServiceTemplateBuildGarbageCollectServer{stream})
+}
+
+type TemplateService_TemplateBuildGarbageCollectServer interface {
+	Send(*TemplateBuildGarbageCollectResponse) error
+	Recv() (*TemplateBuildGarbageCollectRequest, error)
+	grpc.ServerStream
+}
+
+type templateServiceTemplateBuildGarbageCollectServer struct {
+	grpc.ServerStream
+}
+
+func (x *templateServiceTemplateBuildGarbageCollectServer) Send(m *TemplateBuildGarbageCollectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *templateServiceTemplateBuildGarbageCollectServer) Recv() (*TemplateBuildGarbageCollectRequest, error) {
+	m := new(TemplateBuildGarbageCollectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TemplateService_TemplateBuildCompact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildCompactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildCompact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildCompact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildCompact(ctx, req.(*TemplateBuildCompactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TemplateService_ServiceDesc is the grpc.ServiceDesc for TemplateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -162,6 +260,10 @@ var TemplateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateBuildDelete",
 			Handler:    _TemplateService_TemplateBuildDelete_Handler,
 		},
+		{
+			MethodName: "TemplateBuildCompact",
+			Handler:    _TemplateService_TemplateBuildCompact_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -169,6 +271,12 @@ var TemplateService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _TemplateService_TemplateCreate_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TemplateBuildGarbageCollect",
+			Handler:       _TemplateService_TemplateBuildGarbageCollect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "template-manager.proto",
 }
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/google/uuid"
+)
+
+// Checkpoint is the model entity for the Checkpoint schema.
+type Checkpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// SandboxID holds the value of the "sandbox_id" field.
+	SandboxID string `json:"sandbox_id,omitempty"`
+	// BaseEnvID holds the value of the "base_env_id" field.
+	BaseEnvID string `json:"base_env_id,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// BuildID holds the value of the "build_id" field.
+	BuildID uuid.UUID `json:"build_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CheckpointQuery when eager-loading is set.
+	Edges        CheckpointEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CheckpointEdges holds the relations/edges for other nodes in the graph.
+type CheckpointEdges struct {
+	// Env holds the value of the env edge.
+	Env *Env `json:"env,omitempty"`
+	// Build holds the value of the build edge.
+	Build *EnvBuild `json:"build,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EnvOrErr returns the Env value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CheckpointEdges) EnvOrErr() (*Env, error) {
+	if e.loadedTypes[0] {
+		if e.Env == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: env.Label}
+		}
+		return e.Env, nil
+	}
+	return nil, &NotLoadedError{edge: "env"}
+}
+
+// BuildOrErr returns the Build value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CheckpointEdges) BuildOrErr() (*EnvBuild, error) {
+	if e.loadedTypes[1] {
+		if e.Build == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: envbuild.Label}
+		}
+		return e.Build, nil
+	}
+	return nil, &NotLoadedError{edge: "build"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Checkpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldMetadata:
+			values[i] = new([]byte)
+		case checkpoint.FieldName, checkpoint.FieldSandboxID, checkpoint.FieldBaseEnvID, checkpoint.FieldEnvID:
+			values[i] = new(sql.NullString)
+		case checkpoint.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case checkpoint.FieldID, checkpoint.FieldBuildID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Checkpoint fields.
+func (c *Checkpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case checkpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case checkpoint.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = new(string)
+				*c.Name = value.String
+			}
+		case checkpoint.FieldSandboxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sandbox_id", values[i])
+			} else if value.Valid {
+				c.SandboxID = value.String
+			}
+		case checkpoint.FieldBaseEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_env_id", values[i])
+			} else if value.Valid {
+				c.BaseEnvID = value.String
+			}
+		case checkpoint.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				c.EnvID = value.String
+			}
+		case checkpoint.FieldBuildID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field build_id", values[i])
+			} else if value != nil {
+				c.BuildID = *value
+			}
+		case checkpoint.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Checkpoint.
+// This includes values selected through modifiers, order, etc.
+func (c *Checkpoint) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryEnv queries the "env" edge of the Checkpoint entity.
+func (c *Checkpoint) QueryEnv() *EnvQuery {
+	return NewCheckpointClient(c.config).QueryEnv(c)
+}
+
+// QueryBuild queries the "build" edge of the Checkpoint entity.
+func (c *Checkpoint) QueryBuild() *EnvBuildQuery {
+	return NewCheckpointClient(c.config).QueryBuild(c)
+}
+
+// Update returns a builder for updating this Checkpoint.
+// Note that you need to call Checkpoint.Unwrap() before calling this method if this Checkpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Checkpoint) Update() *CheckpointUpdateOne {
+	return NewCheckpointClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Checkpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Checkpoint) Unwrap() *Checkpoint {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("models: Checkpoint is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Checkpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("Checkpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.Name; v != nil {
+		builder.WriteString("name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sandbox_id=")
+	builder.WriteString(c.SandboxID)
+	builder.WriteString(", ")
+	builder.WriteString("base_env_id=")
+	builder.WriteString(c.BaseEnvID)
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(c.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("build_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.BuildID))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", c.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Checkpoints is a parsable slice of Checkpoint.
+type Checkpoints []*Checkpoint
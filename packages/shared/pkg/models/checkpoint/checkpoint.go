@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the checkpoint type in the database.
+	Label = "checkpoint"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSandboxID holds the string denoting the sandbox_id field in the database.
+	FieldSandboxID = "sandbox_id"
+	// FieldBaseEnvID holds the string denoting the base_env_id field in the database.
+	FieldBaseEnvID = "base_env_id"
+	// FieldEnvID holds the string denoting the env_id field in the database.
+	FieldEnvID = "env_id"
+	// FieldBuildID holds the string denoting the build_id field in the database.
+	FieldBuildID = "build_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// EdgeEnv holds the string denoting the env edge name in mutations.
+	EdgeEnv = "env"
+	// EdgeBuild holds the string denoting the build edge name in mutations.
+	EdgeBuild = "build"
+	// Table holds the table name of the checkpoint in the database.
+	Table = "checkpoints"
+	// EnvTable is the table that holds the env relation/edge.
+	EnvTable = "checkpoints"
+	// EnvInverseTable is the table name for the Env entity.
+	// It exists in this package in order to avoid circular dependency with the "env" package.
+	EnvInverseTable = "envs"
+	// EnvColumn is the table column denoting the env relation/edge.
+	EnvColumn = "env_id"
+	// BuildTable is the table that holds the build relation/edge.
+	BuildTable = "checkpoints"
+	// BuildInverseTable is the table name for the EnvBuild entity.
+	// It exists in this package in order to avoid circular dependency with the "envbuild" package.
+	BuildInverseTable = "env_builds"
+	// BuildColumn is the table column denoting the build relation/edge.
+	BuildColumn = "build_id"
+)
+
+// Columns holds all SQL columns for checkpoint fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldName,
+	FieldSandboxID,
+	FieldBaseEnvID,
+	FieldEnvID,
+	FieldBuildID,
+	FieldMetadata,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Checkpoint queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySandboxID orders the results by the sandbox_id field.
+func BySandboxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSandboxID, opts...).ToFunc()
+}
+
+// ByBaseEnvID orders the results by the base_env_id field.
+func ByBaseEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseEnvID, opts...).ToFunc()
+}
+
+// ByEnvID orders the results by the env_id field.
+func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
+}
+
+// ByBuildID orders the results by the build_id field.
+func ByBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildID, opts...).ToFunc()
+}
+
+// ByEnvField orders the results by env field.
+func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBuildField orders the results by build field.
+func ByBuildField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuildStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEnvStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnvInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
+	)
+}
+func newBuildStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuildInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BuildTable, BuildColumn),
+	)
+}
@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldName, v))
+}
+
+// SandboxID applies equality check predicate on the "sandbox_id" field. It's identical to SandboxIDEQ.
+func SandboxID(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldSandboxID, v))
+}
+
+// BaseEnvID applies equality check predicate on the "base_env_id" field. It's identical to BaseEnvIDEQ.
+func BaseEnvID(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBaseEnvID, v))
+}
+
+// EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
+func EnvID(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldEnvID, v))
+}
+
+// BuildID applies equality check predicate on the "build_id" field. It's identical to BuildIDEQ.
+func BuildID(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBuildID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldName, v))
+}
+
+// SandboxIDEQ applies the EQ predicate on the "sandbox_id" field.
+func SandboxIDEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldSandboxID, v))
+}
+
+// SandboxIDNEQ applies the NEQ predicate on the "sandbox_id" field.
+func SandboxIDNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldSandboxID, v))
+}
+
+// SandboxIDIn applies the In predicate on the "sandbox_id" field.
+func SandboxIDIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldSandboxID, vs...))
+}
+
+// SandboxIDNotIn applies the NotIn predicate on the "sandbox_id" field.
+func SandboxIDNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldSandboxID, vs...))
+}
+
+// SandboxIDGT applies the GT predicate on the "sandbox_id" field.
+func SandboxIDGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldSandboxID, v))
+}
+
+// SandboxIDGTE applies the GTE predicate on the "sandbox_id" field.
+func SandboxIDGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldSandboxID, v))
+}
+
+// SandboxIDLT applies the LT predicate on the "sandbox_id" field.
+func SandboxIDLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldSandboxID, v))
+}
+
+// SandboxIDLTE applies the LTE predicate on the "sandbox_id" field.
+func SandboxIDLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldSandboxID, v))
+}
+
+// SandboxIDContains applies the Contains predicate on the "sandbox_id" field.
+func SandboxIDContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldSandboxID, v))
+}
+
+// SandboxIDHasPrefix applies the HasPrefix predicate on the "sandbox_id" field.
+func SandboxIDHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldSandboxID, v))
+}
+
+// SandboxIDHasSuffix applies the HasSuffix predicate on the "sandbox_id" field.
+func SandboxIDHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldSandboxID, v))
+}
+
+// SandboxIDEqualFold applies the EqualFold predicate on the "sandbox_id" field.
+func SandboxIDEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldSandboxID, v))
+}
+
+// SandboxIDContainsFold applies the ContainsFold predicate on the "sandbox_id" field.
+func SandboxIDContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldSandboxID, v))
+}
+
+// BaseEnvIDEQ applies the EQ predicate on the "base_env_id" field.
+func BaseEnvIDEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDNEQ applies the NEQ predicate on the "base_env_id" field.
+func BaseEnvIDNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDIn applies the In predicate on the "base_env_id" field.
+func BaseEnvIDIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldBaseEnvID, vs...))
+}
+
+// BaseEnvIDNotIn applies the NotIn predicate on the "base_env_id" field.
+func BaseEnvIDNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldBaseEnvID, vs...))
+}
+
+// BaseEnvIDGT applies the GT predicate on the "base_env_id" field.
+func BaseEnvIDGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDGTE applies the GTE predicate on the "base_env_id" field.
+func BaseEnvIDGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDLT applies the LT predicate on the "base_env_id" field.
+func BaseEnvIDLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDLTE applies the LTE predicate on the "base_env_id" field.
+func BaseEnvIDLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDContains applies the Contains predicate on the "base_env_id" field.
+func BaseEnvIDContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDHasPrefix applies the HasPrefix predicate on the "base_env_id" field.
+func BaseEnvIDHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDHasSuffix applies the HasSuffix predicate on the "base_env_id" field.
+func BaseEnvIDHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDEqualFold applies the EqualFold predicate on the "base_env_id" field.
+func BaseEnvIDEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDContainsFold applies the ContainsFold predicate on the "base_env_id" field.
+func BaseEnvIDContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldBaseEnvID, v))
+}
+
+// EnvIDEQ applies the EQ predicate on the "env_id" field.
+func EnvIDEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldEnvID, v))
+}
+
+// EnvIDNEQ applies the NEQ predicate on the "env_id" field.
+func EnvIDNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldEnvID, v))
+}
+
+// EnvIDIn applies the In predicate on the "env_id" field.
+func EnvIDIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldEnvID, vs...))
+}
+
+// EnvIDNotIn applies the NotIn predicate on the "env_id" field.
+func EnvIDNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldEnvID, vs...))
+}
+
+// EnvIDGT applies the GT predicate on the "env_id" field.
+func EnvIDGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldEnvID, v))
+}
+
+// EnvIDGTE applies the GTE predicate on the "env_id" field.
+func EnvIDGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldEnvID, v))
+}
+
+// EnvIDLT applies the LT predicate on the "env_id" field.
+func EnvIDLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldEnvID, v))
+}
+
+// EnvIDLTE applies the LTE predicate on the "env_id" field.
+func EnvIDLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldEnvID, v))
+}
+
+// EnvIDContains applies the Contains predicate on the "env_id" field.
+func EnvIDContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldEnvID, v))
+}
+
+// EnvIDHasPrefix applies the HasPrefix predicate on the "env_id" field.
+func EnvIDHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldEnvID, v))
+}
+
+// EnvIDHasSuffix applies the HasSuffix predicate on the "env_id" field.
+func EnvIDHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldEnvID, v))
+}
+
+// EnvIDEqualFold applies the EqualFold predicate on the "env_id" field.
+func EnvIDEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldEnvID, v))
+}
+
+// EnvIDContainsFold applies the ContainsFold predicate on the "env_id" field.
+func EnvIDContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldEnvID, v))
+}
+
+// BuildIDEQ applies the EQ predicate on the "build_id" field.
+func BuildIDEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBuildID, v))
+}
+
+// BuildIDNEQ applies the NEQ predicate on the "build_id" field.
+func BuildIDNEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldBuildID, v))
+}
+
+// BuildIDIn applies the In predicate on the "build_id" field.
+func BuildIDIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldBuildID, vs...))
+}
+
+// BuildIDNotIn applies the NotIn predicate on the "build_id" field.
+func BuildIDNotIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldBuildID, vs...))
+}
+
+// HasEnv applies the HasEdge predicate on the "env" edge.
+func HasEnv() predicate.Checkpoint {
+	return predicate.Checkpoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvWith applies the HasEdge predicate on the "env" edge with a given conditions (other predicates).
+func HasEnvWith(preds ...predicate.Env) predicate.Checkpoint {
+	return predicate.Checkpoint(func(s *sql.Selector) {
+		step := newEnvStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBuild applies the HasEdge predicate on the "build" edge.
+func HasBuild() predicate.Checkpoint {
+	return predicate.Checkpoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BuildTable, BuildColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuildWith applies the HasEdge predicate on the "build" edge with a given conditions (other predicates).
+func HasBuildWith(preds ...predicate.EnvBuild) predicate.Checkpoint {
+	return predicate.Checkpoint(func(s *sql.Selector) {
+		step := newBuildStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.NotPredicates(p))
+}
@@ -0,0 +1,865 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/google/uuid"
+)
+
+// CheckpointCreate is the builder for creating a Checkpoint entity.
+type CheckpointCreate struct {
+	config
+	mutation *CheckpointMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CheckpointCreate) SetCreatedAt(t time.Time) *CheckpointCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CheckpointCreate) SetNillableCreatedAt(t *time.Time) *CheckpointCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetName sets the "name" field.
+func (cc *CheckpointCreate) SetName(s string) *CheckpointCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cc *CheckpointCreate) SetNillableName(s *string) *CheckpointCreate {
+	if s != nil {
+		cc.SetName(*s)
+	}
+	return cc
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (cc *CheckpointCreate) SetSandboxID(s string) *CheckpointCreate {
+	cc.mutation.SetSandboxID(s)
+	return cc
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (cc *CheckpointCreate) SetBaseEnvID(s string) *CheckpointCreate {
+	cc.mutation.SetBaseEnvID(s)
+	return cc
+}
+
+// SetEnvID sets the "env_id" field.
+func (cc *CheckpointCreate) SetEnvID(s string) *CheckpointCreate {
+	cc.mutation.SetEnvID(s)
+	return cc
+}
+
+// SetBuildID sets the "build_id" field.
+func (cc *CheckpointCreate) SetBuildID(u uuid.UUID) *CheckpointCreate {
+	cc.mutation.SetBuildID(u)
+	return cc
+}
+
+// SetMetadata sets the "metadata" field.
+func (cc *CheckpointCreate) SetMetadata(m map[string]string) *CheckpointCreate {
+	cc.mutation.SetMetadata(m)
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CheckpointCreate) SetID(u uuid.UUID) *CheckpointCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (cc *CheckpointCreate) SetEnv(e *Env) *CheckpointCreate {
+	return cc.SetEnvID(e.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (cc *CheckpointCreate) SetBuild(e *EnvBuild) *CheckpointCreate {
+	return cc.SetBuildID(e.ID)
+}
+
+// Mutation returns the CheckpointMutation object of the builder.
+func (cc *CheckpointCreate) Mutation() *CheckpointMutation {
+	return cc.mutation
+}
+
+// Save creates the Checkpoint in the database.
+func (cc *CheckpointCreate) Save(ctx context.Context) (*Checkpoint, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CheckpointCreate) SaveX(ctx context.Context) *Checkpoint {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CheckpointCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CheckpointCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CheckpointCreate) defaults() {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := checkpoint.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CheckpointCreate) check() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "Checkpoint.created_at"`)}
+	}
+	if _, ok := cc.mutation.SandboxID(); !ok {
+		return &ValidationError{Name: "sandbox_id", err: errors.New(`models: missing required field "Checkpoint.sandbox_id"`)}
+	}
+	if _, ok := cc.mutation.BaseEnvID(); !ok {
+		return &ValidationError{Name: "base_env_id", err: errors.New(`models: missing required field "Checkpoint.base_env_id"`)}
+	}
+	if _, ok := cc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "Checkpoint.env_id"`)}
+	}
+	if _, ok := cc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build_id", err: errors.New(`models: missing required field "Checkpoint.build_id"`)}
+	}
+	if _, ok := cc.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`models: missing required field "Checkpoint.metadata"`)}
+	}
+	if _, ok := cc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env", err: errors.New(`models: missing required edge "Checkpoint.env"`)}
+	}
+	if _, ok := cc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build", err: errors.New(`models: missing required edge "Checkpoint.build"`)}
+	}
+	return nil
+}
+
+func (cc *CheckpointCreate) sqlSave(ctx context.Context) (*Checkpoint, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CheckpointCreate) createSpec() (*Checkpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Checkpoint{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(checkpoint.Table, sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = cc.schemaConfig.Checkpoint
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(checkpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(checkpoint.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := cc.mutation.SandboxID(); ok {
+		_spec.SetField(checkpoint.FieldSandboxID, field.TypeString, value)
+		_node.SandboxID = value
+	}
+	if value, ok := cc.mutation.BaseEnvID(); ok {
+		_spec.SetField(checkpoint.FieldBaseEnvID, field.TypeString, value)
+		_node.BaseEnvID = value
+	}
+	if value, ok := cc.mutation.Metadata(); ok {
+		_spec.SetField(checkpoint.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := cc.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.EnvTable,
+			Columns: []string{checkpoint.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cc.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.BuildTable,
+			Columns: []string{checkpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = cc.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checkpoint.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheckpointUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CheckpointCreate) OnConflict(opts ...sql.ConflictOption) *CheckpointUpsertOne {
+	cc.conflict = opts
+	return &CheckpointUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CheckpointCreate) OnConflictColumns(columns ...string) *CheckpointUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CheckpointUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CheckpointUpsertOne is the builder for "upsert"-ing
+	//  one Checkpoint node.
+	CheckpointUpsertOne struct {
+		create *CheckpointCreate
+	}
+
+	// CheckpointUpsert is the "OnConflict" setter.
+	CheckpointUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *CheckpointUpsert) SetName(v string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateName() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CheckpointUpsert) ClearName() *CheckpointUpsert {
+	u.SetNull(checkpoint.FieldName)
+	return u
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (u *CheckpointUpsert) SetSandboxID(v string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldSandboxID, v)
+	return u
+}
+
+// UpdateSandboxID sets the "sandbox_id" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateSandboxID() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldSandboxID)
+	return u
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (u *CheckpointUpsert) SetBaseEnvID(v string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldBaseEnvID, v)
+	return u
+}
+
+// UpdateBaseEnvID sets the "base_env_id" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateBaseEnvID() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldBaseEnvID)
+	return u
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *CheckpointUpsert) SetEnvID(v string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldEnvID, v)
+	return u
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateEnvID() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldEnvID)
+	return u
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *CheckpointUpsert) SetBuildID(v uuid.UUID) *CheckpointUpsert {
+	u.Set(checkpoint.FieldBuildID, v)
+	return u
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateBuildID() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldBuildID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *CheckpointUpsert) SetMetadata(v map[string]string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateMetadata() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(checkpoint.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CheckpointUpsertOne) UpdateNewValues() *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(checkpoint.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(checkpoint.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CheckpointUpsertOne) Ignore() *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheckpointUpsertOne) DoNothing() *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheckpointCreate.OnConflict
+// documentation for more info.
+func (u *CheckpointUpsertOne) Update(set func(*CheckpointUpsert)) *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CheckpointUpsertOne) SetName(v string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateName() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CheckpointUpsertOne) ClearName() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (u *CheckpointUpsertOne) SetSandboxID(v string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetSandboxID(v)
+	})
+}
+
+// UpdateSandboxID sets the "sandbox_id" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateSandboxID() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateSandboxID()
+	})
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (u *CheckpointUpsertOne) SetBaseEnvID(v string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBaseEnvID(v)
+	})
+}
+
+// UpdateBaseEnvID sets the "base_env_id" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateBaseEnvID() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBaseEnvID()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *CheckpointUpsertOne) SetEnvID(v string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateEnvID() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *CheckpointUpsertOne) SetBuildID(v uuid.UUID) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateBuildID() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *CheckpointUpsertOne) SetMetadata(v map[string]string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateMetadata() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *CheckpointUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for CheckpointCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheckpointUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CheckpointUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: CheckpointUpsertOne.ID is not supported by MySQL driver. Use CheckpointUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CheckpointUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CheckpointCreateBulk is the builder for creating many Checkpoint entities in bulk.
+type CheckpointCreateBulk struct {
+	config
+	err      error
+	builders []*CheckpointCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Checkpoint entities in the database.
+func (ccb *CheckpointCreateBulk) Save(ctx context.Context) ([]*Checkpoint, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Checkpoint, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CheckpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CheckpointCreateBulk) SaveX(ctx context.Context) []*Checkpoint {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CheckpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CheckpointCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checkpoint.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheckpointUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CheckpointCreateBulk) OnConflict(opts ...sql.ConflictOption) *CheckpointUpsertBulk {
+	ccb.conflict = opts
+	return &CheckpointUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CheckpointCreateBulk) OnConflictColumns(columns ...string) *CheckpointUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CheckpointUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CheckpointUpsertBulk is the builder for "upsert"-ing
+// a bulk of Checkpoint nodes.
+type CheckpointUpsertBulk struct {
+	create *CheckpointCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(checkpoint.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CheckpointUpsertBulk) UpdateNewValues() *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(checkpoint.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(checkpoint.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CheckpointUpsertBulk) Ignore() *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheckpointUpsertBulk) DoNothing() *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheckpointCreateBulk.OnConflict
+// documentation for more info.
+func (u *CheckpointUpsertBulk) Update(set func(*CheckpointUpsert)) *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CheckpointUpsertBulk) SetName(v string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateName() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CheckpointUpsertBulk) ClearName() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (u *CheckpointUpsertBulk) SetSandboxID(v string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetSandboxID(v)
+	})
+}
+
+// UpdateSandboxID sets the "sandbox_id" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateSandboxID() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateSandboxID()
+	})
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (u *CheckpointUpsertBulk) SetBaseEnvID(v string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBaseEnvID(v)
+	})
+}
+
+// UpdateBaseEnvID sets the "base_env_id" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateBaseEnvID() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBaseEnvID()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *CheckpointUpsertBulk) SetEnvID(v string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateEnvID() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *CheckpointUpsertBulk) SetBuildID(v uuid.UUID) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateBuildID() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *CheckpointUpsertBulk) SetMetadata(v map[string]string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateMetadata() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *CheckpointUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the CheckpointCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for CheckpointCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheckpointUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// CheckpointUpdate is the builder for updating Checkpoint entities.
+type CheckpointUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CheckpointMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CheckpointUpdate builder.
+func (cu *CheckpointUpdate) Where(ps ...predicate.Checkpoint) *CheckpointUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CheckpointUpdate) SetName(s string) *CheckpointUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CheckpointUpdate) SetNillableName(s *string) *CheckpointUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// ClearName clears the value of the "name" field.
+func (cu *CheckpointUpdate) ClearName() *CheckpointUpdate {
+	cu.mutation.ClearName()
+	return cu
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (cu *CheckpointUpdate) SetSandboxID(s string) *CheckpointUpdate {
+	cu.mutation.SetSandboxID(s)
+	return cu
+}
+
+// SetNillableSandboxID sets the "sandbox_id" field if the given value is not nil.
+func (cu *CheckpointUpdate) SetNillableSandboxID(s *string) *CheckpointUpdate {
+	if s != nil {
+		cu.SetSandboxID(*s)
+	}
+	return cu
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (cu *CheckpointUpdate) SetBaseEnvID(s string) *CheckpointUpdate {
+	cu.mutation.SetBaseEnvID(s)
+	return cu
+}
+
+// SetNillableBaseEnvID sets the "base_env_id" field if the given value is not nil.
+func (cu *CheckpointUpdate) SetNillableBaseEnvID(s *string) *CheckpointUpdate {
+	if s != nil {
+		cu.SetBaseEnvID(*s)
+	}
+	return cu
+}
+
+// SetEnvID sets the "env_id" field.
+func (cu *CheckpointUpdate) SetEnvID(s string) *CheckpointUpdate {
+	cu.mutation.SetEnvID(s)
+	return cu
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (cu *CheckpointUpdate) SetNillableEnvID(s *string) *CheckpointUpdate {
+	if s != nil {
+		cu.SetEnvID(*s)
+	}
+	return cu
+}
+
+// SetBuildID sets the "build_id" field.
+func (cu *CheckpointUpdate) SetBuildID(u uuid.UUID) *CheckpointUpdate {
+	cu.mutation.SetBuildID(u)
+	return cu
+}
+
+// SetNillableBuildID sets the "build_id" field if the given value is not nil.
+func (cu *CheckpointUpdate) SetNillableBuildID(u *uuid.UUID) *CheckpointUpdate {
+	if u != nil {
+		cu.SetBuildID(*u)
+	}
+	return cu
+}
+
+// SetMetadata sets the "metadata" field.
+func (cu *CheckpointUpdate) SetMetadata(m map[string]string) *CheckpointUpdate {
+	cu.mutation.SetMetadata(m)
+	return cu
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (cu *CheckpointUpdate) SetEnv(e *Env) *CheckpointUpdate {
+	return cu.SetEnvID(e.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (cu *CheckpointUpdate) SetBuild(e *EnvBuild) *CheckpointUpdate {
+	return cu.SetBuildID(e.ID)
+}
+
+// Mutation returns the CheckpointMutation object of the builder.
+func (cu *CheckpointUpdate) Mutation() *CheckpointMutation {
+	return cu.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (cu *CheckpointUpdate) ClearEnv() *CheckpointUpdate {
+	cu.mutation.ClearEnv()
+	return cu
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (cu *CheckpointUpdate) ClearBuild() *CheckpointUpdate {
+	cu.mutation.ClearBuild()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CheckpointUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CheckpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CheckpointUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CheckpointUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CheckpointUpdate) check() error {
+	if _, ok := cu.mutation.EnvID(); cu.mutation.EnvCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "Checkpoint.env"`)
+	}
+	if _, ok := cu.mutation.BuildID(); cu.mutation.BuildCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "Checkpoint.build"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CheckpointUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CheckpointUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *CheckpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkpoint.Table, checkpoint.Columns, sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(checkpoint.FieldName, field.TypeString, value)
+	}
+	if cu.mutation.NameCleared() {
+		_spec.ClearField(checkpoint.FieldName, field.TypeString)
+	}
+	if value, ok := cu.mutation.SandboxID(); ok {
+		_spec.SetField(checkpoint.FieldSandboxID, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.BaseEnvID(); ok {
+		_spec.SetField(checkpoint.FieldBaseEnvID, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Metadata(); ok {
+		_spec.SetField(checkpoint.FieldMetadata, field.TypeJSON, value)
+	}
+	if cu.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.EnvTable,
+			Columns: []string{checkpoint.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cu.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.EnvTable,
+			Columns: []string{checkpoint.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.BuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.BuildTable,
+			Columns: []string{checkpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = cu.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.BuildTable,
+			Columns: []string{checkpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = cu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = cu.schemaConfig.Checkpoint
+	ctx = internal.NewSchemaConfigContext(ctx, cu.schemaConfig)
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CheckpointUpdateOne is the builder for updating a single Checkpoint entity.
+type CheckpointUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CheckpointMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (cuo *CheckpointUpdateOne) SetName(s string) *CheckpointUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CheckpointUpdateOne) SetNillableName(s *string) *CheckpointUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// ClearName clears the value of the "name" field.
+func (cuo *CheckpointUpdateOne) ClearName() *CheckpointUpdateOne {
+	cuo.mutation.ClearName()
+	return cuo
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (cuo *CheckpointUpdateOne) SetSandboxID(s string) *CheckpointUpdateOne {
+	cuo.mutation.SetSandboxID(s)
+	return cuo
+}
+
+// SetNillableSandboxID sets the "sandbox_id" field if the given value is not nil.
+func (cuo *CheckpointUpdateOne) SetNillableSandboxID(s *string) *CheckpointUpdateOne {
+	if s != nil {
+		cuo.SetSandboxID(*s)
+	}
+	return cuo
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (cuo *CheckpointUpdateOne) SetBaseEnvID(s string) *CheckpointUpdateOne {
+	cuo.mutation.SetBaseEnvID(s)
+	return cuo
+}
+
+// SetNillableBaseEnvID sets the "base_env_id" field if the given value is not nil.
+func (cuo *CheckpointUpdateOne) SetNillableBaseEnvID(s *string) *CheckpointUpdateOne {
+	if s != nil {
+		cuo.SetBaseEnvID(*s)
+	}
+	return cuo
+}
+
+// SetEnvID sets the "env_id" field.
+func (cuo *CheckpointUpdateOne) SetEnvID(s string) *CheckpointUpdateOne {
+	cuo.mutation.SetEnvID(s)
+	return cuo
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (cuo *CheckpointUpdateOne) SetNillableEnvID(s *string) *CheckpointUpdateOne {
+	if s != nil {
+		cuo.SetEnvID(*s)
+	}
+	return cuo
+}
+
+// SetBuildID sets the "build_id" field.
+func (cuo *CheckpointUpdateOne) SetBuildID(u uuid.UUID) *CheckpointUpdateOne {
+	cuo.mutation.SetBuildID(u)
+	return cuo
+}
+
+// SetNillableBuildID sets the "build_id" field if the given value is not nil.
+func (cuo *CheckpointUpdateOne) SetNillableBuildID(u *uuid.UUID) *CheckpointUpdateOne {
+	if u != nil {
+		cuo.SetBuildID(*u)
+	}
+	return cuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (cuo *CheckpointUpdateOne) SetMetadata(m map[string]string) *CheckpointUpdateOne {
+	cuo.mutation.SetMetadata(m)
+	return cuo
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (cuo *CheckpointUpdateOne) SetEnv(e *Env) *CheckpointUpdateOne {
+	return cuo.SetEnvID(e.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (cuo *CheckpointUpdateOne) SetBuild(e *EnvBuild) *CheckpointUpdateOne {
+	return cuo.SetBuildID(e.ID)
+}
+
+// Mutation returns the CheckpointMutation object of the builder.
+func (cuo *CheckpointUpdateOne) Mutation() *CheckpointMutation {
+	return cuo.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (cuo *CheckpointUpdateOne) ClearEnv() *CheckpointUpdateOne {
+	cuo.mutation.ClearEnv()
+	return cuo
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (cuo *CheckpointUpdateOne) ClearBuild() *CheckpointUpdateOne {
+	cuo.mutation.ClearBuild()
+	return cuo
+}
+
+// Where appends a list predicates to the CheckpointUpdate builder.
+func (cuo *CheckpointUpdateOne) Where(ps ...predicate.Checkpoint) *CheckpointUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CheckpointUpdateOne) Select(field string, fields ...string) *CheckpointUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Checkpoint entity.
+func (cuo *CheckpointUpdateOne) Save(ctx context.Context) (*Checkpoint, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CheckpointUpdateOne) SaveX(ctx context.Context) *Checkpoint {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CheckpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CheckpointUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CheckpointUpdateOne) check() error {
+	if _, ok := cuo.mutation.EnvID(); cuo.mutation.EnvCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "Checkpoint.env"`)
+	}
+	if _, ok := cuo.mutation.BuildID(); cuo.mutation.BuildCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "Checkpoint.build"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CheckpointUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CheckpointUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *CheckpointUpdateOne) sqlSave(ctx context.Context) (_node *Checkpoint, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkpoint.Table, checkpoint.Columns, sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "Checkpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checkpoint.FieldID)
+		for _, f := range fields {
+			if !checkpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != checkpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(checkpoint.FieldName, field.TypeString, value)
+	}
+	if cuo.mutation.NameCleared() {
+		_spec.ClearField(checkpoint.FieldName, field.TypeString)
+	}
+	if value, ok := cuo.mutation.SandboxID(); ok {
+		_spec.SetField(checkpoint.FieldSandboxID, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.BaseEnvID(); ok {
+		_spec.SetField(checkpoint.FieldBaseEnvID, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Metadata(); ok {
+		_spec.SetField(checkpoint.FieldMetadata, field.TypeJSON, value)
+	}
+	if cuo.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.EnvTable,
+			Columns: []string{checkpoint.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cuo.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.EnvTable,
+			Columns: []string{checkpoint.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cuo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.BuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.BuildTable,
+			Columns: []string{checkpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = cuo.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkpoint.BuildTable,
+			Columns: []string{checkpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = cuo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = cuo.schemaConfig.Checkpoint
+	ctx = internal.NewSchemaConfigContext(ctx, cuo.schemaConfig)
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Checkpoint{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
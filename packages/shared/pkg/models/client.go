@@ -17,15 +17,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 )
@@ -37,6 +40,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// AccessToken is the client for interacting with the AccessToken builders.
 	AccessToken *AccessTokenClient
+	// Checkpoint is the client for interacting with the Checkpoint builders.
+	Checkpoint *CheckpointClient
 	// Env is the client for interacting with the Env builders.
 	Env *EnvClient
 	// EnvAlias is the client for interacting with the EnvAlias builders.
@@ -49,12 +54,16 @@ type Client struct {
 	Team *TeamClient
 	// TeamAPIKey is the client for interacting with the TeamAPIKey builders.
 	TeamAPIKey *TeamAPIKeyClient
+	// TeamWebhook is the client for interacting with the TeamWebhook builders.
+	TeamWebhook *TeamWebhookClient
 	// Tier is the client for interacting with the Tier builders.
 	Tier *TierClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UsersTeams is the client for interacting with the UsersTeams builders.
 	UsersTeams *UsersTeamsClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -67,15 +76,18 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AccessToken = NewAccessTokenClient(c.config)
+	c.Checkpoint = NewCheckpointClient(c.config)
 	c.Env = NewEnvClient(c.config)
 	c.EnvAlias = NewEnvAliasClient(c.config)
 	c.EnvBuild = NewEnvBuildClient(c.config)
 	c.Snapshot = NewSnapshotClient(c.config)
 	c.Team = NewTeamClient(c.config)
 	c.TeamAPIKey = NewTeamAPIKeyClient(c.config)
+	c.TeamWebhook = NewTeamWebhookClient(c.config)
 	c.Tier = NewTierClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UsersTeams = NewUsersTeamsClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -169,18 +181,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AccessToken: NewAccessTokenClient(cfg),
-		Env:         NewEnvClient(cfg),
-		EnvAlias:    NewEnvAliasClient(cfg),
-		EnvBuild:    NewEnvBuildClient(cfg),
-		Snapshot:    NewSnapshotClient(cfg),
-		Team:        NewTeamClient(cfg),
-		TeamAPIKey:  NewTeamAPIKeyClient(cfg),
-		Tier:        NewTierClient(cfg),
-		User:        NewUserClient(cfg),
-		UsersTeams:  NewUsersTeamsClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AccessToken:     NewAccessTokenClient(cfg),
+		Checkpoint:      NewCheckpointClient(cfg),
+		Env:             NewEnvClient(cfg),
+		EnvAlias:        NewEnvAliasClient(cfg),
+		EnvBuild:        NewEnvBuildClient(cfg),
+		Snapshot:        NewSnapshotClient(cfg),
+		Team:            NewTeamClient(cfg),
+		TeamAPIKey:      NewTeamAPIKeyClient(cfg),
+		TeamWebhook:     NewTeamWebhookClient(cfg),
+		Tier:            NewTierClient(cfg),
+		User:            NewUserClient(cfg),
+		UsersTeams:      NewUsersTeamsClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -198,18 +213,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AccessToken: NewAccessTokenClient(cfg),
-		Env:         NewEnvClient(cfg),
-		EnvAlias:    NewEnvAliasClient(cfg),
-		EnvBuild:    NewEnvBuildClient(cfg),
-		Snapshot:    NewSnapshotClient(cfg),
-		Team:        NewTeamClient(cfg),
-		TeamAPIKey:  NewTeamAPIKeyClient(cfg),
-		Tier:        NewTierClient(cfg),
-		User:        NewUserClient(cfg),
-		UsersTeams:  NewUsersTeamsClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AccessToken:     NewAccessTokenClient(cfg),
+		Checkpoint:      NewCheckpointClient(cfg),
+		Env:             NewEnvClient(cfg),
+		EnvAlias:        NewEnvAliasClient(cfg),
+		EnvBuild:        NewEnvBuildClient(cfg),
+		Snapshot:        NewSnapshotClient(cfg),
+		Team:            NewTeamClient(cfg),
+		TeamAPIKey:      NewTeamAPIKeyClient(cfg),
+		TeamWebhook:     NewTeamWebhookClient(cfg),
+		Tier:            NewTierClient(cfg),
+		User:            NewUserClient(cfg),
+		UsersTeams:      NewUsersTeamsClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -239,8 +257,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AccessToken, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team, c.TeamAPIKey,
-		c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Checkpoint, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team,
+		c.TeamAPIKey, c.TeamWebhook, c.Tier, c.User, c.UsersTeams, c.WebhookDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -250,8 +268,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AccessToken, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team, c.TeamAPIKey,
-		c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Checkpoint, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team,
+		c.TeamAPIKey, c.TeamWebhook, c.Tier, c.User, c.UsersTeams, c.WebhookDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -262,6 +280,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AccessTokenMutation:
 		return c.AccessToken.mutate(ctx, m)
+	case *CheckpointMutation:
+		return c.Checkpoint.mutate(ctx, m)
 	case *EnvMutation:
 		return c.Env.mutate(ctx, m)
 	case *EnvAliasMutation:
@@ -274,12 +294,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Team.mutate(ctx, m)
 	case *TeamAPIKeyMutation:
 		return c.TeamAPIKey.mutate(ctx, m)
+	case *TeamWebhookMutation:
+		return c.TeamWebhook.mutate(ctx, m)
 	case *TierMutation:
 		return c.Tier.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UsersTeamsMutation:
 		return c.UsersTeams.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("models: unknown mutation type %T", m)
 	}
@@ -437,6 +461,177 @@ func (c *AccessTokenClient) mutate(ctx context.Context, m *AccessTokenMutation)
 	}
 }
 
+// CheckpointClient is a client for the Checkpoint schema.
+type CheckpointClient struct {
+	config
+}
+
+// NewCheckpointClient returns a client for the Checkpoint from the given config.
+func NewCheckpointClient(c config) *CheckpointClient {
+	return &CheckpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `checkpoint.Hooks(f(g(h())))`.
+func (c *CheckpointClient) Use(hooks ...Hook) {
+	c.hooks.Checkpoint = append(c.hooks.Checkpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `checkpoint.Intercept(f(g(h())))`.
+func (c *CheckpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Checkpoint = append(c.inters.Checkpoint, interceptors...)
+}
+
+// Create returns a builder for creating a Checkpoint entity.
+func (c *CheckpointClient) Create() *CheckpointCreate {
+	mutation := newCheckpointMutation(c.config, OpCreate)
+	return &CheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Checkpoint entities.
+func (c *CheckpointClient) CreateBulk(builders ...*CheckpointCreate) *CheckpointCreateBulk {
+	return &CheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CheckpointClient) MapCreateBulk(slice any, setFunc func(*CheckpointCreate, int)) *CheckpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CheckpointCreateBulk{err: fmt.Errorf("calling to CheckpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CheckpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Checkpoint.
+func (c *CheckpointClient) Update() *CheckpointUpdate {
+	mutation := newCheckpointMutation(c.config, OpUpdate)
+	return &CheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CheckpointClient) UpdateOne(ch *Checkpoint) *CheckpointUpdateOne {
+	mutation := newCheckpointMutation(c.config, OpUpdateOne, withCheckpoint(ch))
+	return &CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CheckpointClient) UpdateOneID(id uuid.UUID) *CheckpointUpdateOne {
+	mutation := newCheckpointMutation(c.config, OpUpdateOne, withCheckpointID(id))
+	return &CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Checkpoint.
+func (c *CheckpointClient) Delete() *CheckpointDelete {
+	mutation := newCheckpointMutation(c.config, OpDelete)
+	return &CheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CheckpointClient) DeleteOne(ch *Checkpoint) *CheckpointDeleteOne {
+	return c.DeleteOneID(ch.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CheckpointClient) DeleteOneID(id uuid.UUID) *CheckpointDeleteOne {
+	builder := c.Delete().Where(checkpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CheckpointDeleteOne{builder}
+}
+
+// Query returns a query builder for Checkpoint.
+func (c *CheckpointClient) Query() *CheckpointQuery {
+	return &CheckpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCheckpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Checkpoint entity by its id.
+func (c *CheckpointClient) Get(ctx context.Context, id uuid.UUID) (*Checkpoint, error) {
+	return c.Query().Where(checkpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CheckpointClient) GetX(ctx context.Context, id uuid.UUID) *Checkpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnv queries the env edge of a Checkpoint.
+func (c *CheckpointClient) QueryEnv(ch *Checkpoint) *EnvQuery {
+	query := (&EnvClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checkpoint.Table, checkpoint.FieldID, id),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, checkpoint.EnvTable, checkpoint.EnvColumn),
+		)
+		schemaConfig := ch.schemaConfig
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuild queries the build edge of a Checkpoint.
+func (c *CheckpointClient) QueryBuild(ch *Checkpoint) *EnvBuildQuery {
+	query := (&EnvBuildClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checkpoint.Table, checkpoint.FieldID, id),
+			sqlgraph.To(envbuild.Table, envbuild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, checkpoint.BuildTable, checkpoint.BuildColumn),
+		)
+		schemaConfig := ch.schemaConfig
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CheckpointClient) Hooks() []Hook {
+	return c.hooks.Checkpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *CheckpointClient) Interceptors() []Interceptor {
+	return c.inters.Checkpoint
+}
+
+func (c *CheckpointClient) mutate(ctx context.Context, m *CheckpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown Checkpoint mutation op: %q", m.Op())
+	}
+}
+
 // EnvClient is a client for the Env schema.
 type EnvClient struct {
 	config
@@ -640,6 +835,25 @@ func (c *EnvClient) QuerySnapshots(e *Env) *SnapshotQuery {
 	return query
 }
 
+// QueryCheckpoints queries the checkpoints edge of a Env.
+func (c *EnvClient) QueryCheckpoints(e *Env) *CheckpointQuery {
+	query := (&CheckpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, id),
+			sqlgraph.To(checkpoint.Table, checkpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, env.CheckpointsTable, env.CheckpointsColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EnvClient) Hooks() []Hook {
 	return c.hooks.Env
@@ -944,6 +1158,25 @@ func (c *EnvBuildClient) QueryEnv(eb *EnvBuild) *EnvQuery {
 	return query
 }
 
+// QueryCheckpoints queries the checkpoints edge of a EnvBuild.
+func (c *EnvBuildClient) QueryCheckpoints(eb *EnvBuild) *CheckpointQuery {
+	query := (&CheckpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := eb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envbuild.Table, envbuild.FieldID, id),
+			sqlgraph.To(checkpoint.Table, checkpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, envbuild.CheckpointsTable, envbuild.CheckpointsColumn),
+		)
+		schemaConfig := eb.schemaConfig
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromV = sqlgraph.Neighbors(eb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EnvBuildClient) Hooks() []Hook {
 	return c.hooks.EnvBuild
@@ -1267,6 +1500,25 @@ func (c *TeamClient) QueryTeamAPIKeys(t *Team) *TeamAPIKeyQuery {
 	return query
 }
 
+// QueryTeamWebhooks queries the team_webhooks edge of a Team.
+func (c *TeamClient) QueryTeamWebhooks(t *Team) *TeamWebhookQuery {
+	query := (&TeamWebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(teamwebhook.Table, teamwebhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamWebhooksTable, team.TeamWebhooksColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.TeamWebhook
+		step.Edge.Schema = schemaConfig.TeamWebhook
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryTeamTier queries the team_tier edge of a Team.
 func (c *TeamClient) QueryTeamTier(t *Team) *TierQuery {
 	query := (&TierClient{config: c.config}).Query()
@@ -1520,6 +1772,177 @@ func (c *TeamAPIKeyClient) mutate(ctx context.Context, m *TeamAPIKeyMutation) (V
 	}
 }
 
+// TeamWebhookClient is a client for the TeamWebhook schema.
+type TeamWebhookClient struct {
+	config
+}
+
+// NewTeamWebhookClient returns a client for the TeamWebhook from the given config.
+func NewTeamWebhookClient(c config) *TeamWebhookClient {
+	return &TeamWebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `teamwebhook.Hooks(f(g(h())))`.
+func (c *TeamWebhookClient) Use(hooks ...Hook) {
+	c.hooks.TeamWebhook = append(c.hooks.TeamWebhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `teamwebhook.Intercept(f(g(h())))`.
+func (c *TeamWebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamWebhook = append(c.inters.TeamWebhook, interceptors...)
+}
+
+// Create returns a builder for creating a TeamWebhook entity.
+func (c *TeamWebhookClient) Create() *TeamWebhookCreate {
+	mutation := newTeamWebhookMutation(c.config, OpCreate)
+	return &TeamWebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TeamWebhook entities.
+func (c *TeamWebhookClient) CreateBulk(builders ...*TeamWebhookCreate) *TeamWebhookCreateBulk {
+	return &TeamWebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamWebhookClient) MapCreateBulk(slice any, setFunc func(*TeamWebhookCreate, int)) *TeamWebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamWebhookCreateBulk{err: fmt.Errorf("calling to TeamWebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamWebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamWebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TeamWebhook.
+func (c *TeamWebhookClient) Update() *TeamWebhookUpdate {
+	mutation := newTeamWebhookMutation(c.config, OpUpdate)
+	return &TeamWebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamWebhookClient) UpdateOne(tw *TeamWebhook) *TeamWebhookUpdateOne {
+	mutation := newTeamWebhookMutation(c.config, OpUpdateOne, withTeamWebhook(tw))
+	return &TeamWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamWebhookClient) UpdateOneID(id uuid.UUID) *TeamWebhookUpdateOne {
+	mutation := newTeamWebhookMutation(c.config, OpUpdateOne, withTeamWebhookID(id))
+	return &TeamWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TeamWebhook.
+func (c *TeamWebhookClient) Delete() *TeamWebhookDelete {
+	mutation := newTeamWebhookMutation(c.config, OpDelete)
+	return &TeamWebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamWebhookClient) DeleteOne(tw *TeamWebhook) *TeamWebhookDeleteOne {
+	return c.DeleteOneID(tw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamWebhookClient) DeleteOneID(id uuid.UUID) *TeamWebhookDeleteOne {
+	builder := c.Delete().Where(teamwebhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamWebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for TeamWebhook.
+func (c *TeamWebhookClient) Query() *TeamWebhookQuery {
+	return &TeamWebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeamWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TeamWebhook entity by its id.
+func (c *TeamWebhookClient) Get(ctx context.Context, id uuid.UUID) (*TeamWebhook, error) {
+	return c.Query().Where(teamwebhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamWebhookClient) GetX(ctx context.Context, id uuid.UUID) *TeamWebhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTeam queries the team edge of a TeamWebhook.
+func (c *TeamWebhookClient) QueryTeam(tw *TeamWebhook) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamwebhook.Table, teamwebhook.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamwebhook.TeamTable, teamwebhook.TeamColumn),
+		)
+		schemaConfig := tw.schemaConfig
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.TeamWebhook
+		fromV = sqlgraph.Neighbors(tw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a TeamWebhook.
+func (c *TeamWebhookClient) QueryDeliveries(tw *TeamWebhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamwebhook.Table, teamwebhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, teamwebhook.DeliveriesTable, teamwebhook.DeliveriesColumn),
+		)
+		schemaConfig := tw.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(tw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamWebhookClient) Hooks() []Hook {
+	return c.hooks.TeamWebhook
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamWebhookClient) Interceptors() []Interceptor {
+	return c.inters.TeamWebhook
+}
+
+func (c *TeamWebhookClient) mutate(ctx context.Context, m *TeamWebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamWebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamWebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamWebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown TeamWebhook mutation op: %q", m.Op())
+	}
+}
+
 // TierClient is a client for the Tier schema.
 type TierClient struct {
 	config
@@ -2071,31 +2494,186 @@ func (c *UsersTeamsClient) mutate(ctx context.Context, m *UsersTeamsMutation) (V
 	}
 }
 
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *TeamWebhookQuery {
+	query := (&TeamWebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(teamwebhook.Table, teamwebhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		schemaConfig := wd.schemaConfig
+		step.To.Schema = schemaConfig.TeamWebhook
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AccessToken, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier, User,
-		UsersTeams []ent.Hook
+		AccessToken, Checkpoint, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey,
+		TeamWebhook, Tier, User, UsersTeams, WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		AccessToken, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier, User,
-		UsersTeams []ent.Interceptor
+		AccessToken, Checkpoint, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey,
+		TeamWebhook, Tier, User, UsersTeams, WebhookDelivery []ent.Interceptor
 	}
 )
 
 var (
 	// DefaultSchemaConfig represents the default schema names for all tables as defined in ent/schema.
 	DefaultSchemaConfig = SchemaConfig{
-		AccessToken: tableSchemas[1],
-		Env:         tableSchemas[1],
-		EnvAlias:    tableSchemas[1],
-		EnvBuild:    tableSchemas[1],
-		Snapshot:    tableSchemas[1],
-		Team:        tableSchemas[1],
-		TeamAPIKey:  tableSchemas[1],
-		Tier:        tableSchemas[1],
-		User:        tableSchemas[0],
-		UsersTeams:  tableSchemas[1],
+		AccessToken:     tableSchemas[1],
+		Checkpoint:      tableSchemas[1],
+		Env:             tableSchemas[1],
+		EnvAlias:        tableSchemas[1],
+		EnvBuild:        tableSchemas[1],
+		Snapshot:        tableSchemas[1],
+		Team:            tableSchemas[1],
+		TeamAPIKey:      tableSchemas[1],
+		TeamWebhook:     tableSchemas[1],
+		Tier:            tableSchemas[1],
+		User:            tableSchemas[0],
+		UsersTeams:      tableSchemas[1],
+		WebhookDelivery: tableSchemas[1],
 	}
 	tableSchemas = [...]string{"auth", "public"}
 )
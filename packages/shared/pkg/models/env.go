@@ -54,9 +54,11 @@ type EnvEdges struct {
 	Builds []*EnvBuild `json:"builds,omitempty"`
 	// Snapshots holds the value of the snapshots edge.
 	Snapshots []*Snapshot `json:"snapshots,omitempty"`
+	// Checkpoints holds the value of the checkpoints edge.
+	Checkpoints []*Checkpoint `json:"checkpoints,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // TeamOrErr returns the Team value or an error if the edge
@@ -112,6 +114,15 @@ func (e EnvEdges) SnapshotsOrErr() ([]*Snapshot, error) {
 	return nil, &NotLoadedError{edge: "snapshots"}
 }
 
+// CheckpointsOrErr returns the Checkpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvEdges) CheckpointsOrErr() ([]*Checkpoint, error) {
+	if e.loadedTypes[5] {
+		return e.Checkpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "checkpoints"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Env) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -237,6 +248,11 @@ func (e *Env) QuerySnapshots() *SnapshotQuery {
 	return NewEnvClient(e.config).QuerySnapshots(e)
 }
 
+// QueryCheckpoints queries the "checkpoints" edge of the Env entity.
+func (e *Env) QueryCheckpoints() *CheckpointQuery {
+	return NewEnvClient(e.config).QueryCheckpoints(e)
+}
+
 // Update returns a builder for updating this Env.
 // Note that you need to call Env.Unwrap() before calling this method if this Env
 // was returned from a transaction, and the transaction was committed or rolled back.
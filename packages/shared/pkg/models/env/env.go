@@ -40,6 +40,8 @@ const (
 	EdgeBuilds = "builds"
 	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
 	EdgeSnapshots = "snapshots"
+	// EdgeCheckpoints holds the string denoting the checkpoints edge name in mutations.
+	EdgeCheckpoints = "checkpoints"
 	// EnvAliasFieldID holds the string denoting the ID field of the EnvAlias.
 	EnvAliasFieldID = "alias"
 	// Table holds the table name of the env in the database.
@@ -79,6 +81,13 @@ const (
 	SnapshotsInverseTable = "snapshots"
 	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
 	SnapshotsColumn = "env_id"
+	// CheckpointsTable is the table that holds the checkpoints relation/edge.
+	CheckpointsTable = "checkpoints"
+	// CheckpointsInverseTable is the table name for the Checkpoint entity.
+	// It exists in this package in order to avoid circular dependency with the "checkpoint" package.
+	CheckpointsInverseTable = "checkpoints"
+	// CheckpointsColumn is the table column denoting the checkpoints relation/edge.
+	CheckpointsColumn = "env_id"
 )
 
 // Columns holds all SQL columns for env fields.
@@ -218,6 +227,20 @@ func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCheckpointsCount orders the results by checkpoints count.
+func ByCheckpointsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCheckpointsStep(), opts...)
+	}
+}
+
+// ByCheckpoints orders the results by checkpoints terms.
+func ByCheckpoints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheckpointsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTeamStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -253,3 +276,10 @@ func newSnapshotsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
 	)
 }
+func newCheckpointsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheckpointsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+	)
+}
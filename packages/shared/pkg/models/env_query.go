@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
@@ -25,16 +26,17 @@ import (
 // EnvQuery is the builder for querying Env entities.
 type EnvQuery struct {
 	config
-	ctx            *QueryContext
-	order          []env.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Env
-	withTeam       *TeamQuery
-	withCreator    *UserQuery
-	withEnvAliases *EnvAliasQuery
-	withBuilds     *EnvBuildQuery
-	withSnapshots  *SnapshotQuery
-	modifiers      []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []env.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Env
+	withTeam        *TeamQuery
+	withCreator     *UserQuery
+	withEnvAliases  *EnvAliasQuery
+	withBuilds      *EnvBuildQuery
+	withSnapshots   *SnapshotQuery
+	withCheckpoints *CheckpointQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -196,6 +198,31 @@ func (eq *EnvQuery) QuerySnapshots() *SnapshotQuery {
 	return query
 }
 
+// QueryCheckpoints chains the current query on the "checkpoints" edge.
+func (eq *EnvQuery) QueryCheckpoints() *CheckpointQuery {
+	query := (&CheckpointClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, selector),
+			sqlgraph.To(checkpoint.Table, checkpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, env.CheckpointsTable, env.CheckpointsColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Env entity from the query.
 // Returns a *NotFoundError when no Env was found.
 func (eq *EnvQuery) First(ctx context.Context) (*Env, error) {
@@ -383,16 +410,17 @@ func (eq *EnvQuery) Clone() *EnvQuery {
 		return nil
 	}
 	return &EnvQuery{
-		config:         eq.config,
-		ctx:            eq.ctx.Clone(),
-		order:          append([]env.OrderOption{}, eq.order...),
-		inters:         append([]Interceptor{}, eq.inters...),
-		predicates:     append([]predicate.Env{}, eq.predicates...),
-		withTeam:       eq.withTeam.Clone(),
-		withCreator:    eq.withCreator.Clone(),
-		withEnvAliases: eq.withEnvAliases.Clone(),
-		withBuilds:     eq.withBuilds.Clone(),
-		withSnapshots:  eq.withSnapshots.Clone(),
+		config:          eq.config,
+		ctx:             eq.ctx.Clone(),
+		order:           append([]env.OrderOption{}, eq.order...),
+		inters:          append([]Interceptor{}, eq.inters...),
+		predicates:      append([]predicate.Env{}, eq.predicates...),
+		withTeam:        eq.withTeam.Clone(),
+		withCreator:     eq.withCreator.Clone(),
+		withEnvAliases:  eq.withEnvAliases.Clone(),
+		withBuilds:      eq.withBuilds.Clone(),
+		withSnapshots:   eq.withSnapshots.Clone(),
+		withCheckpoints: eq.withCheckpoints.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -454,6 +482,17 @@ func (eq *EnvQuery) WithSnapshots(opts ...func(*SnapshotQuery)) *EnvQuery {
 	return eq
 }
 
+// WithCheckpoints tells the query-builder to eager-load the nodes that are connected to
+// the "checkpoints" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvQuery) WithCheckpoints(opts ...func(*CheckpointQuery)) *EnvQuery {
+	query := (&CheckpointClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withCheckpoints = query
+	return eq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -532,12 +571,13 @@ func (eq *EnvQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Env, err
 	var (
 		nodes       = []*Env{}
 		_spec       = eq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			eq.withTeam != nil,
 			eq.withCreator != nil,
 			eq.withEnvAliases != nil,
 			eq.withBuilds != nil,
 			eq.withSnapshots != nil,
+			eq.withCheckpoints != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -596,6 +636,13 @@ func (eq *EnvQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Env, err
 			return nil, err
 		}
 	}
+	if query := eq.withCheckpoints; query != nil {
+		if err := eq.loadCheckpoints(ctx, query, nodes,
+			func(n *Env) { n.Edges.Checkpoints = []*Checkpoint{} },
+			func(n *Env, e *Checkpoint) { n.Edges.Checkpoints = append(n.Edges.Checkpoints, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -753,6 +800,36 @@ func (eq *EnvQuery) loadSnapshots(ctx context.Context, query *SnapshotQuery, nod
 	}
 	return nil
 }
+func (eq *EnvQuery) loadCheckpoints(ctx context.Context, query *CheckpointQuery, nodes []*Env, init func(*Env), assign func(*Env, *Checkpoint)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Env)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(checkpoint.FieldEnvID)
+	}
+	query.Where(predicate.Checkpoint(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(env.CheckpointsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "env_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (eq *EnvQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
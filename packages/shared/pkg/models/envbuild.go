@@ -57,9 +57,11 @@ type EnvBuild struct {
 type EnvBuildEdges struct {
 	// Env holds the value of the env edge.
 	Env *Env `json:"env,omitempty"`
+	// Checkpoints holds the value of the checkpoints edge.
+	Checkpoints []*Checkpoint `json:"checkpoints,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // EnvOrErr returns the Env value or an error if the edge
@@ -75,6 +77,15 @@ func (e EnvBuildEdges) EnvOrErr() (*Env, error) {
 	return nil, &NotLoadedError{edge: "env"}
 }
 
+// CheckpointsOrErr returns the Checkpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvBuildEdges) CheckpointsOrErr() ([]*Checkpoint, error) {
+	if e.loadedTypes[1] {
+		return e.Checkpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "checkpoints"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*EnvBuild) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -217,6 +228,11 @@ func (eb *EnvBuild) QueryEnv() *EnvQuery {
 	return NewEnvBuildClient(eb.config).QueryEnv(eb)
 }
 
+// QueryCheckpoints queries the "checkpoints" edge of the EnvBuild entity.
+func (eb *EnvBuild) QueryCheckpoints() *CheckpointQuery {
+	return NewEnvBuildClient(eb.config).QueryCheckpoints(eb)
+}
+
 // Update returns a builder for updating this EnvBuild.
 // Note that you need to call EnvBuild.Unwrap() before calling this method if this EnvBuild
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -45,6 +45,8 @@ const (
 	FieldEnvdVersion = "envd_version"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
+	// EdgeCheckpoints holds the string denoting the checkpoints edge name in mutations.
+	EdgeCheckpoints = "checkpoints"
 	// Table holds the table name of the envbuild in the database.
 	Table = "env_builds"
 	// EnvTable is the table that holds the env relation/edge.
@@ -54,6 +56,13 @@ const (
 	EnvInverseTable = "envs"
 	// EnvColumn is the table column denoting the env relation/edge.
 	EnvColumn = "env_id"
+	// CheckpointsTable is the table that holds the checkpoints relation/edge.
+	CheckpointsTable = "checkpoints"
+	// CheckpointsInverseTable is the table name for the Checkpoint entity.
+	// It exists in this package in order to avoid circular dependency with the "checkpoint" package.
+	CheckpointsInverseTable = "checkpoints"
+	// CheckpointsColumn is the table column denoting the checkpoints relation/edge.
+	CheckpointsColumn = "build_id"
 )
 
 // Columns holds all SQL columns for envbuild fields.
@@ -109,6 +118,7 @@ const (
 	StatusFailed   Status = "failed"
 	StatusSuccess  Status = "success"
 	StatusUploaded Status = "uploaded"
+	StatusDeleted  Status = "deleted"
 )
 
 func (s Status) String() string {
@@ -118,7 +128,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusWaiting, StatusBuilding, StatusFailed, StatusSuccess, StatusUploaded:
+	case StatusWaiting, StatusBuilding, StatusFailed, StatusSuccess, StatusUploaded, StatusDeleted:
 		return nil
 	default:
 		return fmt.Errorf("envbuild: invalid enum value for status field: %q", s)
@@ -209,6 +219,20 @@ func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEnvStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCheckpointsCount orders the results by checkpoints count.
+func ByCheckpointsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCheckpointsStep(), opts...)
+	}
+}
+
+// ByCheckpoints orders the results by checkpoints terms.
+func ByCheckpoints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheckpointsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnvStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -216,3 +240,10 @@ func newEnvStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
 	)
 }
+func newCheckpointsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheckpointsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+	)
+}
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/google/uuid"
@@ -208,6 +209,21 @@ func (ebc *EnvBuildCreate) SetEnv(e *Env) *EnvBuildCreate {
 	return ebc.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (ebc *EnvBuildCreate) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.AddCheckpointIDs(ids...)
+	return ebc
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (ebc *EnvBuildCreate) AddCheckpoints(c ...*Checkpoint) *EnvBuildCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebc.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebc *EnvBuildCreate) Mutation() *EnvBuildMutation {
 	return ebc.mutation
@@ -403,6 +419,23 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_node.EnvID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ebc.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebc.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,12 +4,14 @@ package models
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
@@ -20,12 +22,13 @@ import (
 // EnvBuildQuery is the builder for querying EnvBuild entities.
 type EnvBuildQuery struct {
 	config
-	ctx        *QueryContext
-	order      []envbuild.OrderOption
-	inters     []Interceptor
-	predicates []predicate.EnvBuild
-	withEnv    *EnvQuery
-	modifiers  []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []envbuild.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.EnvBuild
+	withEnv         *EnvQuery
+	withCheckpoints *CheckpointQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +90,31 @@ func (ebq *EnvBuildQuery) QueryEnv() *EnvQuery {
 	return query
 }
 
+// QueryCheckpoints chains the current query on the "checkpoints" edge.
+func (ebq *EnvBuildQuery) QueryCheckpoints() *CheckpointQuery {
+	query := (&CheckpointClient{config: ebq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ebq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ebq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envbuild.Table, envbuild.FieldID, selector),
+			sqlgraph.To(checkpoint.Table, checkpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, envbuild.CheckpointsTable, envbuild.CheckpointsColumn),
+		)
+		schemaConfig := ebq.schemaConfig
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		fromU = sqlgraph.SetNeighbors(ebq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first EnvBuild entity from the query.
 // Returns a *NotFoundError when no EnvBuild was found.
 func (ebq *EnvBuildQuery) First(ctx context.Context) (*EnvBuild, error) {
@@ -274,12 +302,13 @@ func (ebq *EnvBuildQuery) Clone() *EnvBuildQuery {
 		return nil
 	}
 	return &EnvBuildQuery{
-		config:     ebq.config,
-		ctx:        ebq.ctx.Clone(),
-		order:      append([]envbuild.OrderOption{}, ebq.order...),
-		inters:     append([]Interceptor{}, ebq.inters...),
-		predicates: append([]predicate.EnvBuild{}, ebq.predicates...),
-		withEnv:    ebq.withEnv.Clone(),
+		config:          ebq.config,
+		ctx:             ebq.ctx.Clone(),
+		order:           append([]envbuild.OrderOption{}, ebq.order...),
+		inters:          append([]Interceptor{}, ebq.inters...),
+		predicates:      append([]predicate.EnvBuild{}, ebq.predicates...),
+		withEnv:         ebq.withEnv.Clone(),
+		withCheckpoints: ebq.withCheckpoints.Clone(),
 		// clone intermediate query.
 		sql:  ebq.sql.Clone(),
 		path: ebq.path,
@@ -297,6 +326,17 @@ func (ebq *EnvBuildQuery) WithEnv(opts ...func(*EnvQuery)) *EnvBuildQuery {
 	return ebq
 }
 
+// WithCheckpoints tells the query-builder to eager-load the nodes that are connected to
+// the "checkpoints" edge. The optional arguments are used to configure the query builder of the edge.
+func (ebq *EnvBuildQuery) WithCheckpoints(opts ...func(*CheckpointQuery)) *EnvBuildQuery {
+	query := (&CheckpointClient{config: ebq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ebq.withCheckpoints = query
+	return ebq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -375,8 +415,9 @@ func (ebq *EnvBuildQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*En
 	var (
 		nodes       = []*EnvBuild{}
 		_spec       = ebq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			ebq.withEnv != nil,
+			ebq.withCheckpoints != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -408,6 +449,13 @@ func (ebq *EnvBuildQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*En
 			return nil, err
 		}
 	}
+	if query := ebq.withCheckpoints; query != nil {
+		if err := ebq.loadCheckpoints(ctx, query, nodes,
+			func(n *EnvBuild) { n.Edges.Checkpoints = []*Checkpoint{} },
+			func(n *EnvBuild, e *Checkpoint) { n.Edges.Checkpoints = append(n.Edges.Checkpoints, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -443,6 +491,36 @@ func (ebq *EnvBuildQuery) loadEnv(ctx context.Context, query *EnvQuery, nodes []
 	}
 	return nil
 }
+func (ebq *EnvBuildQuery) loadCheckpoints(ctx context.Context, query *CheckpointQuery, nodes []*EnvBuild, init func(*EnvBuild), assign func(*EnvBuild, *Checkpoint)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*EnvBuild)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(checkpoint.FieldBuildID)
+	}
+	query.Where(predicate.Checkpoint(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(envbuild.CheckpointsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BuildID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "build_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (ebq *EnvBuildQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ebq.querySpec()
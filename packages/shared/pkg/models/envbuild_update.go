@@ -11,10 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
 )
 
 // EnvBuildUpdate is the builder for updating EnvBuild entities.
@@ -282,6 +284,21 @@ func (ebu *EnvBuildUpdate) SetEnv(e *Env) *EnvBuildUpdate {
 	return ebu.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (ebu *EnvBuildUpdate) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.AddCheckpointIDs(ids...)
+	return ebu
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (ebu *EnvBuildUpdate) AddCheckpoints(c ...*Checkpoint) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebu.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebu *EnvBuildUpdate) Mutation() *EnvBuildMutation {
 	return ebu.mutation
@@ -293,6 +310,27 @@ func (ebu *EnvBuildUpdate) ClearEnv() *EnvBuildUpdate {
 	return ebu
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the Checkpoint entity.
+func (ebu *EnvBuildUpdate) ClearCheckpoints() *EnvBuildUpdate {
+	ebu.mutation.ClearCheckpoints()
+	return ebu
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to Checkpoint entities by IDs.
+func (ebu *EnvBuildUpdate) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.RemoveCheckpointIDs(ids...)
+	return ebu
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to Checkpoint entities.
+func (ebu *EnvBuildUpdate) RemoveCheckpoints(c ...*Checkpoint) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebu.RemoveCheckpointIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ebu *EnvBuildUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ebu.sqlSave, ebu.mutation, ebu.hooks)
@@ -442,6 +480,54 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ebu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !ebu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ebu.schemaConfig.EnvBuild
 	ctx = internal.NewSchemaConfigContext(ctx, ebu.schemaConfig)
 	_spec.AddModifiers(ebu.modifiers...)
@@ -717,6 +803,21 @@ func (ebuo *EnvBuildUpdateOne) SetEnv(e *Env) *EnvBuildUpdateOne {
 	return ebuo.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (ebuo *EnvBuildUpdateOne) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.AddCheckpointIDs(ids...)
+	return ebuo
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (ebuo *EnvBuildUpdateOne) AddCheckpoints(c ...*Checkpoint) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebuo.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebuo *EnvBuildUpdateOne) Mutation() *EnvBuildMutation {
 	return ebuo.mutation
@@ -728,6 +829,27 @@ func (ebuo *EnvBuildUpdateOne) ClearEnv() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the Checkpoint entity.
+func (ebuo *EnvBuildUpdateOne) ClearCheckpoints() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearCheckpoints()
+	return ebuo
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to Checkpoint entities by IDs.
+func (ebuo *EnvBuildUpdateOne) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.RemoveCheckpointIDs(ids...)
+	return ebuo
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to Checkpoint entities.
+func (ebuo *EnvBuildUpdateOne) RemoveCheckpoints(c ...*Checkpoint) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebuo.RemoveCheckpointIDs(ids...)
+}
+
 // Where appends a list predicates to the EnvBuildUpdate builder.
 func (ebuo *EnvBuildUpdateOne) Where(ps ...predicate.EnvBuild) *EnvBuildUpdateOne {
 	ebuo.mutation.Where(ps...)
@@ -907,6 +1029,54 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ebuo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !ebuo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ebuo.schemaConfig.EnvBuild
 	ctx = internal.NewSchemaConfigContext(ctx, ebuo.schemaConfig)
 	_spec.AddModifiers(ebuo.modifiers...)
@@ -21,6 +21,18 @@ func (f AccessTokenFunc) Mutate(ctx context.Context, m models.Mutation) (models.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.AccessTokenMutation", m)
 }
 
+// The CheckpointFunc type is an adapter to allow the use of ordinary
+// function as Checkpoint mutator.
+type CheckpointFunc func(context.Context, *models.CheckpointMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CheckpointFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.CheckpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.CheckpointMutation", m)
+}
+
 // The EnvFunc type is an adapter to allow the use of ordinary
 // function as Env mutator.
 type EnvFunc func(context.Context, *models.EnvMutation) (models.Value, error)
@@ -93,6 +105,18 @@ func (f TeamAPIKeyFunc) Mutate(ctx context.Context, m models.Mutation) (models.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamAPIKeyMutation", m)
 }
 
+// The TeamWebhookFunc type is an adapter to allow the use of ordinary
+// function as TeamWebhook mutator.
+type TeamWebhookFunc func(context.Context, *models.TeamWebhookMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamWebhookFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.TeamWebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.TeamWebhookMutation", m)
+}
+
 // The TierFunc type is an adapter to allow the use of ordinary
 // function as Tier mutator.
 type TierFunc func(context.Context, *models.TierMutation) (models.Value, error)
@@ -129,6 +153,18 @@ func (f UsersTeamsFunc) Mutate(ctx context.Context, m models.Mutation) (models.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.UsersTeamsMutation", m)
 }
 
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *models.WebhookDeliveryMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WebhookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, models.Mutation) bool
 
@@ -7,16 +7,19 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	AccessToken string // AccessToken table.
-	Env         string // Env table.
-	EnvAlias    string // EnvAlias table.
-	EnvBuild    string // EnvBuild table.
-	Snapshot    string // Snapshot table.
-	Team        string // Team table.
-	TeamAPIKey  string // TeamAPIKey table.
-	Tier        string // Tier table.
-	User        string // User table.
-	UsersTeams  string // UsersTeams table.
+	AccessToken     string // AccessToken table.
+	Checkpoint      string // Checkpoint table.
+	Env             string // Env table.
+	EnvAlias        string // EnvAlias table.
+	EnvBuild        string // EnvBuild table.
+	Snapshot        string // Snapshot table.
+	Team            string // Team table.
+	TeamAPIKey      string // TeamAPIKey table.
+	TeamWebhook     string // TeamWebhook table.
+	Tier            string // Tier table.
+	User            string // User table.
+	UsersTeams      string // UsersTeams table.
+	WebhookDelivery string // WebhookDelivery table.
 }
 
 type schemaCtxKey struct{}
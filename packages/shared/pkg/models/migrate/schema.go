@@ -29,6 +29,37 @@ var (
 			},
 		},
 	}
+	// CheckpointsColumns holds the columns for the "checkpoints" table.
+	CheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "sandbox_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "base_env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "metadata", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "build_id", Type: field.TypeUUID},
+	}
+	// CheckpointsTable holds the schema information for the "checkpoints" table.
+	CheckpointsTable = &schema.Table{
+		Name:       "checkpoints",
+		Columns:    CheckpointsColumns,
+		PrimaryKey: []*schema.Column{CheckpointsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "checkpoints_envs_checkpoints",
+				Columns:    []*schema.Column{CheckpointsColumns[6]},
+				RefColumns: []*schema.Column{EnvsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "checkpoints_env_builds_checkpoints",
+				Columns:    []*schema.Column{CheckpointsColumns[7]},
+				RefColumns: []*schema.Column{EnvBuildsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// EnvsColumns holds the columns for the "envs" table.
 	EnvsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
@@ -87,7 +118,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "building", "failed", "success", "uploaded"}, Default: "waiting", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "building", "failed", "success", "uploaded", "deleted"}, Default: "waiting", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "dockerfile", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "start_cmd", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "vcpu", Type: field.TypeInt64},
@@ -169,6 +200,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, Default: "Unnamed API Key", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "last_used", Type: field.TypeTime, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "template_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "team_id", Type: field.TypeUUID},
 		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
 	}
@@ -180,18 +214,41 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "team_api_keys_teams_team_api_keys",
-				Columns:    []*schema.Column{TeamAPIKeysColumns[6]},
+				Columns:    []*schema.Column{TeamAPIKeysColumns[9]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "team_api_keys_users_created_api_keys",
-				Columns:    []*schema.Column{TeamAPIKeysColumns[7]},
+				Columns:    []*schema.Column{TeamAPIKeysColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// TeamWebhooksColumns holds the columns for the "team_webhooks" table.
+	TeamWebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "url", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "secret", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "events", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "team_id", Type: field.TypeUUID},
+	}
+	// TeamWebhooksTable holds the schema information for the "team_webhooks" table.
+	TeamWebhooksTable = &schema.Table{
+		Name:       "team_webhooks",
+		Columns:    TeamWebhooksColumns,
+		PrimaryKey: []*schema.Column{TeamWebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "team_webhooks_teams_team_webhooks",
+				Columns:    []*schema.Column{TeamWebhooksColumns[5]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// TiersColumns holds the columns for the "tiers" table.
 	TiersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "text"}},
@@ -199,6 +256,11 @@ var (
 		{Name: "disk_mb", Type: field.TypeInt64, Default: "512"},
 		{Name: "concurrent_instances", Type: field.TypeInt64, Comment: "The number of instances the team can run concurrently"},
 		{Name: "max_length_hours", Type: field.TypeInt64},
+		{Name: "max_vcpu", Type: field.TypeInt64, Nullable: true, Comment: "The total number of vCPUs the running sandboxes of the team can use, unlimited if null"},
+		{Name: "max_ram_mb", Type: field.TypeInt64, Nullable: true, Comment: "The total RAM in MB the running sandboxes of the team can use, unlimited if null"},
+		{Name: "max_sandbox_starts_per_minute", Type: field.TypeInt64, Nullable: true, Comment: "The number of sandboxes the team can start per minute, unlimited if null"},
+		{Name: "max_concurrent_builds", Type: field.TypeInt64, Nullable: true, Comment: "The number of template builds the team can run concurrently, unlimited if null"},
+		{Name: "max_snapshot_storage_mb", Type: field.TypeInt64, Nullable: true, Comment: "The total size in MB of the stored snapshots of the team, unlimited if null"},
 	}
 	// TiersTable holds the schema information for the "tiers" table.
 	TiersTable = &schema.Table{
@@ -251,24 +313,59 @@ var (
 			},
 		},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "payload", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "attempts", Type: field.TypeInt32, Default: 0},
+		{Name: "response_status", Type: field.TypeInt32, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_team_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{TeamWebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccessTokensTable,
+		CheckpointsTable,
 		EnvsTable,
 		EnvAliasesTable,
 		EnvBuildsTable,
 		SnapshotsTable,
 		TeamsTable,
 		TeamAPIKeysTable,
+		TeamWebhooksTable,
 		TiersTable,
 		UsersTable,
 		UsersTeamsTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
 	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	AccessTokensTable.Annotation = &entsql.Annotation{}
+	CheckpointsTable.ForeignKeys[0].RefTable = EnvsTable
+	CheckpointsTable.ForeignKeys[1].RefTable = EnvBuildsTable
+	CheckpointsTable.Annotation = &entsql.Annotation{}
 	EnvsTable.ForeignKeys[0].RefTable = TeamsTable
 	EnvsTable.ForeignKeys[1].RefTable = UsersTable
 	EnvsTable.Annotation = &entsql.Annotation{}
@@ -285,6 +382,8 @@ func init() {
 	TeamAPIKeysTable.ForeignKeys[0].RefTable = TeamsTable
 	TeamAPIKeysTable.ForeignKeys[1].RefTable = UsersTable
 	TeamAPIKeysTable.Annotation = &entsql.Annotation{}
+	TeamWebhooksTable.ForeignKeys[0].RefTable = TeamsTable
+	TeamWebhooksTable.Annotation = &entsql.Annotation{}
 	TiersTable.Annotation = &entsql.Annotation{}
 	TiersTable.Annotation.Checks = map[string]string{
 		"tiers_concurrent_sessions_check": "concurrent_instances > 0",
@@ -294,4 +393,6 @@ func init() {
 	UsersTeamsTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTeamsTable.ForeignKeys[1].RefTable = TeamsTable
 	UsersTeamsTable.Annotation = &entsql.Annotation{}
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = TeamWebhooksTable
+	WebhookDeliveriesTable.Annotation = &entsql.Annotation{}
 }
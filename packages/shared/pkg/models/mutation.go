@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
@@ -19,9 +20,11 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
 	"github.com/google/uuid"
 )
 
@@ -34,16 +37,19 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccessToken = "AccessToken"
-	TypeEnv         = "Env"
-	TypeEnvAlias    = "EnvAlias"
-	TypeEnvBuild    = "EnvBuild"
-	TypeSnapshot    = "Snapshot"
-	TypeTeam        = "Team"
-	TypeTeamAPIKey  = "TeamAPIKey"
-	TypeTier        = "Tier"
-	TypeUser        = "User"
-	TypeUsersTeams  = "UsersTeams"
+	TypeAccessToken     = "AccessToken"
+	TypeCheckpoint      = "Checkpoint"
+	TypeEnv             = "Env"
+	TypeEnvAlias        = "EnvAlias"
+	TypeEnvBuild        = "EnvBuild"
+	TypeSnapshot        = "Snapshot"
+	TypeTeam            = "Team"
+	TypeTeamAPIKey      = "TeamAPIKey"
+	TypeTeamWebhook     = "TeamWebhook"
+	TypeTier            = "Tier"
+	TypeUser            = "User"
+	TypeUsersTeams      = "UsersTeams"
+	TypeWebhookDelivery = "WebhookDelivery"
 )
 
 // AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
@@ -508,50 +514,38 @@ func (m *AccessTokenMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AccessToken edge %s", name)
 }
 
-// EnvMutation represents an operation that mutates the Env nodes in the graph.
-type EnvMutation struct {
+// CheckpointMutation represents an operation that mutates the Checkpoint nodes in the graph.
+type CheckpointMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	public             *bool
-	build_count        *int32
-	addbuild_count     *int32
-	spawn_count        *int64
-	addspawn_count     *int64
-	last_spawned_at    *time.Time
-	clearedFields      map[string]struct{}
-	team               *uuid.UUID
-	clearedteam        bool
-	creator            *uuid.UUID
-	clearedcreator     bool
-	env_aliases        map[string]struct{}
-	removedenv_aliases map[string]struct{}
-	clearedenv_aliases bool
-	builds             map[uuid.UUID]struct{}
-	removedbuilds      map[uuid.UUID]struct{}
-	clearedbuilds      bool
-	snapshots          map[uuid.UUID]struct{}
-	removedsnapshots   map[uuid.UUID]struct{}
-	clearedsnapshots   bool
-	done               bool
-	oldValue           func(context.Context) (*Env, error)
-	predicates         []predicate.Env
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	name          *string
+	sandbox_id    *string
+	base_env_id   *string
+	metadata      *map[string]string
+	clearedFields map[string]struct{}
+	env           *string
+	clearedenv    bool
+	build         *uuid.UUID
+	clearedbuild  bool
+	done          bool
+	oldValue      func(context.Context) (*Checkpoint, error)
+	predicates    []predicate.Checkpoint
 }
 
-var _ ent.Mutation = (*EnvMutation)(nil)
+var _ ent.Mutation = (*CheckpointMutation)(nil)
 
-// envOption allows management of the mutation configuration using functional options.
-type envOption func(*EnvMutation)
+// checkpointOption allows management of the mutation configuration using functional options.
+type checkpointOption func(*CheckpointMutation)
 
-// newEnvMutation creates new mutation for the Env entity.
-func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
-	m := &EnvMutation{
+// newCheckpointMutation creates new mutation for the Checkpoint entity.
+func newCheckpointMutation(c config, op Op, opts ...checkpointOption) *CheckpointMutation {
+	m := &CheckpointMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnv,
+		typ:           TypeCheckpoint,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -560,20 +554,20 @@ func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
 	return m
 }
 
-// withEnvID sets the ID field of the mutation.
-func withEnvID(id string) envOption {
-	return func(m *EnvMutation) {
+// withCheckpointID sets the ID field of the mutation.
+func withCheckpointID(id uuid.UUID) checkpointOption {
+	return func(m *CheckpointMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Env
+			value *Checkpoint
 		)
-		m.oldValue = func(ctx context.Context) (*Env, error) {
+		m.oldValue = func(ctx context.Context) (*Checkpoint, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Env.Get(ctx, id)
+					value, err = m.Client().Checkpoint.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -582,10 +576,10 @@ func withEnvID(id string) envOption {
 	}
 }
 
-// withEnv sets the old Env of the mutation.
-func withEnv(node *Env) envOption {
-	return func(m *EnvMutation) {
-		m.oldValue = func(context.Context) (*Env, error) {
+// withCheckpoint sets the old Checkpoint of the mutation.
+func withCheckpoint(node *Checkpoint) checkpointOption {
+	return func(m *CheckpointMutation) {
+		m.oldValue = func(context.Context) (*Checkpoint, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -594,7 +588,7 @@ func withEnv(node *Env) envOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvMutation) Client() *Client {
+func (m CheckpointMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -602,7 +596,7 @@ func (m EnvMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvMutation) Tx() (*Tx, error) {
+func (m CheckpointMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -612,14 +606,14 @@ func (m EnvMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Env entities.
-func (m *EnvMutation) SetID(id string) {
+// operation is only accepted on creation of Checkpoint entities.
+func (m *CheckpointMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvMutation) ID() (id string, exists bool) {
+func (m *CheckpointMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -630,28 +624,28 @@ func (m *EnvMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *CheckpointMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Checkpoint.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EnvMutation) SetCreatedAt(t time.Time) {
+func (m *CheckpointMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CheckpointMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -659,10 +653,10 @@ func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CheckpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -677,566 +671,3225 @@ func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvMutation) ResetCreatedAt() {
+func (m *CheckpointMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnvMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetName sets the "name" field.
+func (m *CheckpointMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Name returns the value of the "name" field in the mutation.
+func (m *CheckpointMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CheckpointMutation) OldName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Name, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearName clears the value of the "name" field.
+func (m *CheckpointMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[checkpoint.FieldName] = struct{}{}
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *EnvMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *CheckpointMutation) NameCleared() bool {
+	_, ok := m.clearedFields[checkpoint.FieldName]
+	return ok
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *EnvMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
+// ResetName resets all changes to the "name" field.
+func (m *CheckpointMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, checkpoint.FieldName)
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (m *CheckpointMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
+}
+
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *CheckpointMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldSandboxID returns the old "sandbox_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CheckpointMutation) OldSandboxID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
 	}
-	return oldValue.TeamID, nil
+	return oldValue.SandboxID, nil
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *EnvMutation) ResetTeamID() {
-	m.team = nil
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *CheckpointMutation) ResetSandboxID() {
+	m.sandbox_id = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *EnvMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// SetBaseEnvID sets the "base_env_id" field.
+func (m *CheckpointMutation) SetBaseEnvID(s string) {
+	m.base_env_id = &s
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EnvMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
+// BaseEnvID returns the value of the "base_env_id" field in the mutation.
+func (m *CheckpointMutation) BaseEnvID() (r string, exists bool) {
+	v := m.base_env_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldBaseEnvID returns the old "base_env_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *CheckpointMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
 	}
-	return oldValue.CreatedBy, nil
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *EnvMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[env.FieldCreatedBy] = struct{}{}
-}
-
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *EnvMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[env.FieldCreatedBy]
-	return ok
+	return oldValue.BaseEnvID, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EnvMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, env.FieldCreatedBy)
+// ResetBaseEnvID resets all changes to the "base_env_id" field.
+func (m *CheckpointMutation) ResetBaseEnvID() {
+	m.base_env_id = nil
 }
 
-// SetPublic sets the "public" field.
-func (m *EnvMutation) SetPublic(b bool) {
-	m.public = &b
+// SetEnvID sets the "env_id" field.
+func (m *CheckpointMutation) SetEnvID(s string) {
+	m.env = &s
 }
 
-// Public returns the value of the "public" field in the mutation.
-func (m *EnvMutation) Public() (r bool, exists bool) {
-	v := m.public
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *CheckpointMutation) EnvID() (r string, exists bool) {
+	v := m.env
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublic returns the old "public" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldEnvID returns the old "env_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldPublic(ctx context.Context) (v bool, err error) {
+func (m *CheckpointMutation) OldEnvID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublic requires an ID field in the mutation")
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
 	}
-	return oldValue.Public, nil
+	return oldValue.EnvID, nil
 }
 
-// ResetPublic resets all changes to the "public" field.
-func (m *EnvMutation) ResetPublic() {
-	m.public = nil
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *CheckpointMutation) ResetEnvID() {
+	m.env = nil
 }
 
-// SetBuildCount sets the "build_count" field.
-func (m *EnvMutation) SetBuildCount(i int32) {
-	m.build_count = &i
-	m.addbuild_count = nil
+// SetBuildID sets the "build_id" field.
+func (m *CheckpointMutation) SetBuildID(u uuid.UUID) {
+	m.build = &u
 }
 
-// BuildCount returns the value of the "build_count" field in the mutation.
-func (m *EnvMutation) BuildCount() (r int32, exists bool) {
-	v := m.build_count
+// BuildID returns the value of the "build_id" field in the mutation.
+func (m *CheckpointMutation) BuildID() (r uuid.UUID, exists bool) {
+	v := m.build
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuildCount returns the old "build_count" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldBuildID returns the old "build_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldBuildCount(ctx context.Context) (v int32, err error) {
+func (m *CheckpointMutation) OldBuildID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuildCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldBuildID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuildCount requires an ID field in the mutation")
+		return v, errors.New("OldBuildID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuildCount: %w", err)
-	}
-	return oldValue.BuildCount, nil
-}
-
-// AddBuildCount adds i to the "build_count" field.
-func (m *EnvMutation) AddBuildCount(i int32) {
-	if m.addbuild_count != nil {
-		*m.addbuild_count += i
-	} else {
-		m.addbuild_count = &i
-	}
-}
-
-// AddedBuildCount returns the value that was added to the "build_count" field in this mutation.
-func (m *EnvMutation) AddedBuildCount() (r int32, exists bool) {
-	v := m.addbuild_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldBuildID: %w", err)
 	}
-	return *v, true
+	return oldValue.BuildID, nil
 }
 
-// ResetBuildCount resets all changes to the "build_count" field.
-func (m *EnvMutation) ResetBuildCount() {
-	m.build_count = nil
-	m.addbuild_count = nil
+// ResetBuildID resets all changes to the "build_id" field.
+func (m *CheckpointMutation) ResetBuildID() {
+	m.build = nil
 }
 
-// SetSpawnCount sets the "spawn_count" field.
-func (m *EnvMutation) SetSpawnCount(i int64) {
-	m.spawn_count = &i
-	m.addspawn_count = nil
+// SetMetadata sets the "metadata" field.
+func (m *CheckpointMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
 }
 
-// SpawnCount returns the value of the "spawn_count" field in the mutation.
-func (m *EnvMutation) SpawnCount() (r int64, exists bool) {
-	v := m.spawn_count
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *CheckpointMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpawnCount returns the old "spawn_count" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldSpawnCount(ctx context.Context) (v int64, err error) {
+func (m *CheckpointMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpawnCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpawnCount requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpawnCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.SpawnCount, nil
+	return oldValue.Metadata, nil
 }
 
-// AddSpawnCount adds i to the "spawn_count" field.
-func (m *EnvMutation) AddSpawnCount(i int64) {
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *CheckpointMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *CheckpointMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[checkpoint.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *CheckpointMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *CheckpointMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *CheckpointMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (m *CheckpointMutation) ClearBuild() {
+	m.clearedbuild = true
+	m.clearedFields[checkpoint.FieldBuildID] = struct{}{}
+}
+
+// BuildCleared reports if the "build" edge to the EnvBuild entity was cleared.
+func (m *CheckpointMutation) BuildCleared() bool {
+	return m.clearedbuild
+}
+
+// BuildIDs returns the "build" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuildID instead. It exists only for internal usage by the builders.
+func (m *CheckpointMutation) BuildIDs() (ids []uuid.UUID) {
+	if id := m.build; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBuild resets all changes to the "build" edge.
+func (m *CheckpointMutation) ResetBuild() {
+	m.build = nil
+	m.clearedbuild = false
+}
+
+// Where appends a list predicates to the CheckpointMutation builder.
+func (m *CheckpointMutation) Where(ps ...predicate.Checkpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CheckpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CheckpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Checkpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CheckpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CheckpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Checkpoint).
+func (m *CheckpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CheckpointMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, checkpoint.FieldCreatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, checkpoint.FieldName)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, checkpoint.FieldSandboxID)
+	}
+	if m.base_env_id != nil {
+		fields = append(fields, checkpoint.FieldBaseEnvID)
+	}
+	if m.env != nil {
+		fields = append(fields, checkpoint.FieldEnvID)
+	}
+	if m.build != nil {
+		fields = append(fields, checkpoint.FieldBuildID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, checkpoint.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CheckpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checkpoint.FieldCreatedAt:
+		return m.CreatedAt()
+	case checkpoint.FieldName:
+		return m.Name()
+	case checkpoint.FieldSandboxID:
+		return m.SandboxID()
+	case checkpoint.FieldBaseEnvID:
+		return m.BaseEnvID()
+	case checkpoint.FieldEnvID:
+		return m.EnvID()
+	case checkpoint.FieldBuildID:
+		return m.BuildID()
+	case checkpoint.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CheckpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checkpoint.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case checkpoint.FieldName:
+		return m.OldName(ctx)
+	case checkpoint.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case checkpoint.FieldBaseEnvID:
+		return m.OldBaseEnvID(ctx)
+	case checkpoint.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case checkpoint.FieldBuildID:
+		return m.OldBuildID(ctx)
+	case checkpoint.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown Checkpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checkpoint.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case checkpoint.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case checkpoint.FieldSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxID(v)
+		return nil
+	case checkpoint.FieldBaseEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseEnvID(v)
+		return nil
+	case checkpoint.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case checkpoint.FieldBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildID(v)
+		return nil
+	case checkpoint.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CheckpointMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CheckpointMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Checkpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CheckpointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(checkpoint.FieldName) {
+		fields = append(fields, checkpoint.FieldName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CheckpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CheckpointMutation) ClearField(name string) error {
+	switch name {
+	case checkpoint.FieldName:
+		m.ClearName()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CheckpointMutation) ResetField(name string) error {
+	switch name {
+	case checkpoint.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case checkpoint.FieldName:
+		m.ResetName()
+		return nil
+	case checkpoint.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case checkpoint.FieldBaseEnvID:
+		m.ResetBaseEnvID()
+		return nil
+	case checkpoint.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case checkpoint.FieldBuildID:
+		m.ResetBuildID()
+		return nil
+	case checkpoint.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CheckpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.env != nil {
+		edges = append(edges, checkpoint.EdgeEnv)
+	}
+	if m.build != nil {
+		edges = append(edges, checkpoint.EdgeBuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CheckpointMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case checkpoint.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	case checkpoint.EdgeBuild:
+		if id := m.build; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CheckpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CheckpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CheckpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedenv {
+		edges = append(edges, checkpoint.EdgeEnv)
+	}
+	if m.clearedbuild {
+		edges = append(edges, checkpoint.EdgeBuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CheckpointMutation) EdgeCleared(name string) bool {
+	switch name {
+	case checkpoint.EdgeEnv:
+		return m.clearedenv
+	case checkpoint.EdgeBuild:
+		return m.clearedbuild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CheckpointMutation) ClearEdge(name string) error {
+	switch name {
+	case checkpoint.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	case checkpoint.EdgeBuild:
+		m.ClearBuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CheckpointMutation) ResetEdge(name string) error {
+	switch name {
+	case checkpoint.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	case checkpoint.EdgeBuild:
+		m.ResetBuild()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint edge %s", name)
+}
+
+// EnvMutation represents an operation that mutates the Env nodes in the graph.
+type EnvMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	public             *bool
+	build_count        *int32
+	addbuild_count     *int32
+	spawn_count        *int64
+	addspawn_count     *int64
+	last_spawned_at    *time.Time
+	clearedFields      map[string]struct{}
+	team               *uuid.UUID
+	clearedteam        bool
+	creator            *uuid.UUID
+	clearedcreator     bool
+	env_aliases        map[string]struct{}
+	removedenv_aliases map[string]struct{}
+	clearedenv_aliases bool
+	builds             map[uuid.UUID]struct{}
+	removedbuilds      map[uuid.UUID]struct{}
+	clearedbuilds      bool
+	snapshots          map[uuid.UUID]struct{}
+	removedsnapshots   map[uuid.UUID]struct{}
+	clearedsnapshots   bool
+	checkpoints        map[uuid.UUID]struct{}
+	removedcheckpoints map[uuid.UUID]struct{}
+	clearedcheckpoints bool
+	done               bool
+	oldValue           func(context.Context) (*Env, error)
+	predicates         []predicate.Env
+}
+
+var _ ent.Mutation = (*EnvMutation)(nil)
+
+// envOption allows management of the mutation configuration using functional options.
+type envOption func(*EnvMutation)
+
+// newEnvMutation creates new mutation for the Env entity.
+func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
+	m := &EnvMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnv,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvID sets the ID field of the mutation.
+func withEnvID(id string) envOption {
+	return func(m *EnvMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Env
+		)
+		m.oldValue = func(ctx context.Context) (*Env, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Env.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnv sets the old Env of the mutation.
+func withEnv(node *Env) envOption {
+	return func(m *EnvMutation) {
+		m.oldValue = func(context.Context) (*Env, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Env entities.
+func (m *EnvMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *EnvMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *EnvMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *EnvMutation) ResetTeamID() {
+	m.team = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *EnvMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EnvMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *EnvMutation) ClearCreatedBy() {
+	m.creator = nil
+	m.clearedFields[env.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *EnvMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[env.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EnvMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, env.FieldCreatedBy)
+}
+
+// SetPublic sets the "public" field.
+func (m *EnvMutation) SetPublic(b bool) {
+	m.public = &b
+}
+
+// Public returns the value of the "public" field in the mutation.
+func (m *EnvMutation) Public() (r bool, exists bool) {
+	v := m.public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublic returns the old "public" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+	}
+	return oldValue.Public, nil
+}
+
+// ResetPublic resets all changes to the "public" field.
+func (m *EnvMutation) ResetPublic() {
+	m.public = nil
+}
+
+// SetBuildCount sets the "build_count" field.
+func (m *EnvMutation) SetBuildCount(i int32) {
+	m.build_count = &i
+	m.addbuild_count = nil
+}
+
+// BuildCount returns the value of the "build_count" field in the mutation.
+func (m *EnvMutation) BuildCount() (r int32, exists bool) {
+	v := m.build_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildCount returns the old "build_count" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldBuildCount(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildCount: %w", err)
+	}
+	return oldValue.BuildCount, nil
+}
+
+// AddBuildCount adds i to the "build_count" field.
+func (m *EnvMutation) AddBuildCount(i int32) {
+	if m.addbuild_count != nil {
+		*m.addbuild_count += i
+	} else {
+		m.addbuild_count = &i
+	}
+}
+
+// AddedBuildCount returns the value that was added to the "build_count" field in this mutation.
+func (m *EnvMutation) AddedBuildCount() (r int32, exists bool) {
+	v := m.addbuild_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBuildCount resets all changes to the "build_count" field.
+func (m *EnvMutation) ResetBuildCount() {
+	m.build_count = nil
+	m.addbuild_count = nil
+}
+
+// SetSpawnCount sets the "spawn_count" field.
+func (m *EnvMutation) SetSpawnCount(i int64) {
+	m.spawn_count = &i
+	m.addspawn_count = nil
+}
+
+// SpawnCount returns the value of the "spawn_count" field in the mutation.
+func (m *EnvMutation) SpawnCount() (r int64, exists bool) {
+	v := m.spawn_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpawnCount returns the old "spawn_count" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldSpawnCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpawnCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpawnCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpawnCount: %w", err)
+	}
+	return oldValue.SpawnCount, nil
+}
+
+// AddSpawnCount adds i to the "spawn_count" field.
+func (m *EnvMutation) AddSpawnCount(i int64) {
+	if m.addspawn_count != nil {
+		*m.addspawn_count += i
+	} else {
+		m.addspawn_count = &i
+	}
+}
+
+// AddedSpawnCount returns the value that was added to the "spawn_count" field in this mutation.
+func (m *EnvMutation) AddedSpawnCount() (r int64, exists bool) {
+	v := m.addspawn_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpawnCount resets all changes to the "spawn_count" field.
+func (m *EnvMutation) ResetSpawnCount() {
+	m.spawn_count = nil
+	m.addspawn_count = nil
+}
+
+// SetLastSpawnedAt sets the "last_spawned_at" field.
+func (m *EnvMutation) SetLastSpawnedAt(t time.Time) {
+	m.last_spawned_at = &t
+}
+
+// LastSpawnedAt returns the value of the "last_spawned_at" field in the mutation.
+func (m *EnvMutation) LastSpawnedAt() (r time.Time, exists bool) {
+	v := m.last_spawned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSpawnedAt returns the old "last_spawned_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldLastSpawnedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSpawnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSpawnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSpawnedAt: %w", err)
+	}
+	return oldValue.LastSpawnedAt, nil
+}
+
+// ClearLastSpawnedAt clears the value of the "last_spawned_at" field.
+func (m *EnvMutation) ClearLastSpawnedAt() {
+	m.last_spawned_at = nil
+	m.clearedFields[env.FieldLastSpawnedAt] = struct{}{}
+}
+
+// LastSpawnedAtCleared returns if the "last_spawned_at" field was cleared in this mutation.
+func (m *EnvMutation) LastSpawnedAtCleared() bool {
+	_, ok := m.clearedFields[env.FieldLastSpawnedAt]
+	return ok
+}
+
+// ResetLastSpawnedAt resets all changes to the "last_spawned_at" field.
+func (m *EnvMutation) ResetLastSpawnedAt() {
+	m.last_spawned_at = nil
+	delete(m.clearedFields, env.FieldLastSpawnedAt)
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *EnvMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[env.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *EnvMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *EnvMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *EnvMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *EnvMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *EnvMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[env.FieldCreatedBy] = struct{}{}
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *EnvMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *EnvMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *EnvMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *EnvMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// AddEnvAliasIDs adds the "env_aliases" edge to the EnvAlias entity by ids.
+func (m *EnvMutation) AddEnvAliasIDs(ids ...string) {
+	if m.env_aliases == nil {
+		m.env_aliases = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.env_aliases[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvAliases clears the "env_aliases" edge to the EnvAlias entity.
+func (m *EnvMutation) ClearEnvAliases() {
+	m.clearedenv_aliases = true
+}
+
+// EnvAliasesCleared reports if the "env_aliases" edge to the EnvAlias entity was cleared.
+func (m *EnvMutation) EnvAliasesCleared() bool {
+	return m.clearedenv_aliases
+}
+
+// RemoveEnvAliasIDs removes the "env_aliases" edge to the EnvAlias entity by IDs.
+func (m *EnvMutation) RemoveEnvAliasIDs(ids ...string) {
+	if m.removedenv_aliases == nil {
+		m.removedenv_aliases = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.env_aliases, ids[i])
+		m.removedenv_aliases[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnvAliases returns the removed IDs of the "env_aliases" edge to the EnvAlias entity.
+func (m *EnvMutation) RemovedEnvAliasesIDs() (ids []string) {
+	for id := range m.removedenv_aliases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvAliasesIDs returns the "env_aliases" edge IDs in the mutation.
+func (m *EnvMutation) EnvAliasesIDs() (ids []string) {
+	for id := range m.env_aliases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvAliases resets all changes to the "env_aliases" edge.
+func (m *EnvMutation) ResetEnvAliases() {
+	m.env_aliases = nil
+	m.clearedenv_aliases = false
+	m.removedenv_aliases = nil
+}
+
+// AddBuildIDs adds the "builds" edge to the EnvBuild entity by ids.
+func (m *EnvMutation) AddBuildIDs(ids ...uuid.UUID) {
+	if m.builds == nil {
+		m.builds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.builds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBuilds clears the "builds" edge to the EnvBuild entity.
+func (m *EnvMutation) ClearBuilds() {
+	m.clearedbuilds = true
+}
+
+// BuildsCleared reports if the "builds" edge to the EnvBuild entity was cleared.
+func (m *EnvMutation) BuildsCleared() bool {
+	return m.clearedbuilds
+}
+
+// RemoveBuildIDs removes the "builds" edge to the EnvBuild entity by IDs.
+func (m *EnvMutation) RemoveBuildIDs(ids ...uuid.UUID) {
+	if m.removedbuilds == nil {
+		m.removedbuilds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.builds, ids[i])
+		m.removedbuilds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuilds returns the removed IDs of the "builds" edge to the EnvBuild entity.
+func (m *EnvMutation) RemovedBuildsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbuilds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BuildsIDs returns the "builds" edge IDs in the mutation.
+func (m *EnvMutation) BuildsIDs() (ids []uuid.UUID) {
+	for id := range m.builds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBuilds resets all changes to the "builds" edge.
+func (m *EnvMutation) ResetBuilds() {
+	m.builds = nil
+	m.clearedbuilds = false
+	m.removedbuilds = nil
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by ids.
+func (m *EnvMutation) AddSnapshotIDs(ids ...uuid.UUID) {
+	if m.snapshots == nil {
+		m.snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSnapshots clears the "snapshots" edge to the Snapshot entity.
+func (m *EnvMutation) ClearSnapshots() {
+	m.clearedsnapshots = true
+}
+
+// SnapshotsCleared reports if the "snapshots" edge to the Snapshot entity was cleared.
+func (m *EnvMutation) SnapshotsCleared() bool {
+	return m.clearedsnapshots
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to the Snapshot entity by IDs.
+func (m *EnvMutation) RemoveSnapshotIDs(ids ...uuid.UUID) {
+	if m.removedsnapshots == nil {
+		m.removedsnapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.snapshots, ids[i])
+		m.removedsnapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the Snapshot entity.
+func (m *EnvMutation) RemovedSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsnapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
+func (m *EnvMutation) SnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSnapshots resets all changes to the "snapshots" edge.
+func (m *EnvMutation) ResetSnapshots() {
+	m.snapshots = nil
+	m.clearedsnapshots = false
+	m.removedsnapshots = nil
+}
+
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by ids.
+func (m *EnvMutation) AddCheckpointIDs(ids ...uuid.UUID) {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.checkpoints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCheckpoints clears the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvMutation) ClearCheckpoints() {
+	m.clearedcheckpoints = true
+}
+
+// CheckpointsCleared reports if the "checkpoints" edge to the Checkpoint entity was cleared.
+func (m *EnvMutation) CheckpointsCleared() bool {
+	return m.clearedcheckpoints
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to the Checkpoint entity by IDs.
+func (m *EnvMutation) RemoveCheckpointIDs(ids ...uuid.UUID) {
+	if m.removedcheckpoints == nil {
+		m.removedcheckpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.checkpoints, ids[i])
+		m.removedcheckpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCheckpoints returns the removed IDs of the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvMutation) RemovedCheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcheckpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CheckpointsIDs returns the "checkpoints" edge IDs in the mutation.
+func (m *EnvMutation) CheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.checkpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCheckpoints resets all changes to the "checkpoints" edge.
+func (m *EnvMutation) ResetCheckpoints() {
+	m.checkpoints = nil
+	m.clearedcheckpoints = false
+	m.removedcheckpoints = nil
+}
+
+// Where appends a list predicates to the EnvMutation builder.
+func (m *EnvMutation) Where(ps ...predicate.Env) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Env, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Env).
+func (m *EnvMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, env.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, env.FieldUpdatedAt)
+	}
+	if m.team != nil {
+		fields = append(fields, env.FieldTeamID)
+	}
+	if m.creator != nil {
+		fields = append(fields, env.FieldCreatedBy)
+	}
+	if m.public != nil {
+		fields = append(fields, env.FieldPublic)
+	}
+	if m.build_count != nil {
+		fields = append(fields, env.FieldBuildCount)
+	}
+	if m.spawn_count != nil {
+		fields = append(fields, env.FieldSpawnCount)
+	}
+	if m.last_spawned_at != nil {
+		fields = append(fields, env.FieldLastSpawnedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case env.FieldCreatedAt:
+		return m.CreatedAt()
+	case env.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case env.FieldTeamID:
+		return m.TeamID()
+	case env.FieldCreatedBy:
+		return m.CreatedBy()
+	case env.FieldPublic:
+		return m.Public()
+	case env.FieldBuildCount:
+		return m.BuildCount()
+	case env.FieldSpawnCount:
+		return m.SpawnCount()
+	case env.FieldLastSpawnedAt:
+		return m.LastSpawnedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case env.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case env.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case env.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case env.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case env.FieldPublic:
+		return m.OldPublic(ctx)
+	case env.FieldBuildCount:
+		return m.OldBuildCount(ctx)
+	case env.FieldSpawnCount:
+		return m.OldSpawnCount(ctx)
+	case env.FieldLastSpawnedAt:
+		return m.OldLastSpawnedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Env field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case env.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case env.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case env.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case env.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case env.FieldPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublic(v)
+		return nil
+	case env.FieldBuildCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildCount(v)
+		return nil
+	case env.FieldSpawnCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpawnCount(v)
+		return nil
+	case env.FieldLastSpawnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSpawnedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Env field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvMutation) AddedFields() []string {
+	var fields []string
+	if m.addbuild_count != nil {
+		fields = append(fields, env.FieldBuildCount)
+	}
 	if m.addspawn_count != nil {
-		*m.addspawn_count += i
+		fields = append(fields, env.FieldSpawnCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case env.FieldBuildCount:
+		return m.AddedBuildCount()
+	case env.FieldSpawnCount:
+		return m.AddedSpawnCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case env.FieldBuildCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuildCount(v)
+		return nil
+	case env.FieldSpawnCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpawnCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Env numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(env.FieldCreatedBy) {
+		fields = append(fields, env.FieldCreatedBy)
+	}
+	if m.FieldCleared(env.FieldLastSpawnedAt) {
+		fields = append(fields, env.FieldLastSpawnedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvMutation) ClearField(name string) error {
+	switch name {
+	case env.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case env.FieldLastSpawnedAt:
+		m.ClearLastSpawnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Env nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvMutation) ResetField(name string) error {
+	switch name {
+	case env.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case env.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case env.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case env.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case env.FieldPublic:
+		m.ResetPublic()
+		return nil
+	case env.FieldBuildCount:
+		m.ResetBuildCount()
+		return nil
+	case env.FieldSpawnCount:
+		m.ResetSpawnCount()
+		return nil
+	case env.FieldLastSpawnedAt:
+		m.ResetLastSpawnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Env field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.team != nil {
+		edges = append(edges, env.EdgeTeam)
+	}
+	if m.creator != nil {
+		edges = append(edges, env.EdgeCreator)
+	}
+	if m.env_aliases != nil {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.builds != nil {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.snapshots != nil {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.checkpoints != nil {
+		edges = append(edges, env.EdgeCheckpoints)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case env.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case env.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	case env.EdgeEnvAliases:
+		ids := make([]ent.Value, 0, len(m.env_aliases))
+		for id := range m.env_aliases {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.builds))
+		for id := range m.builds {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.snapshots))
+		for id := range m.snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.checkpoints))
+		for id := range m.checkpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedenv_aliases != nil {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.removedbuilds != nil {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.removedsnapshots != nil {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.removedcheckpoints != nil {
+		edges = append(edges, env.EdgeCheckpoints)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case env.EdgeEnvAliases:
+		ids := make([]ent.Value, 0, len(m.removedenv_aliases))
+		for id := range m.removedenv_aliases {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.removedbuilds))
+		for id := range m.removedbuilds {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedsnapshots))
+		for id := range m.removedsnapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.removedcheckpoints))
+		for id := range m.removedcheckpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedteam {
+		edges = append(edges, env.EdgeTeam)
+	}
+	if m.clearedcreator {
+		edges = append(edges, env.EdgeCreator)
+	}
+	if m.clearedenv_aliases {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.clearedbuilds {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.clearedsnapshots {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.clearedcheckpoints {
+		edges = append(edges, env.EdgeCheckpoints)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvMutation) EdgeCleared(name string) bool {
+	switch name {
+	case env.EdgeTeam:
+		return m.clearedteam
+	case env.EdgeCreator:
+		return m.clearedcreator
+	case env.EdgeEnvAliases:
+		return m.clearedenv_aliases
+	case env.EdgeBuilds:
+		return m.clearedbuilds
+	case env.EdgeSnapshots:
+		return m.clearedsnapshots
+	case env.EdgeCheckpoints:
+		return m.clearedcheckpoints
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvMutation) ClearEdge(name string) error {
+	switch name {
+	case env.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case env.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown Env unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvMutation) ResetEdge(name string) error {
+	switch name {
+	case env.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case env.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case env.EdgeEnvAliases:
+		m.ResetEnvAliases()
+		return nil
+	case env.EdgeBuilds:
+		m.ResetBuilds()
+		return nil
+	case env.EdgeSnapshots:
+		m.ResetSnapshots()
+		return nil
+	case env.EdgeCheckpoints:
+		m.ResetCheckpoints()
+		return nil
+	}
+	return fmt.Errorf("unknown Env edge %s", name)
+}
+
+// EnvAliasMutation represents an operation that mutates the EnvAlias nodes in the graph.
+type EnvAliasMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	is_renamable  *bool
+	clearedFields map[string]struct{}
+	env           *string
+	clearedenv    bool
+	done          bool
+	oldValue      func(context.Context) (*EnvAlias, error)
+	predicates    []predicate.EnvAlias
+}
+
+var _ ent.Mutation = (*EnvAliasMutation)(nil)
+
+// envaliasOption allows management of the mutation configuration using functional options.
+type envaliasOption func(*EnvAliasMutation)
+
+// newEnvAliasMutation creates new mutation for the EnvAlias entity.
+func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutation {
+	m := &EnvAliasMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvAlias,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvAliasID sets the ID field of the mutation.
+func withEnvAliasID(id string) envaliasOption {
+	return func(m *EnvAliasMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvAlias
+		)
+		m.oldValue = func(ctx context.Context) (*EnvAlias, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvAlias.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvAlias sets the old EnvAlias of the mutation.
+func withEnvAlias(node *EnvAlias) envaliasOption {
+	return func(m *EnvAliasMutation) {
+		m.oldValue = func(context.Context) (*EnvAlias, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvAliasMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvAliasMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvAlias entities.
+func (m *EnvAliasMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvAliasMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvAliasMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvAlias.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvAliasMutation) SetEnvID(s string) {
+	m.env = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvAliasMutation) EnvID() (r string, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvAlias entity.
+// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvAliasMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvAliasMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetIsRenamable sets the "is_renamable" field.
+func (m *EnvAliasMutation) SetIsRenamable(b bool) {
+	m.is_renamable = &b
+}
+
+// IsRenamable returns the value of the "is_renamable" field in the mutation.
+func (m *EnvAliasMutation) IsRenamable() (r bool, exists bool) {
+	v := m.is_renamable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRenamable returns the old "is_renamable" field's value of the EnvAlias entity.
+// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvAliasMutation) OldIsRenamable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRenamable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRenamable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRenamable: %w", err)
+	}
+	return oldValue.IsRenamable, nil
+}
+
+// ResetIsRenamable resets all changes to the "is_renamable" field.
+func (m *EnvAliasMutation) ResetIsRenamable() {
+	m.is_renamable = nil
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvAliasMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envalias.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvAliasMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvAliasMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvAliasMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// Where appends a list predicates to the EnvAliasMutation builder.
+func (m *EnvAliasMutation) Where(ps ...predicate.EnvAlias) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvAliasMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvAliasMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvAlias).
+func (m *EnvAliasMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvAliasMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.env != nil {
+		fields = append(fields, envalias.FieldEnvID)
+	}
+	if m.is_renamable != nil {
+		fields = append(fields, envalias.FieldIsRenamable)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envalias.FieldEnvID:
+		return m.EnvID()
+	case envalias.FieldIsRenamable:
+		return m.IsRenamable()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envalias.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envalias.FieldIsRenamable:
+		return m.OldIsRenamable(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvAlias field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvAliasMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envalias.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envalias.FieldIsRenamable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRenamable(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvAlias field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvAliasMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvAliasMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvAliasMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EnvAlias numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvAliasMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvAliasMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EnvAlias nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvAliasMutation) ResetField(name string) error {
+	switch name {
+	case envalias.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envalias.FieldIsRenamable:
+		m.ResetIsRenamable()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvAlias field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, envalias.EdgeEnv)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvAliasMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envalias.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvAliasMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, envalias.EdgeEnv)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvAliasMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envalias.EdgeEnv:
+		return m.clearedenv
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvAliasMutation) ClearEdge(name string) error {
+	switch name {
+	case envalias.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvAlias unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvAliasMutation) ResetEdge(name string) error {
+	switch name {
+	case envalias.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvAlias edge %s", name)
+}
+
+// EnvBuildMutation represents an operation that mutates the EnvBuild nodes in the graph.
+type EnvBuildMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	created_at            *time.Time
+	updated_at            *time.Time
+	finished_at           *time.Time
+	status                *envbuild.Status
+	dockerfile            *string
+	start_cmd             *string
+	vcpu                  *int64
+	addvcpu               *int64
+	ram_mb                *int64
+	addram_mb             *int64
+	free_disk_size_mb     *int64
+	addfree_disk_size_mb  *int64
+	total_disk_size_mb    *int64
+	addtotal_disk_size_mb *int64
+	kernel_version        *string
+	firecracker_version   *string
+	envd_version          *string
+	clearedFields         map[string]struct{}
+	env                   *string
+	clearedenv            bool
+	checkpoints           map[uuid.UUID]struct{}
+	removedcheckpoints    map[uuid.UUID]struct{}
+	clearedcheckpoints    bool
+	done                  bool
+	oldValue              func(context.Context) (*EnvBuild, error)
+	predicates            []predicate.EnvBuild
+}
+
+var _ ent.Mutation = (*EnvBuildMutation)(nil)
+
+// envbuildOption allows management of the mutation configuration using functional options.
+type envbuildOption func(*EnvBuildMutation)
+
+// newEnvBuildMutation creates new mutation for the EnvBuild entity.
+func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutation {
+	m := &EnvBuildMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvBuild,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvBuildID sets the ID field of the mutation.
+func withEnvBuildID(id uuid.UUID) envbuildOption {
+	return func(m *EnvBuildMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvBuild
+		)
+		m.oldValue = func(ctx context.Context) (*EnvBuild, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvBuild.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvBuild sets the old EnvBuild of the mutation.
+func withEnvBuild(node *EnvBuild) envbuildOption {
+	return func(m *EnvBuildMutation) {
+		m.oldValue = func(context.Context) (*EnvBuild, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvBuildMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvBuildMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvBuild entities.
+func (m *EnvBuildMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvBuildMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvBuild.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvBuildMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvBuildMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvBuildMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvBuildMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvBuildMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvBuildMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *EnvBuildMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *EnvBuildMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *EnvBuildMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[envbuild.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *EnvBuildMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *EnvBuildMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, envbuild.FieldFinishedAt)
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvBuildMutation) SetEnvID(s string) {
+	m.env = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvBuildMutation) EnvID() (r string, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldEnvID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ClearEnvID clears the value of the "env_id" field.
+func (m *EnvBuildMutation) ClearEnvID() {
+	m.env = nil
+	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+}
+
+// EnvIDCleared returns if the "env_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) EnvIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEnvID]
+	return ok
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvBuildMutation) ResetEnvID() {
+	m.env = nil
+	delete(m.clearedFields, envbuild.FieldEnvID)
+}
+
+// SetStatus sets the "status" field.
+func (m *EnvBuildMutation) SetStatus(e envbuild.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EnvBuildMutation) Status() (r envbuild.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldStatus(ctx context.Context) (v envbuild.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EnvBuildMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDockerfile sets the "dockerfile" field.
+func (m *EnvBuildMutation) SetDockerfile(s string) {
+	m.dockerfile = &s
+}
+
+// Dockerfile returns the value of the "dockerfile" field in the mutation.
+func (m *EnvBuildMutation) Dockerfile() (r string, exists bool) {
+	v := m.dockerfile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDockerfile returns the old "dockerfile" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldDockerfile(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDockerfile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDockerfile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDockerfile: %w", err)
+	}
+	return oldValue.Dockerfile, nil
+}
+
+// ClearDockerfile clears the value of the "dockerfile" field.
+func (m *EnvBuildMutation) ClearDockerfile() {
+	m.dockerfile = nil
+	m.clearedFields[envbuild.FieldDockerfile] = struct{}{}
+}
+
+// DockerfileCleared returns if the "dockerfile" field was cleared in this mutation.
+func (m *EnvBuildMutation) DockerfileCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldDockerfile]
+	return ok
+}
+
+// ResetDockerfile resets all changes to the "dockerfile" field.
+func (m *EnvBuildMutation) ResetDockerfile() {
+	m.dockerfile = nil
+	delete(m.clearedFields, envbuild.FieldDockerfile)
+}
+
+// SetStartCmd sets the "start_cmd" field.
+func (m *EnvBuildMutation) SetStartCmd(s string) {
+	m.start_cmd = &s
+}
+
+// StartCmd returns the value of the "start_cmd" field in the mutation.
+func (m *EnvBuildMutation) StartCmd() (r string, exists bool) {
+	v := m.start_cmd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartCmd returns the old "start_cmd" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldStartCmd(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartCmd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartCmd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartCmd: %w", err)
+	}
+	return oldValue.StartCmd, nil
+}
+
+// ClearStartCmd clears the value of the "start_cmd" field.
+func (m *EnvBuildMutation) ClearStartCmd() {
+	m.start_cmd = nil
+	m.clearedFields[envbuild.FieldStartCmd] = struct{}{}
+}
+
+// StartCmdCleared returns if the "start_cmd" field was cleared in this mutation.
+func (m *EnvBuildMutation) StartCmdCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldStartCmd]
+	return ok
+}
+
+// ResetStartCmd resets all changes to the "start_cmd" field.
+func (m *EnvBuildMutation) ResetStartCmd() {
+	m.start_cmd = nil
+	delete(m.clearedFields, envbuild.FieldStartCmd)
+}
+
+// SetVcpu sets the "vcpu" field.
+func (m *EnvBuildMutation) SetVcpu(i int64) {
+	m.vcpu = &i
+	m.addvcpu = nil
+}
+
+// Vcpu returns the value of the "vcpu" field in the mutation.
+func (m *EnvBuildMutation) Vcpu() (r int64, exists bool) {
+	v := m.vcpu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVcpu returns the old "vcpu" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldVcpu(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVcpu is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVcpu requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVcpu: %w", err)
+	}
+	return oldValue.Vcpu, nil
+}
+
+// AddVcpu adds i to the "vcpu" field.
+func (m *EnvBuildMutation) AddVcpu(i int64) {
+	if m.addvcpu != nil {
+		*m.addvcpu += i
 	} else {
-		m.addspawn_count = &i
+		m.addvcpu = &i
+	}
+}
+
+// AddedVcpu returns the value that was added to the "vcpu" field in this mutation.
+func (m *EnvBuildMutation) AddedVcpu() (r int64, exists bool) {
+	v := m.addvcpu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVcpu resets all changes to the "vcpu" field.
+func (m *EnvBuildMutation) ResetVcpu() {
+	m.vcpu = nil
+	m.addvcpu = nil
+}
+
+// SetRAMMB sets the "ram_mb" field.
+func (m *EnvBuildMutation) SetRAMMB(i int64) {
+	m.ram_mb = &i
+	m.addram_mb = nil
+}
+
+// RAMMB returns the value of the "ram_mb" field in the mutation.
+func (m *EnvBuildMutation) RAMMB() (r int64, exists bool) {
+	v := m.ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRAMMB returns the old "ram_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldRAMMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRAMMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRAMMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRAMMB: %w", err)
+	}
+	return oldValue.RAMMB, nil
+}
+
+// AddRAMMB adds i to the "ram_mb" field.
+func (m *EnvBuildMutation) AddRAMMB(i int64) {
+	if m.addram_mb != nil {
+		*m.addram_mb += i
+	} else {
+		m.addram_mb = &i
+	}
+}
+
+// AddedRAMMB returns the value that was added to the "ram_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedRAMMB() (r int64, exists bool) {
+	v := m.addram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRAMMB resets all changes to the "ram_mb" field.
+func (m *EnvBuildMutation) ResetRAMMB() {
+	m.ram_mb = nil
+	m.addram_mb = nil
+}
+
+// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) SetFreeDiskSizeMB(i int64) {
+	m.free_disk_size_mb = &i
+	m.addfree_disk_size_mb = nil
+}
+
+// FreeDiskSizeMB returns the value of the "free_disk_size_mb" field in the mutation.
+func (m *EnvBuildMutation) FreeDiskSizeMB() (r int64, exists bool) {
+	v := m.free_disk_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFreeDiskSizeMB returns the old "free_disk_size_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFreeDiskSizeMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFreeDiskSizeMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFreeDiskSizeMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFreeDiskSizeMB: %w", err)
+	}
+	return oldValue.FreeDiskSizeMB, nil
+}
+
+// AddFreeDiskSizeMB adds i to the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) AddFreeDiskSizeMB(i int64) {
+	if m.addfree_disk_size_mb != nil {
+		*m.addfree_disk_size_mb += i
+	} else {
+		m.addfree_disk_size_mb = &i
 	}
 }
 
-// AddedSpawnCount returns the value that was added to the "spawn_count" field in this mutation.
-func (m *EnvMutation) AddedSpawnCount() (r int64, exists bool) {
-	v := m.addspawn_count
+// AddedFreeDiskSizeMB returns the value that was added to the "free_disk_size_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedFreeDiskSizeMB() (r int64, exists bool) {
+	v := m.addfree_disk_size_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSpawnCount resets all changes to the "spawn_count" field.
-func (m *EnvMutation) ResetSpawnCount() {
-	m.spawn_count = nil
-	m.addspawn_count = nil
+// ResetFreeDiskSizeMB resets all changes to the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) ResetFreeDiskSizeMB() {
+	m.free_disk_size_mb = nil
+	m.addfree_disk_size_mb = nil
 }
 
-// SetLastSpawnedAt sets the "last_spawned_at" field.
-func (m *EnvMutation) SetLastSpawnedAt(t time.Time) {
-	m.last_spawned_at = &t
+// SetTotalDiskSizeMB sets the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) SetTotalDiskSizeMB(i int64) {
+	m.total_disk_size_mb = &i
+	m.addtotal_disk_size_mb = nil
 }
 
-// LastSpawnedAt returns the value of the "last_spawned_at" field in the mutation.
-func (m *EnvMutation) LastSpawnedAt() (r time.Time, exists bool) {
-	v := m.last_spawned_at
+// TotalDiskSizeMB returns the value of the "total_disk_size_mb" field in the mutation.
+func (m *EnvBuildMutation) TotalDiskSizeMB() (r int64, exists bool) {
+	v := m.total_disk_size_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSpawnedAt returns the old "last_spawned_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalDiskSizeMB returns the old "total_disk_size_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldLastSpawnedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvBuildMutation) OldTotalDiskSizeMB(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSpawnedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalDiskSizeMB is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSpawnedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotalDiskSizeMB requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSpawnedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalDiskSizeMB: %w", err)
 	}
-	return oldValue.LastSpawnedAt, nil
+	return oldValue.TotalDiskSizeMB, nil
 }
 
-// ClearLastSpawnedAt clears the value of the "last_spawned_at" field.
-func (m *EnvMutation) ClearLastSpawnedAt() {
-	m.last_spawned_at = nil
-	m.clearedFields[env.FieldLastSpawnedAt] = struct{}{}
+// AddTotalDiskSizeMB adds i to the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) AddTotalDiskSizeMB(i int64) {
+	if m.addtotal_disk_size_mb != nil {
+		*m.addtotal_disk_size_mb += i
+	} else {
+		m.addtotal_disk_size_mb = &i
+	}
 }
 
-// LastSpawnedAtCleared returns if the "last_spawned_at" field was cleared in this mutation.
-func (m *EnvMutation) LastSpawnedAtCleared() bool {
-	_, ok := m.clearedFields[env.FieldLastSpawnedAt]
-	return ok
+// AddedTotalDiskSizeMB returns the value that was added to the "total_disk_size_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedTotalDiskSizeMB() (r int64, exists bool) {
+	v := m.addtotal_disk_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetLastSpawnedAt resets all changes to the "last_spawned_at" field.
-func (m *EnvMutation) ResetLastSpawnedAt() {
-	m.last_spawned_at = nil
-	delete(m.clearedFields, env.FieldLastSpawnedAt)
+// ClearTotalDiskSizeMB clears the value of the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) ClearTotalDiskSizeMB() {
+	m.total_disk_size_mb = nil
+	m.addtotal_disk_size_mb = nil
+	m.clearedFields[envbuild.FieldTotalDiskSizeMB] = struct{}{}
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *EnvMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[env.FieldTeamID] = struct{}{}
+// TotalDiskSizeMBCleared returns if the "total_disk_size_mb" field was cleared in this mutation.
+func (m *EnvBuildMutation) TotalDiskSizeMBCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldTotalDiskSizeMB]
+	return ok
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *EnvMutation) TeamCleared() bool {
-	return m.clearedteam
+// ResetTotalDiskSizeMB resets all changes to the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) ResetTotalDiskSizeMB() {
+	m.total_disk_size_mb = nil
+	m.addtotal_disk_size_mb = nil
+	delete(m.clearedFields, envbuild.FieldTotalDiskSizeMB)
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *EnvMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetKernelVersion sets the "kernel_version" field.
+func (m *EnvBuildMutation) SetKernelVersion(s string) {
+	m.kernel_version = &s
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *EnvMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// KernelVersion returns the value of the "kernel_version" field in the mutation.
+func (m *EnvBuildMutation) KernelVersion() (r string, exists bool) {
+	v := m.kernel_version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *EnvMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// OldKernelVersion returns the old "kernel_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+	}
+	return oldValue.KernelVersion, nil
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *EnvMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[env.FieldCreatedBy] = struct{}{}
+// ResetKernelVersion resets all changes to the "kernel_version" field.
+func (m *EnvBuildMutation) ResetKernelVersion() {
+	m.kernel_version = nil
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *EnvMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// SetFirecrackerVersion sets the "firecracker_version" field.
+func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
+	m.firecracker_version = &s
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *EnvMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
+func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
+	v := m.firecracker_version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *EnvMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetCreator resets all changes to the "creator" edge.
-func (m *EnvMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
-}
-
-// AddEnvAliasIDs adds the "env_aliases" edge to the EnvAlias entity by ids.
-func (m *EnvMutation) AddEnvAliasIDs(ids ...string) {
-	if m.env_aliases == nil {
-		m.env_aliases = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.env_aliases[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
 	}
+	return oldValue.FirecrackerVersion, nil
 }
 
-// ClearEnvAliases clears the "env_aliases" edge to the EnvAlias entity.
-func (m *EnvMutation) ClearEnvAliases() {
-	m.clearedenv_aliases = true
+// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
+func (m *EnvBuildMutation) ResetFirecrackerVersion() {
+	m.firecracker_version = nil
 }
 
-// EnvAliasesCleared reports if the "env_aliases" edge to the EnvAlias entity was cleared.
-func (m *EnvMutation) EnvAliasesCleared() bool {
-	return m.clearedenv_aliases
+// SetEnvdVersion sets the "envd_version" field.
+func (m *EnvBuildMutation) SetEnvdVersion(s string) {
+	m.envd_version = &s
 }
 
-// RemoveEnvAliasIDs removes the "env_aliases" edge to the EnvAlias entity by IDs.
-func (m *EnvMutation) RemoveEnvAliasIDs(ids ...string) {
-	if m.removedenv_aliases == nil {
-		m.removedenv_aliases = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.env_aliases, ids[i])
-		m.removedenv_aliases[ids[i]] = struct{}{}
+// EnvdVersion returns the value of the "envd_version" field in the mutation.
+func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
+	v := m.envd_version
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEnvAliases returns the removed IDs of the "env_aliases" edge to the EnvAlias entity.
-func (m *EnvMutation) RemovedEnvAliasesIDs() (ids []string) {
-	for id := range m.removedenv_aliases {
-		ids = append(ids, id)
+// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// EnvAliasesIDs returns the "env_aliases" edge IDs in the mutation.
-func (m *EnvMutation) EnvAliasesIDs() (ids []string) {
-	for id := range m.env_aliases {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetEnvAliases resets all changes to the "env_aliases" edge.
-func (m *EnvMutation) ResetEnvAliases() {
-	m.env_aliases = nil
-	m.clearedenv_aliases = false
-	m.removedenv_aliases = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
+	}
+	return oldValue.EnvdVersion, nil
 }
 
-// AddBuildIDs adds the "builds" edge to the EnvBuild entity by ids.
-func (m *EnvMutation) AddBuildIDs(ids ...uuid.UUID) {
-	if m.builds == nil {
-		m.builds = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.builds[ids[i]] = struct{}{}
-	}
+// ClearEnvdVersion clears the value of the "envd_version" field.
+func (m *EnvBuildMutation) ClearEnvdVersion() {
+	m.envd_version = nil
+	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
 }
 
-// ClearBuilds clears the "builds" edge to the EnvBuild entity.
-func (m *EnvMutation) ClearBuilds() {
-	m.clearedbuilds = true
+// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
+func (m *EnvBuildMutation) EnvdVersionCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
+	return ok
 }
 
-// BuildsCleared reports if the "builds" edge to the EnvBuild entity was cleared.
-func (m *EnvMutation) BuildsCleared() bool {
-	return m.clearedbuilds
+// ResetEnvdVersion resets all changes to the "envd_version" field.
+func (m *EnvBuildMutation) ResetEnvdVersion() {
+	m.envd_version = nil
+	delete(m.clearedFields, envbuild.FieldEnvdVersion)
 }
 
-// RemoveBuildIDs removes the "builds" edge to the EnvBuild entity by IDs.
-func (m *EnvMutation) RemoveBuildIDs(ids ...uuid.UUID) {
-	if m.removedbuilds == nil {
-		m.removedbuilds = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.builds, ids[i])
-		m.removedbuilds[ids[i]] = struct{}{}
-	}
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvBuildMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
 }
 
-// RemovedBuilds returns the removed IDs of the "builds" edge to the EnvBuild entity.
-func (m *EnvMutation) RemovedBuildsIDs() (ids []uuid.UUID) {
-	for id := range m.removedbuilds {
-		ids = append(ids, id)
-	}
-	return
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvBuildMutation) EnvCleared() bool {
+	return m.EnvIDCleared() || m.clearedenv
 }
 
-// BuildsIDs returns the "builds" edge IDs in the mutation.
-func (m *EnvMutation) BuildsIDs() (ids []uuid.UUID) {
-	for id := range m.builds {
-		ids = append(ids, id)
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvBuildMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetBuilds resets all changes to the "builds" edge.
-func (m *EnvMutation) ResetBuilds() {
-	m.builds = nil
-	m.clearedbuilds = false
-	m.removedbuilds = nil
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvBuildMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
 }
 
-// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by ids.
-func (m *EnvMutation) AddSnapshotIDs(ids ...uuid.UUID) {
-	if m.snapshots == nil {
-		m.snapshots = make(map[uuid.UUID]struct{})
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by ids.
+func (m *EnvBuildMutation) AddCheckpointIDs(ids ...uuid.UUID) {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.snapshots[ids[i]] = struct{}{}
+		m.checkpoints[ids[i]] = struct{}{}
 	}
 }
 
-// ClearSnapshots clears the "snapshots" edge to the Snapshot entity.
-func (m *EnvMutation) ClearSnapshots() {
-	m.clearedsnapshots = true
+// ClearCheckpoints clears the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvBuildMutation) ClearCheckpoints() {
+	m.clearedcheckpoints = true
 }
 
-// SnapshotsCleared reports if the "snapshots" edge to the Snapshot entity was cleared.
-func (m *EnvMutation) SnapshotsCleared() bool {
-	return m.clearedsnapshots
+// CheckpointsCleared reports if the "checkpoints" edge to the Checkpoint entity was cleared.
+func (m *EnvBuildMutation) CheckpointsCleared() bool {
+	return m.clearedcheckpoints
 }
 
-// RemoveSnapshotIDs removes the "snapshots" edge to the Snapshot entity by IDs.
-func (m *EnvMutation) RemoveSnapshotIDs(ids ...uuid.UUID) {
-	if m.removedsnapshots == nil {
-		m.removedsnapshots = make(map[uuid.UUID]struct{})
+// RemoveCheckpointIDs removes the "checkpoints" edge to the Checkpoint entity by IDs.
+func (m *EnvBuildMutation) RemoveCheckpointIDs(ids ...uuid.UUID) {
+	if m.removedcheckpoints == nil {
+		m.removedcheckpoints = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.snapshots, ids[i])
-		m.removedsnapshots[ids[i]] = struct{}{}
+		delete(m.checkpoints, ids[i])
+		m.removedcheckpoints[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the Snapshot entity.
-func (m *EnvMutation) RemovedSnapshotsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsnapshots {
+// RemovedCheckpoints returns the removed IDs of the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvBuildMutation) RemovedCheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcheckpoints {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
-func (m *EnvMutation) SnapshotsIDs() (ids []uuid.UUID) {
-	for id := range m.snapshots {
+// CheckpointsIDs returns the "checkpoints" edge IDs in the mutation.
+func (m *EnvBuildMutation) CheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.checkpoints {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetSnapshots resets all changes to the "snapshots" edge.
-func (m *EnvMutation) ResetSnapshots() {
-	m.snapshots = nil
-	m.clearedsnapshots = false
-	m.removedsnapshots = nil
+// ResetCheckpoints resets all changes to the "checkpoints" edge.
+func (m *EnvBuildMutation) ResetCheckpoints() {
+	m.checkpoints = nil
+	m.clearedcheckpoints = false
+	m.removedcheckpoints = nil
 }
 
-// Where appends a list predicates to the EnvMutation builder.
-func (m *EnvMutation) Where(ps ...predicate.Env) {
+// Where appends a list predicates to the EnvBuildMutation builder.
+func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Env, len(ps))
+func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvBuild, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1244,48 +3897,66 @@ func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvMutation) Op() Op {
+func (m *EnvBuildMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvMutation) SetOp(op Op) {
+func (m *EnvBuildMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Env).
-func (m *EnvMutation) Type() string {
+// Type returns the node type of this mutation (EnvBuild).
+func (m *EnvBuildMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *EnvBuildMutation) Fields() []string {
+	fields := make([]string, 0, 14)
 	if m.created_at != nil {
-		fields = append(fields, env.FieldCreatedAt)
+		fields = append(fields, envbuild.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, env.FieldUpdatedAt)
+		fields = append(fields, envbuild.FieldUpdatedAt)
 	}
-	if m.team != nil {
-		fields = append(fields, env.FieldTeamID)
+	if m.finished_at != nil {
+		fields = append(fields, envbuild.FieldFinishedAt)
 	}
-	if m.creator != nil {
-		fields = append(fields, env.FieldCreatedBy)
+	if m.env != nil {
+		fields = append(fields, envbuild.FieldEnvID)
 	}
-	if m.public != nil {
-		fields = append(fields, env.FieldPublic)
+	if m.status != nil {
+		fields = append(fields, envbuild.FieldStatus)
 	}
-	if m.build_count != nil {
-		fields = append(fields, env.FieldBuildCount)
+	if m.dockerfile != nil {
+		fields = append(fields, envbuild.FieldDockerfile)
 	}
-	if m.spawn_count != nil {
-		fields = append(fields, env.FieldSpawnCount)
+	if m.start_cmd != nil {
+		fields = append(fields, envbuild.FieldStartCmd)
 	}
-	if m.last_spawned_at != nil {
-		fields = append(fields, env.FieldLastSpawnedAt)
+	if m.vcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
+	}
+	if m.ram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.free_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.total_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.kernel_version != nil {
+		fields = append(fields, envbuild.FieldKernelVersion)
+	}
+	if m.firecracker_version != nil {
+		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	}
+	if m.envd_version != nil {
+		fields = append(fields, envbuild.FieldEnvdVersion)
 	}
 	return fields
 }
@@ -1293,24 +3964,36 @@ func (m *EnvMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvMutation) Field(name string) (ent.Value, bool) {
+func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case env.FieldCreatedAt:
+	case envbuild.FieldCreatedAt:
 		return m.CreatedAt()
-	case env.FieldUpdatedAt:
+	case envbuild.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case env.FieldTeamID:
-		return m.TeamID()
-	case env.FieldCreatedBy:
-		return m.CreatedBy()
-	case env.FieldPublic:
-		return m.Public()
-	case env.FieldBuildCount:
-		return m.BuildCount()
-	case env.FieldSpawnCount:
-		return m.SpawnCount()
-	case env.FieldLastSpawnedAt:
-		return m.LastSpawnedAt()
+	case envbuild.FieldFinishedAt:
+		return m.FinishedAt()
+	case envbuild.FieldEnvID:
+		return m.EnvID()
+	case envbuild.FieldStatus:
+		return m.Status()
+	case envbuild.FieldDockerfile:
+		return m.Dockerfile()
+	case envbuild.FieldStartCmd:
+		return m.StartCmd()
+	case envbuild.FieldVcpu:
+		return m.Vcpu()
+	case envbuild.FieldRAMMB:
+		return m.RAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.FreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.TotalDiskSizeMB()
+	case envbuild.FieldKernelVersion:
+		return m.KernelVersion()
+	case envbuild.FieldFirecrackerVersion:
+		return m.FirecrackerVersion()
+	case envbuild.FieldEnvdVersion:
+		return m.EnvdVersion()
 	}
 	return nil, false
 }
@@ -1318,102 +4001,162 @@ func (m *EnvMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case env.FieldCreatedAt:
+	case envbuild.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case env.FieldUpdatedAt:
+	case envbuild.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case env.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case env.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case env.FieldPublic:
-		return m.OldPublic(ctx)
-	case env.FieldBuildCount:
-		return m.OldBuildCount(ctx)
-	case env.FieldSpawnCount:
-		return m.OldSpawnCount(ctx)
-	case env.FieldLastSpawnedAt:
-		return m.OldLastSpawnedAt(ctx)
+	case envbuild.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case envbuild.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envbuild.FieldStatus:
+		return m.OldStatus(ctx)
+	case envbuild.FieldDockerfile:
+		return m.OldDockerfile(ctx)
+	case envbuild.FieldStartCmd:
+		return m.OldStartCmd(ctx)
+	case envbuild.FieldVcpu:
+		return m.OldVcpu(ctx)
+	case envbuild.FieldRAMMB:
+		return m.OldRAMMB(ctx)
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.OldFreeDiskSizeMB(ctx)
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.OldTotalDiskSizeMB(ctx)
+	case envbuild.FieldKernelVersion:
+		return m.OldKernelVersion(ctx)
+	case envbuild.FieldFirecrackerVersion:
+		return m.OldFirecrackerVersion(ctx)
+	case envbuild.FieldEnvdVersion:
+		return m.OldEnvdVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown Env field %s", name)
+	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvMutation) SetField(name string, value ent.Value) error {
+func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case env.FieldCreatedAt:
+	case envbuild.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envbuild.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
+		return nil
+	case envbuild.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case envbuild.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
 		return nil
-	case env.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case envbuild.FieldStatus:
+		v, ok := value.(envbuild.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetStatus(v)
 		return nil
-	case env.FieldTeamID:
-		v, ok := value.(uuid.UUID)
+	case envbuild.FieldDockerfile:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.SetDockerfile(v)
 		return nil
-	case env.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
+	case envbuild.FieldStartCmd:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetStartCmd(v)
 		return nil
-	case env.FieldPublic:
-		v, ok := value.(bool)
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublic(v)
+		m.SetVcpu(v)
 		return nil
-	case env.FieldBuildCount:
-		v, ok := value.(int32)
+	case envbuild.FieldRAMMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuildCount(v)
+		m.SetRAMMB(v)
 		return nil
-	case env.FieldSpawnCount:
+	case envbuild.FieldFreeDiskSizeMB:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpawnCount(v)
+		m.SetFreeDiskSizeMB(v)
 		return nil
-	case env.FieldLastSpawnedAt:
-		v, ok := value.(time.Time)
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSpawnedAt(v)
+		m.SetTotalDiskSizeMB(v)
+		return nil
+	case envbuild.FieldKernelVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernelVersion(v)
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirecrackerVersion(v)
+		return nil
+	case envbuild.FieldEnvdVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvdVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Env field %s", name)
+	return fmt.Errorf("unknown EnvBuild field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvMutation) AddedFields() []string {
+func (m *EnvBuildMutation) AddedFields() []string {
 	var fields []string
-	if m.addbuild_count != nil {
-		fields = append(fields, env.FieldBuildCount)
+	if m.addvcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
 	}
-	if m.addspawn_count != nil {
-		fields = append(fields, env.FieldSpawnCount)
+	if m.addram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.addfree_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.addtotal_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
 	}
 	return fields
 }
@@ -1421,12 +4164,16 @@ func (m *EnvMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case env.FieldBuildCount:
-		return m.AddedBuildCount()
-	case env.FieldSpawnCount:
-		return m.AddedSpawnCount()
+	case envbuild.FieldVcpu:
+		return m.AddedVcpu()
+	case envbuild.FieldRAMMB:
+		return m.AddedRAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.AddedFreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.AddedTotalDiskSizeMB()
 	}
 	return nil, false
 }
@@ -1434,140 +4181,171 @@ func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvMutation) AddField(name string, value ent.Value) error {
+func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case env.FieldBuildCount:
-		v, ok := value.(int32)
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBuildCount(v)
+		m.AddVcpu(v)
 		return nil
-	case env.FieldSpawnCount:
+	case envbuild.FieldRAMMB:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSpawnCount(v)
+		m.AddRAMMB(v)
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFreeDiskSizeMB(v)
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDiskSizeMB(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Env numeric field %s", name)
+	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvMutation) ClearedFields() []string {
+func (m *EnvBuildMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(env.FieldCreatedBy) {
-		fields = append(fields, env.FieldCreatedBy)
+	if m.FieldCleared(envbuild.FieldFinishedAt) {
+		fields = append(fields, envbuild.FieldFinishedAt)
 	}
-	if m.FieldCleared(env.FieldLastSpawnedAt) {
-		fields = append(fields, env.FieldLastSpawnedAt)
+	if m.FieldCleared(envbuild.FieldEnvID) {
+		fields = append(fields, envbuild.FieldEnvID)
+	}
+	if m.FieldCleared(envbuild.FieldDockerfile) {
+		fields = append(fields, envbuild.FieldDockerfile)
+	}
+	if m.FieldCleared(envbuild.FieldStartCmd) {
+		fields = append(fields, envbuild.FieldStartCmd)
+	}
+	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.FieldCleared(envbuild.FieldEnvdVersion) {
+		fields = append(fields, envbuild.FieldEnvdVersion)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvMutation) FieldCleared(name string) bool {
+func (m *EnvBuildMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvMutation) ClearField(name string) error {
+func (m *EnvBuildMutation) ClearField(name string) error {
 	switch name {
-	case env.FieldCreatedBy:
-		m.ClearCreatedBy()
+	case envbuild.FieldFinishedAt:
+		m.ClearFinishedAt()
 		return nil
-	case env.FieldLastSpawnedAt:
-		m.ClearLastSpawnedAt()
+	case envbuild.FieldEnvID:
+		m.ClearEnvID()
+		return nil
+	case envbuild.FieldDockerfile:
+		m.ClearDockerfile()
+		return nil
+	case envbuild.FieldStartCmd:
+		m.ClearStartCmd()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ClearTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ClearEnvdVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown Env nullable field %s", name)
+	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvMutation) ResetField(name string) error {
+func (m *EnvBuildMutation) ResetField(name string) error {
 	switch name {
-	case env.FieldCreatedAt:
+	case envbuild.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case env.FieldUpdatedAt:
+	case envbuild.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case env.FieldTeamID:
-		m.ResetTeamID()
+	case envbuild.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
-	case env.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case envbuild.FieldEnvID:
+		m.ResetEnvID()
 		return nil
-	case env.FieldPublic:
-		m.ResetPublic()
+	case envbuild.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case env.FieldBuildCount:
-		m.ResetBuildCount()
+	case envbuild.FieldDockerfile:
+		m.ResetDockerfile()
 		return nil
-	case env.FieldSpawnCount:
-		m.ResetSpawnCount()
+	case envbuild.FieldStartCmd:
+		m.ResetStartCmd()
 		return nil
-	case env.FieldLastSpawnedAt:
-		m.ResetLastSpawnedAt()
+	case envbuild.FieldVcpu:
+		m.ResetVcpu()
+		return nil
+	case envbuild.FieldRAMMB:
+		m.ResetRAMMB()
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		m.ResetFreeDiskSizeMB()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ResetTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldKernelVersion:
+		m.ResetKernelVersion()
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		m.ResetFirecrackerVersion()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ResetEnvdVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown Env field %s", name)
+	return fmt.Errorf("unknown EnvBuild field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.team != nil {
-		edges = append(edges, env.EdgeTeam)
-	}
-	if m.creator != nil {
-		edges = append(edges, env.EdgeCreator)
-	}
-	if m.env_aliases != nil {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.builds != nil {
-		edges = append(edges, env.EdgeBuilds)
+func (m *EnvBuildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.env != nil {
+		edges = append(edges, envbuild.EdgeEnv)
 	}
-	if m.snapshots != nil {
-		edges = append(edges, env.EdgeSnapshots)
+	if m.checkpoints != nil {
+		edges = append(edges, envbuild.EdgeCheckpoints)
 	}
 	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EnvMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case env.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case env.EdgeCreator:
-		if id := m.creator; id != nil {
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envbuild.EdgeEnv:
+		if id := m.env; id != nil {
 			return []ent.Value{*id}
 		}
-	case env.EdgeEnvAliases:
-		ids := make([]ent.Value, 0, len(m.env_aliases))
-		for id := range m.env_aliases {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeBuilds:
-		ids := make([]ent.Value, 0, len(m.builds))
-		for id := range m.builds {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeSnapshots:
-		ids := make([]ent.Value, 0, len(m.snapshots))
-		for id := range m.snapshots {
+	case envbuild.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.checkpoints))
+		for id := range m.checkpoints {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1576,39 +4354,21 @@ func (m *EnvMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedenv_aliases != nil {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.removedbuilds != nil {
-		edges = append(edges, env.EdgeBuilds)
-	}
-	if m.removedsnapshots != nil {
-		edges = append(edges, env.EdgeSnapshots)
+func (m *EnvBuildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcheckpoints != nil {
+		edges = append(edges, envbuild.EdgeCheckpoints)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
+func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case env.EdgeEnvAliases:
-		ids := make([]ent.Value, 0, len(m.removedenv_aliases))
-		for id := range m.removedenv_aliases {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeBuilds:
-		ids := make([]ent.Value, 0, len(m.removedbuilds))
-		for id := range m.removedbuilds {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeSnapshots:
-		ids := make([]ent.Value, 0, len(m.removedsnapshots))
-		for id := range m.removedsnapshots {
+	case envbuild.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.removedcheckpoints))
+		for id := range m.removedcheckpoints {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1617,107 +4377,83 @@ func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedteam {
-		edges = append(edges, env.EdgeTeam)
-	}
-	if m.clearedcreator {
-		edges = append(edges, env.EdgeCreator)
-	}
-	if m.clearedenv_aliases {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.clearedbuilds {
-		edges = append(edges, env.EdgeBuilds)
+func (m *EnvBuildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedenv {
+		edges = append(edges, envbuild.EdgeEnv)
 	}
-	if m.clearedsnapshots {
-		edges = append(edges, env.EdgeSnapshots)
+	if m.clearedcheckpoints {
+		edges = append(edges, envbuild.EdgeCheckpoints)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvMutation) EdgeCleared(name string) bool {
+func (m *EnvBuildMutation) EdgeCleared(name string) bool {
 	switch name {
-	case env.EdgeTeam:
-		return m.clearedteam
-	case env.EdgeCreator:
-		return m.clearedcreator
-	case env.EdgeEnvAliases:
-		return m.clearedenv_aliases
-	case env.EdgeBuilds:
-		return m.clearedbuilds
-	case env.EdgeSnapshots:
-		return m.clearedsnapshots
+	case envbuild.EdgeEnv:
+		return m.clearedenv
+	case envbuild.EdgeCheckpoints:
+		return m.clearedcheckpoints
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvMutation) ClearEdge(name string) error {
+func (m *EnvBuildMutation) ClearEdge(name string) error {
 	switch name {
-	case env.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case env.EdgeCreator:
-		m.ClearCreator()
+	case envbuild.EdgeEnv:
+		m.ClearEnv()
 		return nil
 	}
-	return fmt.Errorf("unknown Env unique edge %s", name)
+	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvMutation) ResetEdge(name string) error {
+func (m *EnvBuildMutation) ResetEdge(name string) error {
 	switch name {
-	case env.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case env.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	case env.EdgeEnvAliases:
-		m.ResetEnvAliases()
-		return nil
-	case env.EdgeBuilds:
-		m.ResetBuilds()
+	case envbuild.EdgeEnv:
+		m.ResetEnv()
 		return nil
-	case env.EdgeSnapshots:
-		m.ResetSnapshots()
+	case envbuild.EdgeCheckpoints:
+		m.ResetCheckpoints()
 		return nil
 	}
-	return fmt.Errorf("unknown Env edge %s", name)
+	return fmt.Errorf("unknown EnvBuild edge %s", name)
 }
 
-// EnvAliasMutation represents an operation that mutates the EnvAlias nodes in the graph.
-type EnvAliasMutation struct {
+// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
+type SnapshotMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *string
-	is_renamable  *bool
+	id            *uuid.UUID
+	created_at    *time.Time
+	base_env_id   *string
+	sandbox_id    *string
+	metadata      *map[string]string
 	clearedFields map[string]struct{}
 	env           *string
 	clearedenv    bool
 	done          bool
-	oldValue      func(context.Context) (*EnvAlias, error)
-	predicates    []predicate.EnvAlias
+	oldValue      func(context.Context) (*Snapshot, error)
+	predicates    []predicate.Snapshot
 }
 
-var _ ent.Mutation = (*EnvAliasMutation)(nil)
+var _ ent.Mutation = (*SnapshotMutation)(nil)
 
-// envaliasOption allows management of the mutation configuration using functional options.
-type envaliasOption func(*EnvAliasMutation)
+// snapshotOption allows management of the mutation configuration using functional options.
+type snapshotOption func(*SnapshotMutation)
 
-// newEnvAliasMutation creates new mutation for the EnvAlias entity.
-func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutation {
-	m := &EnvAliasMutation{
+// newSnapshotMutation creates new mutation for the Snapshot entity.
+func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
+	m := &SnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvAlias,
+		typ:           TypeSnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1726,20 +4462,20 @@ func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutat
 	return m
 }
 
-// withEnvAliasID sets the ID field of the mutation.
-func withEnvAliasID(id string) envaliasOption {
-	return func(m *EnvAliasMutation) {
+// withSnapshotID sets the ID field of the mutation.
+func withSnapshotID(id uuid.UUID) snapshotOption {
+	return func(m *SnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EnvAlias
+			value *Snapshot
 		)
-		m.oldValue = func(ctx context.Context) (*EnvAlias, error) {
+		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EnvAlias.Get(ctx, id)
+					value, err = m.Client().Snapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1748,10 +4484,10 @@ func withEnvAliasID(id string) envaliasOption {
 	}
 }
 
-// withEnvAlias sets the old EnvAlias of the mutation.
-func withEnvAlias(node *EnvAlias) envaliasOption {
-	return func(m *EnvAliasMutation) {
-		m.oldValue = func(context.Context) (*EnvAlias, error) {
+// withSnapshot sets the old Snapshot of the mutation.
+func withSnapshot(node *Snapshot) snapshotOption {
+	return func(m *SnapshotMutation) {
+		m.oldValue = func(context.Context) (*Snapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1760,7 +4496,7 @@ func withEnvAlias(node *EnvAlias) envaliasOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvAliasMutation) Client() *Client {
+func (m SnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1768,7 +4504,7 @@ func (m EnvAliasMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvAliasMutation) Tx() (*Tx, error) {
+func (m SnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -1778,46 +4514,118 @@ func (m EnvAliasMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnvAlias entities.
-func (m *EnvAliasMutation) SetID(id string) {
+// operation is only accepted on creation of Snapshot entities.
+func (m *SnapshotMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvAliasMutation) ID() (id string, exists bool) {
+func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
-	return *m.id, true
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (m *SnapshotMutation) SetBaseEnvID(s string) {
+	m.base_env_id = &s
+}
+
+// BaseEnvID returns the value of the "base_env_id" field in the mutation.
+func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
+	v := m.base_env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+	}
+	return oldValue.BaseEnvID, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *EnvAliasMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnvAlias.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetBaseEnvID resets all changes to the "base_env_id" field.
+func (m *SnapshotMutation) ResetBaseEnvID() {
+	m.base_env_id = nil
 }
 
 // SetEnvID sets the "env_id" field.
-func (m *EnvAliasMutation) SetEnvID(s string) {
+func (m *SnapshotMutation) SetEnvID(s string) {
 	m.env = &s
 }
 
 // EnvID returns the value of the "env_id" field in the mutation.
-func (m *EnvAliasMutation) EnvID() (r string, exists bool) {
+func (m *SnapshotMutation) EnvID() (r string, exists bool) {
 	v := m.env
 	if v == nil {
 		return
@@ -1825,10 +4633,10 @@ func (m *EnvAliasMutation) EnvID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the EnvAlias entity.
-// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
+// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvAliasMutation) OldEnvID(ctx context.Context) (v string, err error) {
+func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
 	}
@@ -1843,61 +4651,97 @@ func (m *EnvAliasMutation) OldEnvID(ctx context.Context) (v string, err error) {
 }
 
 // ResetEnvID resets all changes to the "env_id" field.
-func (m *EnvAliasMutation) ResetEnvID() {
+func (m *SnapshotMutation) ResetEnvID() {
 	m.env = nil
 }
 
-// SetIsRenamable sets the "is_renamable" field.
-func (m *EnvAliasMutation) SetIsRenamable(b bool) {
-	m.is_renamable = &b
+// SetSandboxID sets the "sandbox_id" field.
+func (m *SnapshotMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
 }
 
-// IsRenamable returns the value of the "is_renamable" field in the mutation.
-func (m *EnvAliasMutation) IsRenamable() (r bool, exists bool) {
-	v := m.is_renamable
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRenamable returns the old "is_renamable" field's value of the EnvAlias entity.
-// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
+// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvAliasMutation) OldIsRenamable(ctx context.Context) (v bool, err error) {
+func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRenamable is only allowed on UpdateOne operations")
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRenamable requires an ID field in the mutation")
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRenamable: %w", err)
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
 	}
-	return oldValue.IsRenamable, nil
+	return oldValue.SandboxID, nil
 }
 
-// ResetIsRenamable resets all changes to the "is_renamable" field.
-func (m *EnvAliasMutation) ResetIsRenamable() {
-	m.is_renamable = nil
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *SnapshotMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SnapshotMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SnapshotMutation) ResetMetadata() {
+	m.metadata = nil
 }
 
 // ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvAliasMutation) ClearEnv() {
+func (m *SnapshotMutation) ClearEnv() {
 	m.clearedenv = true
-	m.clearedFields[envalias.FieldEnvID] = struct{}{}
+	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
 }
 
 // EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvAliasMutation) EnvCleared() bool {
+func (m *SnapshotMutation) EnvCleared() bool {
 	return m.clearedenv
 }
 
 // EnvIDs returns the "env" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvAliasMutation) EnvIDs() (ids []string) {
+func (m *SnapshotMutation) EnvIDs() (ids []string) {
 	if id := m.env; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1905,20 +4749,20 @@ func (m *EnvAliasMutation) EnvIDs() (ids []string) {
 }
 
 // ResetEnv resets all changes to the "env" edge.
-func (m *EnvAliasMutation) ResetEnv() {
+func (m *SnapshotMutation) ResetEnv() {
 	m.env = nil
 	m.clearedenv = false
 }
 
-// Where appends a list predicates to the EnvAliasMutation builder.
-func (m *EnvAliasMutation) Where(ps ...predicate.EnvAlias) {
+// Where appends a list predicates to the SnapshotMutation builder.
+func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvAliasMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvAlias, len(ps))
+func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Snapshot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1926,30 +4770,39 @@ func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvAliasMutation) Op() Op {
+func (m *SnapshotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvAliasMutation) SetOp(op Op) {
+func (m *SnapshotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EnvAlias).
-func (m *EnvAliasMutation) Type() string {
+// Type returns the node type of this mutation (Snapshot).
+func (m *SnapshotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvAliasMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+func (m *SnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, snapshot.FieldCreatedAt)
+	}
+	if m.base_env_id != nil {
+		fields = append(fields, snapshot.FieldBaseEnvID)
+	}
 	if m.env != nil {
-		fields = append(fields, envalias.FieldEnvID)
+		fields = append(fields, snapshot.FieldEnvID)
 	}
-	if m.is_renamable != nil {
-		fields = append(fields, envalias.FieldIsRenamable)
+	if m.sandbox_id != nil {
+		fields = append(fields, snapshot.FieldSandboxID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, snapshot.FieldMetadata)
 	}
 	return fields
 }
@@ -1957,12 +4810,18 @@ func (m *EnvAliasMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
+func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case envalias.FieldEnvID:
+	case snapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	case snapshot.FieldBaseEnvID:
+		return m.BaseEnvID()
+	case snapshot.FieldEnvID:
 		return m.EnvID()
-	case envalias.FieldIsRenamable:
-		return m.IsRenamable()
+	case snapshot.FieldSandboxID:
+		return m.SandboxID()
+	case snapshot.FieldMetadata:
+		return m.Metadata()
 	}
 	return nil, false
 }
@@ -1970,108 +4829,144 @@ func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case envalias.FieldEnvID:
+	case snapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case snapshot.FieldBaseEnvID:
+		return m.OldBaseEnvID(ctx)
+	case snapshot.FieldEnvID:
 		return m.OldEnvID(ctx)
-	case envalias.FieldIsRenamable:
-		return m.OldIsRenamable(ctx)
+	case snapshot.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case snapshot.FieldMetadata:
+		return m.OldMetadata(ctx)
 	}
-	return nil, fmt.Errorf("unknown EnvAlias field %s", name)
+	return nil, fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvAliasMutation) SetField(name string, value ent.Value) error {
+func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case envalias.FieldEnvID:
+	case snapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case snapshot.FieldBaseEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseEnvID(v)
+		return nil
+	case snapshot.FieldEnvID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetEnvID(v)
 		return nil
-	case envalias.FieldIsRenamable:
-		v, ok := value.(bool)
+	case snapshot.FieldSandboxID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsRenamable(v)
+		m.SetSandboxID(v)
+		return nil
+	case snapshot.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias field %s", name)
+	return fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvAliasMutation) AddedFields() []string {
+func (m *SnapshotMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvAliasMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvAliasMutation) AddField(name string, value ent.Value) error {
+func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown EnvAlias numeric field %s", name)
+	return fmt.Errorf("unknown Snapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvAliasMutation) ClearedFields() []string {
+func (m *SnapshotMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvAliasMutation) FieldCleared(name string) bool {
+func (m *SnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvAliasMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown EnvAlias nullable field %s", name)
+func (m *SnapshotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Snapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvAliasMutation) ResetField(name string) error {
+func (m *SnapshotMutation) ResetField(name string) error {
 	switch name {
-	case envalias.FieldEnvID:
+	case snapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case snapshot.FieldBaseEnvID:
+		m.ResetBaseEnvID()
+		return nil
+	case snapshot.FieldEnvID:
 		m.ResetEnvID()
 		return nil
-	case envalias.FieldIsRenamable:
-		m.ResetIsRenamable()
+	case snapshot.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case snapshot.FieldMetadata:
+		m.ResetMetadata()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias field %s", name)
+	return fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvAliasMutation) AddedEdges() []string {
+func (m *SnapshotMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.env != nil {
-		edges = append(edges, envalias.EdgeEnv)
+		edges = append(edges, snapshot.EdgeEnv)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvAliasMutation) AddedIDs(name string) []ent.Value {
+func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case envalias.EdgeEnv:
+	case snapshot.EdgeEnv:
 		if id := m.env; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2080,31 +4975,31 @@ func (m *EnvAliasMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvAliasMutation) RemovedEdges() []string {
+func (m *SnapshotMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvAliasMutation) RemovedIDs(name string) []ent.Value {
+func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvAliasMutation) ClearedEdges() []string {
+func (m *SnapshotMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedenv {
-		edges = append(edges, envalias.EdgeEnv)
+		edges = append(edges, snapshot.EdgeEnv)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvAliasMutation) EdgeCleared(name string) bool {
+func (m *SnapshotMutation) EdgeCleared(name string) bool {
 	switch name {
-	case envalias.EdgeEnv:
+	case snapshot.EdgeEnv:
 		return m.clearedenv
 	}
 	return false
@@ -2112,68 +5007,72 @@ func (m *EnvAliasMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvAliasMutation) ClearEdge(name string) error {
+func (m *SnapshotMutation) ClearEdge(name string) error {
 	switch name {
-	case envalias.EdgeEnv:
+	case snapshot.EdgeEnv:
 		m.ClearEnv()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias unique edge %s", name)
+	return fmt.Errorf("unknown Snapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvAliasMutation) ResetEdge(name string) error {
+func (m *SnapshotMutation) ResetEdge(name string) error {
 	switch name {
-	case envalias.EdgeEnv:
+	case snapshot.EdgeEnv:
 		m.ResetEnv()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias edge %s", name)
+	return fmt.Errorf("unknown Snapshot edge %s", name)
 }
 
-// EnvBuildMutation represents an operation that mutates the EnvBuild nodes in the graph.
-type EnvBuildMutation struct {
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	created_at            *time.Time
-	updated_at            *time.Time
-	finished_at           *time.Time
-	status                *envbuild.Status
-	dockerfile            *string
-	start_cmd             *string
-	vcpu                  *int64
-	addvcpu               *int64
-	ram_mb                *int64
-	addram_mb             *int64
-	free_disk_size_mb     *int64
-	addfree_disk_size_mb  *int64
-	total_disk_size_mb    *int64
-	addtotal_disk_size_mb *int64
-	kernel_version        *string
-	firecracker_version   *string
-	envd_version          *string
-	clearedFields         map[string]struct{}
-	env                   *string
-	clearedenv            bool
-	done                  bool
-	oldValue              func(context.Context) (*EnvBuild, error)
-	predicates            []predicate.EnvBuild
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *time.Time
+	is_banned            *bool
+	is_blocked           *bool
+	blocked_reason       *string
+	name                 *string
+	email                *string
+	clearedFields        map[string]struct{}
+	users                map[uuid.UUID]struct{}
+	removedusers         map[uuid.UUID]struct{}
+	clearedusers         bool
+	team_api_keys        map[uuid.UUID]struct{}
+	removedteam_api_keys map[uuid.UUID]struct{}
+	clearedteam_api_keys bool
+	team_webhooks        map[uuid.UUID]struct{}
+	removedteam_webhooks map[uuid.UUID]struct{}
+	clearedteam_webhooks bool
+	team_tier            *string
+	clearedteam_tier     bool
+	envs                 map[string]struct{}
+	removedenvs          map[string]struct{}
+	clearedenvs          bool
+	users_teams          map[int]struct{}
+	removedusers_teams   map[int]struct{}
+	clearedusers_teams   bool
+	done                 bool
+	oldValue             func(context.Context) (*Team, error)
+	predicates           []predicate.Team
 }
 
-var _ ent.Mutation = (*EnvBuildMutation)(nil)
+var _ ent.Mutation = (*TeamMutation)(nil)
 
-// envbuildOption allows management of the mutation configuration using functional options.
-type envbuildOption func(*EnvBuildMutation)
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
 
-// newEnvBuildMutation creates new mutation for the EnvBuild entity.
-func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutation {
-	m := &EnvBuildMutation{
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvBuild,
+		typ:           TypeTeam,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2182,20 +5081,20 @@ func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutat
 	return m
 }
 
-// withEnvBuildID sets the ID field of the mutation.
-func withEnvBuildID(id uuid.UUID) envbuildOption {
-	return func(m *EnvBuildMutation) {
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id uuid.UUID) teamOption {
+	return func(m *TeamMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EnvBuild
+			value *Team
 		)
-		m.oldValue = func(ctx context.Context) (*EnvBuild, error) {
+		m.oldValue = func(ctx context.Context) (*Team, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EnvBuild.Get(ctx, id)
+					value, err = m.Client().Team.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2204,10 +5103,10 @@ func withEnvBuildID(id uuid.UUID) envbuildOption {
 	}
 }
 
-// withEnvBuild sets the old EnvBuild of the mutation.
-func withEnvBuild(node *EnvBuild) envbuildOption {
-	return func(m *EnvBuildMutation) {
-		m.oldValue = func(context.Context) (*EnvBuild, error) {
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2216,7 +5115,7 @@ func withEnvBuild(node *EnvBuild) envbuildOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvBuildMutation) Client() *Client {
+func (m TeamMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2224,7 +5123,7 @@ func (m EnvBuildMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvBuildMutation) Tx() (*Tx, error) {
+func (m TeamMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -2234,14 +5133,14 @@ func (m EnvBuildMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnvBuild entities.
-func (m *EnvBuildMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Team entities.
+func (m *TeamMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2252,720 +5151,631 @@ func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvBuildMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
 			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnvBuild.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *EnvBuildMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvBuildMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvBuildMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnvBuildMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvBuildMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvBuildMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetFinishedAt sets the "finished_at" field.
-func (m *EnvBuildMutation) SetFinishedAt(t time.Time) {
-	m.finished_at = &t
-}
-
-// FinishedAt returns the value of the "finished_at" field in the mutation.
-func (m *EnvBuildMutation) FinishedAt() (r time.Time, exists bool) {
-	v := m.finished_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFinishedAt returns the old "finished_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
-	}
-	return oldValue.FinishedAt, nil
-}
-
-// ClearFinishedAt clears the value of the "finished_at" field.
-func (m *EnvBuildMutation) ClearFinishedAt() {
-	m.finished_at = nil
-	m.clearedFields[envbuild.FieldFinishedAt] = struct{}{}
-}
-
-// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
-func (m *EnvBuildMutation) FinishedAtCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldFinishedAt]
-	return ok
-}
-
-// ResetFinishedAt resets all changes to the "finished_at" field.
-func (m *EnvBuildMutation) ResetFinishedAt() {
-	m.finished_at = nil
-	delete(m.clearedFields, envbuild.FieldFinishedAt)
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *EnvBuildMutation) SetEnvID(s string) {
-	m.env = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *EnvBuildMutation) EnvID() (r string, exists bool) {
-	v := m.env
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldEnvID(ctx context.Context) (v *string, err error) {
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.EnvID, nil
-}
-
-// ClearEnvID clears the value of the "env_id" field.
-func (m *EnvBuildMutation) ClearEnvID() {
-	m.env = nil
-	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
-}
-
-// EnvIDCleared returns if the "env_id" field was cleared in this mutation.
-func (m *EnvBuildMutation) EnvIDCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldEnvID]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *EnvBuildMutation) ResetEnvID() {
-	m.env = nil
-	delete(m.clearedFields, envbuild.FieldEnvID)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *EnvBuildMutation) SetStatus(e envbuild.Status) {
-	m.status = &e
+// SetIsBanned sets the "is_banned" field.
+func (m *TeamMutation) SetIsBanned(b bool) {
+	m.is_banned = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EnvBuildMutation) Status() (r envbuild.Status, exists bool) {
-	v := m.status
+// IsBanned returns the value of the "is_banned" field in the mutation.
+func (m *TeamMutation) IsBanned() (r bool, exists bool) {
+	v := m.is_banned
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldIsBanned returns the old "is_banned" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldStatus(ctx context.Context) (v envbuild.Status, err error) {
+func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldIsBanned requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.IsBanned, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *EnvBuildMutation) ResetStatus() {
-	m.status = nil
+// ClearIsBanned clears the value of the "is_banned" field.
+func (m *TeamMutation) ClearIsBanned() {
+	m.is_banned = nil
+	m.clearedFields[team.FieldIsBanned] = struct{}{}
 }
 
-// SetDockerfile sets the "dockerfile" field.
-func (m *EnvBuildMutation) SetDockerfile(s string) {
-	m.dockerfile = &s
+// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
+func (m *TeamMutation) IsBannedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBanned]
+	return ok
 }
 
-// Dockerfile returns the value of the "dockerfile" field in the mutation.
-func (m *EnvBuildMutation) Dockerfile() (r string, exists bool) {
-	v := m.dockerfile
+// ResetIsBanned resets all changes to the "is_banned" field.
+func (m *TeamMutation) ResetIsBanned() {
+	m.is_banned = nil
+	delete(m.clearedFields, team.FieldIsBanned)
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (m *TeamMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
+
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDockerfile returns the old "dockerfile" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldDockerfile(ctx context.Context) (v *string, err error) {
+func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDockerfile is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDockerfile requires an ID field in the mutation")
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDockerfile: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
 	}
-	return oldValue.Dockerfile, nil
+	return oldValue.IsBlocked, nil
 }
 
-// ClearDockerfile clears the value of the "dockerfile" field.
-func (m *EnvBuildMutation) ClearDockerfile() {
-	m.dockerfile = nil
-	m.clearedFields[envbuild.FieldDockerfile] = struct{}{}
+// ClearIsBlocked clears the value of the "is_blocked" field.
+func (m *TeamMutation) ClearIsBlocked() {
+	m.is_blocked = nil
+	m.clearedFields[team.FieldIsBlocked] = struct{}{}
 }
 
-// DockerfileCleared returns if the "dockerfile" field was cleared in this mutation.
-func (m *EnvBuildMutation) DockerfileCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldDockerfile]
+// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
+func (m *TeamMutation) IsBlockedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBlocked]
 	return ok
 }
 
-// ResetDockerfile resets all changes to the "dockerfile" field.
-func (m *EnvBuildMutation) ResetDockerfile() {
-	m.dockerfile = nil
-	delete(m.clearedFields, envbuild.FieldDockerfile)
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *TeamMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+	delete(m.clearedFields, team.FieldIsBlocked)
 }
 
-// SetStartCmd sets the "start_cmd" field.
-func (m *EnvBuildMutation) SetStartCmd(s string) {
-	m.start_cmd = &s
+// SetBlockedReason sets the "blocked_reason" field.
+func (m *TeamMutation) SetBlockedReason(s string) {
+	m.blocked_reason = &s
 }
 
-// StartCmd returns the value of the "start_cmd" field in the mutation.
-func (m *EnvBuildMutation) StartCmd() (r string, exists bool) {
-	v := m.start_cmd
+// BlockedReason returns the value of the "blocked_reason" field in the mutation.
+func (m *TeamMutation) BlockedReason() (r string, exists bool) {
+	v := m.blocked_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartCmd returns the old "start_cmd" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldStartCmd(ctx context.Context) (v *string, err error) {
+func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartCmd is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartCmd requires an ID field in the mutation")
+		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartCmd: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
 	}
-	return oldValue.StartCmd, nil
+	return oldValue.BlockedReason, nil
 }
 
-// ClearStartCmd clears the value of the "start_cmd" field.
-func (m *EnvBuildMutation) ClearStartCmd() {
-	m.start_cmd = nil
-	m.clearedFields[envbuild.FieldStartCmd] = struct{}{}
+// ClearBlockedReason clears the value of the "blocked_reason" field.
+func (m *TeamMutation) ClearBlockedReason() {
+	m.blocked_reason = nil
+	m.clearedFields[team.FieldBlockedReason] = struct{}{}
 }
 
-// StartCmdCleared returns if the "start_cmd" field was cleared in this mutation.
-func (m *EnvBuildMutation) StartCmdCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldStartCmd]
+// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
+func (m *TeamMutation) BlockedReasonCleared() bool {
+	_, ok := m.clearedFields[team.FieldBlockedReason]
 	return ok
 }
 
-// ResetStartCmd resets all changes to the "start_cmd" field.
-func (m *EnvBuildMutation) ResetStartCmd() {
-	m.start_cmd = nil
-	delete(m.clearedFields, envbuild.FieldStartCmd)
+// ResetBlockedReason resets all changes to the "blocked_reason" field.
+func (m *TeamMutation) ResetBlockedReason() {
+	m.blocked_reason = nil
+	delete(m.clearedFields, team.FieldBlockedReason)
 }
 
-// SetVcpu sets the "vcpu" field.
-func (m *EnvBuildMutation) SetVcpu(i int64) {
-	m.vcpu = &i
-	m.addvcpu = nil
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Vcpu returns the value of the "vcpu" field in the mutation.
-func (m *EnvBuildMutation) Vcpu() (r int64, exists bool) {
-	v := m.vcpu
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVcpu returns the old "vcpu" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldVcpu(ctx context.Context) (v int64, err error) {
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVcpu is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVcpu requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVcpu: %w", err)
-	}
-	return oldValue.Vcpu, nil
-}
-
-// AddVcpu adds i to the "vcpu" field.
-func (m *EnvBuildMutation) AddVcpu(i int64) {
-	if m.addvcpu != nil {
-		*m.addvcpu += i
-	} else {
-		m.addvcpu = &i
-	}
-}
-
-// AddedVcpu returns the value that was added to the "vcpu" field in this mutation.
-func (m *EnvBuildMutation) AddedVcpu() (r int64, exists bool) {
-	v := m.addvcpu
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetVcpu resets all changes to the "vcpu" field.
-func (m *EnvBuildMutation) ResetVcpu() {
-	m.vcpu = nil
-	m.addvcpu = nil
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
 }
 
-// SetRAMMB sets the "ram_mb" field.
-func (m *EnvBuildMutation) SetRAMMB(i int64) {
-	m.ram_mb = &i
-	m.addram_mb = nil
+// SetTier sets the "tier" field.
+func (m *TeamMutation) SetTier(s string) {
+	m.team_tier = &s
 }
 
-// RAMMB returns the value of the "ram_mb" field in the mutation.
-func (m *EnvBuildMutation) RAMMB() (r int64, exists bool) {
-	v := m.ram_mb
+// Tier returns the value of the "tier" field in the mutation.
+func (m *TeamMutation) Tier() (r string, exists bool) {
+	v := m.team_tier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRAMMB returns the old "ram_mb" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldTier returns the old "tier" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldRAMMB(ctx context.Context) (v int64, err error) {
+func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRAMMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRAMMB requires an ID field in the mutation")
+		return v, errors.New("OldTier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRAMMB: %w", err)
-	}
-	return oldValue.RAMMB, nil
-}
-
-// AddRAMMB adds i to the "ram_mb" field.
-func (m *EnvBuildMutation) AddRAMMB(i int64) {
-	if m.addram_mb != nil {
-		*m.addram_mb += i
-	} else {
-		m.addram_mb = &i
-	}
-}
-
-// AddedRAMMB returns the value that was added to the "ram_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedRAMMB() (r int64, exists bool) {
-	v := m.addram_mb
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
 	}
-	return *v, true
+	return oldValue.Tier, nil
 }
 
-// ResetRAMMB resets all changes to the "ram_mb" field.
-func (m *EnvBuildMutation) ResetRAMMB() {
-	m.ram_mb = nil
-	m.addram_mb = nil
+// ResetTier resets all changes to the "tier" field.
+func (m *TeamMutation) ResetTier() {
+	m.team_tier = nil
 }
 
-// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) SetFreeDiskSizeMB(i int64) {
-	m.free_disk_size_mb = &i
-	m.addfree_disk_size_mb = nil
+// SetEmail sets the "email" field.
+func (m *TeamMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// FreeDiskSizeMB returns the value of the "free_disk_size_mb" field in the mutation.
-func (m *EnvBuildMutation) FreeDiskSizeMB() (r int64, exists bool) {
-	v := m.free_disk_size_mb
+// Email returns the value of the "email" field in the mutation.
+func (m *TeamMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFreeDiskSizeMB returns the old "free_disk_size_mb" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFreeDiskSizeMB(ctx context.Context) (v int64, err error) {
+func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFreeDiskSizeMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFreeDiskSizeMB requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFreeDiskSizeMB: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.FreeDiskSizeMB, nil
+	return oldValue.Email, nil
 }
 
-// AddFreeDiskSizeMB adds i to the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) AddFreeDiskSizeMB(i int64) {
-	if m.addfree_disk_size_mb != nil {
-		*m.addfree_disk_size_mb += i
-	} else {
-		m.addfree_disk_size_mb = &i
-	}
+// ResetEmail resets all changes to the "email" field.
+func (m *TeamMutation) ResetEmail() {
+	m.email = nil
 }
 
-// AddedFreeDiskSizeMB returns the value that was added to the "free_disk_size_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedFreeDiskSizeMB() (r int64, exists bool) {
-	v := m.addfree_disk_size_mb
-	if v == nil {
-		return
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetFreeDiskSizeMB resets all changes to the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) ResetFreeDiskSizeMB() {
-	m.free_disk_size_mb = nil
-	m.addfree_disk_size_mb = nil
+// ClearUsers clears the "users" edge to the User entity.
+func (m *TeamMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// SetTotalDiskSizeMB sets the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) SetTotalDiskSizeMB(i int64) {
-	m.total_disk_size_mb = &i
-	m.addtotal_disk_size_mb = nil
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *TeamMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// TotalDiskSizeMB returns the value of the "total_disk_size_mb" field in the mutation.
-func (m *EnvBuildMutation) TotalDiskSizeMB() (r int64, exists bool) {
-	v := m.total_disk_size_mb
-	if v == nil {
-		return
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTotalDiskSizeMB returns the old "total_disk_size_mb" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldTotalDiskSizeMB(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalDiskSizeMB is only allowed on UpdateOne operations")
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalDiskSizeMB requires an ID field in the mutation")
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalDiskSizeMB: %w", err)
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *TeamMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.team_api_keys == nil {
+		m.team_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.team_api_keys[ids[i]] = struct{}{}
 	}
-	return oldValue.TotalDiskSizeMB, nil
 }
 
-// AddTotalDiskSizeMB adds i to the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) AddTotalDiskSizeMB(i int64) {
-	if m.addtotal_disk_size_mb != nil {
-		*m.addtotal_disk_size_mb += i
-	} else {
-		m.addtotal_disk_size_mb = &i
+// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) ClearTeamAPIKeys() {
+	m.clearedteam_api_keys = true
+}
+
+// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *TeamMutation) TeamAPIKeysCleared() bool {
+	return m.clearedteam_api_keys
+}
+
+// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedteam_api_keys == nil {
+		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.team_api_keys, ids[i])
+		m.removedteam_api_keys[ids[i]] = struct{}{}
 	}
 }
 
-// AddedTotalDiskSizeMB returns the value that was added to the "total_disk_size_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedTotalDiskSizeMB() (r int64, exists bool) {
-	v := m.addtotal_disk_size_mb
-	if v == nil {
-		return
+// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedteam_api_keys {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ClearTotalDiskSizeMB clears the value of the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) ClearTotalDiskSizeMB() {
-	m.total_disk_size_mb = nil
-	m.addtotal_disk_size_mb = nil
-	m.clearedFields[envbuild.FieldTotalDiskSizeMB] = struct{}{}
+// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
+func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.team_api_keys {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// TotalDiskSizeMBCleared returns if the "total_disk_size_mb" field was cleared in this mutation.
-func (m *EnvBuildMutation) TotalDiskSizeMBCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldTotalDiskSizeMB]
-	return ok
+// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
+func (m *TeamMutation) ResetTeamAPIKeys() {
+	m.team_api_keys = nil
+	m.clearedteam_api_keys = false
+	m.removedteam_api_keys = nil
 }
 
-// ResetTotalDiskSizeMB resets all changes to the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) ResetTotalDiskSizeMB() {
-	m.total_disk_size_mb = nil
-	m.addtotal_disk_size_mb = nil
-	delete(m.clearedFields, envbuild.FieldTotalDiskSizeMB)
+// AddTeamWebhookIDs adds the "team_webhooks" edge to the TeamWebhook entity by ids.
+func (m *TeamMutation) AddTeamWebhookIDs(ids ...uuid.UUID) {
+	if m.team_webhooks == nil {
+		m.team_webhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.team_webhooks[ids[i]] = struct{}{}
+	}
 }
 
-// SetKernelVersion sets the "kernel_version" field.
-func (m *EnvBuildMutation) SetKernelVersion(s string) {
-	m.kernel_version = &s
+// ClearTeamWebhooks clears the "team_webhooks" edge to the TeamWebhook entity.
+func (m *TeamMutation) ClearTeamWebhooks() {
+	m.clearedteam_webhooks = true
 }
 
-// KernelVersion returns the value of the "kernel_version" field in the mutation.
-func (m *EnvBuildMutation) KernelVersion() (r string, exists bool) {
-	v := m.kernel_version
-	if v == nil {
-		return
-	}
-	return *v, true
+// TeamWebhooksCleared reports if the "team_webhooks" edge to the TeamWebhook entity was cleared.
+func (m *TeamMutation) TeamWebhooksCleared() bool {
+	return m.clearedteam_webhooks
 }
 
-// OldKernelVersion returns the old "kernel_version" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
+// RemoveTeamWebhookIDs removes the "team_webhooks" edge to the TeamWebhook entity by IDs.
+func (m *TeamMutation) RemoveTeamWebhookIDs(ids ...uuid.UUID) {
+	if m.removedteam_webhooks == nil {
+		m.removedteam_webhooks = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.team_webhooks, ids[i])
+		m.removedteam_webhooks[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+}
+
+// RemovedTeamWebhooks returns the removed IDs of the "team_webhooks" edge to the TeamWebhook entity.
+func (m *TeamMutation) RemovedTeamWebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedteam_webhooks {
+		ids = append(ids, id)
 	}
-	return oldValue.KernelVersion, nil
+	return
+}
+
+// TeamWebhooksIDs returns the "team_webhooks" edge IDs in the mutation.
+func (m *TeamMutation) TeamWebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.team_webhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamWebhooks resets all changes to the "team_webhooks" edge.
+func (m *TeamMutation) ResetTeamWebhooks() {
+	m.team_webhooks = nil
+	m.clearedteam_webhooks = false
+	m.removedteam_webhooks = nil
 }
 
-// ResetKernelVersion resets all changes to the "kernel_version" field.
-func (m *EnvBuildMutation) ResetKernelVersion() {
-	m.kernel_version = nil
+// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
+func (m *TeamMutation) SetTeamTierID(id string) {
+	m.team_tier = &id
 }
 
-// SetFirecrackerVersion sets the "firecracker_version" field.
-func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
-	m.firecracker_version = &s
+// ClearTeamTier clears the "team_tier" edge to the Tier entity.
+func (m *TeamMutation) ClearTeamTier() {
+	m.clearedteam_tier = true
+	m.clearedFields[team.FieldTier] = struct{}{}
 }
 
-// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
-func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
-	v := m.firecracker_version
-	if v == nil {
-		return
+// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
+func (m *TeamMutation) TeamTierCleared() bool {
+	return m.clearedteam_tier
+}
+
+// TeamTierID returns the "team_tier" edge ID in the mutation.
+func (m *TeamMutation) TeamTierID() (id string, exists bool) {
+	if m.team_tier != nil {
+		return *m.team_tier, true
 	}
-	return *v, true
+	return
 }
 
-// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
+// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamTierID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) TeamTierIDs() (ids []string) {
+	if id := m.team_tier; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
+	return
+}
+
+// ResetTeamTier resets all changes to the "team_tier" edge.
+func (m *TeamMutation) ResetTeamTier() {
+	m.team_tier = nil
+	m.clearedteam_tier = false
+}
+
+// AddEnvIDs adds the "envs" edge to the Env entity by ids.
+func (m *TeamMutation) AddEnvIDs(ids ...string) {
+	if m.envs == nil {
+		m.envs = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
+	for i := range ids {
+		m.envs[ids[i]] = struct{}{}
 	}
-	return oldValue.FirecrackerVersion, nil
 }
 
-// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
-func (m *EnvBuildMutation) ResetFirecrackerVersion() {
-	m.firecracker_version = nil
+// ClearEnvs clears the "envs" edge to the Env entity.
+func (m *TeamMutation) ClearEnvs() {
+	m.clearedenvs = true
 }
 
-// SetEnvdVersion sets the "envd_version" field.
-func (m *EnvBuildMutation) SetEnvdVersion(s string) {
-	m.envd_version = &s
+// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
+func (m *TeamMutation) EnvsCleared() bool {
+	return m.clearedenvs
 }
 
-// EnvdVersion returns the value of the "envd_version" field in the mutation.
-func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
-	v := m.envd_version
-	if v == nil {
-		return
+// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
+func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
+	if m.removedenvs == nil {
+		m.removedenvs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.envs, ids[i])
+		m.removedenvs[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
+// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
+func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
+	for id := range m.removedenvs {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
+	return
+}
+
+// EnvsIDs returns the "envs" edge IDs in the mutation.
+func (m *TeamMutation) EnvsIDs() (ids []string) {
+	for id := range m.envs {
+		ids = append(ids, id)
 	}
-	return oldValue.EnvdVersion, nil
+	return
 }
 
-// ClearEnvdVersion clears the value of the "envd_version" field.
-func (m *EnvBuildMutation) ClearEnvdVersion() {
-	m.envd_version = nil
-	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
+// ResetEnvs resets all changes to the "envs" edge.
+func (m *TeamMutation) ResetEnvs() {
+	m.envs = nil
+	m.clearedenvs = false
+	m.removedenvs = nil
 }
 
-// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
-func (m *EnvBuildMutation) EnvdVersionCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
-	return ok
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users_teams[ids[i]] = struct{}{}
+	}
 }
 
-// ResetEnvdVersion resets all changes to the "envd_version" field.
-func (m *EnvBuildMutation) ResetEnvdVersion() {
-	m.envd_version = nil
-	delete(m.clearedFields, envbuild.FieldEnvdVersion)
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvBuildMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *TeamMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvBuildMutation) EnvCleared() bool {
-	return m.EnvIDCleared() || m.clearedenv
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
+	}
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvBuildMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *EnvBuildMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the EnvBuildMutation builder.
-func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *TeamMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvBuild, len(ps))
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2973,66 +5783,45 @@ func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvBuildMutation) Op() Op {
+func (m *TeamMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvBuildMutation) SetOp(op Op) {
+func (m *TeamMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EnvBuild).
-func (m *EnvBuildMutation) Type() string {
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvBuildMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, envbuild.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, envbuild.FieldUpdatedAt)
-	}
-	if m.finished_at != nil {
-		fields = append(fields, envbuild.FieldFinishedAt)
-	}
-	if m.env != nil {
-		fields = append(fields, envbuild.FieldEnvID)
-	}
-	if m.status != nil {
-		fields = append(fields, envbuild.FieldStatus)
-	}
-	if m.dockerfile != nil {
-		fields = append(fields, envbuild.FieldDockerfile)
-	}
-	if m.start_cmd != nil {
-		fields = append(fields, envbuild.FieldStartCmd)
-	}
-	if m.vcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
+		fields = append(fields, team.FieldCreatedAt)
 	}
-	if m.ram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
+	if m.is_banned != nil {
+		fields = append(fields, team.FieldIsBanned)
 	}
-	if m.free_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	if m.is_blocked != nil {
+		fields = append(fields, team.FieldIsBlocked)
 	}
-	if m.total_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	if m.blocked_reason != nil {
+		fields = append(fields, team.FieldBlockedReason)
 	}
-	if m.kernel_version != nil {
-		fields = append(fields, envbuild.FieldKernelVersion)
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
 	}
-	if m.firecracker_version != nil {
-		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	if m.team_tier != nil {
+		fields = append(fields, team.FieldTier)
 	}
-	if m.envd_version != nil {
-		fields = append(fields, envbuild.FieldEnvdVersion)
+	if m.email != nil {
+		fields = append(fields, team.FieldEmail)
 	}
 	return fields
 }
@@ -3040,36 +5829,22 @@ func (m *EnvBuildMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		return m.CreatedAt()
-	case envbuild.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case envbuild.FieldFinishedAt:
-		return m.FinishedAt()
-	case envbuild.FieldEnvID:
-		return m.EnvID()
-	case envbuild.FieldStatus:
-		return m.Status()
-	case envbuild.FieldDockerfile:
-		return m.Dockerfile()
-	case envbuild.FieldStartCmd:
-		return m.StartCmd()
-	case envbuild.FieldVcpu:
-		return m.Vcpu()
-	case envbuild.FieldRAMMB:
-		return m.RAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.FreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.TotalDiskSizeMB()
-	case envbuild.FieldKernelVersion:
-		return m.KernelVersion()
-	case envbuild.FieldFirecrackerVersion:
-		return m.FirecrackerVersion()
-	case envbuild.FieldEnvdVersion:
-		return m.EnvdVersion()
+	case team.FieldIsBanned:
+		return m.IsBanned()
+	case team.FieldIsBlocked:
+		return m.IsBlocked()
+	case team.FieldBlockedReason:
+		return m.BlockedReason()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldTier:
+		return m.Tier()
+	case team.FieldEmail:
+		return m.Email()
 	}
 	return nil, false
 }
@@ -3077,431 +5852,417 @@ func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case envbuild.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case envbuild.FieldFinishedAt:
-		return m.OldFinishedAt(ctx)
-	case envbuild.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case envbuild.FieldStatus:
-		return m.OldStatus(ctx)
-	case envbuild.FieldDockerfile:
-		return m.OldDockerfile(ctx)
-	case envbuild.FieldStartCmd:
-		return m.OldStartCmd(ctx)
-	case envbuild.FieldVcpu:
-		return m.OldVcpu(ctx)
-	case envbuild.FieldRAMMB:
-		return m.OldRAMMB(ctx)
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.OldFreeDiskSizeMB(ctx)
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.OldTotalDiskSizeMB(ctx)
-	case envbuild.FieldKernelVersion:
-		return m.OldKernelVersion(ctx)
-	case envbuild.FieldFirecrackerVersion:
-		return m.OldFirecrackerVersion(ctx)
-	case envbuild.FieldEnvdVersion:
-		return m.OldEnvdVersion(ctx)
+	case team.FieldIsBanned:
+		return m.OldIsBanned(ctx)
+	case team.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case team.FieldBlockedReason:
+		return m.OldBlockedReason(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldTier:
+		return m.OldTier(ctx)
+	case team.FieldEmail:
+		return m.OldEmail(ctx)
 	}
-	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
+	return nil, fmt.Errorf("unknown Team field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case envbuild.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case envbuild.FieldFinishedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFinishedAt(v)
-		return nil
-	case envbuild.FieldEnvID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnvID(v)
-		return nil
-	case envbuild.FieldStatus:
-		v, ok := value.(envbuild.Status)
+	case team.FieldIsBanned:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetIsBanned(v)
 		return nil
-	case envbuild.FieldDockerfile:
-		v, ok := value.(string)
+	case team.FieldIsBlocked:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDockerfile(v)
+		m.SetIsBlocked(v)
 		return nil
-	case envbuild.FieldStartCmd:
+	case team.FieldBlockedReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartCmd(v)
-		return nil
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVcpu(v)
-		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRAMMB(v)
-		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFreeDiskSizeMB(v)
-		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalDiskSizeMB(v)
+		m.SetBlockedReason(v)
 		return nil
-	case envbuild.FieldKernelVersion:
+	case team.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKernelVersion(v)
+		m.SetName(v)
 		return nil
-	case envbuild.FieldFirecrackerVersion:
+	case team.FieldTier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirecrackerVersion(v)
+		m.SetTier(v)
 		return nil
-	case envbuild.FieldEnvdVersion:
+	case team.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvdVersion(v)
+		m.SetEmail(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvBuildMutation) AddedFields() []string {
-	var fields []string
-	if m.addvcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
-	}
-	if m.addram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
-	}
-	if m.addfree_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
-	}
-	if m.addtotal_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
-	}
-	return fields
+func (m *TeamMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case envbuild.FieldVcpu:
-		return m.AddedVcpu()
-	case envbuild.FieldRAMMB:
-		return m.AddedRAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.AddedFreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.AddedTotalDiskSizeMB()
-	}
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVcpu(v)
-		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRAMMB(v)
-		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFreeDiskSizeMB(v)
-		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalDiskSizeMB(v)
-		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
+	return fmt.Errorf("unknown Team numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvBuildMutation) ClearedFields() []string {
+func (m *TeamMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(envbuild.FieldFinishedAt) {
-		fields = append(fields, envbuild.FieldFinishedAt)
-	}
-	if m.FieldCleared(envbuild.FieldEnvID) {
-		fields = append(fields, envbuild.FieldEnvID)
-	}
-	if m.FieldCleared(envbuild.FieldDockerfile) {
-		fields = append(fields, envbuild.FieldDockerfile)
-	}
-	if m.FieldCleared(envbuild.FieldStartCmd) {
-		fields = append(fields, envbuild.FieldStartCmd)
+	if m.FieldCleared(team.FieldIsBanned) {
+		fields = append(fields, team.FieldIsBanned)
 	}
-	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	if m.FieldCleared(team.FieldIsBlocked) {
+		fields = append(fields, team.FieldIsBlocked)
 	}
-	if m.FieldCleared(envbuild.FieldEnvdVersion) {
-		fields = append(fields, envbuild.FieldEnvdVersion)
+	if m.FieldCleared(team.FieldBlockedReason) {
+		fields = append(fields, team.FieldBlockedReason)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvBuildMutation) FieldCleared(name string) bool {
+func (m *TeamMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ClearField(name string) error {
+func (m *TeamMutation) ClearField(name string) error {
 	switch name {
-	case envbuild.FieldFinishedAt:
-		m.ClearFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ClearEnvID()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ClearDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ClearStartCmd()
+	case team.FieldIsBanned:
+		m.ClearIsBanned()
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ClearTotalDiskSizeMB()
+	case team.FieldIsBlocked:
+		m.ClearIsBlocked()
 		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ClearEnvdVersion()
+	case team.FieldBlockedReason:
+		m.ClearBlockedReason()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
+	return fmt.Errorf("unknown Team nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ResetField(name string) error {
+func (m *TeamMutation) ResetField(name string) error {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case team.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case envbuild.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case envbuild.FieldFinishedAt:
-		m.ResetFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ResetEnvID()
-		return nil
-	case envbuild.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ResetDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ResetStartCmd()
-		return nil
-	case envbuild.FieldVcpu:
-		m.ResetVcpu()
-		return nil
-	case envbuild.FieldRAMMB:
-		m.ResetRAMMB()
+	case team.FieldIsBanned:
+		m.ResetIsBanned()
 		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		m.ResetFreeDiskSizeMB()
+	case team.FieldIsBlocked:
+		m.ResetIsBlocked()
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ResetTotalDiskSizeMB()
+	case team.FieldBlockedReason:
+		m.ResetBlockedReason()
 		return nil
-	case envbuild.FieldKernelVersion:
-		m.ResetKernelVersion()
+	case team.FieldName:
+		m.ResetName()
 		return nil
-	case envbuild.FieldFirecrackerVersion:
-		m.ResetFirecrackerVersion()
+	case team.FieldTier:
+		m.ResetTier()
 		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ResetEnvdVersion()
+	case team.FieldEmail:
+		m.ResetEmail()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvBuildMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, envbuild.EdgeEnv)
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.users != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.team_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.team_webhooks != nil {
+		edges = append(edges, team.EdgeTeamWebhooks)
+	}
+	if m.team_tier != nil {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.envs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case envbuild.EdgeEnv:
-		if id := m.env; id != nil {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.team_api_keys))
+		for id := range m.team_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamWebhooks:
+		ids := make([]ent.Value, 0, len(m.team_webhooks))
+		for id := range m.team_webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamTier:
+		if id := m.team_tier; id != nil {
 			return []ent.Value{*id}
 		}
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.envs))
+		for id := range m.envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvBuildMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedusers != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.removedteam_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.removedteam_webhooks != nil {
+		edges = append(edges, team.EdgeTeamWebhooks)
+	}
+	if m.removedenvs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
+		for id := range m.removedteam_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedteam_webhooks))
+		for id := range m.removedteam_webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.removedenvs))
+		for id := range m.removedenvs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvBuildMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, envbuild.EdgeEnv)
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedusers {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.clearedteam_api_keys {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.clearedteam_webhooks {
+		edges = append(edges, team.EdgeTeamWebhooks)
+	}
+	if m.clearedteam_tier {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.clearedenvs {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, team.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvBuildMutation) EdgeCleared(name string) bool {
+func (m *TeamMutation) EdgeCleared(name string) bool {
 	switch name {
-	case envbuild.EdgeEnv:
-		return m.clearedenv
+	case team.EdgeUsers:
+		return m.clearedusers
+	case team.EdgeTeamAPIKeys:
+		return m.clearedteam_api_keys
+	case team.EdgeTeamWebhooks:
+		return m.clearedteam_webhooks
+	case team.EdgeTeamTier:
+		return m.clearedteam_tier
+	case team.EdgeEnvs:
+		return m.clearedenvs
+	case team.EdgeUsersTeams:
+		return m.clearedusers_teams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvBuildMutation) ClearEdge(name string) error {
+func (m *TeamMutation) ClearEdge(name string) error {
 	switch name {
-	case envbuild.EdgeEnv:
-		m.ClearEnv()
+	case team.EdgeTeamTier:
+		m.ClearTeamTier()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
+	return fmt.Errorf("unknown Team unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvBuildMutation) ResetEdge(name string) error {
+func (m *TeamMutation) ResetEdge(name string) error {
 	switch name {
-	case envbuild.EdgeEnv:
-		m.ResetEnv()
+	case team.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case team.EdgeTeamAPIKeys:
+		m.ResetTeamAPIKeys()
+		return nil
+	case team.EdgeTeamWebhooks:
+		m.ResetTeamWebhooks()
+		return nil
+	case team.EdgeTeamTier:
+		m.ResetTeamTier()
+		return nil
+	case team.EdgeEnvs:
+		m.ResetEnvs()
+		return nil
+	case team.EdgeUsersTeams:
+		m.ResetUsersTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild edge %s", name)
+	return fmt.Errorf("unknown Team edge %s", name)
 }
 
-// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
-type SnapshotMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	base_env_id   *string
-	sandbox_id    *string
-	metadata      *map[string]string
-	clearedFields map[string]struct{}
-	env           *string
-	clearedenv    bool
-	done          bool
-	oldValue      func(context.Context) (*Snapshot, error)
-	predicates    []predicate.Snapshot
+// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
+type TeamAPIKeyMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	api_key        *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	name           *string
+	last_used      *time.Time
+	scopes         *[]string
+	appendscopes   []string
+	expires_at     *time.Time
+	template_id    *string
+	clearedFields  map[string]struct{}
+	team           *uuid.UUID
+	clearedteam    bool
+	creator        *uuid.UUID
+	clearedcreator bool
+	done           bool
+	oldValue       func(context.Context) (*TeamAPIKey, error)
+	predicates     []predicate.TeamAPIKey
 }
 
-var _ ent.Mutation = (*SnapshotMutation)(nil)
+var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
 
-// snapshotOption allows management of the mutation configuration using functional options.
-type snapshotOption func(*SnapshotMutation)
+// teamapikeyOption allows management of the mutation configuration using functional options.
+type teamapikeyOption func(*TeamAPIKeyMutation)
 
-// newSnapshotMutation creates new mutation for the Snapshot entity.
-func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
-	m := &SnapshotMutation{
+// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
+func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
+	m := &TeamAPIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSnapshot,
+		typ:           TypeTeamAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3510,20 +6271,20 @@ func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutat
 	return m
 }
 
-// withSnapshotID sets the ID field of the mutation.
-func withSnapshotID(id uuid.UUID) snapshotOption {
-	return func(m *SnapshotMutation) {
+// withTeamAPIKeyID sets the ID field of the mutation.
+func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Snapshot
+			value *TeamAPIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
+		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Snapshot.Get(ctx, id)
+					value, err = m.Client().TeamAPIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3532,10 +6293,10 @@ func withSnapshotID(id uuid.UUID) snapshotOption {
 	}
 }
 
-// withSnapshot sets the old Snapshot of the mutation.
-func withSnapshot(node *Snapshot) snapshotOption {
-	return func(m *SnapshotMutation) {
-		m.oldValue = func(context.Context) (*Snapshot, error) {
+// withTeamAPIKey sets the old TeamAPIKey of the mutation.
+func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
+		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3544,7 +6305,7 @@ func withSnapshot(node *Snapshot) snapshotOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SnapshotMutation) Client() *Client {
+func (m TeamAPIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3552,7 +6313,7 @@ func (m SnapshotMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SnapshotMutation) Tx() (*Tx, error) {
+func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -3562,255 +6323,569 @@ func (m SnapshotMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Snapshot entities.
-func (m *SnapshotMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TeamAPIKey entities.
+func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
-	return *m.id, true
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAPIKey sets the "api_key" field.
+func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
+	m.api_key = &s
+}
+
+// APIKey returns the value of the "api_key" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
+	v := m.api_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+	}
+	return oldValue.APIKey, nil
+}
+
+// ResetAPIKey resets all changes to the "api_key" field.
+func (m *TeamAPIKeyMutation) ResetAPIKey() {
+	m.api_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamAPIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamAPIKeyMutation) ResetTeamID() {
+	m.team = nil
+}
+
+// SetName sets the "name" field.
+func (m *TeamAPIKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetName resets all changes to the "name" field.
+func (m *TeamAPIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCreatedBy sets the "created_by" field.
+func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SnapshotMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *TeamAPIKeyMutation) ClearCreatedBy() {
+	m.creator = nil
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
 }
 
-// SetBaseEnvID sets the "base_env_id" field.
-func (m *SnapshotMutation) SetBaseEnvID(s string) {
-	m.base_env_id = &s
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
+	return ok
 }
 
-// BaseEnvID returns the value of the "base_env_id" field in the mutation.
-func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
-	v := m.base_env_id
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TeamAPIKeyMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, teamapikey.FieldCreatedBy)
+}
+
+// SetLastUsed sets the "last_used" field.
+func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
+}
+
+// LastUsed returns the value of the "last_used" field in the mutation.
+func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
+func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+		return v, errors.New("OldLastUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
 	}
-	return oldValue.BaseEnvID, nil
+	return oldValue.LastUsed, nil
 }
 
-// ResetBaseEnvID resets all changes to the "base_env_id" field.
-func (m *SnapshotMutation) ResetBaseEnvID() {
-	m.base_env_id = nil
+// ClearLastUsed clears the value of the "last_used" field.
+func (m *TeamAPIKeyMutation) ClearLastUsed() {
+	m.last_used = nil
+	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *SnapshotMutation) SetEnvID(s string) {
-	m.env = &s
+// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
+	return ok
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *SnapshotMutation) EnvID() (r string, exists bool) {
-	v := m.env
+// ResetLastUsed resets all changes to the "last_used" field.
+func (m *TeamAPIKeyMutation) ResetLastUsed() {
+	m.last_used = nil
+	delete(m.clearedFields, teamapikey.FieldLastUsed)
+}
+
+// SetScopes sets the "scopes" field.
+func (m *TeamAPIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *TeamAPIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
+func (m *TeamAPIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.EnvID, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *SnapshotMutation) ResetEnvID() {
-	m.env = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *TeamAPIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// SetSandboxID sets the "sandbox_id" field.
-func (m *SnapshotMutation) SetSandboxID(s string) {
-	m.sandbox_id = &s
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *TeamAPIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// SandboxID returns the value of the "sandbox_id" field in the mutation.
-func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
-	v := m.sandbox_id
+// ClearScopes clears the value of the "scopes" field.
+func (m *TeamAPIKeyMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[teamapikey.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *TeamAPIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, teamapikey.FieldScopes)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *TeamAPIKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TeamAPIKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+func (m *TeamAPIKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.SandboxID, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetSandboxID resets all changes to the "sandbox_id" field.
-func (m *SnapshotMutation) ResetSandboxID() {
-	m.sandbox_id = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *TeamAPIKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[teamapikey.FieldExpiresAt] = struct{}{}
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SnapshotMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldExpiresAt]
+	return ok
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TeamAPIKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, teamapikey.FieldExpiresAt)
+}
+
+// SetTemplateID sets the "template_id" field.
+func (m *TeamAPIKeyMutation) SetTemplateID(s string) {
+	m.template_id = &s
+}
+
+// TemplateID returns the value of the "template_id" field in the mutation.
+func (m *TeamAPIKeyMutation) TemplateID() (r string, exists bool) {
+	v := m.template_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldTemplateID returns the old "template_id" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *TeamAPIKeyMutation) OldTemplateID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldTemplateID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldTemplateID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldTemplateID: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.TemplateID, nil
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SnapshotMutation) ResetMetadata() {
-	m.metadata = nil
+// ClearTemplateID clears the value of the "template_id" field.
+func (m *TeamAPIKeyMutation) ClearTemplateID() {
+	m.template_id = nil
+	m.clearedFields[teamapikey.FieldTemplateID] = struct{}{}
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *SnapshotMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
+// TemplateIDCleared returns if the "template_id" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) TemplateIDCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldTemplateID]
+	return ok
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *SnapshotMutation) EnvCleared() bool {
-	return m.clearedenv
+// ResetTemplateID resets all changes to the "template_id" field.
+func (m *TeamAPIKeyMutation) ResetTemplateID() {
+	m.template_id = nil
+	delete(m.clearedFields, teamapikey.FieldTemplateID)
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *TeamAPIKeyMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *TeamAPIKeyMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *SnapshotMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *TeamAPIKeyMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *TeamAPIKeyMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *TeamAPIKeyMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *SnapshotMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// ResetCreator resets all changes to the "creator" edge.
+func (m *TeamAPIKeyMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
 }
 
-// Where appends a list predicates to the SnapshotMutation builder.
-func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
+// Where appends a list predicates to the TeamAPIKeyMutation builder.
+func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Snapshot, len(ps))
+func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamAPIKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3818,39 +6893,54 @@ func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SnapshotMutation) Op() Op {
+func (m *TeamAPIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SnapshotMutation) SetOp(op Op) {
+func (m *TeamAPIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Snapshot).
-func (m *SnapshotMutation) Type() string {
+// Type returns the node type of this mutation (TeamAPIKey).
+func (m *TeamAPIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SnapshotMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *TeamAPIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.api_key != nil {
+		fields = append(fields, teamapikey.FieldAPIKey)
+	}
 	if m.created_at != nil {
-		fields = append(fields, snapshot.FieldCreatedAt)
+		fields = append(fields, teamapikey.FieldCreatedAt)
 	}
-	if m.base_env_id != nil {
-		fields = append(fields, snapshot.FieldBaseEnvID)
+	if m.updated_at != nil {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
 	}
-	if m.env != nil {
-		fields = append(fields, snapshot.FieldEnvID)
+	if m.team != nil {
+		fields = append(fields, teamapikey.FieldTeamID)
 	}
-	if m.sandbox_id != nil {
-		fields = append(fields, snapshot.FieldSandboxID)
+	if m.name != nil {
+		fields = append(fields, teamapikey.FieldName)
 	}
-	if m.metadata != nil {
-		fields = append(fields, snapshot.FieldMetadata)
+	if m.creator != nil {
+		fields = append(fields, teamapikey.FieldCreatedBy)
+	}
+	if m.last_used != nil {
+		fields = append(fields, teamapikey.FieldLastUsed)
+	}
+	if m.scopes != nil {
+		fields = append(fields, teamapikey.FieldScopes)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, teamapikey.FieldExpiresAt)
+	}
+	if m.template_id != nil {
+		fields = append(fields, teamapikey.FieldTemplateID)
 	}
 	return fields
 }
@@ -3858,18 +6948,28 @@ func (m *SnapshotMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamapikey.FieldAPIKey:
+		return m.APIKey()
+	case teamapikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case snapshot.FieldBaseEnvID:
-		return m.BaseEnvID()
-	case snapshot.FieldEnvID:
-		return m.EnvID()
-	case snapshot.FieldSandboxID:
-		return m.SandboxID()
-	case snapshot.FieldMetadata:
-		return m.Metadata()
+	case teamapikey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case teamapikey.FieldTeamID:
+		return m.TeamID()
+	case teamapikey.FieldName:
+		return m.Name()
+	case teamapikey.FieldCreatedBy:
+		return m.CreatedBy()
+	case teamapikey.FieldLastUsed:
+		return m.LastUsed()
+	case teamapikey.FieldScopes:
+		return m.Scopes()
+	case teamapikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case teamapikey.FieldTemplateID:
+		return m.TemplateID()
 	}
 	return nil, false
 }
@@ -3877,145 +6977,251 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamapikey.FieldAPIKey:
+		return m.OldAPIKey(ctx)
+	case teamapikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case snapshot.FieldBaseEnvID:
-		return m.OldBaseEnvID(ctx)
-	case snapshot.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case snapshot.FieldSandboxID:
-		return m.OldSandboxID(ctx)
-	case snapshot.FieldMetadata:
-		return m.OldMetadata(ctx)
+	case teamapikey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case teamapikey.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamapikey.FieldName:
+		return m.OldName(ctx)
+	case teamapikey.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case teamapikey.FieldLastUsed:
+		return m.OldLastUsed(ctx)
+	case teamapikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case teamapikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case teamapikey.FieldTemplateID:
+		return m.OldTemplateID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Snapshot field %s", name)
+	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
+func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamapikey.FieldAPIKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKey(v)
+		return nil
+	case teamapikey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case snapshot.FieldBaseEnvID:
-		v, ok := value.(string)
+	case teamapikey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseEnvID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case snapshot.FieldEnvID:
-		v, ok := value.(string)
+	case teamapikey.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvID(v)
+		m.SetTeamID(v)
 		return nil
-	case snapshot.FieldSandboxID:
+	case teamapikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSandboxID(v)
+		m.SetName(v)
 		return nil
-	case snapshot.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case teamapikey.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetCreatedBy(v)
+		return nil
+	case teamapikey.FieldLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsed(v)
+		return nil
+	case teamapikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case teamapikey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case teamapikey.FieldTemplateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SnapshotMutation) AddedFields() []string {
+func (m *TeamAPIKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
+func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Snapshot numeric field %s", name)
+	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SnapshotMutation) ClearedFields() []string {
-	return nil
+func (m *TeamAPIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
+	}
+	if m.FieldCleared(teamapikey.FieldCreatedBy) {
+		fields = append(fields, teamapikey.FieldCreatedBy)
+	}
+	if m.FieldCleared(teamapikey.FieldLastUsed) {
+		fields = append(fields, teamapikey.FieldLastUsed)
+	}
+	if m.FieldCleared(teamapikey.FieldScopes) {
+		fields = append(fields, teamapikey.FieldScopes)
+	}
+	if m.FieldCleared(teamapikey.FieldExpiresAt) {
+		fields = append(fields, teamapikey.FieldExpiresAt)
+	}
+	if m.FieldCleared(teamapikey.FieldTemplateID) {
+		fields = append(fields, teamapikey.FieldTemplateID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SnapshotMutation) FieldCleared(name string) bool {
+func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SnapshotMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Snapshot nullable field %s", name)
+func (m *TeamAPIKeyMutation) ClearField(name string) error {
+	switch name {
+	case teamapikey.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case teamapikey.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case teamapikey.FieldLastUsed:
+		m.ClearLastUsed()
+		return nil
+	case teamapikey.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case teamapikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case teamapikey.FieldTemplateID:
+		m.ClearTemplateID()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SnapshotMutation) ResetField(name string) error {
+func (m *TeamAPIKeyMutation) ResetField(name string) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
+	case teamapikey.FieldAPIKey:
+		m.ResetAPIKey()
+		return nil
+	case teamapikey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case snapshot.FieldBaseEnvID:
-		m.ResetBaseEnvID()
+	case teamapikey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case snapshot.FieldEnvID:
-		m.ResetEnvID()
+	case teamapikey.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case snapshot.FieldSandboxID:
-		m.ResetSandboxID()
+	case teamapikey.FieldName:
+		m.ResetName()
 		return nil
-	case snapshot.FieldMetadata:
-		m.ResetMetadata()
+	case teamapikey.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case teamapikey.FieldLastUsed:
+		m.ResetLastUsed()
+		return nil
+	case teamapikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case teamapikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case teamapikey.FieldTemplateID:
+		m.ResetTemplateID()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SnapshotMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, snapshot.EdgeEnv)
+func (m *TeamAPIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, teamapikey.EdgeTeam)
+	}
+	if m.creator != nil {
+		edges = append(edges, teamapikey.EdgeCreator)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case snapshot.EdgeEnv:
-		if id := m.env; id != nil {
+	case teamapikey.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case teamapikey.EdgeCreator:
+		if id := m.creator; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4023,101 +7229,102 @@ func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SnapshotMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TeamAPIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SnapshotMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, snapshot.EdgeEnv)
+func (m *TeamAPIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, teamapikey.EdgeTeam)
+	}
+	if m.clearedcreator {
+		edges = append(edges, teamapikey.EdgeCreator)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SnapshotMutation) EdgeCleared(name string) bool {
+func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case snapshot.EdgeEnv:
-		return m.clearedenv
+	case teamapikey.EdgeTeam:
+		return m.clearedteam
+	case teamapikey.EdgeCreator:
+		return m.clearedcreator
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SnapshotMutation) ClearEdge(name string) error {
+func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case snapshot.EdgeEnv:
-		m.ClearEnv()
+	case teamapikey.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case teamapikey.EdgeCreator:
+		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot unique edge %s", name)
+	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SnapshotMutation) ResetEdge(name string) error {
+func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case snapshot.EdgeEnv:
-		m.ResetEnv()
+	case teamapikey.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case teamapikey.EdgeCreator:
+		m.ResetCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot edge %s", name)
+	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
 }
 
-// TeamMutation represents an operation that mutates the Team nodes in the graph.
-type TeamMutation struct {
+// TeamWebhookMutation represents an operation that mutates the TeamWebhook nodes in the graph.
+type TeamWebhookMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	created_at           *time.Time
-	is_banned            *bool
-	is_blocked           *bool
-	blocked_reason       *string
-	name                 *string
-	email                *string
-	clearedFields        map[string]struct{}
-	users                map[uuid.UUID]struct{}
-	removedusers         map[uuid.UUID]struct{}
-	clearedusers         bool
-	team_api_keys        map[uuid.UUID]struct{}
-	removedteam_api_keys map[uuid.UUID]struct{}
-	clearedteam_api_keys bool
-	team_tier            *string
-	clearedteam_tier     bool
-	envs                 map[string]struct{}
-	removedenvs          map[string]struct{}
-	clearedenvs          bool
-	users_teams          map[int]struct{}
-	removedusers_teams   map[int]struct{}
-	clearedusers_teams   bool
-	done                 bool
-	oldValue             func(context.Context) (*Team, error)
-	predicates           []predicate.Team
-}
-
-var _ ent.Mutation = (*TeamMutation)(nil)
-
-// teamOption allows management of the mutation configuration using functional options.
-type teamOption func(*TeamMutation)
-
-// newTeamMutation creates new mutation for the Team entity.
-func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
-	m := &TeamMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	url               *string
+	secret            *string
+	events            *[]string
+	appendevents      []string
+	clearedFields     map[string]struct{}
+	team              *uuid.UUID
+	clearedteam       bool
+	deliveries        map[uuid.UUID]struct{}
+	removeddeliveries map[uuid.UUID]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*TeamWebhook, error)
+	predicates        []predicate.TeamWebhook
+}
+
+var _ ent.Mutation = (*TeamWebhookMutation)(nil)
+
+// teamwebhookOption allows management of the mutation configuration using functional options.
+type teamwebhookOption func(*TeamWebhookMutation)
+
+// newTeamWebhookMutation creates new mutation for the TeamWebhook entity.
+func newTeamWebhookMutation(c config, op Op, opts ...teamwebhookOption) *TeamWebhookMutation {
+	m := &TeamWebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeam,
+		typ:           TypeTeamWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4126,20 +7333,20 @@ func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
 	return m
 }
 
-// withTeamID sets the ID field of the mutation.
-func withTeamID(id uuid.UUID) teamOption {
-	return func(m *TeamMutation) {
+// withTeamWebhookID sets the ID field of the mutation.
+func withTeamWebhookID(id uuid.UUID) teamwebhookOption {
+	return func(m *TeamWebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Team
+			value *TeamWebhook
 		)
-		m.oldValue = func(ctx context.Context) (*Team, error) {
+		m.oldValue = func(ctx context.Context) (*TeamWebhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Team.Get(ctx, id)
+					value, err = m.Client().TeamWebhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4148,10 +7355,10 @@ func withTeamID(id uuid.UUID) teamOption {
 	}
 }
 
-// withTeam sets the old Team of the mutation.
-func withTeam(node *Team) teamOption {
-	return func(m *TeamMutation) {
-		m.oldValue = func(context.Context) (*Team, error) {
+// withTeamWebhook sets the old TeamWebhook of the mutation.
+func withTeamWebhook(node *TeamWebhook) teamwebhookOption {
+	return func(m *TeamWebhookMutation) {
+		m.oldValue = func(context.Context) (*TeamWebhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4160,7 +7367,7 @@ func withTeam(node *Team) teamOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamMutation) Client() *Client {
+func (m TeamWebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4168,605 +7375,348 @@ func (m TeamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamMutation) Tx() (*Tx, error) {
+func (m TeamWebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("models: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Team entities.
-func (m *TeamMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *TeamMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetIsBanned sets the "is_banned" field.
-func (m *TeamMutation) SetIsBanned(b bool) {
-	m.is_banned = &b
-}
-
-// IsBanned returns the value of the "is_banned" field in the mutation.
-func (m *TeamMutation) IsBanned() (r bool, exists bool) {
-	v := m.is_banned
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsBanned returns the old "is_banned" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBanned requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
-	}
-	return oldValue.IsBanned, nil
-}
-
-// ClearIsBanned clears the value of the "is_banned" field.
-func (m *TeamMutation) ClearIsBanned() {
-	m.is_banned = nil
-	m.clearedFields[team.FieldIsBanned] = struct{}{}
-}
-
-// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
-func (m *TeamMutation) IsBannedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBanned]
-	return ok
-}
-
-// ResetIsBanned resets all changes to the "is_banned" field.
-func (m *TeamMutation) ResetIsBanned() {
-	m.is_banned = nil
-	delete(m.clearedFields, team.FieldIsBanned)
-}
-
-// SetIsBlocked sets the "is_blocked" field.
-func (m *TeamMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
-}
-
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return nil, errors.New("models: mutation is not running in a transaction")
 	}
-	return oldValue.IsBlocked, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearIsBlocked clears the value of the "is_blocked" field.
-func (m *TeamMutation) ClearIsBlocked() {
-	m.is_blocked = nil
-	m.clearedFields[team.FieldIsBlocked] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TeamWebhook entities.
+func (m *TeamWebhookMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
-func (m *TeamMutation) IsBlockedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBlocked]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamWebhookMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *TeamMutation) ResetIsBlocked() {
-	m.is_blocked = nil
-	delete(m.clearedFields, team.FieldIsBlocked)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamWebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamWebhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetBlockedReason sets the "blocked_reason" field.
-func (m *TeamMutation) SetBlockedReason(s string) {
-	m.blocked_reason = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamWebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// BlockedReason returns the value of the "blocked_reason" field in the mutation.
-func (m *TeamMutation) BlockedReason() (r string, exists bool) {
-	v := m.blocked_reason
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamWebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TeamWebhook entity.
+// If the TeamWebhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
+func (m *TeamWebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.BlockedReason, nil
-}
-
-// ClearBlockedReason clears the value of the "blocked_reason" field.
-func (m *TeamMutation) ClearBlockedReason() {
-	m.blocked_reason = nil
-	m.clearedFields[team.FieldBlockedReason] = struct{}{}
-}
-
-// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
-func (m *TeamMutation) BlockedReasonCleared() bool {
-	_, ok := m.clearedFields[team.FieldBlockedReason]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetBlockedReason resets all changes to the "blocked_reason" field.
-func (m *TeamMutation) ResetBlockedReason() {
-	m.blocked_reason = nil
-	delete(m.clearedFields, team.FieldBlockedReason)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamWebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *TeamMutation) SetName(s string) {
-	m.name = &s
+// SetTeamID sets the "team_id" field.
+func (m *TeamWebhookMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamMutation) Name() (r string, exists bool) {
-	v := m.name
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamWebhookMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the TeamWebhook entity.
+// If the TeamWebhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TeamWebhookMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamMutation) ResetName() {
-	m.name = nil
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamWebhookMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// SetTier sets the "tier" field.
-func (m *TeamMutation) SetTier(s string) {
-	m.team_tier = &s
+// SetURL sets the "url" field.
+func (m *TeamWebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Tier returns the value of the "tier" field in the mutation.
-func (m *TeamMutation) Tier() (r string, exists bool) {
-	v := m.team_tier
+// URL returns the value of the "url" field in the mutation.
+func (m *TeamWebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTier returns the old "tier" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the TeamWebhook entity.
+// If the TeamWebhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
+func (m *TeamWebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTier requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Tier, nil
+	return oldValue.URL, nil
 }
 
-// ResetTier resets all changes to the "tier" field.
-func (m *TeamMutation) ResetTier() {
-	m.team_tier = nil
+// ResetURL resets all changes to the "url" field.
+func (m *TeamWebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *TeamMutation) SetEmail(s string) {
-	m.email = &s
+// SetSecret sets the "secret" field.
+func (m *TeamWebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *TeamMutation) Email() (r string, exists bool) {
-	v := m.email
+// Secret returns the value of the "secret" field in the mutation.
+func (m *TeamWebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the TeamWebhook entity.
+// If the TeamWebhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *TeamWebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
-	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *TeamMutation) ResetEmail() {
-	m.email = nil
-}
-
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUsers clears the "users" edge to the User entity.
-func (m *TeamMutation) ClearUsers() {
-	m.clearedusers = true
-}
-
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *TeamMutation) UsersCleared() bool {
-	return m.clearedusers
-}
-
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *TeamMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
-}
-
-// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.team_api_keys == nil {
-		m.team_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.team_api_keys[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) ClearTeamAPIKeys() {
-	m.clearedteam_api_keys = true
-}
-
-// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *TeamMutation) TeamAPIKeysCleared() bool {
-	return m.clearedteam_api_keys
-}
-
-// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedteam_api_keys == nil {
-		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.team_api_keys, ids[i])
-		m.removedteam_api_keys[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
+	return oldValue.Secret, nil
 }
 
-// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedteam_api_keys {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
-func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.team_api_keys {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
-func (m *TeamMutation) ResetTeamAPIKeys() {
-	m.team_api_keys = nil
-	m.clearedteam_api_keys = false
-	m.removedteam_api_keys = nil
-}
-
-// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
-func (m *TeamMutation) SetTeamTierID(id string) {
-	m.team_tier = &id
-}
-
-// ClearTeamTier clears the "team_tier" edge to the Tier entity.
-func (m *TeamMutation) ClearTeamTier() {
-	m.clearedteam_tier = true
-	m.clearedFields[team.FieldTier] = struct{}{}
+// ResetSecret resets all changes to the "secret" field.
+func (m *TeamWebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
-func (m *TeamMutation) TeamTierCleared() bool {
-	return m.clearedteam_tier
+// SetEvents sets the "events" field.
+func (m *TeamWebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
 }
 
-// TeamTierID returns the "team_tier" edge ID in the mutation.
-func (m *TeamMutation) TeamTierID() (id string, exists bool) {
-	if m.team_tier != nil {
-		return *m.team_tier, true
+// Events returns the value of the "events" field in the mutation.
+func (m *TeamWebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamTierID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) TeamTierIDs() (ids []string) {
-	if id := m.team_tier; id != nil {
-		ids = append(ids, *id)
+// OldEvents returns the old "events" field's value of the TeamWebhook entity.
+// If the TeamWebhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamWebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
 }
 
-// ResetTeamTier resets all changes to the "team_tier" edge.
-func (m *TeamMutation) ResetTeamTier() {
-	m.team_tier = nil
-	m.clearedteam_tier = false
+// AppendEvents adds s to the "events" field.
+func (m *TeamWebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
 }
 
-// AddEnvIDs adds the "envs" edge to the Env entity by ids.
-func (m *TeamMutation) AddEnvIDs(ids ...string) {
-	if m.envs == nil {
-		m.envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.envs[ids[i]] = struct{}{}
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *TeamWebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
 	}
+	return m.appendevents, true
 }
 
-// ClearEnvs clears the "envs" edge to the Env entity.
-func (m *TeamMutation) ClearEnvs() {
-	m.clearedenvs = true
+// ClearEvents clears the value of the "events" field.
+func (m *TeamWebhookMutation) ClearEvents() {
+	m.events = nil
+	m.appendevents = nil
+	m.clearedFields[teamwebhook.FieldEvents] = struct{}{}
 }
 
-// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
-func (m *TeamMutation) EnvsCleared() bool {
-	return m.clearedenvs
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *TeamWebhookMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[teamwebhook.FieldEvents]
+	return ok
 }
 
-// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
-func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
-	if m.removedenvs == nil {
-		m.removedenvs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.envs, ids[i])
-		m.removedenvs[ids[i]] = struct{}{}
-	}
+// ResetEvents resets all changes to the "events" field.
+func (m *TeamWebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+	delete(m.clearedFields, teamwebhook.FieldEvents)
 }
 
-// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
-func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
-	for id := range m.removedenvs {
-		ids = append(ids, id)
-	}
-	return
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *TeamWebhookMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[teamwebhook.FieldTeamID] = struct{}{}
 }
 
-// EnvsIDs returns the "envs" edge IDs in the mutation.
-func (m *TeamMutation) EnvsIDs() (ids []string) {
-	for id := range m.envs {
-		ids = append(ids, id)
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *TeamWebhookMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *TeamWebhookMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetEnvs resets all changes to the "envs" edge.
-func (m *TeamMutation) ResetEnvs() {
-	m.envs = nil
-	m.clearedenvs = false
-	m.removedenvs = nil
+// ResetTeam resets all changes to the "team" edge.
+func (m *TeamWebhookMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *TeamWebhookMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *TeamWebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *TeamMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *TeamWebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *TeamWebhookMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *TeamWebhookMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *TeamWebhookMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *TeamMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *TeamWebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the TeamMutation builder.
-func (m *TeamMutation) Where(ps ...predicate.Team) {
+// Where appends a list predicates to the TeamWebhookMutation builder.
+func (m *TeamWebhookMutation) Where(ps ...predicate.TeamWebhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamWebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Team, len(ps))
+func (m *TeamWebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamWebhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4774,45 +7724,39 @@ func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamMutation) Op() Op {
+func (m *TeamWebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamMutation) SetOp(op Op) {
+func (m *TeamWebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Team).
-func (m *TeamMutation) Type() string {
+// Type returns the node type of this mutation (TeamWebhook).
+func (m *TeamWebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *TeamWebhookMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, team.FieldCreatedAt)
-	}
-	if m.is_banned != nil {
-		fields = append(fields, team.FieldIsBanned)
-	}
-	if m.is_blocked != nil {
-		fields = append(fields, team.FieldIsBlocked)
+		fields = append(fields, teamwebhook.FieldCreatedAt)
 	}
-	if m.blocked_reason != nil {
-		fields = append(fields, team.FieldBlockedReason)
+	if m.team != nil {
+		fields = append(fields, teamwebhook.FieldTeamID)
 	}
-	if m.name != nil {
-		fields = append(fields, team.FieldName)
+	if m.url != nil {
+		fields = append(fields, teamwebhook.FieldURL)
 	}
-	if m.team_tier != nil {
-		fields = append(fields, team.FieldTier)
+	if m.secret != nil {
+		fields = append(fields, teamwebhook.FieldSecret)
 	}
-	if m.email != nil {
-		fields = append(fields, team.FieldEmail)
+	if m.events != nil {
+		fields = append(fields, teamwebhook.FieldEvents)
 	}
 	return fields
 }
@@ -4820,22 +7764,18 @@ func (m *TeamMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamWebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case team.FieldCreatedAt:
+	case teamwebhook.FieldCreatedAt:
 		return m.CreatedAt()
-	case team.FieldIsBanned:
-		return m.IsBanned()
-	case team.FieldIsBlocked:
-		return m.IsBlocked()
-	case team.FieldBlockedReason:
-		return m.BlockedReason()
-	case team.FieldName:
-		return m.Name()
-	case team.FieldTier:
-		return m.Tier()
-	case team.FieldEmail:
-		return m.Email()
+	case teamwebhook.FieldTeamID:
+		return m.TeamID()
+	case teamwebhook.FieldURL:
+		return m.URL()
+	case teamwebhook.FieldSecret:
+		return m.Secret()
+	case teamwebhook.FieldEvents:
+		return m.Events()
 	}
 	return nil, false
 }
@@ -4843,225 +7783,162 @@ func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamWebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case team.FieldCreatedAt:
+	case teamwebhook.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case team.FieldIsBanned:
-		return m.OldIsBanned(ctx)
-	case team.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case team.FieldBlockedReason:
-		return m.OldBlockedReason(ctx)
-	case team.FieldName:
-		return m.OldName(ctx)
-	case team.FieldTier:
-		return m.OldTier(ctx)
-	case team.FieldEmail:
-		return m.OldEmail(ctx)
+	case teamwebhook.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamwebhook.FieldURL:
+		return m.OldURL(ctx)
+	case teamwebhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case teamwebhook.FieldEvents:
+		return m.OldEvents(ctx)
 	}
-	return nil, fmt.Errorf("unknown Team field %s", name)
+	return nil, fmt.Errorf("unknown TeamWebhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) SetField(name string, value ent.Value) error {
+func (m *TeamWebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case team.FieldCreatedAt:
+	case teamwebhook.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case team.FieldIsBanned:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBanned(v)
-		return nil
-	case team.FieldIsBlocked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBlocked(v)
-		return nil
-	case team.FieldBlockedReason:
-		v, ok := value.(string)
+	case teamwebhook.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBlockedReason(v)
+		m.SetTeamID(v)
 		return nil
-	case team.FieldName:
+	case teamwebhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetURL(v)
 		return nil
-	case team.FieldTier:
+	case teamwebhook.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTier(v)
+		m.SetSecret(v)
 		return nil
-	case team.FieldEmail:
-		v, ok := value.(string)
+	case teamwebhook.FieldEvents:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetEvents(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown TeamWebhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamMutation) AddedFields() []string {
+func (m *TeamWebhookMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamWebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) AddField(name string, value ent.Value) error {
+func (m *TeamWebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Team numeric field %s", name)
+	return fmt.Errorf("unknown TeamWebhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamMutation) ClearedFields() []string {
+func (m *TeamWebhookMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(team.FieldIsBanned) {
-		fields = append(fields, team.FieldIsBanned)
-	}
-	if m.FieldCleared(team.FieldIsBlocked) {
-		fields = append(fields, team.FieldIsBlocked)
-	}
-	if m.FieldCleared(team.FieldBlockedReason) {
-		fields = append(fields, team.FieldBlockedReason)
+	if m.FieldCleared(teamwebhook.FieldEvents) {
+		fields = append(fields, teamwebhook.FieldEvents)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamMutation) FieldCleared(name string) bool {
+func (m *TeamWebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamMutation) ClearField(name string) error {
+func (m *TeamWebhookMutation) ClearField(name string) error {
 	switch name {
-	case team.FieldIsBanned:
-		m.ClearIsBanned()
-		return nil
-	case team.FieldIsBlocked:
-		m.ClearIsBlocked()
-		return nil
-	case team.FieldBlockedReason:
-		m.ClearBlockedReason()
+	case teamwebhook.FieldEvents:
+		m.ClearEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown Team nullable field %s", name)
+	return fmt.Errorf("unknown TeamWebhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamMutation) ResetField(name string) error {
+func (m *TeamWebhookMutation) ResetField(name string) error {
 	switch name {
-	case team.FieldCreatedAt:
+	case teamwebhook.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case team.FieldIsBanned:
-		m.ResetIsBanned()
-		return nil
-	case team.FieldIsBlocked:
-		m.ResetIsBlocked()
-		return nil
-	case team.FieldBlockedReason:
-		m.ResetBlockedReason()
+	case teamwebhook.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case team.FieldName:
-		m.ResetName()
+	case teamwebhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case team.FieldTier:
-		m.ResetTier()
+	case teamwebhook.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case team.FieldEmail:
-		m.ResetEmail()
+	case teamwebhook.FieldEvents:
+		m.ResetEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown TeamWebhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.users != nil {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.team_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.team_tier != nil {
-		edges = append(edges, team.EdgeTeamTier)
-	}
-	if m.envs != nil {
-		edges = append(edges, team.EdgeEnvs)
+func (m *TeamWebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, teamwebhook.EdgeTeam)
 	}
-	if m.users_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
+	if m.deliveries != nil {
+		edges = append(edges, teamwebhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamWebhookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.team_api_keys))
-		for id := range m.team_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamTier:
-		if id := m.team_tier; id != nil {
+	case teamwebhook.EdgeTeam:
+		if id := m.team; id != nil {
 			return []ent.Value{*id}
 		}
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.envs))
-		for id := range m.envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
+	case teamwebhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5070,48 +7947,21 @@ func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedusers != nil {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.removedteam_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.removedenvs != nil {
-		edges = append(edges, team.EdgeEnvs)
-	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
+func (m *TeamWebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, teamwebhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamWebhookMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
-		for id := range m.removedteam_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.removedenvs))
-		for id := range m.removedenvs {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
+	case teamwebhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5120,110 +7970,97 @@ func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedusers {
-		edges = append(edges, team.EdgeUsers)
-	}
-	if m.clearedteam_api_keys {
-		edges = append(edges, team.EdgeTeamAPIKeys)
-	}
-	if m.clearedteam_tier {
-		edges = append(edges, team.EdgeTeamTier)
-	}
-	if m.clearedenvs {
-		edges = append(edges, team.EdgeEnvs)
+func (m *TeamWebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, teamwebhook.EdgeTeam)
 	}
-	if m.clearedusers_teams {
-		edges = append(edges, team.EdgeUsersTeams)
+	if m.cleareddeliveries {
+		edges = append(edges, teamwebhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamMutation) EdgeCleared(name string) bool {
+func (m *TeamWebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case team.EdgeUsers:
-		return m.clearedusers
-	case team.EdgeTeamAPIKeys:
-		return m.clearedteam_api_keys
-	case team.EdgeTeamTier:
-		return m.clearedteam_tier
-	case team.EdgeEnvs:
-		return m.clearedenvs
-	case team.EdgeUsersTeams:
-		return m.clearedusers_teams
+	case teamwebhook.EdgeTeam:
+		return m.clearedteam
+	case teamwebhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamMutation) ClearEdge(name string) error {
+func (m *TeamWebhookMutation) ClearEdge(name string) error {
 	switch name {
-	case team.EdgeTeamTier:
-		m.ClearTeamTier()
+	case teamwebhook.EdgeTeam:
+		m.ClearTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown Team unique edge %s", name)
+	return fmt.Errorf("unknown TeamWebhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamMutation) ResetEdge(name string) error {
+func (m *TeamWebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case team.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case team.EdgeTeamAPIKeys:
-		m.ResetTeamAPIKeys()
-		return nil
-	case team.EdgeTeamTier:
-		m.ResetTeamTier()
-		return nil
-	case team.EdgeEnvs:
-		m.ResetEnvs()
+	case teamwebhook.EdgeTeam:
+		m.ResetTeam()
 		return nil
-	case team.EdgeUsersTeams:
-		m.ResetUsersTeams()
+	case teamwebhook.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Team edge %s", name)
+	return fmt.Errorf("unknown TeamWebhook edge %s", name)
 }
 
-// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
-type TeamAPIKeyMutation struct {
+// TierMutation represents an operation that mutates the Tier nodes in the graph.
+type TierMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	api_key        *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	name           *string
-	last_used      *time.Time
-	clearedFields  map[string]struct{}
-	team           *uuid.UUID
-	clearedteam    bool
-	creator        *uuid.UUID
-	clearedcreator bool
-	done           bool
-	oldValue       func(context.Context) (*TeamAPIKey, error)
-	predicates     []predicate.TeamAPIKey
+	op                               Op
+	typ                              string
+	id                               *string
+	name                             *string
+	disk_mb                          *int64
+	adddisk_mb                       *int64
+	concurrent_instances             *int64
+	addconcurrent_instances          *int64
+	max_length_hours                 *int64
+	addmax_length_hours              *int64
+	max_vcpu                         *int64
+	addmax_vcpu                      *int64
+	max_ram_mb                       *int64
+	addmax_ram_mb                    *int64
+	max_sandbox_starts_per_minute    *int64
+	addmax_sandbox_starts_per_minute *int64
+	max_concurrent_builds            *int64
+	addmax_concurrent_builds         *int64
+	max_snapshot_storage_mb          *int64
+	addmax_snapshot_storage_mb       *int64
+	clearedFields                    map[string]struct{}
+	teams                            map[uuid.UUID]struct{}
+	removedteams                     map[uuid.UUID]struct{}
+	clearedteams                     bool
+	done                             bool
+	oldValue                         func(context.Context) (*Tier, error)
+	predicates                       []predicate.Tier
 }
 
-var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
+var _ ent.Mutation = (*TierMutation)(nil)
 
-// teamapikeyOption allows management of the mutation configuration using functional options.
-type teamapikeyOption func(*TeamAPIKeyMutation)
+// tierOption allows management of the mutation configuration using functional options.
+type tierOption func(*TierMutation)
 
-// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
-func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
-	m := &TeamAPIKeyMutation{
+// newTierMutation creates new mutation for the Tier entity.
+func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
+	m := &TierMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeamAPIKey,
+		typ:           TypeTier,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5232,20 +8069,20 @@ func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKe
 	return m
 }
 
-// withTeamAPIKeyID sets the ID field of the mutation.
-func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
+// withTierID sets the ID field of the mutation.
+func withTierID(id string) tierOption {
+	return func(m *TierMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TeamAPIKey
+			value *Tier
 		)
-		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
+		m.oldValue = func(ctx context.Context) (*Tier, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TeamAPIKey.Get(ctx, id)
+					value, err = m.Client().Tier.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5254,436 +8091,686 @@ func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
 	}
 }
 
-// withTeamAPIKey sets the old TeamAPIKey of the mutation.
-func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
-		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
+// withTier sets the old Tier of the mutation.
+func withTier(node *Tier) tierOption {
+	return func(m *TierMutation) {
+		m.oldValue = func(context.Context) (*Tier, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamAPIKeyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TierMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TierMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tier entities.
+func (m *TierMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TierMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TierMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tier.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TierMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TierMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TierMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDiskMB sets the "disk_mb" field.
+func (m *TierMutation) SetDiskMB(i int64) {
+	m.disk_mb = &i
+	m.adddisk_mb = nil
+}
+
+// DiskMB returns the value of the "disk_mb" field in the mutation.
+func (m *TierMutation) DiskMB() (r int64, exists bool) {
+	v := m.disk_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("models: mutation is not running in a transaction")
+// OldDiskMB returns the old "disk_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldDiskMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskMB is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskMB: %w", err)
+	}
+	return oldValue.DiskMB, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TeamAPIKey entities.
-func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// AddDiskMB adds i to the "disk_mb" field.
+func (m *TierMutation) AddDiskMB(i int64) {
+	if m.adddisk_mb != nil {
+		*m.adddisk_mb += i
+	} else {
+		m.adddisk_mb = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// AddedDiskMB returns the value that was added to the "disk_mb" field in this mutation.
+func (m *TierMutation) AddedDiskMB() (r int64, exists bool) {
+	v := m.adddisk_mb
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetDiskMB resets all changes to the "disk_mb" field.
+func (m *TierMutation) ResetDiskMB() {
+	m.disk_mb = nil
+	m.adddisk_mb = nil
 }
 
-// SetAPIKey sets the "api_key" field.
-func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
-	m.api_key = &s
+// SetConcurrentInstances sets the "concurrent_instances" field.
+func (m *TierMutation) SetConcurrentInstances(i int64) {
+	m.concurrent_instances = &i
+	m.addconcurrent_instances = nil
 }
 
-// APIKey returns the value of the "api_key" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
-	v := m.api_key
+// ConcurrentInstances returns the value of the "concurrent_instances" field in the mutation.
+func (m *TierMutation) ConcurrentInstances() (r int64, exists bool) {
+	v := m.concurrent_instances
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldConcurrentInstances returns the old "concurrent_instances" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
+func (m *TierMutation) OldConcurrentInstances(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldConcurrentInstances is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+		return v, errors.New("OldConcurrentInstances requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldConcurrentInstances: %w", err)
 	}
-	return oldValue.APIKey, nil
+	return oldValue.ConcurrentInstances, nil
 }
 
-// ResetAPIKey resets all changes to the "api_key" field.
-func (m *TeamAPIKeyMutation) ResetAPIKey() {
-	m.api_key = nil
+// AddConcurrentInstances adds i to the "concurrent_instances" field.
+func (m *TierMutation) AddConcurrentInstances(i int64) {
+	if m.addconcurrent_instances != nil {
+		*m.addconcurrent_instances += i
+	} else {
+		m.addconcurrent_instances = &i
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedConcurrentInstances returns the value that was added to the "concurrent_instances" field in this mutation.
+func (m *TierMutation) AddedConcurrentInstances() (r int64, exists bool) {
+	v := m.addconcurrent_instances
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetConcurrentInstances resets all changes to the "concurrent_instances" field.
+func (m *TierMutation) ResetConcurrentInstances() {
+	m.concurrent_instances = nil
+	m.addconcurrent_instances = nil
+}
+
+// SetMaxLengthHours sets the "max_length_hours" field.
+func (m *TierMutation) SetMaxLengthHours(i int64) {
+	m.max_length_hours = &i
+	m.addmax_length_hours = nil
+}
+
+// MaxLengthHours returns the value of the "max_length_hours" field in the mutation.
+func (m *TierMutation) MaxLengthHours() (r int64, exists bool) {
+	v := m.max_length_hours
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxLengthHours returns the old "max_length_hours" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TierMutation) OldMaxLengthHours(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxLengthHours is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMaxLengthHours requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxLengthHours: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.MaxLengthHours, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamAPIKeyMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddMaxLengthHours adds i to the "max_length_hours" field.
+func (m *TierMutation) AddMaxLengthHours(i int64) {
+	if m.addmax_length_hours != nil {
+		*m.addmax_length_hours += i
+	} else {
+		m.addmax_length_hours = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedMaxLengthHours returns the value that was added to the "max_length_hours" field in this mutation.
+func (m *TierMutation) AddedMaxLengthHours() (r int64, exists bool) {
+	v := m.addmax_length_hours
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetMaxLengthHours resets all changes to the "max_length_hours" field.
+func (m *TierMutation) ResetMaxLengthHours() {
+	m.max_length_hours = nil
+	m.addmax_length_hours = nil
+}
+
+// SetMaxVcpu sets the "max_vcpu" field.
+func (m *TierMutation) SetMaxVcpu(i int64) {
+	m.max_vcpu = &i
+	m.addmax_vcpu = nil
+}
+
+// MaxVcpu returns the value of the "max_vcpu" field in the mutation.
+func (m *TierMutation) MaxVcpu() (r int64, exists bool) {
+	v := m.max_vcpu
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxVcpu returns the old "max_vcpu" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TierMutation) OldMaxVcpu(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxVcpu is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMaxVcpu requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxVcpu: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.MaxVcpu, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (m *TierMutation) AddMaxVcpu(i int64) {
+	if m.addmax_vcpu != nil {
+		*m.addmax_vcpu += i
+	} else {
+		m.addmax_vcpu = &i
+	}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
+// AddedMaxVcpu returns the value that was added to the "max_vcpu" field in this mutation.
+func (m *TierMutation) AddedMaxVcpu() (r int64, exists bool) {
+	v := m.addmax_vcpu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxVcpu clears the value of the "max_vcpu" field.
+func (m *TierMutation) ClearMaxVcpu() {
+	m.max_vcpu = nil
+	m.addmax_vcpu = nil
+	m.clearedFields[tier.FieldMaxVcpu] = struct{}{}
+}
+
+// MaxVcpuCleared returns if the "max_vcpu" field was cleared in this mutation.
+func (m *TierMutation) MaxVcpuCleared() bool {
+	_, ok := m.clearedFields[tier.FieldMaxVcpu]
 	return ok
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
+// ResetMaxVcpu resets all changes to the "max_vcpu" field.
+func (m *TierMutation) ResetMaxVcpu() {
+	m.max_vcpu = nil
+	m.addmax_vcpu = nil
+	delete(m.clearedFields, tier.FieldMaxVcpu)
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (m *TierMutation) SetMaxRAMMB(i int64) {
+	m.max_ram_mb = &i
+	m.addmax_ram_mb = nil
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
+// MaxRAMMB returns the value of the "max_ram_mb" field in the mutation.
+func (m *TierMutation) MaxRAMMB() (r int64, exists bool) {
+	v := m.max_ram_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxRAMMB returns the old "max_ram_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TierMutation) OldMaxRAMMB(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxRAMMB is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldMaxRAMMB requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxRAMMB: %w", err)
 	}
-	return oldValue.TeamID, nil
+	return oldValue.MaxRAMMB, nil
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *TeamAPIKeyMutation) ResetTeamID() {
-	m.team = nil
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (m *TierMutation) AddMaxRAMMB(i int64) {
+	if m.addmax_ram_mb != nil {
+		*m.addmax_ram_mb += i
+	} else {
+		m.addmax_ram_mb = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *TeamAPIKeyMutation) SetName(s string) {
-	m.name = &s
+// AddedMaxRAMMB returns the value that was added to the "max_ram_mb" field in this mutation.
+func (m *TierMutation) AddedMaxRAMMB() (r int64, exists bool) {
+	v := m.addmax_ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
-	v := m.name
+// ClearMaxRAMMB clears the value of the "max_ram_mb" field.
+func (m *TierMutation) ClearMaxRAMMB() {
+	m.max_ram_mb = nil
+	m.addmax_ram_mb = nil
+	m.clearedFields[tier.FieldMaxRAMMB] = struct{}{}
+}
+
+// MaxRAMMBCleared returns if the "max_ram_mb" field was cleared in this mutation.
+func (m *TierMutation) MaxRAMMBCleared() bool {
+	_, ok := m.clearedFields[tier.FieldMaxRAMMB]
+	return ok
+}
+
+// ResetMaxRAMMB resets all changes to the "max_ram_mb" field.
+func (m *TierMutation) ResetMaxRAMMB() {
+	m.max_ram_mb = nil
+	m.addmax_ram_mb = nil
+	delete(m.clearedFields, tier.FieldMaxRAMMB)
+}
+
+// SetMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field.
+func (m *TierMutation) SetMaxSandboxStartsPerMinute(i int64) {
+	m.max_sandbox_starts_per_minute = &i
+	m.addmax_sandbox_starts_per_minute = nil
+}
+
+// MaxSandboxStartsPerMinute returns the value of the "max_sandbox_starts_per_minute" field in the mutation.
+func (m *TierMutation) MaxSandboxStartsPerMinute() (r int64, exists bool) {
+	v := m.max_sandbox_starts_per_minute
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxSandboxStartsPerMinute returns the old "max_sandbox_starts_per_minute" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TierMutation) OldMaxSandboxStartsPerMinute(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxSandboxStartsPerMinute is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldMaxSandboxStartsPerMinute requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxSandboxStartsPerMinute: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.MaxSandboxStartsPerMinute, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamAPIKeyMutation) ResetName() {
-	m.name = nil
+// AddMaxSandboxStartsPerMinute adds i to the "max_sandbox_starts_per_minute" field.
+func (m *TierMutation) AddMaxSandboxStartsPerMinute(i int64) {
+	if m.addmax_sandbox_starts_per_minute != nil {
+		*m.addmax_sandbox_starts_per_minute += i
+	} else {
+		m.addmax_sandbox_starts_per_minute = &i
+	}
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// AddedMaxSandboxStartsPerMinute returns the value that was added to the "max_sandbox_starts_per_minute" field in this mutation.
+func (m *TierMutation) AddedMaxSandboxStartsPerMinute() (r int64, exists bool) {
+	v := m.addmax_sandbox_starts_per_minute
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
+// ClearMaxSandboxStartsPerMinute clears the value of the "max_sandbox_starts_per_minute" field.
+func (m *TierMutation) ClearMaxSandboxStartsPerMinute() {
+	m.max_sandbox_starts_per_minute = nil
+	m.addmax_sandbox_starts_per_minute = nil
+	m.clearedFields[tier.FieldMaxSandboxStartsPerMinute] = struct{}{}
+}
+
+// MaxSandboxStartsPerMinuteCleared returns if the "max_sandbox_starts_per_minute" field was cleared in this mutation.
+func (m *TierMutation) MaxSandboxStartsPerMinuteCleared() bool {
+	_, ok := m.clearedFields[tier.FieldMaxSandboxStartsPerMinute]
+	return ok
+}
+
+// ResetMaxSandboxStartsPerMinute resets all changes to the "max_sandbox_starts_per_minute" field.
+func (m *TierMutation) ResetMaxSandboxStartsPerMinute() {
+	m.max_sandbox_starts_per_minute = nil
+	m.addmax_sandbox_starts_per_minute = nil
+	delete(m.clearedFields, tier.FieldMaxSandboxStartsPerMinute)
+}
+
+// SetMaxConcurrentBuilds sets the "max_concurrent_builds" field.
+func (m *TierMutation) SetMaxConcurrentBuilds(i int64) {
+	m.max_concurrent_builds = &i
+	m.addmax_concurrent_builds = nil
+}
+
+// MaxConcurrentBuilds returns the value of the "max_concurrent_builds" field in the mutation.
+func (m *TierMutation) MaxConcurrentBuilds() (r int64, exists bool) {
+	v := m.max_concurrent_builds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxConcurrentBuilds returns the old "max_concurrent_builds" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *TierMutation) OldMaxConcurrentBuilds(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxConcurrentBuilds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldMaxConcurrentBuilds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxConcurrentBuilds: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.MaxConcurrentBuilds, nil
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *TeamAPIKeyMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+// AddMaxConcurrentBuilds adds i to the "max_concurrent_builds" field.
+func (m *TierMutation) AddMaxConcurrentBuilds(i int64) {
+	if m.addmax_concurrent_builds != nil {
+		*m.addmax_concurrent_builds += i
+	} else {
+		m.addmax_concurrent_builds = &i
+	}
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
+// AddedMaxConcurrentBuilds returns the value that was added to the "max_concurrent_builds" field in this mutation.
+func (m *TierMutation) AddedMaxConcurrentBuilds() (r int64, exists bool) {
+	v := m.addmax_concurrent_builds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxConcurrentBuilds clears the value of the "max_concurrent_builds" field.
+func (m *TierMutation) ClearMaxConcurrentBuilds() {
+	m.max_concurrent_builds = nil
+	m.addmax_concurrent_builds = nil
+	m.clearedFields[tier.FieldMaxConcurrentBuilds] = struct{}{}
+}
+
+// MaxConcurrentBuildsCleared returns if the "max_concurrent_builds" field was cleared in this mutation.
+func (m *TierMutation) MaxConcurrentBuildsCleared() bool {
+	_, ok := m.clearedFields[tier.FieldMaxConcurrentBuilds]
 	return ok
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *TeamAPIKeyMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, teamapikey.FieldCreatedBy)
+// ResetMaxConcurrentBuilds resets all changes to the "max_concurrent_builds" field.
+func (m *TierMutation) ResetMaxConcurrentBuilds() {
+	m.max_concurrent_builds = nil
+	m.addmax_concurrent_builds = nil
+	delete(m.clearedFields, tier.FieldMaxConcurrentBuilds)
 }
 
-// SetLastUsed sets the "last_used" field.
-func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
-	m.last_used = &t
+// SetMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field.
+func (m *TierMutation) SetMaxSnapshotStorageMB(i int64) {
+	m.max_snapshot_storage_mb = &i
+	m.addmax_snapshot_storage_mb = nil
 }
 
-// LastUsed returns the value of the "last_used" field in the mutation.
-func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
-	v := m.last_used
+// MaxSnapshotStorageMB returns the value of the "max_snapshot_storage_mb" field in the mutation.
+func (m *TierMutation) MaxSnapshotStorageMB() (r int64, exists bool) {
+	v := m.max_snapshot_storage_mb
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxSnapshotStorageMB returns the old "max_snapshot_storage_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
+func (m *TierMutation) OldMaxSnapshotStorageMB(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxSnapshotStorageMB is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+		return v, errors.New("OldMaxSnapshotStorageMB requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxSnapshotStorageMB: %w", err)
 	}
-	return oldValue.LastUsed, nil
+	return oldValue.MaxSnapshotStorageMB, nil
 }
 
-// ClearLastUsed clears the value of the "last_used" field.
-func (m *TeamAPIKeyMutation) ClearLastUsed() {
-	m.last_used = nil
-	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
+// AddMaxSnapshotStorageMB adds i to the "max_snapshot_storage_mb" field.
+func (m *TierMutation) AddMaxSnapshotStorageMB(i int64) {
+	if m.addmax_snapshot_storage_mb != nil {
+		*m.addmax_snapshot_storage_mb += i
+	} else {
+		m.addmax_snapshot_storage_mb = &i
+	}
 }
 
-// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
-	return ok
+// AddedMaxSnapshotStorageMB returns the value that was added to the "max_snapshot_storage_mb" field in this mutation.
+func (m *TierMutation) AddedMaxSnapshotStorageMB() (r int64, exists bool) {
+	v := m.addmax_snapshot_storage_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetLastUsed resets all changes to the "last_used" field.
-func (m *TeamAPIKeyMutation) ResetLastUsed() {
-	m.last_used = nil
-	delete(m.clearedFields, teamapikey.FieldLastUsed)
+// ClearMaxSnapshotStorageMB clears the value of the "max_snapshot_storage_mb" field.
+func (m *TierMutation) ClearMaxSnapshotStorageMB() {
+	m.max_snapshot_storage_mb = nil
+	m.addmax_snapshot_storage_mb = nil
+	m.clearedFields[tier.FieldMaxSnapshotStorageMB] = struct{}{}
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *TeamAPIKeyMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
+// MaxSnapshotStorageMBCleared returns if the "max_snapshot_storage_mb" field was cleared in this mutation.
+func (m *TierMutation) MaxSnapshotStorageMBCleared() bool {
+	_, ok := m.clearedFields[tier.FieldMaxSnapshotStorageMB]
+	return ok
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *TeamAPIKeyMutation) TeamCleared() bool {
-	return m.clearedteam
+// ResetMaxSnapshotStorageMB resets all changes to the "max_snapshot_storage_mb" field.
+func (m *TierMutation) ResetMaxSnapshotStorageMB() {
+	m.max_snapshot_storage_mb = nil
+	m.addmax_snapshot_storage_mb = nil
+	delete(m.clearedFields, tier.FieldMaxSnapshotStorageMB)
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
 	}
-	return
-}
-
-// ResetTeam resets all changes to the "team" edge.
-func (m *TeamAPIKeyMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *TierMutation) ClearTeams() {
+	m.clearedteams = true
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *TeamAPIKeyMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *TierMutation) TeamsCleared() bool {
+	return m.clearedteams
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *TeamAPIKeyMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *TeamAPIKeyMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// ResetTeams resets all changes to the "teams" edge.
+func (m *TierMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
 }
 
-// Where appends a list predicates to the TeamAPIKeyMutation builder.
-func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
+// Where appends a list predicates to the TierMutation builder.
+func (m *TierMutation) Where(ps ...predicate.Tier) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TeamAPIKey, len(ps))
+func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tier, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5691,45 +8778,51 @@ func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamAPIKeyMutation) Op() Op {
+func (m *TierMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamAPIKeyMutation) SetOp(op Op) {
+func (m *TierMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TeamAPIKey).
-func (m *TeamAPIKeyMutation) Type() string {
+// Type returns the node type of this mutation (Tier).
+func (m *TierMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamAPIKeyMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.api_key != nil {
-		fields = append(fields, teamapikey.FieldAPIKey)
+func (m *TierMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, tier.FieldName)
 	}
-	if m.created_at != nil {
-		fields = append(fields, teamapikey.FieldCreatedAt)
+	if m.disk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
+	if m.concurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
 	}
-	if m.team != nil {
-		fields = append(fields, teamapikey.FieldTeamID)
+	if m.max_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
 	}
-	if m.name != nil {
-		fields = append(fields, teamapikey.FieldName)
+	if m.max_vcpu != nil {
+		fields = append(fields, tier.FieldMaxVcpu)
 	}
-	if m.creator != nil {
-		fields = append(fields, teamapikey.FieldCreatedBy)
+	if m.max_ram_mb != nil {
+		fields = append(fields, tier.FieldMaxRAMMB)
 	}
-	if m.last_used != nil {
-		fields = append(fields, teamapikey.FieldLastUsed)
+	if m.max_sandbox_starts_per_minute != nil {
+		fields = append(fields, tier.FieldMaxSandboxStartsPerMinute)
+	}
+	if m.max_concurrent_builds != nil {
+		fields = append(fields, tier.FieldMaxConcurrentBuilds)
+	}
+	if m.max_snapshot_storage_mb != nil {
+		fields = append(fields, tier.FieldMaxSnapshotStorageMB)
 	}
 	return fields
 }
@@ -5737,323 +8830,460 @@ func (m *TeamAPIKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *TierMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.APIKey()
-	case teamapikey.FieldCreatedAt:
-		return m.CreatedAt()
-	case teamapikey.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case teamapikey.FieldTeamID:
-		return m.TeamID()
-	case teamapikey.FieldName:
+	case tier.FieldName:
 		return m.Name()
-	case teamapikey.FieldCreatedBy:
-		return m.CreatedBy()
-	case teamapikey.FieldLastUsed:
-		return m.LastUsed()
+	case tier.FieldDiskMB:
+		return m.DiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.ConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.MaxLengthHours()
+	case tier.FieldMaxVcpu:
+		return m.MaxVcpu()
+	case tier.FieldMaxRAMMB:
+		return m.MaxRAMMB()
+	case tier.FieldMaxSandboxStartsPerMinute:
+		return m.MaxSandboxStartsPerMinute()
+	case tier.FieldMaxConcurrentBuilds:
+		return m.MaxConcurrentBuilds()
+	case tier.FieldMaxSnapshotStorageMB:
+		return m.MaxSnapshotStorageMB()
 	}
 	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.OldAPIKey(ctx)
-	case teamapikey.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case teamapikey.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case teamapikey.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case teamapikey.FieldName:
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tier.FieldName:
 		return m.OldName(ctx)
-	case teamapikey.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case teamapikey.FieldLastUsed:
-		return m.OldLastUsed(ctx)
+	case tier.FieldDiskMB:
+		return m.OldDiskMB(ctx)
+	case tier.FieldConcurrentInstances:
+		return m.OldConcurrentInstances(ctx)
+	case tier.FieldMaxLengthHours:
+		return m.OldMaxLengthHours(ctx)
+	case tier.FieldMaxVcpu:
+		return m.OldMaxVcpu(ctx)
+	case tier.FieldMaxRAMMB:
+		return m.OldMaxRAMMB(ctx)
+	case tier.FieldMaxSandboxStartsPerMinute:
+		return m.OldMaxSandboxStartsPerMinute(ctx)
+	case tier.FieldMaxConcurrentBuilds:
+		return m.OldMaxConcurrentBuilds(ctx)
+	case tier.FieldMaxSnapshotStorageMB:
+		return m.OldMaxSnapshotStorageMB(ctx)
 	}
-	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return nil, fmt.Errorf("unknown Tier field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
+func (m *TierMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
+	case tier.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIKey(v)
+		m.SetName(v)
 		return nil
-	case teamapikey.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDiskMB(v)
 		return nil
-	case teamapikey.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetConcurrentInstances(v)
 		return nil
-	case teamapikey.FieldTeamID:
-		v, ok := value.(uuid.UUID)
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.SetMaxLengthHours(v)
 		return nil
-	case teamapikey.FieldName:
-		v, ok := value.(string)
+	case tier.FieldMaxVcpu:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetMaxVcpu(v)
 		return nil
-	case teamapikey.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
+	case tier.FieldMaxRAMMB:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetMaxRAMMB(v)
 		return nil
-	case teamapikey.FieldLastUsed:
-		v, ok := value.(time.Time)
+	case tier.FieldMaxSandboxStartsPerMinute:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsed(v)
+		m.SetMaxSandboxStartsPerMinute(v)
+		return nil
+	case tier.FieldMaxConcurrentBuilds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxConcurrentBuilds(v)
+		return nil
+	case tier.FieldMaxSnapshotStorageMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSnapshotStorageMB(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return fmt.Errorf("unknown Tier field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamAPIKeyMutation) AddedFields() []string {
-	return nil
+func (m *TierMutation) AddedFields() []string {
+	var fields []string
+	if m.adddisk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
+	}
+	if m.addconcurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
+	}
+	if m.addmax_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
+	}
+	if m.addmax_vcpu != nil {
+		fields = append(fields, tier.FieldMaxVcpu)
+	}
+	if m.addmax_ram_mb != nil {
+		fields = append(fields, tier.FieldMaxRAMMB)
+	}
+	if m.addmax_sandbox_starts_per_minute != nil {
+		fields = append(fields, tier.FieldMaxSandboxStartsPerMinute)
+	}
+	if m.addmax_concurrent_builds != nil {
+		fields = append(fields, tier.FieldMaxConcurrentBuilds)
+	}
+	if m.addmax_snapshot_storage_mb != nil {
+		fields = append(fields, tier.FieldMaxSnapshotStorageMB)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tier.FieldDiskMB:
+		return m.AddedDiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.AddedConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.AddedMaxLengthHours()
+	case tier.FieldMaxVcpu:
+		return m.AddedMaxVcpu()
+	case tier.FieldMaxRAMMB:
+		return m.AddedMaxRAMMB()
+	case tier.FieldMaxSandboxStartsPerMinute:
+		return m.AddedMaxSandboxStartsPerMinute()
+	case tier.FieldMaxConcurrentBuilds:
+		return m.AddedMaxConcurrentBuilds()
+	case tier.FieldMaxSnapshotStorageMB:
+		return m.AddedMaxSnapshotStorageMB()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
+func (m *TierMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskMB(v)
+		return nil
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConcurrentInstances(v)
+		return nil
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLengthHours(v)
+		return nil
+	case tier.FieldMaxVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxVcpu(v)
+		return nil
+	case tier.FieldMaxRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRAMMB(v)
+		return nil
+	case tier.FieldMaxSandboxStartsPerMinute:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSandboxStartsPerMinute(v)
+		return nil
+	case tier.FieldMaxConcurrentBuilds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxConcurrentBuilds(v)
+		return nil
+	case tier.FieldMaxSnapshotStorageMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSnapshotStorageMB(v)
+		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
+	return fmt.Errorf("unknown Tier numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamAPIKeyMutation) ClearedFields() []string {
+func (m *TierMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
+	if m.FieldCleared(tier.FieldMaxVcpu) {
+		fields = append(fields, tier.FieldMaxVcpu)
 	}
-	if m.FieldCleared(teamapikey.FieldCreatedBy) {
-		fields = append(fields, teamapikey.FieldCreatedBy)
+	if m.FieldCleared(tier.FieldMaxRAMMB) {
+		fields = append(fields, tier.FieldMaxRAMMB)
 	}
-	if m.FieldCleared(teamapikey.FieldLastUsed) {
-		fields = append(fields, teamapikey.FieldLastUsed)
+	if m.FieldCleared(tier.FieldMaxSandboxStartsPerMinute) {
+		fields = append(fields, tier.FieldMaxSandboxStartsPerMinute)
+	}
+	if m.FieldCleared(tier.FieldMaxConcurrentBuilds) {
+		fields = append(fields, tier.FieldMaxConcurrentBuilds)
+	}
+	if m.FieldCleared(tier.FieldMaxSnapshotStorageMB) {
+		fields = append(fields, tier.FieldMaxSnapshotStorageMB)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
+func (m *TierMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearField(name string) error {
+func (m *TierMutation) ClearField(name string) error {
 	switch name {
-	case teamapikey.FieldUpdatedAt:
-		m.ClearUpdatedAt()
+	case tier.FieldMaxVcpu:
+		m.ClearMaxVcpu()
 		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ClearCreatedBy()
+	case tier.FieldMaxRAMMB:
+		m.ClearMaxRAMMB()
 		return nil
-	case teamapikey.FieldLastUsed:
-		m.ClearLastUsed()
+	case tier.FieldMaxSandboxStartsPerMinute:
+		m.ClearMaxSandboxStartsPerMinute()
+		return nil
+	case tier.FieldMaxConcurrentBuilds:
+		m.ClearMaxConcurrentBuilds()
+		return nil
+	case tier.FieldMaxSnapshotStorageMB:
+		m.ClearMaxSnapshotStorageMB()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
+	return fmt.Errorf("unknown Tier nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetField(name string) error {
+func (m *TierMutation) ResetField(name string) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		m.ResetAPIKey()
+	case tier.FieldName:
+		m.ResetName()
 		return nil
-	case teamapikey.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case tier.FieldDiskMB:
+		m.ResetDiskMB()
 		return nil
-	case teamapikey.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case tier.FieldConcurrentInstances:
+		m.ResetConcurrentInstances()
 		return nil
-	case teamapikey.FieldTeamID:
-		m.ResetTeamID()
+	case tier.FieldMaxLengthHours:
+		m.ResetMaxLengthHours()
 		return nil
-	case teamapikey.FieldName:
-		m.ResetName()
+	case tier.FieldMaxVcpu:
+		m.ResetMaxVcpu()
 		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case tier.FieldMaxRAMMB:
+		m.ResetMaxRAMMB()
 		return nil
-	case teamapikey.FieldLastUsed:
-		m.ResetLastUsed()
+	case tier.FieldMaxSandboxStartsPerMinute:
+		m.ResetMaxSandboxStartsPerMinute()
+		return nil
+	case tier.FieldMaxConcurrentBuilds:
+		m.ResetMaxConcurrentBuilds()
+		return nil
+	case tier.FieldMaxSnapshotStorageMB:
+		m.ResetMaxSnapshotStorageMB()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return fmt.Errorf("unknown Tier field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamAPIKeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.team != nil {
-		edges = append(edges, teamapikey.EdgeTeam)
-	}
-	if m.creator != nil {
-		edges = append(edges, teamapikey.EdgeCreator)
+func (m *TierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.teams != nil {
+		edges = append(edges, tier.EdgeTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
+func (m *TierMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case teamapikey.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case teamapikey.EdgeCreator:
-		if id := m.creator; id != nil {
-			return []ent.Value{*id}
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamAPIKeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedteams != nil {
+		edges = append(edges, tier.EdgeTeams)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *TierMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamAPIKeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedteam {
-		edges = append(edges, teamapikey.EdgeTeam)
-	}
-	if m.clearedcreator {
-		edges = append(edges, teamapikey.EdgeCreator)
+func (m *TierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedteams {
+		edges = append(edges, tier.EdgeTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
+func (m *TierMutation) EdgeCleared(name string) bool {
 	switch name {
-	case teamapikey.EdgeTeam:
-		return m.clearedteam
-	case teamapikey.EdgeCreator:
-		return m.clearedcreator
+	case tier.EdgeTeams:
+		return m.clearedteams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
+func (m *TierMutation) ClearEdge(name string) error {
 	switch name {
-	case teamapikey.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case teamapikey.EdgeCreator:
-		m.ClearCreator()
-		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
+	return fmt.Errorf("unknown Tier unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
+func (m *TierMutation) ResetEdge(name string) error {
 	switch name {
-	case teamapikey.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case teamapikey.EdgeCreator:
-		m.ResetCreator()
+	case tier.EdgeTeams:
+		m.ResetTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
+	return fmt.Errorf("unknown Tier edge %s", name)
 }
 
-// TierMutation represents an operation that mutates the Tier nodes in the graph.
-type TierMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
 	op                      Op
 	typ                     string
-	id                      *string
-	name                    *string
-	disk_mb                 *int64
-	adddisk_mb              *int64
-	concurrent_instances    *int64
-	addconcurrent_instances *int64
-	max_length_hours        *int64
-	addmax_length_hours     *int64
+	id                      *uuid.UUID
+	email                   *string
 	clearedFields           map[string]struct{}
 	teams                   map[uuid.UUID]struct{}
 	removedteams            map[uuid.UUID]struct{}
 	clearedteams            bool
+	created_envs            map[string]struct{}
+	removedcreated_envs     map[string]struct{}
+	clearedcreated_envs     bool
+	access_tokens           map[string]struct{}
+	removedaccess_tokens    map[string]struct{}
+	clearedaccess_tokens    bool
+	created_api_keys        map[uuid.UUID]struct{}
+	removedcreated_api_keys map[uuid.UUID]struct{}
+	clearedcreated_api_keys bool
+	users_teams             map[int]struct{}
+	removedusers_teams      map[int]struct{}
+	clearedusers_teams      bool
 	done                    bool
-	oldValue                func(context.Context) (*Tier, error)
-	predicates              []predicate.Tier
+	oldValue                func(context.Context) (*User, error)
+	predicates              []predicate.User
 }
 
-var _ ent.Mutation = (*TierMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// tierOption allows management of the mutation configuration using functional options.
-type tierOption func(*TierMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newTierMutation creates new mutation for the Tier entity.
-func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
-	m := &TierMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTier,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6062,20 +9292,20 @@ func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
 	return m
 }
 
-// withTierID sets the ID field of the mutation.
-func withTierID(id string) tierOption {
-	return func(m *TierMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tier
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Tier, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tier.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6084,10 +9314,10 @@ func withTierID(id string) tierOption {
 	}
 }
 
-// withTier sets the old Tier of the mutation.
-func withTier(node *Tier) tierOption {
-	return func(m *TierMutation) {
-		m.oldValue = func(context.Context) (*Tier, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6096,7 +9326,7 @@ func withTier(node *Tier) tierOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TierMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6104,7 +9334,7 @@ func (m TierMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TierMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -6114,14 +9344,14 @@ func (m TierMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tier entities.
-func (m *TierMutation) SetID(id string) {
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TierMutation) ID() (id string, exists bool) {
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6132,288 +9362,336 @@ func (m *TierMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TierMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tier.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TierMutation) SetName(s string) {
-	m.name = &s
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TierMutation) Name() (r string, exists bool) {
-	v := m.name
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Email, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TierMutation) ResetName() {
-	m.name = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetDiskMB sets the "disk_mb" field.
-func (m *TierMutation) SetDiskMB(i int64) {
-	m.disk_mb = &i
-	m.adddisk_mb = nil
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
 }
 
-// DiskMB returns the value of the "disk_mb" field in the mutation.
-func (m *TierMutation) DiskMB() (r int64, exists bool) {
-	v := m.disk_mb
-	if v == nil {
-		return
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UserMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UserMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldDiskMB returns the old "disk_mb" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldDiskMB(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiskMB is only allowed on UpdateOne operations")
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiskMB requires an ID field in the mutation")
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiskMB: %w", err)
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UserMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
+func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
+	if m.created_envs == nil {
+		m.created_envs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.created_envs[ids[i]] = struct{}{}
 	}
-	return oldValue.DiskMB, nil
 }
 
-// AddDiskMB adds i to the "disk_mb" field.
-func (m *TierMutation) AddDiskMB(i int64) {
-	if m.adddisk_mb != nil {
-		*m.adddisk_mb += i
-	} else {
-		m.adddisk_mb = &i
+// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
+func (m *UserMutation) ClearCreatedEnvs() {
+	m.clearedcreated_envs = true
+}
+
+// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
+func (m *UserMutation) CreatedEnvsCleared() bool {
+	return m.clearedcreated_envs
+}
+
+// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
+func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
+	if m.removedcreated_envs == nil {
+		m.removedcreated_envs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.created_envs, ids[i])
+		m.removedcreated_envs[ids[i]] = struct{}{}
 	}
 }
 
-// AddedDiskMB returns the value that was added to the "disk_mb" field in this mutation.
-func (m *TierMutation) AddedDiskMB() (r int64, exists bool) {
-	v := m.adddisk_mb
-	if v == nil {
-		return
+// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
+func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
+	for id := range m.removedcreated_envs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
+func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
+	for id := range m.created_envs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedEnvs resets all changes to the "created_envs" edge.
+func (m *UserMutation) ResetCreatedEnvs() {
+	m.created_envs = nil
+	m.clearedcreated_envs = false
+	m.removedcreated_envs = nil
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
+func (m *UserMutation) AddAccessTokenIDs(ids ...string) {
+	if m.access_tokens == nil {
+		m.access_tokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.access_tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetDiskMB resets all changes to the "disk_mb" field.
-func (m *TierMutation) ResetDiskMB() {
-	m.disk_mb = nil
-	m.adddisk_mb = nil
+// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) ClearAccessTokens() {
+	m.clearedaccess_tokens = true
 }
 
-// SetConcurrentInstances sets the "concurrent_instances" field.
-func (m *TierMutation) SetConcurrentInstances(i int64) {
-	m.concurrent_instances = &i
-	m.addconcurrent_instances = nil
+// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
+func (m *UserMutation) AccessTokensCleared() bool {
+	return m.clearedaccess_tokens
 }
 
-// ConcurrentInstances returns the value of the "concurrent_instances" field in the mutation.
-func (m *TierMutation) ConcurrentInstances() (r int64, exists bool) {
-	v := m.concurrent_instances
-	if v == nil {
-		return
+// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
+func (m *UserMutation) RemoveAccessTokenIDs(ids ...string) {
+	if m.removedaccess_tokens == nil {
+		m.removedaccess_tokens = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.access_tokens, ids[i])
+		m.removedaccess_tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldConcurrentInstances returns the old "concurrent_instances" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldConcurrentInstances(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConcurrentInstances is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConcurrentInstances requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConcurrentInstances: %w", err)
+// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) RemovedAccessTokensIDs() (ids []string) {
+	for id := range m.removedaccess_tokens {
+		ids = append(ids, id)
 	}
-	return oldValue.ConcurrentInstances, nil
+	return
 }
 
-// AddConcurrentInstances adds i to the "concurrent_instances" field.
-func (m *TierMutation) AddConcurrentInstances(i int64) {
-	if m.addconcurrent_instances != nil {
-		*m.addconcurrent_instances += i
-	} else {
-		m.addconcurrent_instances = &i
+// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccessTokensIDs() (ids []string) {
+	for id := range m.access_tokens {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedConcurrentInstances returns the value that was added to the "concurrent_instances" field in this mutation.
-func (m *TierMutation) AddedConcurrentInstances() (r int64, exists bool) {
-	v := m.addconcurrent_instances
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetAccessTokens resets all changes to the "access_tokens" edge.
+func (m *UserMutation) ResetAccessTokens() {
+	m.access_tokens = nil
+	m.clearedaccess_tokens = false
+	m.removedaccess_tokens = nil
 }
 
-// ResetConcurrentInstances resets all changes to the "concurrent_instances" field.
-func (m *TierMutation) ResetConcurrentInstances() {
-	m.concurrent_instances = nil
-	m.addconcurrent_instances = nil
+// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.created_api_keys == nil {
+		m.created_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.created_api_keys[ids[i]] = struct{}{}
+	}
 }
 
-// SetMaxLengthHours sets the "max_length_hours" field.
-func (m *TierMutation) SetMaxLengthHours(i int64) {
-	m.max_length_hours = &i
-	m.addmax_length_hours = nil
+// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) ClearCreatedAPIKeys() {
+	m.clearedcreated_api_keys = true
 }
 
-// MaxLengthHours returns the value of the "max_length_hours" field in the mutation.
-func (m *TierMutation) MaxLengthHours() (r int64, exists bool) {
-	v := m.max_length_hours
-	if v == nil {
-		return
-	}
-	return *v, true
+// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *UserMutation) CreatedAPIKeysCleared() bool {
+	return m.clearedcreated_api_keys
 }
 
-// OldMaxLengthHours returns the old "max_length_hours" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldMaxLengthHours(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxLengthHours is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxLengthHours requires an ID field in the mutation")
+// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedcreated_api_keys == nil {
+		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxLengthHours: %w", err)
+	for i := range ids {
+		delete(m.created_api_keys, ids[i])
+		m.removedcreated_api_keys[ids[i]] = struct{}{}
 	}
-	return oldValue.MaxLengthHours, nil
 }
 
-// AddMaxLengthHours adds i to the "max_length_hours" field.
-func (m *TierMutation) AddMaxLengthHours(i int64) {
-	if m.addmax_length_hours != nil {
-		*m.addmax_length_hours += i
-	} else {
-		m.addmax_length_hours = &i
+// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_api_keys {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedMaxLengthHours returns the value that was added to the "max_length_hours" field in this mutation.
-func (m *TierMutation) AddedMaxLengthHours() (r int64, exists bool) {
-	v := m.addmax_length_hours
-	if v == nil {
-		return
+// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
+func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.created_api_keys {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetMaxLengthHours resets all changes to the "max_length_hours" field.
-func (m *TierMutation) ResetMaxLengthHours() {
-	m.max_length_hours = nil
-	m.addmax_length_hours = nil
+// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
+func (m *UserMutation) ResetCreatedAPIKeys() {
+	m.created_api_keys = nil
+	m.clearedcreated_api_keys = false
+	m.removedcreated_api_keys = nil
 }
 
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
-	if m.teams == nil {
-		m.teams = make(map[uuid.UUID]struct{})
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
+		m.users_teams[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *TierMutation) ClearTeams() {
-	m.clearedteams = true
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *TierMutation) TeamsCleared() bool {
-	return m.clearedteams
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *UserMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
 }
 
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[uuid.UUID]struct{})
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
-	for id := range m.removedteams {
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
-	for id := range m.teams {
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *UserMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *TierMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *UserMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
 }
 
-// Where appends a list predicates to the TierMutation builder.
-func (m *TierMutation) Where(ps ...predicate.Tier) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tier, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6421,53 +9699,38 @@ func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TierMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TierMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tier).
-func (m *TierMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TierMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, tier.FieldName)
-	}
-	if m.disk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
-	}
-	if m.concurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
-	}
-	if m.max_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TierMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case tier.FieldName:
-		return m.Name()
-	case tier.FieldDiskMB:
-		return m.DiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.ConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.MaxLengthHours()
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldEmail:
+		return m.Email()
 	}
 	return nil, false
 }
@@ -6475,280 +9738,298 @@ func (m *TierMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tier.FieldName:
-		return m.OldName(ctx)
-	case tier.FieldDiskMB:
-		return m.OldDiskMB(ctx)
-	case tier.FieldConcurrentInstances:
-		return m.OldConcurrentInstances(ctx)
-	case tier.FieldMaxLengthHours:
-		return m.OldMaxLengthHours(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tier field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TierMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tier.FieldName:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiskMB(v)
-		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConcurrentInstances(v)
-		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxLengthHours(v)
+		m.SetEmail(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tier field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TierMutation) AddedFields() []string {
-	var fields []string
-	if m.adddisk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
-	}
-	if m.addconcurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
-	}
-	if m.addmax_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tier.FieldDiskMB:
-		return m.AddedDiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.AddedConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.AddedMaxLengthHours()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TierMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiskMB(v)
-		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddConcurrentInstances(v)
-		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxLengthHours(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Tier numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TierMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TierMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TierMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tier nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TierMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case tier.FieldName:
-		m.ResetName()
-		return nil
-	case tier.FieldDiskMB:
-		m.ResetDiskMB()
-		return nil
-	case tier.FieldConcurrentInstances:
-		m.ResetConcurrentInstances()
-		return nil
-	case tier.FieldMaxLengthHours:
-		m.ResetMaxLengthHours()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
 	}
-	return fmt.Errorf("unknown Tier field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TierMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.teams != nil {
-		edges = append(edges, tier.EdgeTeams)
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.created_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.access_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.created_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TierMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		ids := make([]ent.Value, 0, len(m.teams))
 		for id := range m.teams {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.created_envs))
+		for id := range m.created_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.access_tokens))
+		for id := range m.access_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.created_api_keys))
+		for id := range m.created_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TierMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.removedteams != nil {
-		edges = append(edges, tier.EdgeTeams)
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.removedcreated_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.removedaccess_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.removedcreated_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TierMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		ids := make([]ent.Value, 0, len(m.removedteams))
 		for id := range m.removedteams {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
+		for id := range m.removedcreated_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
+		for id := range m.removedaccess_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
+		for id := range m.removedcreated_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TierMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
 	if m.clearedteams {
-		edges = append(edges, tier.EdgeTeams)
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.clearedcreated_envs {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.clearedaccess_tokens {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.clearedcreated_api_keys {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, user.EdgeUsersTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TierMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		return m.clearedteams
+	case user.EdgeCreatedEnvs:
+		return m.clearedcreated_envs
+	case user.EdgeAccessTokens:
+		return m.clearedaccess_tokens
+	case user.EdgeCreatedAPIKeys:
+		return m.clearedcreated_api_keys
+	case user.EdgeUsersTeams:
+		return m.clearedusers_teams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TierMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Tier unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TierMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case tier.EdgeTeams:
+	case user.EdgeTeams:
 		m.ResetTeams()
 		return nil
+	case user.EdgeCreatedEnvs:
+		m.ResetCreatedEnvs()
+		return nil
+	case user.EdgeAccessTokens:
+		m.ResetAccessTokens()
+		return nil
+	case user.EdgeCreatedAPIKeys:
+		m.ResetCreatedAPIKeys()
+		return nil
+	case user.EdgeUsersTeams:
+		m.ResetUsersTeams()
+		return nil
 	}
-	return fmt.Errorf("unknown Tier edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
+type UsersTeamsMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	email                   *string
-	clearedFields           map[string]struct{}
-	teams                   map[uuid.UUID]struct{}
-	removedteams            map[uuid.UUID]struct{}
-	clearedteams            bool
-	created_envs            map[string]struct{}
-	removedcreated_envs     map[string]struct{}
-	clearedcreated_envs     bool
-	access_tokens           map[string]struct{}
-	removedaccess_tokens    map[string]struct{}
-	clearedaccess_tokens    bool
-	created_api_keys        map[uuid.UUID]struct{}
-	removedcreated_api_keys map[uuid.UUID]struct{}
-	clearedcreated_api_keys bool
-	users_teams             map[int]struct{}
-	removedusers_teams      map[int]struct{}
-	clearedusers_teams      bool
-	done                    bool
-	oldValue                func(context.Context) (*User, error)
-	predicates              []predicate.User
+	op            Op
+	typ           string
+	id            *int
+	is_default    *bool
+	clearedFields map[string]struct{}
+	users         *uuid.UUID
+	clearedusers  bool
+	teams         *uuid.UUID
+	clearedteams  bool
+	done          bool
+	oldValue      func(context.Context) (*UsersTeams, error)
+	predicates    []predicate.UsersTeams
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*UsersTeamsMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// usersteamsOption allows management of the mutation configuration using functional options.
+type usersteamsOption func(*UsersTeamsMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
+func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
+	m := &UsersTeamsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUsersTeams,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6757,20 +10038,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withUsersTeamsID sets the ID field of the mutation.
+func withUsersTeamsID(id int) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *UsersTeams
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().UsersTeams.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6779,10 +10060,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withUsersTeams sets the old UsersTeams of the mutation.
+func withUsersTeams(node *UsersTeams) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
+		m.oldValue = func(context.Context) (*UsersTeams, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6791,7 +10072,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m UsersTeamsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6799,7 +10080,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m UsersTeamsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -6808,15 +10089,9 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *UsersTeamsMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6827,336 +10102,218 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetUserID sets the "user_id" field.
+func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
+	m.users = &u
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.users
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
-	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-}
-
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
-	if m.teams == nil {
-		m.teams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *UserMutation) ClearTeams() {
-	m.clearedteams = true
-}
-
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UserMutation) TeamsCleared() bool {
-	return m.clearedteams
-}
-
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
-	for id := range m.removedteams {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
-	for id := range m.teams {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetTeams resets all changes to the "teams" edge.
-func (m *UserMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
-}
-
-// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
-func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
-	if m.created_envs == nil {
-		m.created_envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.created_envs[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
+	return oldValue.UserID, nil
 }
 
-// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
-func (m *UserMutation) ClearCreatedEnvs() {
-	m.clearedcreated_envs = true
-}
-
-// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
-func (m *UserMutation) CreatedEnvsCleared() bool {
-	return m.clearedcreated_envs
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsersTeamsMutation) ResetUserID() {
+	m.users = nil
 }
 
-// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
-func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
-	if m.removedcreated_envs == nil {
-		m.removedcreated_envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.created_envs, ids[i])
-		m.removedcreated_envs[ids[i]] = struct{}{}
-	}
+// SetTeamID sets the "team_id" field.
+func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
+	m.teams = &u
 }
 
-// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
-func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
-	for id := range m.removedcreated_envs {
-		ids = append(ids, id)
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.teams
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
-func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
-	for id := range m.created_envs {
-		ids = append(ids, id)
+// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetCreatedEnvs resets all changes to the "created_envs" edge.
-func (m *UserMutation) ResetCreatedEnvs() {
-	m.created_envs = nil
-	m.clearedcreated_envs = false
-	m.removedcreated_envs = nil
-}
-
-// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
-func (m *UserMutation) AddAccessTokenIDs(ids ...string) {
-	if m.access_tokens == nil {
-		m.access_tokens = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.access_tokens[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
+	return oldValue.TeamID, nil
 }
 
-// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) ClearAccessTokens() {
-	m.clearedaccess_tokens = true
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *UsersTeamsMutation) ResetTeamID() {
+	m.teams = nil
 }
 
-// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
-func (m *UserMutation) AccessTokensCleared() bool {
-	return m.clearedaccess_tokens
+// SetIsDefault sets the "is_default" field.
+func (m *UsersTeamsMutation) SetIsDefault(b bool) {
+	m.is_default = &b
 }
 
-// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
-func (m *UserMutation) RemoveAccessTokenIDs(ids ...string) {
-	if m.removedaccess_tokens == nil {
-		m.removedaccess_tokens = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.access_tokens, ids[i])
-		m.removedaccess_tokens[ids[i]] = struct{}{}
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) RemovedAccessTokensIDs() (ids []string) {
-	for id := range m.removedaccess_tokens {
-		ids = append(ids, id)
+// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
-func (m *UserMutation) AccessTokensIDs() (ids []string) {
-	for id := range m.access_tokens {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetAccessTokens resets all changes to the "access_tokens" edge.
-func (m *UserMutation) ResetAccessTokens() {
-	m.access_tokens = nil
-	m.clearedaccess_tokens = false
-	m.removedaccess_tokens = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
 }
 
-// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.created_api_keys == nil {
-		m.created_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.created_api_keys[ids[i]] = struct{}{}
-	}
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *UsersTeamsMutation) ResetIsDefault() {
+	m.is_default = nil
 }
 
-// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) ClearCreatedAPIKeys() {
-	m.clearedcreated_api_keys = true
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
 }
 
-// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *UserMutation) CreatedAPIKeysCleared() bool {
-	return m.clearedcreated_api_keys
+// ClearUsers clears the "users" edge to the User entity.
+func (m *UsersTeamsMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[usersteams.FieldUserID] = struct{}{}
 }
 
-// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedcreated_api_keys == nil {
-		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.created_api_keys, ids[i])
-		m.removedcreated_api_keys[ids[i]] = struct{}{}
-	}
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *UsersTeamsMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_api_keys {
-		ids = append(ids, id)
+// UsersID returns the "users" edge ID in the mutation.
+func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
 	}
 	return
 }
 
-// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
-func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.created_api_keys {
-		ids = append(ids, id)
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
-func (m *UserMutation) ResetCreatedAPIKeys() {
-	m.created_api_keys = nil
-	m.clearedcreated_api_keys = false
-	m.removedcreated_api_keys = nil
-}
-
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
-	}
+// ResetUsers resets all changes to the "users" edge.
+func (m *UsersTeamsMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// SetTeamsID sets the "teams" edge to the Team entity by id.
+func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
+	m.teams = &id
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *UserMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UsersTeamsMutation) ClearTeams() {
+	m.clearedteams = true
+	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
-	}
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UsersTeamsMutation) TeamsCleared() bool {
+	return m.clearedteams
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
-		ids = append(ids, id)
+// TeamsID returns the "teams" edge ID in the mutation.
+func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
+	if m.teams != nil {
+		return *m.teams, true
 	}
 	return
 }
 
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *UserMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
-		ids = append(ids, id)
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamsID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
+	if id := m.teams; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *UserMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UsersTeamsMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UsersTeamsMutation builder.
+func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsersTeams, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7164,27 +10321,33 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UsersTeamsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UsersTeamsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UsersTeams).
+func (m *UsersTeamsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+func (m *UsersTeamsMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.users != nil {
+		fields = append(fields, usersteams.FieldUserID)
+	}
+	if m.teams != nil {
+		fields = append(fields, usersteams.FieldTeamID)
+	}
+	if m.is_default != nil {
+		fields = append(fields, usersteams.FieldIsDefault)
 	}
 	return fields
 }
@@ -7192,10 +10355,14 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldEmail:
-		return m.Email()
+	case usersteams.FieldUserID:
+		return m.UserID()
+	case usersteams.FieldTeamID:
+		return m.TeamID()
+	case usersteams.FieldIsDefault:
+		return m.IsDefault()
 	}
 	return nil, false
 }
@@ -7203,298 +10370,235 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
+	case usersteams.FieldUserID:
+		return m.OldUserID(ctx)
+	case usersteams.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case usersteams.FieldIsDefault:
+		return m.OldIsDefault(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
-		v, ok := value.(string)
+	case usersteams.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case usersteams.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetTeamID(v)
+		return nil
+	case usersteams.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UsersTeamsMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *UsersTeamsMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UsersTeamsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UsersTeamsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UsersTeamsMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
+	case usersteams.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case usersteams.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case usersteams.FieldIsDefault:
+		m.ResetIsDefault()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.teams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.created_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.access_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.created_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *UsersTeamsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.users_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.teams != nil {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.created_envs))
-		for id := range m.created_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.access_tokens))
-		for id := range m.access_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.created_api_keys))
-		for id := range m.created_api_keys {
-			ids = append(ids, id)
+	case usersteams.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
-			ids = append(ids, id)
+	case usersteams.EdgeTeams:
+		if id := m.teams; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedteams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.removedcreated_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.removedaccess_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.removedcreated_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
-	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
-	}
+func (m *UsersTeamsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
-		for id := range m.removedcreated_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
-		for id := range m.removedaccess_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
-		for id := range m.removedcreated_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedteams {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.clearedcreated_envs {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.clearedaccess_tokens {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.clearedcreated_api_keys {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *UsersTeamsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.clearedusers_teams {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.clearedteams {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeTeams:
+	case usersteams.EdgeUsers:
+		return m.clearedusers
+	case usersteams.EdgeTeams:
 		return m.clearedteams
-	case user.EdgeCreatedEnvs:
-		return m.clearedcreated_envs
-	case user.EdgeAccessTokens:
-		return m.clearedaccess_tokens
-	case user.EdgeCreatedAPIKeys:
-		return m.clearedcreated_api_keys
-	case user.EdgeUsersTeams:
-		return m.clearedusers_teams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UsersTeamsMutation) ClearEdge(name string) error {
 	switch name {
+	case usersteams.EdgeUsers:
+		m.ClearUsers()
+		return nil
+	case usersteams.EdgeTeams:
+		m.ClearTeams()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UsersTeamsMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeTeams:
-		m.ResetTeams()
-		return nil
-	case user.EdgeCreatedEnvs:
-		m.ResetCreatedEnvs()
-		return nil
-	case user.EdgeAccessTokens:
-		m.ResetAccessTokens()
-		return nil
-	case user.EdgeCreatedAPIKeys:
-		m.ResetCreatedAPIKeys()
+	case usersteams.EdgeUsers:
+		m.ResetUsers()
 		return nil
-	case user.EdgeUsersTeams:
-		m.ResetUsersTeams()
+	case usersteams.EdgeTeams:
+		m.ResetTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UsersTeams edge %s", name)
 }
 
-// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
-type UsersTeamsMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	is_default    *bool
-	clearedFields map[string]struct{}
-	users         *uuid.UUID
-	clearedusers  bool
-	teams         *uuid.UUID
-	clearedteams  bool
-	done          bool
-	oldValue      func(context.Context) (*UsersTeams, error)
-	predicates    []predicate.UsersTeams
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	event_id           *uuid.UUID
+	event_type         *string
+	payload            *string
+	status             *webhookdelivery.Status
+	attempts           *int32
+	addattempts        *int32
+	response_status    *int32
+	addresponse_status *int32
+	error              *string
+	delivered_at       *time.Time
+	next_attempt_at    *time.Time
+	clearedFields      map[string]struct{}
+	webhook            *uuid.UUID
+	clearedwebhook     bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
 }
 
-var _ ent.Mutation = (*UsersTeamsMutation)(nil)
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
 
-// usersteamsOption allows management of the mutation configuration using functional options.
-type usersteamsOption func(*UsersTeamsMutation)
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
 
-// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
-func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
-	m := &UsersTeamsMutation{
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUsersTeams,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7503,32 +10607,32 @@ func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeam
 	return m
 }
 
-// withUsersTeamsID sets the ID field of the mutation.
-func withUsersTeamsID(id int) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UsersTeams
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UsersTeams.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
-	}
-}
-
-// withUsersTeams sets the old UsersTeams of the mutation.
-func withUsersTeams(node *UsersTeams) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
-		m.oldValue = func(context.Context) (*UsersTeams, error) {
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7537,7 +10641,7 @@ func withUsersTeams(node *UsersTeams) usersteamsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UsersTeamsMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7545,7 +10649,7 @@ func (m UsersTeamsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UsersTeamsMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -7554,9 +10658,15 @@ func (m UsersTeamsMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UsersTeamsMutation) ID() (id int, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7567,218 +10677,546 @@ func (m *UsersTeamsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
-	m.users = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.users
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UsersTeamsMutation) ResetUserID() {
-	m.users = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
-	m.teams = &u
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.teams
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return oldValue.TeamID, nil
+	return oldValue.WebhookID, nil
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *UsersTeamsMutation) ResetTeamID() {
-	m.teams = nil
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook = nil
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *UsersTeamsMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.IsDefault, nil
+	return oldValue.EventID, nil
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *UsersTeamsMutation) ResetIsDefault() {
-	m.is_default = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *UsersTeamsMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[usersteams.FieldUserID] = struct{}{}
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int32) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int32, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int32) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int32) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int32, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int32) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
+}
+
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int32, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
+
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *UsersTeamsMutation) UsersCleared() bool {
-	return m.clearedusers
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *UsersTeamsMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// SetTeamsID sets the "teams" edge to the Team entity by id.
-func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
-	m.teams = &id
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldNextAttemptAt]
+	return ok
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *UsersTeamsMutation) ClearTeams() {
-	m.clearedteams = true
-	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldNextAttemptAt)
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UsersTeamsMutation) TeamsCleared() bool {
-	return m.clearedteams
+// ClearWebhook clears the "webhook" edge to the TeamWebhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
+	m.clearedFields[webhookdelivery.FieldWebhookID] = struct{}{}
 }
 
-// TeamsID returns the "teams" edge ID in the mutation.
-func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
-	if m.teams != nil {
-		return *m.teams, true
-	}
-	return
+// WebhookCleared reports if the "webhook" edge to the TeamWebhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamsID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
-	if id := m.teams; id != nil {
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *UsersTeamsMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the UsersTeamsMutation builder.
-func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UsersTeams, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7786,33 +11224,57 @@ func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UsersTeamsMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UsersTeamsMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UsersTeams).
-func (m *UsersTeamsMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UsersTeamsMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.users != nil {
-		fields = append(fields, usersteams.FieldUserID)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
-	if m.teams != nil {
-		fields = append(fields, usersteams.FieldTeamID)
+	if m.webhook != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.is_default != nil {
-		fields = append(fields, usersteams.FieldIsDefault)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
 	return fields
 }
@@ -7820,14 +11282,30 @@ func (m *UsersTeamsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.UserID()
-	case usersteams.FieldTeamID:
-		return m.TeamID()
-	case usersteams.FieldIsDefault:
-		return m.IsDefault()
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
 	}
 	return nil, false
 }
@@ -7835,128 +11313,271 @@ func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.OldUserID(ctx)
-	case usersteams.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case usersteams.FieldIsDefault:
-		return m.OldIsDefault(ctx)
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case usersteams.FieldUserID:
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldWebhookID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetWebhookID(v)
 		return nil
-	case usersteams.FieldTeamID:
+	case webhookdelivery.FieldEventID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.SetEventID(v)
 		return nil
-	case usersteams.FieldIsDefault:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldEventType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDefault(v)
+		m.SetEventType(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UsersTeamsMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UsersTeamsMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldNextAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UsersTeamsMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case usersteams.FieldUserID:
-		m.ResetUserID()
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case usersteams.FieldTeamID:
-		m.ResetTeamID()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case usersteams.FieldIsDefault:
-		m.ResetIsDefault()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UsersTeamsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.teams != nil {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case usersteams.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
-	case usersteams.EdgeTeams:
-		if id := m.teams; id != nil {
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7964,65 +11585,54 @@ func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UsersTeamsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UsersTeamsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.clearedteams {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case usersteams.EdgeUsers:
-		return m.clearedusers
-	case usersteams.EdgeTeams:
-		return m.clearedteams
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UsersTeamsMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ClearUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ClearTeams()
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UsersTeamsMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ResetTeams()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
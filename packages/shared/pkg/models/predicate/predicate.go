@@ -9,6 +9,9 @@ import (
 // AccessToken is the predicate function for accesstoken builders.
 type AccessToken func(*sql.Selector)
 
+// Checkpoint is the predicate function for checkpoint builders.
+type Checkpoint func(*sql.Selector)
+
 // Env is the predicate function for env builders.
 type Env func(*sql.Selector)
 
@@ -27,6 +30,9 @@ type Team func(*sql.Selector)
 // TeamAPIKey is the predicate function for teamapikey builders.
 type TeamAPIKey func(*sql.Selector)
 
+// TeamWebhook is the predicate function for teamwebhook builders.
+type TeamWebhook func(*sql.Selector)
+
 // Tier is the predicate function for tier builders.
 type Tier func(*sql.Selector)
 
@@ -35,3 +41,6 @@ type User func(*sql.Selector)
 
 // UsersTeams is the predicate function for usersteams builders.
 type UsersTeams func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
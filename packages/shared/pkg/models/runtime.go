@@ -5,14 +5,17 @@ package models
 import (
 	"time"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 )
 
@@ -20,6 +23,12 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	checkpointFields := schema.Checkpoint{}.Fields()
+	_ = checkpointFields
+	// checkpointDescCreatedAt is the schema descriptor for created_at field.
+	checkpointDescCreatedAt := checkpointFields[1].Descriptor()
+	// checkpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	checkpoint.DefaultCreatedAt = checkpointDescCreatedAt.Default.(func() time.Time)
 	envFields := schema.Env{}.Fields()
 	_ = envFields
 	// envDescCreatedAt is the schema descriptor for created_at field.
@@ -88,6 +97,12 @@ func init() {
 	teamapikeyDescName := teamapikeyFields[5].Descriptor()
 	// teamapikey.DefaultName holds the default value on creation for the name field.
 	teamapikey.DefaultName = teamapikeyDescName.Default.(string)
+	teamwebhookFields := schema.TeamWebhook{}.Fields()
+	_ = teamwebhookFields
+	// teamwebhookDescCreatedAt is the schema descriptor for created_at field.
+	teamwebhookDescCreatedAt := teamwebhookFields[1].Descriptor()
+	// teamwebhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teamwebhook.DefaultCreatedAt = teamwebhookDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -100,4 +115,14 @@ func init() {
 	usersteamsDescIsDefault := usersteamsFields[2].Descriptor()
 	// usersteams.DefaultIsDefault holds the default value on creation for the is_default field.
 	usersteams.DefaultIsDefault = usersteamsDescIsDefault.Default.(bool)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int32)
 }
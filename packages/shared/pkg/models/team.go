@@ -45,6 +45,8 @@ type TeamEdges struct {
 	Users []*User `json:"users,omitempty"`
 	// TeamAPIKeys holds the value of the team_api_keys edge.
 	TeamAPIKeys []*TeamAPIKey `json:"team_api_keys,omitempty"`
+	// TeamWebhooks holds the value of the team_webhooks edge.
+	TeamWebhooks []*TeamWebhook `json:"team_webhooks,omitempty"`
 	// TeamTier holds the value of the team_tier edge.
 	TeamTier *Tier `json:"team_tier,omitempty"`
 	// Envs holds the value of the envs edge.
@@ -53,7 +55,7 @@ type TeamEdges struct {
 	UsersTeams []*UsersTeams `json:"users_teams,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -74,10 +76,19 @@ func (e TeamEdges) TeamAPIKeysOrErr() ([]*TeamAPIKey, error) {
 	return nil, &NotLoadedError{edge: "team_api_keys"}
 }
 
+// TeamWebhooksOrErr returns the TeamWebhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) TeamWebhooksOrErr() ([]*TeamWebhook, error) {
+	if e.loadedTypes[2] {
+		return e.TeamWebhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "team_webhooks"}
+}
+
 // TeamTierOrErr returns the TeamTier value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e TeamEdges) TeamTierOrErr() (*Tier, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		if e.TeamTier == nil {
 			// Edge was loaded but was not found.
 			return nil, &NotFoundError{label: tier.Label}
@@ -90,7 +101,7 @@ func (e TeamEdges) TeamTierOrErr() (*Tier, error) {
 // EnvsOrErr returns the Envs value or an error if the edge
 // was not loaded in eager-loading.
 func (e TeamEdges) EnvsOrErr() ([]*Env, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Envs, nil
 	}
 	return nil, &NotLoadedError{edge: "envs"}
@@ -99,7 +110,7 @@ func (e TeamEdges) EnvsOrErr() ([]*Env, error) {
 // UsersTeamsOrErr returns the UsersTeams value or an error if the edge
 // was not loaded in eager-loading.
 func (e TeamEdges) UsersTeamsOrErr() ([]*UsersTeams, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.UsersTeams, nil
 	}
 	return nil, &NotLoadedError{edge: "users_teams"}
@@ -205,6 +216,11 @@ func (t *Team) QueryTeamAPIKeys() *TeamAPIKeyQuery {
 	return NewTeamClient(t.config).QueryTeamAPIKeys(t)
 }
 
+// QueryTeamWebhooks queries the "team_webhooks" edge of the Team entity.
+func (t *Team) QueryTeamWebhooks() *TeamWebhookQuery {
+	return NewTeamClient(t.config).QueryTeamWebhooks(t)
+}
+
 // QueryTeamTier queries the "team_tier" edge of the Team entity.
 func (t *Team) QueryTeamTier() *TierQuery {
 	return NewTeamClient(t.config).QueryTeamTier(t)
@@ -32,6 +32,8 @@ const (
 	EdgeUsers = "users"
 	// EdgeTeamAPIKeys holds the string denoting the team_api_keys edge name in mutations.
 	EdgeTeamAPIKeys = "team_api_keys"
+	// EdgeTeamWebhooks holds the string denoting the team_webhooks edge name in mutations.
+	EdgeTeamWebhooks = "team_webhooks"
 	// EdgeTeamTier holds the string denoting the team_tier edge name in mutations.
 	EdgeTeamTier = "team_tier"
 	// EdgeEnvs holds the string denoting the envs edge name in mutations.
@@ -52,6 +54,13 @@ const (
 	TeamAPIKeysInverseTable = "team_api_keys"
 	// TeamAPIKeysColumn is the table column denoting the team_api_keys relation/edge.
 	TeamAPIKeysColumn = "team_id"
+	// TeamWebhooksTable is the table that holds the team_webhooks relation/edge.
+	TeamWebhooksTable = "team_webhooks"
+	// TeamWebhooksInverseTable is the table name for the TeamWebhook entity.
+	// It exists in this package in order to avoid circular dependency with the "teamwebhook" package.
+	TeamWebhooksInverseTable = "team_webhooks"
+	// TeamWebhooksColumn is the table column denoting the team_webhooks relation/edge.
+	TeamWebhooksColumn = "team_id"
 	// TeamTierTable is the table that holds the team_tier relation/edge.
 	TeamTierTable = "teams"
 	// TeamTierInverseTable is the table name for the Tier entity.
@@ -181,6 +190,20 @@ func ByTeamAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByTeamWebhooksCount orders the results by team_webhooks count.
+func ByTeamWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamWebhooksStep(), opts...)
+	}
+}
+
+// ByTeamWebhooks orders the results by team_webhooks terms.
+func ByTeamWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByTeamTierField orders the results by team_tier field.
 func ByTeamTierField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -229,6 +252,13 @@ func newTeamAPIKeysStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TeamAPIKeysTable, TeamAPIKeysColumn),
 	)
 }
+func newTeamWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamWebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamWebhooksTable, TeamWebhooksColumn),
+	)
+}
 func newTeamTierStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
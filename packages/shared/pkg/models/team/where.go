@@ -500,6 +500,35 @@ func HasTeamAPIKeysWith(preds ...predicate.TeamAPIKey) predicate.Team {
 	})
 }
 
+// HasTeamWebhooks applies the HasEdge predicate on the "team_webhooks" edge.
+func HasTeamWebhooks() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamWebhooksTable, TeamWebhooksColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TeamWebhook
+		step.Edge.Schema = schemaConfig.TeamWebhook
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWebhooksWith applies the HasEdge predicate on the "team_webhooks" edge with a given conditions (other predicates).
+func HasTeamWebhooksWith(preds ...predicate.TeamWebhook) predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := newTeamWebhooksStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TeamWebhook
+		step.Edge.Schema = schemaConfig.TeamWebhook
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTeamTier applies the HasEdge predicate on the "team_tier" edge.
 func HasTeamTier() predicate.Team {
 	return predicate.Team(func(s *sql.Selector) {
@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
@@ -139,6 +140,21 @@ func (tc *TeamCreate) AddTeamAPIKeys(t ...*TeamAPIKey) *TeamCreate {
 	return tc.AddTeamAPIKeyIDs(ids...)
 }
 
+// AddTeamWebhookIDs adds the "team_webhooks" edge to the TeamWebhook entity by IDs.
+func (tc *TeamCreate) AddTeamWebhookIDs(ids ...uuid.UUID) *TeamCreate {
+	tc.mutation.AddTeamWebhookIDs(ids...)
+	return tc
+}
+
+// AddTeamWebhooks adds the "team_webhooks" edges to the TeamWebhook entity.
+func (tc *TeamCreate) AddTeamWebhooks(t ...*TeamWebhook) *TeamCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTeamWebhookIDs(ids...)
+}
+
 // SetTeamTierID sets the "team_tier" edge to the Tier entity by ID.
 func (tc *TeamCreate) SetTeamTierID(id string) *TeamCreate {
 	tc.mutation.SetTeamTierID(id)
@@ -342,6 +358,23 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.TeamWebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamWebhooksTable,
+			Columns: []string{team.TeamWebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tc.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.TeamTierIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
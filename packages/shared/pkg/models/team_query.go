@@ -16,6 +16,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
@@ -25,16 +26,17 @@ import (
 // TeamQuery is the builder for querying Team entities.
 type TeamQuery struct {
 	config
-	ctx             *QueryContext
-	order           []team.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Team
-	withUsers       *UserQuery
-	withTeamAPIKeys *TeamAPIKeyQuery
-	withTeamTier    *TierQuery
-	withEnvs        *EnvQuery
-	withUsersTeams  *UsersTeamsQuery
-	modifiers       []func(*sql.Selector)
+	ctx              *QueryContext
+	order            []team.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Team
+	withUsers        *UserQuery
+	withTeamAPIKeys  *TeamAPIKeyQuery
+	withTeamWebhooks *TeamWebhookQuery
+	withTeamTier     *TierQuery
+	withEnvs         *EnvQuery
+	withUsersTeams   *UsersTeamsQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -121,6 +123,31 @@ func (tq *TeamQuery) QueryTeamAPIKeys() *TeamAPIKeyQuery {
 	return query
 }
 
+// QueryTeamWebhooks chains the current query on the "team_webhooks" edge.
+func (tq *TeamQuery) QueryTeamWebhooks() *TeamWebhookQuery {
+	query := (&TeamWebhookClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(teamwebhook.Table, teamwebhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.TeamWebhooksTable, team.TeamWebhooksColumn),
+		)
+		schemaConfig := tq.schemaConfig
+		step.To.Schema = schemaConfig.TeamWebhook
+		step.Edge.Schema = schemaConfig.TeamWebhook
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTeamTier chains the current query on the "team_tier" edge.
 func (tq *TeamQuery) QueryTeamTier() *TierQuery {
 	query := (&TierClient{config: tq.config}).Query()
@@ -383,16 +410,17 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 		return nil
 	}
 	return &TeamQuery{
-		config:          tq.config,
-		ctx:             tq.ctx.Clone(),
-		order:           append([]team.OrderOption{}, tq.order...),
-		inters:          append([]Interceptor{}, tq.inters...),
-		predicates:      append([]predicate.Team{}, tq.predicates...),
-		withUsers:       tq.withUsers.Clone(),
-		withTeamAPIKeys: tq.withTeamAPIKeys.Clone(),
-		withTeamTier:    tq.withTeamTier.Clone(),
-		withEnvs:        tq.withEnvs.Clone(),
-		withUsersTeams:  tq.withUsersTeams.Clone(),
+		config:           tq.config,
+		ctx:              tq.ctx.Clone(),
+		order:            append([]team.OrderOption{}, tq.order...),
+		inters:           append([]Interceptor{}, tq.inters...),
+		predicates:       append([]predicate.Team{}, tq.predicates...),
+		withUsers:        tq.withUsers.Clone(),
+		withTeamAPIKeys:  tq.withTeamAPIKeys.Clone(),
+		withTeamWebhooks: tq.withTeamWebhooks.Clone(),
+		withTeamTier:     tq.withTeamTier.Clone(),
+		withEnvs:         tq.withEnvs.Clone(),
+		withUsersTeams:   tq.withUsersTeams.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -421,6 +449,17 @@ func (tq *TeamQuery) WithTeamAPIKeys(opts ...func(*TeamAPIKeyQuery)) *TeamQuery
 	return tq
 }
 
+// WithTeamWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "team_webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithTeamWebhooks(opts ...func(*TeamWebhookQuery)) *TeamQuery {
+	query := (&TeamWebhookClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTeamWebhooks = query
+	return tq
+}
+
 // WithTeamTier tells the query-builder to eager-load the nodes that are connected to
 // the "team_tier" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithTeamTier(opts ...func(*TierQuery)) *TeamQuery {
@@ -532,9 +571,10 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 	var (
 		nodes       = []*Team{}
 		_spec       = tq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			tq.withUsers != nil,
 			tq.withTeamAPIKeys != nil,
+			tq.withTeamWebhooks != nil,
 			tq.withTeamTier != nil,
 			tq.withEnvs != nil,
 			tq.withUsersTeams != nil,
@@ -577,6 +617,13 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 			return nil, err
 		}
 	}
+	if query := tq.withTeamWebhooks; query != nil {
+		if err := tq.loadTeamWebhooks(ctx, query, nodes,
+			func(n *Team) { n.Edges.TeamWebhooks = []*TeamWebhook{} },
+			func(n *Team, e *TeamWebhook) { n.Edges.TeamWebhooks = append(n.Edges.TeamWebhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withTeamTier; query != nil {
 		if err := tq.loadTeamTier(ctx, query, nodes, nil,
 			func(n *Team, e *Tier) { n.Edges.TeamTier = e }); err != nil {
@@ -692,6 +739,36 @@ func (tq *TeamQuery) loadTeamAPIKeys(ctx context.Context, query *TeamAPIKeyQuery
 	}
 	return nil
 }
+func (tq *TeamQuery) loadTeamWebhooks(ctx context.Context, query *TeamWebhookQuery, nodes []*Team, init func(*Team), assign func(*Team, *TeamWebhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(teamwebhook.FieldTeamID)
+	}
+	query.Where(predicate.TeamWebhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.TeamWebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tq *TeamQuery) loadTeamTier(ctx context.Context, query *TierQuery, nodes []*Team, init func(*Team), assign func(*Team, *Tier)) error {
 	ids := make([]string, 0, len(nodes))
 	nodeids := make(map[string][]*Team)
@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
@@ -167,6 +168,21 @@ func (tu *TeamUpdate) AddTeamAPIKeys(t ...*TeamAPIKey) *TeamUpdate {
 	return tu.AddTeamAPIKeyIDs(ids...)
 }
 
+// AddTeamWebhookIDs adds the "team_webhooks" edge to the TeamWebhook entity by IDs.
+func (tu *TeamUpdate) AddTeamWebhookIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.AddTeamWebhookIDs(ids...)
+	return tu
+}
+
+// AddTeamWebhooks adds the "team_webhooks" edges to the TeamWebhook entity.
+func (tu *TeamUpdate) AddTeamWebhooks(t ...*TeamWebhook) *TeamUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTeamWebhookIDs(ids...)
+}
+
 // SetTeamTierID sets the "team_tier" edge to the Tier entity by ID.
 func (tu *TeamUpdate) SetTeamTierID(id string) *TeamUpdate {
 	tu.mutation.SetTeamTierID(id)
@@ -255,6 +271,27 @@ func (tu *TeamUpdate) RemoveTeamAPIKeys(t ...*TeamAPIKey) *TeamUpdate {
 	return tu.RemoveTeamAPIKeyIDs(ids...)
 }
 
+// ClearTeamWebhooks clears all "team_webhooks" edges to the TeamWebhook entity.
+func (tu *TeamUpdate) ClearTeamWebhooks() *TeamUpdate {
+	tu.mutation.ClearTeamWebhooks()
+	return tu
+}
+
+// RemoveTeamWebhookIDs removes the "team_webhooks" edge to TeamWebhook entities by IDs.
+func (tu *TeamUpdate) RemoveTeamWebhookIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.RemoveTeamWebhookIDs(ids...)
+	return tu
+}
+
+// RemoveTeamWebhooks removes "team_webhooks" edges to TeamWebhook entities.
+func (tu *TeamUpdate) RemoveTeamWebhooks(t ...*TeamWebhook) *TeamUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTeamWebhookIDs(ids...)
+}
+
 // ClearTeamTier clears the "team_tier" edge to the Tier entity.
 func (tu *TeamUpdate) ClearTeamTier() *TeamUpdate {
 	tu.mutation.ClearTeamTier()
@@ -493,6 +530,54 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.TeamWebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamWebhooksTable,
+			Columns: []string{team.TeamWebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TeamWebhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTeamWebhooksIDs(); len(nodes) > 0 && !tu.mutation.TeamWebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamWebhooksTable,
+			Columns: []string{team.TeamWebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TeamWebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamWebhooksTable,
+			Columns: []string{team.TeamWebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.TeamTierCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -776,6 +861,21 @@ func (tuo *TeamUpdateOne) AddTeamAPIKeys(t ...*TeamAPIKey) *TeamUpdateOne {
 	return tuo.AddTeamAPIKeyIDs(ids...)
 }
 
+// AddTeamWebhookIDs adds the "team_webhooks" edge to the TeamWebhook entity by IDs.
+func (tuo *TeamUpdateOne) AddTeamWebhookIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.AddTeamWebhookIDs(ids...)
+	return tuo
+}
+
+// AddTeamWebhooks adds the "team_webhooks" edges to the TeamWebhook entity.
+func (tuo *TeamUpdateOne) AddTeamWebhooks(t ...*TeamWebhook) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTeamWebhookIDs(ids...)
+}
+
 // SetTeamTierID sets the "team_tier" edge to the Tier entity by ID.
 func (tuo *TeamUpdateOne) SetTeamTierID(id string) *TeamUpdateOne {
 	tuo.mutation.SetTeamTierID(id)
@@ -864,6 +964,27 @@ func (tuo *TeamUpdateOne) RemoveTeamAPIKeys(t ...*TeamAPIKey) *TeamUpdateOne {
 	return tuo.RemoveTeamAPIKeyIDs(ids...)
 }
 
+// ClearTeamWebhooks clears all "team_webhooks" edges to the TeamWebhook entity.
+func (tuo *TeamUpdateOne) ClearTeamWebhooks() *TeamUpdateOne {
+	tuo.mutation.ClearTeamWebhooks()
+	return tuo
+}
+
+// RemoveTeamWebhookIDs removes the "team_webhooks" edge to TeamWebhook entities by IDs.
+func (tuo *TeamUpdateOne) RemoveTeamWebhookIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.RemoveTeamWebhookIDs(ids...)
+	return tuo
+}
+
+// RemoveTeamWebhooks removes "team_webhooks" edges to TeamWebhook entities.
+func (tuo *TeamUpdateOne) RemoveTeamWebhooks(t ...*TeamWebhook) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTeamWebhookIDs(ids...)
+}
+
 // ClearTeamTier clears the "team_tier" edge to the Tier entity.
 func (tuo *TeamUpdateOne) ClearTeamTier() *TeamUpdateOne {
 	tuo.mutation.ClearTeamTier()
@@ -1132,6 +1253,54 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.TeamWebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamWebhooksTable,
+			Columns: []string{team.TeamWebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TeamWebhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTeamWebhooksIDs(); len(nodes) > 0 && !tuo.mutation.TeamWebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamWebhooksTable,
+			Columns: []string{team.TeamWebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TeamWebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.TeamWebhooksTable,
+			Columns: []string{team.TeamWebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.TeamTierCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -34,6 +35,12 @@ type TeamAPIKey struct {
 	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
 	// LastUsed holds the value of the "last_used" field.
 	LastUsed *time.Time `json:"last_used,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// TemplateID holds the value of the "template_id" field.
+	TemplateID *string `json:"template_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TeamAPIKeyQuery when eager-loading is set.
 	Edges        TeamAPIKeyEdges `json:"edges"`
@@ -84,9 +91,11 @@ func (*TeamAPIKey) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case teamapikey.FieldCreatedBy:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case teamapikey.FieldAPIKey, teamapikey.FieldName:
+		case teamapikey.FieldScopes:
+			values[i] = new([]byte)
+		case teamapikey.FieldAPIKey, teamapikey.FieldName, teamapikey.FieldTemplateID:
 			values[i] = new(sql.NullString)
-		case teamapikey.FieldCreatedAt, teamapikey.FieldUpdatedAt, teamapikey.FieldLastUsed:
+		case teamapikey.FieldCreatedAt, teamapikey.FieldUpdatedAt, teamapikey.FieldLastUsed, teamapikey.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case teamapikey.FieldID, teamapikey.FieldTeamID:
 			values[i] = new(uuid.UUID)
@@ -156,6 +165,28 @@ func (tak *TeamAPIKey) assignValues(columns []string, values []any) error {
 				tak.LastUsed = new(time.Time)
 				*tak.LastUsed = value.Time
 			}
+		case teamapikey.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tak.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case teamapikey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				tak.ExpiresAt = new(time.Time)
+				*tak.ExpiresAt = value.Time
+			}
+		case teamapikey.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				tak.TemplateID = new(string)
+				*tak.TemplateID = value.String
+			}
 		default:
 			tak.selectValues.Set(columns[i], values[i])
 		}
@@ -227,6 +258,19 @@ func (tak *TeamAPIKey) String() string {
 		builder.WriteString("last_used=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", tak.Scopes))
+	builder.WriteString(", ")
+	if v := tak.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tak.TemplateID; v != nil {
+		builder.WriteString("template_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
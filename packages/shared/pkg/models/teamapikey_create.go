@@ -108,6 +108,40 @@ func (takc *TeamAPIKeyCreate) SetNillableLastUsed(t *time.Time) *TeamAPIKeyCreat
 	return takc
 }
 
+// SetScopes sets the "scopes" field.
+func (takc *TeamAPIKeyCreate) SetScopes(s []string) *TeamAPIKeyCreate {
+	takc.mutation.SetScopes(s)
+	return takc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (takc *TeamAPIKeyCreate) SetExpiresAt(t time.Time) *TeamAPIKeyCreate {
+	takc.mutation.SetExpiresAt(t)
+	return takc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (takc *TeamAPIKeyCreate) SetNillableExpiresAt(t *time.Time) *TeamAPIKeyCreate {
+	if t != nil {
+		takc.SetExpiresAt(*t)
+	}
+	return takc
+}
+
+// SetTemplateID sets the "template_id" field.
+func (takc *TeamAPIKeyCreate) SetTemplateID(s string) *TeamAPIKeyCreate {
+	takc.mutation.SetTemplateID(s)
+	return takc
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (takc *TeamAPIKeyCreate) SetNillableTemplateID(s *string) *TeamAPIKeyCreate {
+	if s != nil {
+		takc.SetTemplateID(*s)
+	}
+	return takc
+}
+
 // SetID sets the "id" field.
 func (takc *TeamAPIKeyCreate) SetID(u uuid.UUID) *TeamAPIKeyCreate {
 	takc.mutation.SetID(u)
@@ -257,6 +291,18 @@ func (takc *TeamAPIKeyCreate) createSpec() (*TeamAPIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(teamapikey.FieldLastUsed, field.TypeTime, value)
 		_node.LastUsed = &value
 	}
+	if value, ok := takc.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := takc.mutation.ExpiresAt(); ok {
+		_spec.SetField(teamapikey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := takc.mutation.TemplateID(); ok {
+		_spec.SetField(teamapikey.FieldTemplateID, field.TypeString, value)
+		_node.TemplateID = &value
+	}
 	if nodes := takc.mutation.TeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -435,6 +481,60 @@ func (u *TeamAPIKeyUpsert) ClearLastUsed() *TeamAPIKeyUpsert {
 	return u
 }
 
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsert) SetScopes(v []string) *TeamAPIKeyUpsert {
+	u.Set(teamapikey.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsert) UpdateScopes() *TeamAPIKeyUpsert {
+	u.SetExcluded(teamapikey.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsert) ClearScopes() *TeamAPIKeyUpsert {
+	u.SetNull(teamapikey.FieldScopes)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamAPIKeyUpsert) SetExpiresAt(v time.Time) *TeamAPIKeyUpsert {
+	u.Set(teamapikey.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsert) UpdateExpiresAt() *TeamAPIKeyUpsert {
+	u.SetExcluded(teamapikey.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TeamAPIKeyUpsert) ClearExpiresAt() *TeamAPIKeyUpsert {
+	u.SetNull(teamapikey.FieldExpiresAt)
+	return u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (u *TeamAPIKeyUpsert) SetTemplateID(v string) *TeamAPIKeyUpsert {
+	u.Set(teamapikey.FieldTemplateID, v)
+	return u
+}
+
+// UpdateTemplateID sets the "template_id" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsert) UpdateTemplateID() *TeamAPIKeyUpsert {
+	u.SetExcluded(teamapikey.FieldTemplateID)
+	return u
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (u *TeamAPIKeyUpsert) ClearTemplateID() *TeamAPIKeyUpsert {
+	u.SetNull(teamapikey.FieldTemplateID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -591,6 +691,69 @@ func (u *TeamAPIKeyUpsertOne) ClearLastUsed() *TeamAPIKeyUpsertOne {
 	})
 }
 
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsertOne) SetScopes(v []string) *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertOne) UpdateScopes() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsertOne) ClearScopes() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamAPIKeyUpsertOne) SetExpiresAt(v time.Time) *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertOne) UpdateExpiresAt() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TeamAPIKeyUpsertOne) ClearExpiresAt() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetTemplateID sets the "template_id" field.
+func (u *TeamAPIKeyUpsertOne) SetTemplateID(v string) *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetTemplateID(v)
+	})
+}
+
+// UpdateTemplateID sets the "template_id" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertOne) UpdateTemplateID() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateTemplateID()
+	})
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (u *TeamAPIKeyUpsertOne) ClearTemplateID() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearTemplateID()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamAPIKeyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -914,6 +1077,69 @@ func (u *TeamAPIKeyUpsertBulk) ClearLastUsed() *TeamAPIKeyUpsertBulk {
 	})
 }
 
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsertBulk) SetScopes(v []string) *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertBulk) UpdateScopes() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsertBulk) ClearScopes() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamAPIKeyUpsertBulk) SetExpiresAt(v time.Time) *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertBulk) UpdateExpiresAt() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TeamAPIKeyUpsertBulk) ClearExpiresAt() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetTemplateID sets the "template_id" field.
+func (u *TeamAPIKeyUpsertBulk) SetTemplateID(v string) *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetTemplateID(v)
+	})
+}
+
+// UpdateTemplateID sets the "template_id" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertBulk) UpdateTemplateID() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateTemplateID()
+	})
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (u *TeamAPIKeyUpsertBulk) ClearTemplateID() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearTemplateID()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamAPIKeyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
@@ -135,6 +136,64 @@ func (taku *TeamAPIKeyUpdate) ClearLastUsed() *TeamAPIKeyUpdate {
 	return taku
 }
 
+// SetScopes sets the "scopes" field.
+func (taku *TeamAPIKeyUpdate) SetScopes(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.SetScopes(s)
+	return taku
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (taku *TeamAPIKeyUpdate) AppendScopes(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.AppendScopes(s)
+	return taku
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (taku *TeamAPIKeyUpdate) ClearScopes() *TeamAPIKeyUpdate {
+	taku.mutation.ClearScopes()
+	return taku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (taku *TeamAPIKeyUpdate) SetExpiresAt(t time.Time) *TeamAPIKeyUpdate {
+	taku.mutation.SetExpiresAt(t)
+	return taku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (taku *TeamAPIKeyUpdate) SetNillableExpiresAt(t *time.Time) *TeamAPIKeyUpdate {
+	if t != nil {
+		taku.SetExpiresAt(*t)
+	}
+	return taku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (taku *TeamAPIKeyUpdate) ClearExpiresAt() *TeamAPIKeyUpdate {
+	taku.mutation.ClearExpiresAt()
+	return taku
+}
+
+// SetTemplateID sets the "template_id" field.
+func (taku *TeamAPIKeyUpdate) SetTemplateID(s string) *TeamAPIKeyUpdate {
+	taku.mutation.SetTemplateID(s)
+	return taku
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (taku *TeamAPIKeyUpdate) SetNillableTemplateID(s *string) *TeamAPIKeyUpdate {
+	if s != nil {
+		taku.SetTemplateID(*s)
+	}
+	return taku
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (taku *TeamAPIKeyUpdate) ClearTemplateID() *TeamAPIKeyUpdate {
+	taku.mutation.ClearTemplateID()
+	return taku
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (taku *TeamAPIKeyUpdate) SetTeam(t *Team) *TeamAPIKeyUpdate {
 	return taku.SetTeamID(t.ID)
@@ -247,6 +306,29 @@ func (taku *TeamAPIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if taku.mutation.LastUsedCleared() {
 		_spec.ClearField(teamapikey.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := taku.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := taku.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldScopes, value)
+		})
+	}
+	if taku.mutation.ScopesCleared() {
+		_spec.ClearField(teamapikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := taku.mutation.ExpiresAt(); ok {
+		_spec.SetField(teamapikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if taku.mutation.ExpiresAtCleared() {
+		_spec.ClearField(teamapikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := taku.mutation.TemplateID(); ok {
+		_spec.SetField(teamapikey.FieldTemplateID, field.TypeString, value)
+	}
+	if taku.mutation.TemplateIDCleared() {
+		_spec.ClearField(teamapikey.FieldTemplateID, field.TypeString)
+	}
 	if taku.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -435,6 +517,64 @@ func (takuo *TeamAPIKeyUpdateOne) ClearLastUsed() *TeamAPIKeyUpdateOne {
 	return takuo
 }
 
+// SetScopes sets the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) SetScopes(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.SetScopes(s)
+	return takuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) AppendScopes(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.AppendScopes(s)
+	return takuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) ClearScopes() *TeamAPIKeyUpdateOne {
+	takuo.mutation.ClearScopes()
+	return takuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (takuo *TeamAPIKeyUpdateOne) SetExpiresAt(t time.Time) *TeamAPIKeyUpdateOne {
+	takuo.mutation.SetExpiresAt(t)
+	return takuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (takuo *TeamAPIKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *TeamAPIKeyUpdateOne {
+	if t != nil {
+		takuo.SetExpiresAt(*t)
+	}
+	return takuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (takuo *TeamAPIKeyUpdateOne) ClearExpiresAt() *TeamAPIKeyUpdateOne {
+	takuo.mutation.ClearExpiresAt()
+	return takuo
+}
+
+// SetTemplateID sets the "template_id" field.
+func (takuo *TeamAPIKeyUpdateOne) SetTemplateID(s string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.SetTemplateID(s)
+	return takuo
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (takuo *TeamAPIKeyUpdateOne) SetNillableTemplateID(s *string) *TeamAPIKeyUpdateOne {
+	if s != nil {
+		takuo.SetTemplateID(*s)
+	}
+	return takuo
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (takuo *TeamAPIKeyUpdateOne) ClearTemplateID() *TeamAPIKeyUpdateOne {
+	takuo.mutation.ClearTemplateID()
+	return takuo
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (takuo *TeamAPIKeyUpdateOne) SetTeam(t *Team) *TeamAPIKeyUpdateOne {
 	return takuo.SetTeamID(t.ID)
@@ -577,6 +717,29 @@ func (takuo *TeamAPIKeyUpdateOne) sqlSave(ctx context.Context) (_node *TeamAPIKe
 	if takuo.mutation.LastUsedCleared() {
 		_spec.ClearField(teamapikey.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := takuo.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := takuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldScopes, value)
+		})
+	}
+	if takuo.mutation.ScopesCleared() {
+		_spec.ClearField(teamapikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := takuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(teamapikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if takuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(teamapikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := takuo.mutation.TemplateID(); ok {
+		_spec.SetField(teamapikey.FieldTemplateID, field.TypeString, value)
+	}
+	if takuo.mutation.TemplateIDCleared() {
+		_spec.ClearField(teamapikey.FieldTemplateID, field.TypeString)
+	}
 	if takuo.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
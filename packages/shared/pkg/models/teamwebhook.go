@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
+	"github.com/google/uuid"
+)
+
+// TeamWebhook is the model entity for the TeamWebhook schema.
+type TeamWebhook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamWebhookQuery when eager-loading is set.
+	Edges        TeamWebhookEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TeamWebhookEdges holds the relations/edges for other nodes in the graph.
+type TeamWebhookEdges struct {
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery `json:"deliveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamWebhookEdges) TeamOrErr() (*Team, error) {
+	if e.loadedTypes[0] {
+		if e.Team == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: team.Label}
+		}
+		return e.Team, nil
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamWebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[1] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamWebhook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teamwebhook.FieldEvents:
+			values[i] = new([]byte)
+		case teamwebhook.FieldURL, teamwebhook.FieldSecret:
+			values[i] = new(sql.NullString)
+		case teamwebhook.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case teamwebhook.FieldID, teamwebhook.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamWebhook fields.
+func (tw *TeamWebhook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teamwebhook.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tw.ID = *value
+			}
+		case teamwebhook.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tw.CreatedAt = value.Time
+			}
+		case teamwebhook.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				tw.TeamID = *value
+			}
+		case teamwebhook.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				tw.URL = value.String
+			}
+		case teamwebhook.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				tw.Secret = value.String
+			}
+		case teamwebhook.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tw.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		default:
+			tw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamWebhook.
+// This includes values selected through modifiers, order, etc.
+func (tw *TeamWebhook) Value(name string) (ent.Value, error) {
+	return tw.selectValues.Get(name)
+}
+
+// QueryTeam queries the "team" edge of the TeamWebhook entity.
+func (tw *TeamWebhook) QueryTeam() *TeamQuery {
+	return NewTeamWebhookClient(tw.config).QueryTeam(tw)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the TeamWebhook entity.
+func (tw *TeamWebhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return NewTeamWebhookClient(tw.config).QueryDeliveries(tw)
+}
+
+// Update returns a builder for updating this TeamWebhook.
+// Note that you need to call TeamWebhook.Unwrap() before calling this method if this TeamWebhook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tw *TeamWebhook) Update() *TeamWebhookUpdateOne {
+	return NewTeamWebhookClient(tw.config).UpdateOne(tw)
+}
+
+// Unwrap unwraps the TeamWebhook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tw *TeamWebhook) Unwrap() *TeamWebhook {
+	_tx, ok := tw.config.driver.(*txDriver)
+	if !ok {
+		panic("models: TeamWebhook is not a transactional entity")
+	}
+	tw.config.driver = _tx.drv
+	return tw
+}
+
+// String implements the fmt.Stringer.
+func (tw *TeamWebhook) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamWebhook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tw.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tw.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", tw.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(tw.URL)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", tw.Events))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamWebhooks is a parsable slice of TeamWebhook.
+type TeamWebhooks []*TeamWebhook
@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// TeamWebhookCreate is the builder for creating a TeamWebhook entity.
+type TeamWebhookCreate struct {
+	config
+	mutation *TeamWebhookMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (twc *TeamWebhookCreate) SetCreatedAt(t time.Time) *TeamWebhookCreate {
+	twc.mutation.SetCreatedAt(t)
+	return twc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (twc *TeamWebhookCreate) SetNillableCreatedAt(t *time.Time) *TeamWebhookCreate {
+	if t != nil {
+		twc.SetCreatedAt(*t)
+	}
+	return twc
+}
+
+// SetTeamID sets the "team_id" field.
+func (twc *TeamWebhookCreate) SetTeamID(u uuid.UUID) *TeamWebhookCreate {
+	twc.mutation.SetTeamID(u)
+	return twc
+}
+
+// SetURL sets the "url" field.
+func (twc *TeamWebhookCreate) SetURL(s string) *TeamWebhookCreate {
+	twc.mutation.SetURL(s)
+	return twc
+}
+
+// SetSecret sets the "secret" field.
+func (twc *TeamWebhookCreate) SetSecret(s string) *TeamWebhookCreate {
+	twc.mutation.SetSecret(s)
+	return twc
+}
+
+// SetEvents sets the "events" field.
+func (twc *TeamWebhookCreate) SetEvents(s []string) *TeamWebhookCreate {
+	twc.mutation.SetEvents(s)
+	return twc
+}
+
+// SetID sets the "id" field.
+func (twc *TeamWebhookCreate) SetID(u uuid.UUID) *TeamWebhookCreate {
+	twc.mutation.SetID(u)
+	return twc
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (twc *TeamWebhookCreate) SetTeam(t *Team) *TeamWebhookCreate {
+	return twc.SetTeamID(t.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (twc *TeamWebhookCreate) AddDeliveryIDs(ids ...uuid.UUID) *TeamWebhookCreate {
+	twc.mutation.AddDeliveryIDs(ids...)
+	return twc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (twc *TeamWebhookCreate) AddDeliveries(w ...*WebhookDelivery) *TeamWebhookCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return twc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the TeamWebhookMutation object of the builder.
+func (twc *TeamWebhookCreate) Mutation() *TeamWebhookMutation {
+	return twc.mutation
+}
+
+// Save creates the TeamWebhook in the database.
+func (twc *TeamWebhookCreate) Save(ctx context.Context) (*TeamWebhook, error) {
+	twc.defaults()
+	return withHooks(ctx, twc.sqlSave, twc.mutation, twc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (twc *TeamWebhookCreate) SaveX(ctx context.Context) *TeamWebhook {
+	v, err := twc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (twc *TeamWebhookCreate) Exec(ctx context.Context) error {
+	_, err := twc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twc *TeamWebhookCreate) ExecX(ctx context.Context) {
+	if err := twc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (twc *TeamWebhookCreate) defaults() {
+	if _, ok := twc.mutation.CreatedAt(); !ok {
+		v := teamwebhook.DefaultCreatedAt()
+		twc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twc *TeamWebhookCreate) check() error {
+	if _, ok := twc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "TeamWebhook.created_at"`)}
+	}
+	if _, ok := twc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "TeamWebhook.team_id"`)}
+	}
+	if _, ok := twc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`models: missing required field "TeamWebhook.url"`)}
+	}
+	if _, ok := twc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`models: missing required field "TeamWebhook.secret"`)}
+	}
+	if _, ok := twc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team", err: errors.New(`models: missing required edge "TeamWebhook.team"`)}
+	}
+	return nil
+}
+
+func (twc *TeamWebhookCreate) sqlSave(ctx context.Context) (*TeamWebhook, error) {
+	if err := twc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := twc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, twc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	twc.mutation.id = &_node.ID
+	twc.mutation.done = true
+	return _node, nil
+}
+
+func (twc *TeamWebhookCreate) createSpec() (*TeamWebhook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamWebhook{config: twc.config}
+		_spec = sqlgraph.NewCreateSpec(teamwebhook.Table, sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = twc.schemaConfig.TeamWebhook
+	_spec.OnConflict = twc.conflict
+	if id, ok := twc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := twc.mutation.CreatedAt(); ok {
+		_spec.SetField(teamwebhook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := twc.mutation.URL(); ok {
+		_spec.SetField(teamwebhook.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := twc.mutation.Secret(); ok {
+		_spec.SetField(teamwebhook.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := twc.mutation.Events(); ok {
+		_spec.SetField(teamwebhook.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if nodes := twc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teamwebhook.TeamTable,
+			Columns: []string{teamwebhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twc.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := twc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teamwebhook.DeliveriesTable,
+			Columns: []string{teamwebhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twc.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamWebhook.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamWebhookUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (twc *TeamWebhookCreate) OnConflict(opts ...sql.ConflictOption) *TeamWebhookUpsertOne {
+	twc.conflict = opts
+	return &TeamWebhookUpsertOne{
+		create: twc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamWebhook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (twc *TeamWebhookCreate) OnConflictColumns(columns ...string) *TeamWebhookUpsertOne {
+	twc.conflict = append(twc.conflict, sql.ConflictColumns(columns...))
+	return &TeamWebhookUpsertOne{
+		create: twc,
+	}
+}
+
+type (
+	// TeamWebhookUpsertOne is the builder for "upsert"-ing
+	//  one TeamWebhook node.
+	TeamWebhookUpsertOne struct {
+		create *TeamWebhookCreate
+	}
+
+	// TeamWebhookUpsert is the "OnConflict" setter.
+	TeamWebhookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTeamID sets the "team_id" field.
+func (u *TeamWebhookUpsert) SetTeamID(v uuid.UUID) *TeamWebhookUpsert {
+	u.Set(teamwebhook.FieldTeamID, v)
+	return u
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *TeamWebhookUpsert) UpdateTeamID() *TeamWebhookUpsert {
+	u.SetExcluded(teamwebhook.FieldTeamID)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *TeamWebhookUpsert) SetURL(v string) *TeamWebhookUpsert {
+	u.Set(teamwebhook.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *TeamWebhookUpsert) UpdateURL() *TeamWebhookUpsert {
+	u.SetExcluded(teamwebhook.FieldURL)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *TeamWebhookUpsert) SetSecret(v string) *TeamWebhookUpsert {
+	u.Set(teamwebhook.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *TeamWebhookUpsert) UpdateSecret() *TeamWebhookUpsert {
+	u.SetExcluded(teamwebhook.FieldSecret)
+	return u
+}
+
+// SetEvents sets the "events" field.
+func (u *TeamWebhookUpsert) SetEvents(v []string) *TeamWebhookUpsert {
+	u.Set(teamwebhook.FieldEvents, v)
+	return u
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *TeamWebhookUpsert) UpdateEvents() *TeamWebhookUpsert {
+	u.SetExcluded(teamwebhook.FieldEvents)
+	return u
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *TeamWebhookUpsert) ClearEvents() *TeamWebhookUpsert {
+	u.SetNull(teamwebhook.FieldEvents)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamWebhook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamwebhook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamWebhookUpsertOne) UpdateNewValues() *TeamWebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teamwebhook.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teamwebhook.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamWebhook.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamWebhookUpsertOne) Ignore() *TeamWebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamWebhookUpsertOne) DoNothing() *TeamWebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamWebhookCreate.OnConflict
+// documentation for more info.
+func (u *TeamWebhookUpsertOne) Update(set func(*TeamWebhookUpsert)) *TeamWebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamWebhookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *TeamWebhookUpsertOne) SetTeamID(v uuid.UUID) *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *TeamWebhookUpsertOne) UpdateTeamID() *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *TeamWebhookUpsertOne) SetURL(v string) *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *TeamWebhookUpsertOne) UpdateURL() *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *TeamWebhookUpsertOne) SetSecret(v string) *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *TeamWebhookUpsertOne) UpdateSecret() *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *TeamWebhookUpsertOne) SetEvents(v []string) *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *TeamWebhookUpsertOne) UpdateEvents() *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *TeamWebhookUpsertOne) ClearEvents() *TeamWebhookUpsertOne {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.ClearEvents()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamWebhookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamWebhookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamWebhookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamWebhookUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: TeamWebhookUpsertOne.ID is not supported by MySQL driver. Use TeamWebhookUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamWebhookUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamWebhookCreateBulk is the builder for creating many TeamWebhook entities in bulk.
+type TeamWebhookCreateBulk struct {
+	config
+	err      error
+	builders []*TeamWebhookCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamWebhook entities in the database.
+func (twcb *TeamWebhookCreateBulk) Save(ctx context.Context) ([]*TeamWebhook, error) {
+	if twcb.err != nil {
+		return nil, twcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(twcb.builders))
+	nodes := make([]*TeamWebhook, len(twcb.builders))
+	mutators := make([]Mutator, len(twcb.builders))
+	for i := range twcb.builders {
+		func(i int, root context.Context) {
+			builder := twcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamWebhookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, twcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = twcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, twcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, twcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twcb *TeamWebhookCreateBulk) SaveX(ctx context.Context) []*TeamWebhook {
+	v, err := twcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (twcb *TeamWebhookCreateBulk) Exec(ctx context.Context) error {
+	_, err := twcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twcb *TeamWebhookCreateBulk) ExecX(ctx context.Context) {
+	if err := twcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamWebhook.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamWebhookUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (twcb *TeamWebhookCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamWebhookUpsertBulk {
+	twcb.conflict = opts
+	return &TeamWebhookUpsertBulk{
+		create: twcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamWebhook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (twcb *TeamWebhookCreateBulk) OnConflictColumns(columns ...string) *TeamWebhookUpsertBulk {
+	twcb.conflict = append(twcb.conflict, sql.ConflictColumns(columns...))
+	return &TeamWebhookUpsertBulk{
+		create: twcb,
+	}
+}
+
+// TeamWebhookUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamWebhook nodes.
+type TeamWebhookUpsertBulk struct {
+	create *TeamWebhookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamWebhook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamwebhook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamWebhookUpsertBulk) UpdateNewValues() *TeamWebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teamwebhook.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teamwebhook.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamWebhook.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamWebhookUpsertBulk) Ignore() *TeamWebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamWebhookUpsertBulk) DoNothing() *TeamWebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamWebhookCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamWebhookUpsertBulk) Update(set func(*TeamWebhookUpsert)) *TeamWebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamWebhookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *TeamWebhookUpsertBulk) SetTeamID(v uuid.UUID) *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *TeamWebhookUpsertBulk) UpdateTeamID() *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *TeamWebhookUpsertBulk) SetURL(v string) *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *TeamWebhookUpsertBulk) UpdateURL() *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *TeamWebhookUpsertBulk) SetSecret(v string) *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *TeamWebhookUpsertBulk) UpdateSecret() *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *TeamWebhookUpsertBulk) SetEvents(v []string) *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *TeamWebhookUpsertBulk) UpdateEvents() *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *TeamWebhookUpsertBulk) ClearEvents() *TeamWebhookUpsertBulk {
+	return u.Update(func(s *TeamWebhookUpsert) {
+		s.ClearEvents()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamWebhookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the TeamWebhookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for TeamWebhookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamWebhookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
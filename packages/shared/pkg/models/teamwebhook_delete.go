@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
+)
+
+// TeamWebhookDelete is the builder for deleting a TeamWebhook entity.
+type TeamWebhookDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamWebhookMutation
+}
+
+// Where appends a list predicates to the TeamWebhookDelete builder.
+func (twd *TeamWebhookDelete) Where(ps ...predicate.TeamWebhook) *TeamWebhookDelete {
+	twd.mutation.Where(ps...)
+	return twd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (twd *TeamWebhookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, twd.sqlExec, twd.mutation, twd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twd *TeamWebhookDelete) ExecX(ctx context.Context) int {
+	n, err := twd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (twd *TeamWebhookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teamwebhook.Table, sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID))
+	_spec.Node.Schema = twd.schemaConfig.TeamWebhook
+	ctx = internal.NewSchemaConfigContext(ctx, twd.schemaConfig)
+	if ps := twd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, twd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	twd.mutation.done = true
+	return affected, err
+}
+
+// TeamWebhookDeleteOne is the builder for deleting a single TeamWebhook entity.
+type TeamWebhookDeleteOne struct {
+	twd *TeamWebhookDelete
+}
+
+// Where appends a list predicates to the TeamWebhookDelete builder.
+func (twdo *TeamWebhookDeleteOne) Where(ps ...predicate.TeamWebhook) *TeamWebhookDeleteOne {
+	twdo.twd.mutation.Where(ps...)
+	return twdo
+}
+
+// Exec executes the deletion query.
+func (twdo *TeamWebhookDeleteOne) Exec(ctx context.Context) error {
+	n, err := twdo.twd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teamwebhook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twdo *TeamWebhookDeleteOne) ExecX(ctx context.Context) {
+	if err := twdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
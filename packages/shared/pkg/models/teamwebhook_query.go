@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// TeamWebhookQuery is the builder for querying TeamWebhook entities.
+type TeamWebhookQuery struct {
+	config
+	ctx            *QueryContext
+	order          []teamwebhook.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.TeamWebhook
+	withTeam       *TeamQuery
+	withDeliveries *WebhookDeliveryQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamWebhookQuery builder.
+func (twq *TeamWebhookQuery) Where(ps ...predicate.TeamWebhook) *TeamWebhookQuery {
+	twq.predicates = append(twq.predicates, ps...)
+	return twq
+}
+
+// Limit the number of records to be returned by this query.
+func (twq *TeamWebhookQuery) Limit(limit int) *TeamWebhookQuery {
+	twq.ctx.Limit = &limit
+	return twq
+}
+
+// Offset to start from.
+func (twq *TeamWebhookQuery) Offset(offset int) *TeamWebhookQuery {
+	twq.ctx.Offset = &offset
+	return twq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (twq *TeamWebhookQuery) Unique(unique bool) *TeamWebhookQuery {
+	twq.ctx.Unique = &unique
+	return twq
+}
+
+// Order specifies how the records should be ordered.
+func (twq *TeamWebhookQuery) Order(o ...teamwebhook.OrderOption) *TeamWebhookQuery {
+	twq.order = append(twq.order, o...)
+	return twq
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (twq *TeamWebhookQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: twq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := twq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := twq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamwebhook.Table, teamwebhook.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamwebhook.TeamTable, teamwebhook.TeamColumn),
+		)
+		schemaConfig := twq.schemaConfig
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.TeamWebhook
+		fromU = sqlgraph.SetNeighbors(twq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeliveries chains the current query on the "deliveries" edge.
+func (twq *TeamWebhookQuery) QueryDeliveries() *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: twq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := twq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := twq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamwebhook.Table, teamwebhook.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, teamwebhook.DeliveriesTable, teamwebhook.DeliveriesColumn),
+		)
+		schemaConfig := twq.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromU = sqlgraph.SetNeighbors(twq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TeamWebhook entity from the query.
+// Returns a *NotFoundError when no TeamWebhook was found.
+func (twq *TeamWebhookQuery) First(ctx context.Context) (*TeamWebhook, error) {
+	nodes, err := twq.Limit(1).All(setContextOp(ctx, twq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teamwebhook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (twq *TeamWebhookQuery) FirstX(ctx context.Context) *TeamWebhook {
+	node, err := twq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamWebhook ID from the query.
+// Returns a *NotFoundError when no TeamWebhook ID was found.
+func (twq *TeamWebhookQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = twq.Limit(1).IDs(setContextOp(ctx, twq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teamwebhook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (twq *TeamWebhookQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := twq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamWebhook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamWebhook entity is found.
+// Returns a *NotFoundError when no TeamWebhook entities are found.
+func (twq *TeamWebhookQuery) Only(ctx context.Context) (*TeamWebhook, error) {
+	nodes, err := twq.Limit(2).All(setContextOp(ctx, twq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teamwebhook.Label}
+	default:
+		return nil, &NotSingularError{teamwebhook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (twq *TeamWebhookQuery) OnlyX(ctx context.Context) *TeamWebhook {
+	node, err := twq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamWebhook ID in the query.
+// Returns a *NotSingularError when more than one TeamWebhook ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (twq *TeamWebhookQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = twq.Limit(2).IDs(setContextOp(ctx, twq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teamwebhook.Label}
+	default:
+		err = &NotSingularError{teamwebhook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (twq *TeamWebhookQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := twq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamWebhooks.
+func (twq *TeamWebhookQuery) All(ctx context.Context) ([]*TeamWebhook, error) {
+	ctx = setContextOp(ctx, twq.ctx, "All")
+	if err := twq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamWebhook, *TeamWebhookQuery]()
+	return withInterceptors[[]*TeamWebhook](ctx, twq, qr, twq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (twq *TeamWebhookQuery) AllX(ctx context.Context) []*TeamWebhook {
+	nodes, err := twq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamWebhook IDs.
+func (twq *TeamWebhookQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if twq.ctx.Unique == nil && twq.path != nil {
+		twq.Unique(true)
+	}
+	ctx = setContextOp(ctx, twq.ctx, "IDs")
+	if err = twq.Select(teamwebhook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (twq *TeamWebhookQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := twq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (twq *TeamWebhookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, twq.ctx, "Count")
+	if err := twq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, twq, querierCount[*TeamWebhookQuery](), twq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (twq *TeamWebhookQuery) CountX(ctx context.Context) int {
+	count, err := twq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (twq *TeamWebhookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, twq.ctx, "Exist")
+	switch _, err := twq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (twq *TeamWebhookQuery) ExistX(ctx context.Context) bool {
+	exist, err := twq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamWebhookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (twq *TeamWebhookQuery) Clone() *TeamWebhookQuery {
+	if twq == nil {
+		return nil
+	}
+	return &TeamWebhookQuery{
+		config:         twq.config,
+		ctx:            twq.ctx.Clone(),
+		order:          append([]teamwebhook.OrderOption{}, twq.order...),
+		inters:         append([]Interceptor{}, twq.inters...),
+		predicates:     append([]predicate.TeamWebhook{}, twq.predicates...),
+		withTeam:       twq.withTeam.Clone(),
+		withDeliveries: twq.withDeliveries.Clone(),
+		// clone intermediate query.
+		sql:  twq.sql.Clone(),
+		path: twq.path,
+	}
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (twq *TeamWebhookQuery) WithTeam(opts ...func(*TeamQuery)) *TeamWebhookQuery {
+	query := (&TeamClient{config: twq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	twq.withTeam = query
+	return twq
+}
+
+// WithDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (twq *TeamWebhookQuery) WithDeliveries(opts ...func(*WebhookDeliveryQuery)) *TeamWebhookQuery {
+	query := (&WebhookDeliveryClient{config: twq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	twq.withDeliveries = query
+	return twq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamWebhook.Query().
+//		GroupBy(teamwebhook.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (twq *TeamWebhookQuery) GroupBy(field string, fields ...string) *TeamWebhookGroupBy {
+	twq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamWebhookGroupBy{build: twq}
+	grbuild.flds = &twq.ctx.Fields
+	grbuild.label = teamwebhook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TeamWebhook.Query().
+//		Select(teamwebhook.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (twq *TeamWebhookQuery) Select(fields ...string) *TeamWebhookSelect {
+	twq.ctx.Fields = append(twq.ctx.Fields, fields...)
+	sbuild := &TeamWebhookSelect{TeamWebhookQuery: twq}
+	sbuild.label = teamwebhook.Label
+	sbuild.flds, sbuild.scan = &twq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamWebhookSelect configured with the given aggregations.
+func (twq *TeamWebhookQuery) Aggregate(fns ...AggregateFunc) *TeamWebhookSelect {
+	return twq.Select().Aggregate(fns...)
+}
+
+func (twq *TeamWebhookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range twq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, twq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range twq.ctx.Fields {
+		if !teamwebhook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if twq.path != nil {
+		prev, err := twq.path(ctx)
+		if err != nil {
+			return err
+		}
+		twq.sql = prev
+	}
+	return nil
+}
+
+func (twq *TeamWebhookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamWebhook, error) {
+	var (
+		nodes       = []*TeamWebhook{}
+		_spec       = twq.querySpec()
+		loadedTypes = [2]bool{
+			twq.withTeam != nil,
+			twq.withDeliveries != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamWebhook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamWebhook{config: twq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = twq.schemaConfig.TeamWebhook
+	ctx = internal.NewSchemaConfigContext(ctx, twq.schemaConfig)
+	if len(twq.modifiers) > 0 {
+		_spec.Modifiers = twq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, twq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := twq.withTeam; query != nil {
+		if err := twq.loadTeam(ctx, query, nodes, nil,
+			func(n *TeamWebhook, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := twq.withDeliveries; query != nil {
+		if err := twq.loadDeliveries(ctx, query, nodes,
+			func(n *TeamWebhook) { n.Edges.Deliveries = []*WebhookDelivery{} },
+			func(n *TeamWebhook, e *WebhookDelivery) { n.Edges.Deliveries = append(n.Edges.Deliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (twq *TeamWebhookQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*TeamWebhook, init func(*TeamWebhook), assign func(*TeamWebhook, *Team)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TeamWebhook)
+	for i := range nodes {
+		fk := nodes[i].TeamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(team.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (twq *TeamWebhookQuery) loadDeliveries(ctx context.Context, query *WebhookDeliveryQuery, nodes []*TeamWebhook, init func(*TeamWebhook), assign func(*TeamWebhook, *WebhookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TeamWebhook)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookdelivery.FieldWebhookID)
+	}
+	query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(teamwebhook.DeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WebhookID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "webhook_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (twq *TeamWebhookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := twq.querySpec()
+	_spec.Node.Schema = twq.schemaConfig.TeamWebhook
+	ctx = internal.NewSchemaConfigContext(ctx, twq.schemaConfig)
+	if len(twq.modifiers) > 0 {
+		_spec.Modifiers = twq.modifiers
+	}
+	_spec.Node.Columns = twq.ctx.Fields
+	if len(twq.ctx.Fields) > 0 {
+		_spec.Unique = twq.ctx.Unique != nil && *twq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, twq.driver, _spec)
+}
+
+func (twq *TeamWebhookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teamwebhook.Table, teamwebhook.Columns, sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID))
+	_spec.From = twq.sql
+	if unique := twq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if twq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := twq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamwebhook.FieldID)
+		for i := range fields {
+			if fields[i] != teamwebhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if twq.withTeam != nil {
+			_spec.Node.AddColumnOnce(teamwebhook.FieldTeamID)
+		}
+	}
+	if ps := twq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := twq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := twq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := twq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (twq *TeamWebhookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(twq.driver.Dialect())
+	t1 := builder.Table(teamwebhook.Table)
+	columns := twq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teamwebhook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if twq.sql != nil {
+		selector = twq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if twq.ctx.Unique != nil && *twq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(twq.schemaConfig.TeamWebhook)
+	ctx = internal.NewSchemaConfigContext(ctx, twq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range twq.modifiers {
+		m(selector)
+	}
+	for _, p := range twq.predicates {
+		p(selector)
+	}
+	for _, p := range twq.order {
+		p(selector)
+	}
+	if offset := twq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := twq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (twq *TeamWebhookQuery) Modify(modifiers ...func(s *sql.Selector)) *TeamWebhookSelect {
+	twq.modifiers = append(twq.modifiers, modifiers...)
+	return twq.Select()
+}
+
+// TeamWebhookGroupBy is the group-by builder for TeamWebhook entities.
+type TeamWebhookGroupBy struct {
+	selector
+	build *TeamWebhookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (twgb *TeamWebhookGroupBy) Aggregate(fns ...AggregateFunc) *TeamWebhookGroupBy {
+	twgb.fns = append(twgb.fns, fns...)
+	return twgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (twgb *TeamWebhookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, twgb.build.ctx, "GroupBy")
+	if err := twgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamWebhookQuery, *TeamWebhookGroupBy](ctx, twgb.build, twgb, twgb.build.inters, v)
+}
+
+func (twgb *TeamWebhookGroupBy) sqlScan(ctx context.Context, root *TeamWebhookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(twgb.fns))
+	for _, fn := range twgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*twgb.flds)+len(twgb.fns))
+		for _, f := range *twgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*twgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := twgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamWebhookSelect is the builder for selecting fields of TeamWebhook entities.
+type TeamWebhookSelect struct {
+	*TeamWebhookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tws *TeamWebhookSelect) Aggregate(fns ...AggregateFunc) *TeamWebhookSelect {
+	tws.fns = append(tws.fns, fns...)
+	return tws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tws *TeamWebhookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tws.ctx, "Select")
+	if err := tws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamWebhookQuery, *TeamWebhookSelect](ctx, tws.TeamWebhookQuery, tws, tws.inters, v)
+}
+
+func (tws *TeamWebhookSelect) sqlScan(ctx context.Context, root *TeamWebhookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tws.fns))
+	for _, fn := range tws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tws *TeamWebhookSelect) Modify(modifiers ...func(s *sql.Selector)) *TeamWebhookSelect {
+	tws.modifiers = append(tws.modifiers, modifiers...)
+	return tws
+}
@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamwebhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// TeamWebhookUpdate is the builder for updating TeamWebhook entities.
+type TeamWebhookUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TeamWebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TeamWebhookUpdate builder.
+func (twu *TeamWebhookUpdate) Where(ps ...predicate.TeamWebhook) *TeamWebhookUpdate {
+	twu.mutation.Where(ps...)
+	return twu
+}
+
+// SetTeamID sets the "team_id" field.
+func (twu *TeamWebhookUpdate) SetTeamID(u uuid.UUID) *TeamWebhookUpdate {
+	twu.mutation.SetTeamID(u)
+	return twu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (twu *TeamWebhookUpdate) SetNillableTeamID(u *uuid.UUID) *TeamWebhookUpdate {
+	if u != nil {
+		twu.SetTeamID(*u)
+	}
+	return twu
+}
+
+// SetURL sets the "url" field.
+func (twu *TeamWebhookUpdate) SetURL(s string) *TeamWebhookUpdate {
+	twu.mutation.SetURL(s)
+	return twu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (twu *TeamWebhookUpdate) SetNillableURL(s *string) *TeamWebhookUpdate {
+	if s != nil {
+		twu.SetURL(*s)
+	}
+	return twu
+}
+
+// SetSecret sets the "secret" field.
+func (twu *TeamWebhookUpdate) SetSecret(s string) *TeamWebhookUpdate {
+	twu.mutation.SetSecret(s)
+	return twu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (twu *TeamWebhookUpdate) SetNillableSecret(s *string) *TeamWebhookUpdate {
+	if s != nil {
+		twu.SetSecret(*s)
+	}
+	return twu
+}
+
+// SetEvents sets the "events" field.
+func (twu *TeamWebhookUpdate) SetEvents(s []string) *TeamWebhookUpdate {
+	twu.mutation.SetEvents(s)
+	return twu
+}
+
+// AppendEvents appends s to the "events" field.
+func (twu *TeamWebhookUpdate) AppendEvents(s []string) *TeamWebhookUpdate {
+	twu.mutation.AppendEvents(s)
+	return twu
+}
+
+// ClearEvents clears the value of the "events" field.
+func (twu *TeamWebhookUpdate) ClearEvents() *TeamWebhookUpdate {
+	twu.mutation.ClearEvents()
+	return twu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (twu *TeamWebhookUpdate) SetTeam(t *Team) *TeamWebhookUpdate {
+	return twu.SetTeamID(t.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (twu *TeamWebhookUpdate) AddDeliveryIDs(ids ...uuid.UUID) *TeamWebhookUpdate {
+	twu.mutation.AddDeliveryIDs(ids...)
+	return twu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (twu *TeamWebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *TeamWebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return twu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the TeamWebhookMutation object of the builder.
+func (twu *TeamWebhookUpdate) Mutation() *TeamWebhookMutation {
+	return twu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (twu *TeamWebhookUpdate) ClearTeam() *TeamWebhookUpdate {
+	twu.mutation.ClearTeam()
+	return twu
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (twu *TeamWebhookUpdate) ClearDeliveries() *TeamWebhookUpdate {
+	twu.mutation.ClearDeliveries()
+	return twu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (twu *TeamWebhookUpdate) RemoveDeliveryIDs(ids ...uuid.UUID) *TeamWebhookUpdate {
+	twu.mutation.RemoveDeliveryIDs(ids...)
+	return twu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (twu *TeamWebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *TeamWebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return twu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (twu *TeamWebhookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, twu.sqlSave, twu.mutation, twu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twu *TeamWebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := twu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (twu *TeamWebhookUpdate) Exec(ctx context.Context) error {
+	_, err := twu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twu *TeamWebhookUpdate) ExecX(ctx context.Context) {
+	if err := twu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twu *TeamWebhookUpdate) check() error {
+	if _, ok := twu.mutation.TeamID(); twu.mutation.TeamCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "TeamWebhook.team"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (twu *TeamWebhookUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamWebhookUpdate {
+	twu.modifiers = append(twu.modifiers, modifiers...)
+	return twu
+}
+
+func (twu *TeamWebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := twu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teamwebhook.Table, teamwebhook.Columns, sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID))
+	if ps := twu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := twu.mutation.URL(); ok {
+		_spec.SetField(teamwebhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := twu.mutation.Secret(); ok {
+		_spec.SetField(teamwebhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := twu.mutation.Events(); ok {
+		_spec.SetField(teamwebhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := twu.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamwebhook.FieldEvents, value)
+		})
+	}
+	if twu.mutation.EventsCleared() {
+		_spec.ClearField(teamwebhook.FieldEvents, field.TypeJSON)
+	}
+	if twu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teamwebhook.TeamTable,
+			Columns: []string{teamwebhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twu.schemaConfig.TeamWebhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teamwebhook.TeamTable,
+			Columns: []string{teamwebhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twu.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if twu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teamwebhook.DeliveriesTable,
+			Columns: []string{teamwebhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twu.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !twu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teamwebhook.DeliveriesTable,
+			Columns: []string{teamwebhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teamwebhook.DeliveriesTable,
+			Columns: []string{teamwebhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = twu.schemaConfig.TeamWebhook
+	ctx = internal.NewSchemaConfigContext(ctx, twu.schemaConfig)
+	_spec.AddModifiers(twu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, twu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamwebhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	twu.mutation.done = true
+	return n, nil
+}
+
+// TeamWebhookUpdateOne is the builder for updating a single TeamWebhook entity.
+type TeamWebhookUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamWebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTeamID sets the "team_id" field.
+func (twuo *TeamWebhookUpdateOne) SetTeamID(u uuid.UUID) *TeamWebhookUpdateOne {
+	twuo.mutation.SetTeamID(u)
+	return twuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (twuo *TeamWebhookUpdateOne) SetNillableTeamID(u *uuid.UUID) *TeamWebhookUpdateOne {
+	if u != nil {
+		twuo.SetTeamID(*u)
+	}
+	return twuo
+}
+
+// SetURL sets the "url" field.
+func (twuo *TeamWebhookUpdateOne) SetURL(s string) *TeamWebhookUpdateOne {
+	twuo.mutation.SetURL(s)
+	return twuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (twuo *TeamWebhookUpdateOne) SetNillableURL(s *string) *TeamWebhookUpdateOne {
+	if s != nil {
+		twuo.SetURL(*s)
+	}
+	return twuo
+}
+
+// SetSecret sets the "secret" field.
+func (twuo *TeamWebhookUpdateOne) SetSecret(s string) *TeamWebhookUpdateOne {
+	twuo.mutation.SetSecret(s)
+	return twuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (twuo *TeamWebhookUpdateOne) SetNillableSecret(s *string) *TeamWebhookUpdateOne {
+	if s != nil {
+		twuo.SetSecret(*s)
+	}
+	return twuo
+}
+
+// SetEvents sets the "events" field.
+func (twuo *TeamWebhookUpdateOne) SetEvents(s []string) *TeamWebhookUpdateOne {
+	twuo.mutation.SetEvents(s)
+	return twuo
+}
+
+// AppendEvents appends s to the "events" field.
+func (twuo *TeamWebhookUpdateOne) AppendEvents(s []string) *TeamWebhookUpdateOne {
+	twuo.mutation.AppendEvents(s)
+	return twuo
+}
+
+// ClearEvents clears the value of the "events" field.
+func (twuo *TeamWebhookUpdateOne) ClearEvents() *TeamWebhookUpdateOne {
+	twuo.mutation.ClearEvents()
+	return twuo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (twuo *TeamWebhookUpdateOne) SetTeam(t *Team) *TeamWebhookUpdateOne {
+	return twuo.SetTeamID(t.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (twuo *TeamWebhookUpdateOne) AddDeliveryIDs(ids ...uuid.UUID) *TeamWebhookUpdateOne {
+	twuo.mutation.AddDeliveryIDs(ids...)
+	return twuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (twuo *TeamWebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *TeamWebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return twuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the TeamWebhookMutation object of the builder.
+func (twuo *TeamWebhookUpdateOne) Mutation() *TeamWebhookMutation {
+	return twuo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (twuo *TeamWebhookUpdateOne) ClearTeam() *TeamWebhookUpdateOne {
+	twuo.mutation.ClearTeam()
+	return twuo
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (twuo *TeamWebhookUpdateOne) ClearDeliveries() *TeamWebhookUpdateOne {
+	twuo.mutation.ClearDeliveries()
+	return twuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (twuo *TeamWebhookUpdateOne) RemoveDeliveryIDs(ids ...uuid.UUID) *TeamWebhookUpdateOne {
+	twuo.mutation.RemoveDeliveryIDs(ids...)
+	return twuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (twuo *TeamWebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *TeamWebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return twuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the TeamWebhookUpdate builder.
+func (twuo *TeamWebhookUpdateOne) Where(ps ...predicate.TeamWebhook) *TeamWebhookUpdateOne {
+	twuo.mutation.Where(ps...)
+	return twuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (twuo *TeamWebhookUpdateOne) Select(field string, fields ...string) *TeamWebhookUpdateOne {
+	twuo.fields = append([]string{field}, fields...)
+	return twuo
+}
+
+// Save executes the query and returns the updated TeamWebhook entity.
+func (twuo *TeamWebhookUpdateOne) Save(ctx context.Context) (*TeamWebhook, error) {
+	return withHooks(ctx, twuo.sqlSave, twuo.mutation, twuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twuo *TeamWebhookUpdateOne) SaveX(ctx context.Context) *TeamWebhook {
+	node, err := twuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (twuo *TeamWebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := twuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twuo *TeamWebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := twuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twuo *TeamWebhookUpdateOne) check() error {
+	if _, ok := twuo.mutation.TeamID(); twuo.mutation.TeamCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "TeamWebhook.team"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (twuo *TeamWebhookUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamWebhookUpdateOne {
+	twuo.modifiers = append(twuo.modifiers, modifiers...)
+	return twuo
+}
+
+func (twuo *TeamWebhookUpdateOne) sqlSave(ctx context.Context) (_node *TeamWebhook, err error) {
+	if err := twuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teamwebhook.Table, teamwebhook.Columns, sqlgraph.NewFieldSpec(teamwebhook.FieldID, field.TypeUUID))
+	id, ok := twuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "TeamWebhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := twuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamwebhook.FieldID)
+		for _, f := range fields {
+			if !teamwebhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != teamwebhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := twuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := twuo.mutation.URL(); ok {
+		_spec.SetField(teamwebhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := twuo.mutation.Secret(); ok {
+		_spec.SetField(teamwebhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := twuo.mutation.Events(); ok {
+		_spec.SetField(teamwebhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := twuo.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamwebhook.FieldEvents, value)
+		})
+	}
+	if twuo.mutation.EventsCleared() {
+		_spec.ClearField(teamwebhook.FieldEvents, field.TypeJSON)
+	}
+	if twuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teamwebhook.TeamTable,
+			Columns: []string{teamwebhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twuo.schemaConfig.TeamWebhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teamwebhook.TeamTable,
+			Columns: []string{teamwebhook.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twuo.schemaConfig.TeamWebhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if twuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teamwebhook.DeliveriesTable,
+			Columns: []string{teamwebhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twuo.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !twuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teamwebhook.DeliveriesTable,
+			Columns: []string{teamwebhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twuo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   teamwebhook.DeliveriesTable,
+			Columns: []string{teamwebhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = twuo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = twuo.schemaConfig.TeamWebhook
+	ctx = internal.NewSchemaConfigContext(ctx, twuo.schemaConfig)
+	_spec.AddModifiers(twuo.modifiers...)
+	_node = &TeamWebhook{config: twuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, twuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamwebhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	twuo.mutation.done = true
+	return _node, nil
+}
@@ -24,6 +24,16 @@ type Tier struct {
 	ConcurrentInstances int64 `json:"concurrent_instances,omitempty"`
 	// MaxLengthHours holds the value of the "max_length_hours" field.
 	MaxLengthHours int64 `json:"max_length_hours,omitempty"`
+	// The total number of vCPUs the running sandboxes of the team can use, unlimited if null
+	MaxVcpu *int64 `json:"max_vcpu,omitempty"`
+	// The total RAM in MB the running sandboxes of the team can use, unlimited if null
+	MaxRAMMB *int64 `json:"max_ram_mb,omitempty"`
+	// The number of sandboxes the team can start per minute, unlimited if null
+	MaxSandboxStartsPerMinute *int64 `json:"max_sandbox_starts_per_minute,omitempty"`
+	// The number of template builds the team can run concurrently, unlimited if null
+	MaxConcurrentBuilds *int64 `json:"max_concurrent_builds,omitempty"`
+	// The total size in MB of the stored snapshots of the team, unlimited if null
+	MaxSnapshotStorageMB *int64 `json:"max_snapshot_storage_mb,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TierQuery when eager-loading is set.
 	Edges        TierEdges `json:"edges"`
@@ -53,7 +63,7 @@ func (*Tier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours:
+		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours, tier.FieldMaxVcpu, tier.FieldMaxRAMMB, tier.FieldMaxSandboxStartsPerMinute, tier.FieldMaxConcurrentBuilds, tier.FieldMaxSnapshotStorageMB:
 			values[i] = new(sql.NullInt64)
 		case tier.FieldID, tier.FieldName:
 			values[i] = new(sql.NullString)
@@ -102,6 +112,41 @@ func (t *Tier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.MaxLengthHours = value.Int64
 			}
+		case tier.FieldMaxVcpu:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_vcpu", values[i])
+			} else if value.Valid {
+				t.MaxVcpu = new(int64)
+				*t.MaxVcpu = value.Int64
+			}
+		case tier.FieldMaxRAMMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_ram_mb", values[i])
+			} else if value.Valid {
+				t.MaxRAMMB = new(int64)
+				*t.MaxRAMMB = value.Int64
+			}
+		case tier.FieldMaxSandboxStartsPerMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_sandbox_starts_per_minute", values[i])
+			} else if value.Valid {
+				t.MaxSandboxStartsPerMinute = new(int64)
+				*t.MaxSandboxStartsPerMinute = value.Int64
+			}
+		case tier.FieldMaxConcurrentBuilds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_concurrent_builds", values[i])
+			} else if value.Valid {
+				t.MaxConcurrentBuilds = new(int64)
+				*t.MaxConcurrentBuilds = value.Int64
+			}
+		case tier.FieldMaxSnapshotStorageMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_snapshot_storage_mb", values[i])
+			} else if value.Valid {
+				t.MaxSnapshotStorageMB = new(int64)
+				*t.MaxSnapshotStorageMB = value.Int64
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -154,6 +199,31 @@ func (t *Tier) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("max_length_hours=")
 	builder.WriteString(fmt.Sprintf("%v", t.MaxLengthHours))
+	builder.WriteString(", ")
+	if v := t.MaxVcpu; v != nil {
+		builder.WriteString("max_vcpu=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.MaxRAMMB; v != nil {
+		builder.WriteString("max_ram_mb=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.MaxSandboxStartsPerMinute; v != nil {
+		builder.WriteString("max_sandbox_starts_per_minute=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.MaxConcurrentBuilds; v != nil {
+		builder.WriteString("max_concurrent_builds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.MaxSnapshotStorageMB; v != nil {
+		builder.WriteString("max_snapshot_storage_mb=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
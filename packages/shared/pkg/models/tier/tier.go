@@ -20,6 +20,16 @@ const (
 	FieldConcurrentInstances = "concurrent_instances"
 	// FieldMaxLengthHours holds the string denoting the max_length_hours field in the database.
 	FieldMaxLengthHours = "max_length_hours"
+	// FieldMaxVcpu holds the string denoting the max_vcpu field in the database.
+	FieldMaxVcpu = "max_vcpu"
+	// FieldMaxRAMMB holds the string denoting the max_ram_mb field in the database.
+	FieldMaxRAMMB = "max_ram_mb"
+	// FieldMaxSandboxStartsPerMinute holds the string denoting the max_sandbox_starts_per_minute field in the database.
+	FieldMaxSandboxStartsPerMinute = "max_sandbox_starts_per_minute"
+	// FieldMaxConcurrentBuilds holds the string denoting the max_concurrent_builds field in the database.
+	FieldMaxConcurrentBuilds = "max_concurrent_builds"
+	// FieldMaxSnapshotStorageMB holds the string denoting the max_snapshot_storage_mb field in the database.
+	FieldMaxSnapshotStorageMB = "max_snapshot_storage_mb"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
 	// Table holds the table name of the tier in the database.
@@ -40,6 +50,11 @@ var Columns = []string{
 	FieldDiskMB,
 	FieldConcurrentInstances,
 	FieldMaxLengthHours,
+	FieldMaxVcpu,
+	FieldMaxRAMMB,
+	FieldMaxSandboxStartsPerMinute,
+	FieldMaxConcurrentBuilds,
+	FieldMaxSnapshotStorageMB,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +95,31 @@ func ByMaxLengthHours(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxLengthHours, opts...).ToFunc()
 }
 
+// ByMaxVcpu orders the results by the max_vcpu field.
+func ByMaxVcpu(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxVcpu, opts...).ToFunc()
+}
+
+// ByMaxRAMMB orders the results by the max_ram_mb field.
+func ByMaxRAMMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRAMMB, opts...).ToFunc()
+}
+
+// ByMaxSandboxStartsPerMinute orders the results by the max_sandbox_starts_per_minute field.
+func ByMaxSandboxStartsPerMinute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSandboxStartsPerMinute, opts...).ToFunc()
+}
+
+// ByMaxConcurrentBuilds orders the results by the max_concurrent_builds field.
+func ByMaxConcurrentBuilds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxConcurrentBuilds, opts...).ToFunc()
+}
+
+// ByMaxSnapshotStorageMB orders the results by the max_snapshot_storage_mb field.
+func ByMaxSnapshotStorageMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSnapshotStorageMB, opts...).ToFunc()
+}
+
 // ByTeamsCount orders the results by teams count.
 func ByTeamsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
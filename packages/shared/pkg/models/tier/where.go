@@ -84,6 +84,31 @@ func MaxLengthHours(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldMaxLengthHours, v))
 }
 
+// MaxVcpu applies equality check predicate on the "max_vcpu" field. It's identical to MaxVcpuEQ.
+func MaxVcpu(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVcpu, v))
+}
+
+// MaxRAMMB applies equality check predicate on the "max_ram_mb" field. It's identical to MaxRAMMBEQ.
+func MaxRAMMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxRAMMB, v))
+}
+
+// MaxSandboxStartsPerMinute applies equality check predicate on the "max_sandbox_starts_per_minute" field. It's identical to MaxSandboxStartsPerMinuteEQ.
+func MaxSandboxStartsPerMinute(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxSandboxStartsPerMinute, v))
+}
+
+// MaxConcurrentBuilds applies equality check predicate on the "max_concurrent_builds" field. It's identical to MaxConcurrentBuildsEQ.
+func MaxConcurrentBuilds(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxConcurrentBuilds, v))
+}
+
+// MaxSnapshotStorageMB applies equality check predicate on the "max_snapshot_storage_mb" field. It's identical to MaxSnapshotStorageMBEQ.
+func MaxSnapshotStorageMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxSnapshotStorageMB, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldName, v))
@@ -269,6 +294,256 @@ func MaxLengthHoursLTE(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldLTE(FieldMaxLengthHours, v))
 }
 
+// MaxVcpuEQ applies the EQ predicate on the "max_vcpu" field.
+func MaxVcpuEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVcpu, v))
+}
+
+// MaxVcpuNEQ applies the NEQ predicate on the "max_vcpu" field.
+func MaxVcpuNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxVcpu, v))
+}
+
+// MaxVcpuIn applies the In predicate on the "max_vcpu" field.
+func MaxVcpuIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxVcpu, vs...))
+}
+
+// MaxVcpuNotIn applies the NotIn predicate on the "max_vcpu" field.
+func MaxVcpuNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxVcpu, vs...))
+}
+
+// MaxVcpuGT applies the GT predicate on the "max_vcpu" field.
+func MaxVcpuGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxVcpu, v))
+}
+
+// MaxVcpuGTE applies the GTE predicate on the "max_vcpu" field.
+func MaxVcpuGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxVcpu, v))
+}
+
+// MaxVcpuLT applies the LT predicate on the "max_vcpu" field.
+func MaxVcpuLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxVcpu, v))
+}
+
+// MaxVcpuLTE applies the LTE predicate on the "max_vcpu" field.
+func MaxVcpuLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxVcpu, v))
+}
+
+// MaxVcpuIsNil applies the IsNil predicate on the "max_vcpu" field.
+func MaxVcpuIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldMaxVcpu))
+}
+
+// MaxVcpuNotNil applies the NotNil predicate on the "max_vcpu" field.
+func MaxVcpuNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldMaxVcpu))
+}
+
+// MaxRAMMBEQ applies the EQ predicate on the "max_ram_mb" field.
+func MaxRAMMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBNEQ applies the NEQ predicate on the "max_ram_mb" field.
+func MaxRAMMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBIn applies the In predicate on the "max_ram_mb" field.
+func MaxRAMMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxRAMMB, vs...))
+}
+
+// MaxRAMMBNotIn applies the NotIn predicate on the "max_ram_mb" field.
+func MaxRAMMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxRAMMB, vs...))
+}
+
+// MaxRAMMBGT applies the GT predicate on the "max_ram_mb" field.
+func MaxRAMMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBGTE applies the GTE predicate on the "max_ram_mb" field.
+func MaxRAMMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBLT applies the LT predicate on the "max_ram_mb" field.
+func MaxRAMMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBLTE applies the LTE predicate on the "max_ram_mb" field.
+func MaxRAMMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBIsNil applies the IsNil predicate on the "max_ram_mb" field.
+func MaxRAMMBIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldMaxRAMMB))
+}
+
+// MaxRAMMBNotNil applies the NotNil predicate on the "max_ram_mb" field.
+func MaxRAMMBNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldMaxRAMMB))
+}
+
+// MaxSandboxStartsPerMinuteEQ applies the EQ predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxSandboxStartsPerMinute, v))
+}
+
+// MaxSandboxStartsPerMinuteNEQ applies the NEQ predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxSandboxStartsPerMinute, v))
+}
+
+// MaxSandboxStartsPerMinuteIn applies the In predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxSandboxStartsPerMinute, vs...))
+}
+
+// MaxSandboxStartsPerMinuteNotIn applies the NotIn predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxSandboxStartsPerMinute, vs...))
+}
+
+// MaxSandboxStartsPerMinuteGT applies the GT predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxSandboxStartsPerMinute, v))
+}
+
+// MaxSandboxStartsPerMinuteGTE applies the GTE predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxSandboxStartsPerMinute, v))
+}
+
+// MaxSandboxStartsPerMinuteLT applies the LT predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxSandboxStartsPerMinute, v))
+}
+
+// MaxSandboxStartsPerMinuteLTE applies the LTE predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxSandboxStartsPerMinute, v))
+}
+
+// MaxSandboxStartsPerMinuteIsNil applies the IsNil predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldMaxSandboxStartsPerMinute))
+}
+
+// MaxSandboxStartsPerMinuteNotNil applies the NotNil predicate on the "max_sandbox_starts_per_minute" field.
+func MaxSandboxStartsPerMinuteNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldMaxSandboxStartsPerMinute))
+}
+
+// MaxConcurrentBuildsEQ applies the EQ predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxConcurrentBuilds, v))
+}
+
+// MaxConcurrentBuildsNEQ applies the NEQ predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxConcurrentBuilds, v))
+}
+
+// MaxConcurrentBuildsIn applies the In predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxConcurrentBuilds, vs...))
+}
+
+// MaxConcurrentBuildsNotIn applies the NotIn predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxConcurrentBuilds, vs...))
+}
+
+// MaxConcurrentBuildsGT applies the GT predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxConcurrentBuilds, v))
+}
+
+// MaxConcurrentBuildsGTE applies the GTE predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxConcurrentBuilds, v))
+}
+
+// MaxConcurrentBuildsLT applies the LT predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxConcurrentBuilds, v))
+}
+
+// MaxConcurrentBuildsLTE applies the LTE predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxConcurrentBuilds, v))
+}
+
+// MaxConcurrentBuildsIsNil applies the IsNil predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldMaxConcurrentBuilds))
+}
+
+// MaxConcurrentBuildsNotNil applies the NotNil predicate on the "max_concurrent_builds" field.
+func MaxConcurrentBuildsNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldMaxConcurrentBuilds))
+}
+
+// MaxSnapshotStorageMBEQ applies the EQ predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxSnapshotStorageMB, v))
+}
+
+// MaxSnapshotStorageMBNEQ applies the NEQ predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxSnapshotStorageMB, v))
+}
+
+// MaxSnapshotStorageMBIn applies the In predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxSnapshotStorageMB, vs...))
+}
+
+// MaxSnapshotStorageMBNotIn applies the NotIn predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxSnapshotStorageMB, vs...))
+}
+
+// MaxSnapshotStorageMBGT applies the GT predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxSnapshotStorageMB, v))
+}
+
+// MaxSnapshotStorageMBGTE applies the GTE predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxSnapshotStorageMB, v))
+}
+
+// MaxSnapshotStorageMBLT applies the LT predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxSnapshotStorageMB, v))
+}
+
+// MaxSnapshotStorageMBLTE applies the LTE predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxSnapshotStorageMB, v))
+}
+
+// MaxSnapshotStorageMBIsNil applies the IsNil predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldMaxSnapshotStorageMB))
+}
+
+// MaxSnapshotStorageMBNotNil applies the NotNil predicate on the "max_snapshot_storage_mb" field.
+func MaxSnapshotStorageMBNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldMaxSnapshotStorageMB))
+}
+
 // HasTeams applies the HasEdge predicate on the "teams" edge.
 func HasTeams() predicate.Tier {
 	return predicate.Tier(func(s *sql.Selector) {
@@ -48,6 +48,76 @@ func (tc *TierCreate) SetMaxLengthHours(i int64) *TierCreate {
 	return tc
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tc *TierCreate) SetMaxVcpu(i int64) *TierCreate {
+	tc.mutation.SetMaxVcpu(i)
+	return tc
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tc *TierCreate) SetNillableMaxVcpu(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetMaxVcpu(*i)
+	}
+	return tc
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tc *TierCreate) SetMaxRAMMB(i int64) *TierCreate {
+	tc.mutation.SetMaxRAMMB(i)
+	return tc
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tc *TierCreate) SetNillableMaxRAMMB(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetMaxRAMMB(*i)
+	}
+	return tc
+}
+
+// SetMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field.
+func (tc *TierCreate) SetMaxSandboxStartsPerMinute(i int64) *TierCreate {
+	tc.mutation.SetMaxSandboxStartsPerMinute(i)
+	return tc
+}
+
+// SetNillableMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field if the given value is not nil.
+func (tc *TierCreate) SetNillableMaxSandboxStartsPerMinute(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetMaxSandboxStartsPerMinute(*i)
+	}
+	return tc
+}
+
+// SetMaxConcurrentBuilds sets the "max_concurrent_builds" field.
+func (tc *TierCreate) SetMaxConcurrentBuilds(i int64) *TierCreate {
+	tc.mutation.SetMaxConcurrentBuilds(i)
+	return tc
+}
+
+// SetNillableMaxConcurrentBuilds sets the "max_concurrent_builds" field if the given value is not nil.
+func (tc *TierCreate) SetNillableMaxConcurrentBuilds(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetMaxConcurrentBuilds(*i)
+	}
+	return tc
+}
+
+// SetMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field.
+func (tc *TierCreate) SetMaxSnapshotStorageMB(i int64) *TierCreate {
+	tc.mutation.SetMaxSnapshotStorageMB(i)
+	return tc
+}
+
+// SetNillableMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field if the given value is not nil.
+func (tc *TierCreate) SetNillableMaxSnapshotStorageMB(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetMaxSnapshotStorageMB(*i)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TierCreate) SetID(s string) *TierCreate {
 	tc.mutation.SetID(s)
@@ -168,6 +238,26 @@ func (tc *TierCreate) createSpec() (*Tier, *sqlgraph.CreateSpec) {
 		_spec.SetField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 		_node.MaxLengthHours = value
 	}
+	if value, ok := tc.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+		_node.MaxVcpu = &value
+	}
+	if value, ok := tc.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+		_node.MaxRAMMB = &value
+	}
+	if value, ok := tc.mutation.MaxSandboxStartsPerMinute(); ok {
+		_spec.SetField(tier.FieldMaxSandboxStartsPerMinute, field.TypeInt64, value)
+		_node.MaxSandboxStartsPerMinute = &value
+	}
+	if value, ok := tc.mutation.MaxConcurrentBuilds(); ok {
+		_spec.SetField(tier.FieldMaxConcurrentBuilds, field.TypeInt64, value)
+		_node.MaxConcurrentBuilds = &value
+	}
+	if value, ok := tc.mutation.MaxSnapshotStorageMB(); ok {
+		_spec.SetField(tier.FieldMaxSnapshotStorageMB, field.TypeInt64, value)
+		_node.MaxSnapshotStorageMB = &value
+	}
 	if nodes := tc.mutation.TeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -303,6 +393,126 @@ func (u *TierUpsert) AddMaxLengthHours(v int64) *TierUpsert {
 	return u
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsert) SetMaxVcpu(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxVcpu, v)
+	return u
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxVcpu() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxVcpu)
+	return u
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsert) AddMaxVcpu(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxVcpu, v)
+	return u
+}
+
+// ClearMaxVcpu clears the value of the "max_vcpu" field.
+func (u *TierUpsert) ClearMaxVcpu() *TierUpsert {
+	u.SetNull(tier.FieldMaxVcpu)
+	return u
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsert) SetMaxRAMMB(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxRAMMB, v)
+	return u
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxRAMMB() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxRAMMB)
+	return u
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsert) AddMaxRAMMB(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxRAMMB, v)
+	return u
+}
+
+// ClearMaxRAMMB clears the value of the "max_ram_mb" field.
+func (u *TierUpsert) ClearMaxRAMMB() *TierUpsert {
+	u.SetNull(tier.FieldMaxRAMMB)
+	return u
+}
+
+// SetMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsert) SetMaxSandboxStartsPerMinute(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxSandboxStartsPerMinute, v)
+	return u
+}
+
+// UpdateMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxSandboxStartsPerMinute() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxSandboxStartsPerMinute)
+	return u
+}
+
+// AddMaxSandboxStartsPerMinute adds v to the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsert) AddMaxSandboxStartsPerMinute(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxSandboxStartsPerMinute, v)
+	return u
+}
+
+// ClearMaxSandboxStartsPerMinute clears the value of the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsert) ClearMaxSandboxStartsPerMinute() *TierUpsert {
+	u.SetNull(tier.FieldMaxSandboxStartsPerMinute)
+	return u
+}
+
+// SetMaxConcurrentBuilds sets the "max_concurrent_builds" field.
+func (u *TierUpsert) SetMaxConcurrentBuilds(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxConcurrentBuilds, v)
+	return u
+}
+
+// UpdateMaxConcurrentBuilds sets the "max_concurrent_builds" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxConcurrentBuilds() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxConcurrentBuilds)
+	return u
+}
+
+// AddMaxConcurrentBuilds adds v to the "max_concurrent_builds" field.
+func (u *TierUpsert) AddMaxConcurrentBuilds(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxConcurrentBuilds, v)
+	return u
+}
+
+// ClearMaxConcurrentBuilds clears the value of the "max_concurrent_builds" field.
+func (u *TierUpsert) ClearMaxConcurrentBuilds() *TierUpsert {
+	u.SetNull(tier.FieldMaxConcurrentBuilds)
+	return u
+}
+
+// SetMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field.
+func (u *TierUpsert) SetMaxSnapshotStorageMB(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxSnapshotStorageMB, v)
+	return u
+}
+
+// UpdateMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxSnapshotStorageMB() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxSnapshotStorageMB)
+	return u
+}
+
+// AddMaxSnapshotStorageMB adds v to the "max_snapshot_storage_mb" field.
+func (u *TierUpsert) AddMaxSnapshotStorageMB(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxSnapshotStorageMB, v)
+	return u
+}
+
+// ClearMaxSnapshotStorageMB clears the value of the "max_snapshot_storage_mb" field.
+func (u *TierUpsert) ClearMaxSnapshotStorageMB() *TierUpsert {
+	u.SetNull(tier.FieldMaxSnapshotStorageMB)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -428,6 +638,146 @@ func (u *TierUpsertOne) UpdateMaxLengthHours() *TierUpsertOne {
 	})
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsertOne) SetMaxVcpu(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVcpu(v)
+	})
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsertOne) AddMaxVcpu(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVcpu(v)
+	})
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxVcpu() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVcpu()
+	})
+}
+
+// ClearMaxVcpu clears the value of the "max_vcpu" field.
+func (u *TierUpsertOne) ClearMaxVcpu() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxVcpu()
+	})
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsertOne) SetMaxRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxRAMMB(v)
+	})
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsertOne) AddMaxRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxRAMMB(v)
+	})
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxRAMMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxRAMMB()
+	})
+}
+
+// ClearMaxRAMMB clears the value of the "max_ram_mb" field.
+func (u *TierUpsertOne) ClearMaxRAMMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxRAMMB()
+	})
+}
+
+// SetMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsertOne) SetMaxSandboxStartsPerMinute(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxSandboxStartsPerMinute(v)
+	})
+}
+
+// AddMaxSandboxStartsPerMinute adds v to the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsertOne) AddMaxSandboxStartsPerMinute(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxSandboxStartsPerMinute(v)
+	})
+}
+
+// UpdateMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxSandboxStartsPerMinute() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxSandboxStartsPerMinute()
+	})
+}
+
+// ClearMaxSandboxStartsPerMinute clears the value of the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsertOne) ClearMaxSandboxStartsPerMinute() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxSandboxStartsPerMinute()
+	})
+}
+
+// SetMaxConcurrentBuilds sets the "max_concurrent_builds" field.
+func (u *TierUpsertOne) SetMaxConcurrentBuilds(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxConcurrentBuilds(v)
+	})
+}
+
+// AddMaxConcurrentBuilds adds v to the "max_concurrent_builds" field.
+func (u *TierUpsertOne) AddMaxConcurrentBuilds(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxConcurrentBuilds(v)
+	})
+}
+
+// UpdateMaxConcurrentBuilds sets the "max_concurrent_builds" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxConcurrentBuilds() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxConcurrentBuilds()
+	})
+}
+
+// ClearMaxConcurrentBuilds clears the value of the "max_concurrent_builds" field.
+func (u *TierUpsertOne) ClearMaxConcurrentBuilds() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxConcurrentBuilds()
+	})
+}
+
+// SetMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field.
+func (u *TierUpsertOne) SetMaxSnapshotStorageMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxSnapshotStorageMB(v)
+	})
+}
+
+// AddMaxSnapshotStorageMB adds v to the "max_snapshot_storage_mb" field.
+func (u *TierUpsertOne) AddMaxSnapshotStorageMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxSnapshotStorageMB(v)
+	})
+}
+
+// UpdateMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxSnapshotStorageMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxSnapshotStorageMB()
+	})
+}
+
+// ClearMaxSnapshotStorageMB clears the value of the "max_snapshot_storage_mb" field.
+func (u *TierUpsertOne) ClearMaxSnapshotStorageMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxSnapshotStorageMB()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -719,6 +1069,146 @@ func (u *TierUpsertBulk) UpdateMaxLengthHours() *TierUpsertBulk {
 	})
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsertBulk) SetMaxVcpu(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVcpu(v)
+	})
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsertBulk) AddMaxVcpu(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVcpu(v)
+	})
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxVcpu() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVcpu()
+	})
+}
+
+// ClearMaxVcpu clears the value of the "max_vcpu" field.
+func (u *TierUpsertBulk) ClearMaxVcpu() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxVcpu()
+	})
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsertBulk) SetMaxRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxRAMMB(v)
+	})
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsertBulk) AddMaxRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxRAMMB(v)
+	})
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxRAMMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxRAMMB()
+	})
+}
+
+// ClearMaxRAMMB clears the value of the "max_ram_mb" field.
+func (u *TierUpsertBulk) ClearMaxRAMMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxRAMMB()
+	})
+}
+
+// SetMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsertBulk) SetMaxSandboxStartsPerMinute(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxSandboxStartsPerMinute(v)
+	})
+}
+
+// AddMaxSandboxStartsPerMinute adds v to the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsertBulk) AddMaxSandboxStartsPerMinute(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxSandboxStartsPerMinute(v)
+	})
+}
+
+// UpdateMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxSandboxStartsPerMinute() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxSandboxStartsPerMinute()
+	})
+}
+
+// ClearMaxSandboxStartsPerMinute clears the value of the "max_sandbox_starts_per_minute" field.
+func (u *TierUpsertBulk) ClearMaxSandboxStartsPerMinute() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxSandboxStartsPerMinute()
+	})
+}
+
+// SetMaxConcurrentBuilds sets the "max_concurrent_builds" field.
+func (u *TierUpsertBulk) SetMaxConcurrentBuilds(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxConcurrentBuilds(v)
+	})
+}
+
+// AddMaxConcurrentBuilds adds v to the "max_concurrent_builds" field.
+func (u *TierUpsertBulk) AddMaxConcurrentBuilds(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxConcurrentBuilds(v)
+	})
+}
+
+// UpdateMaxConcurrentBuilds sets the "max_concurrent_builds" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxConcurrentBuilds() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxConcurrentBuilds()
+	})
+}
+
+// ClearMaxConcurrentBuilds clears the value of the "max_concurrent_builds" field.
+func (u *TierUpsertBulk) ClearMaxConcurrentBuilds() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxConcurrentBuilds()
+	})
+}
+
+// SetMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field.
+func (u *TierUpsertBulk) SetMaxSnapshotStorageMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxSnapshotStorageMB(v)
+	})
+}
+
+// AddMaxSnapshotStorageMB adds v to the "max_snapshot_storage_mb" field.
+func (u *TierUpsertBulk) AddMaxSnapshotStorageMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxSnapshotStorageMB(v)
+	})
+}
+
+// UpdateMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxSnapshotStorageMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxSnapshotStorageMB()
+	})
+}
+
+// ClearMaxSnapshotStorageMB clears the value of the "max_snapshot_storage_mb" field.
+func (u *TierUpsertBulk) ClearMaxSnapshotStorageMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearMaxSnapshotStorageMB()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -108,6 +108,141 @@ func (tu *TierUpdate) AddMaxLengthHours(i int64) *TierUpdate {
 	return tu
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tu *TierUpdate) SetMaxVcpu(i int64) *TierUpdate {
+	tu.mutation.ResetMaxVcpu()
+	tu.mutation.SetMaxVcpu(i)
+	return tu
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxVcpu(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxVcpu(*i)
+	}
+	return tu
+}
+
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (tu *TierUpdate) AddMaxVcpu(i int64) *TierUpdate {
+	tu.mutation.AddMaxVcpu(i)
+	return tu
+}
+
+// ClearMaxVcpu clears the value of the "max_vcpu" field.
+func (tu *TierUpdate) ClearMaxVcpu() *TierUpdate {
+	tu.mutation.ClearMaxVcpu()
+	return tu
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tu *TierUpdate) SetMaxRAMMB(i int64) *TierUpdate {
+	tu.mutation.ResetMaxRAMMB()
+	tu.mutation.SetMaxRAMMB(i)
+	return tu
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxRAMMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxRAMMB(*i)
+	}
+	return tu
+}
+
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (tu *TierUpdate) AddMaxRAMMB(i int64) *TierUpdate {
+	tu.mutation.AddMaxRAMMB(i)
+	return tu
+}
+
+// ClearMaxRAMMB clears the value of the "max_ram_mb" field.
+func (tu *TierUpdate) ClearMaxRAMMB() *TierUpdate {
+	tu.mutation.ClearMaxRAMMB()
+	return tu
+}
+
+// SetMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field.
+func (tu *TierUpdate) SetMaxSandboxStartsPerMinute(i int64) *TierUpdate {
+	tu.mutation.ResetMaxSandboxStartsPerMinute()
+	tu.mutation.SetMaxSandboxStartsPerMinute(i)
+	return tu
+}
+
+// SetNillableMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxSandboxStartsPerMinute(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxSandboxStartsPerMinute(*i)
+	}
+	return tu
+}
+
+// AddMaxSandboxStartsPerMinute adds i to the "max_sandbox_starts_per_minute" field.
+func (tu *TierUpdate) AddMaxSandboxStartsPerMinute(i int64) *TierUpdate {
+	tu.mutation.AddMaxSandboxStartsPerMinute(i)
+	return tu
+}
+
+// ClearMaxSandboxStartsPerMinute clears the value of the "max_sandbox_starts_per_minute" field.
+func (tu *TierUpdate) ClearMaxSandboxStartsPerMinute() *TierUpdate {
+	tu.mutation.ClearMaxSandboxStartsPerMinute()
+	return tu
+}
+
+// SetMaxConcurrentBuilds sets the "max_concurrent_builds" field.
+func (tu *TierUpdate) SetMaxConcurrentBuilds(i int64) *TierUpdate {
+	tu.mutation.ResetMaxConcurrentBuilds()
+	tu.mutation.SetMaxConcurrentBuilds(i)
+	return tu
+}
+
+// SetNillableMaxConcurrentBuilds sets the "max_concurrent_builds" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxConcurrentBuilds(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxConcurrentBuilds(*i)
+	}
+	return tu
+}
+
+// AddMaxConcurrentBuilds adds i to the "max_concurrent_builds" field.
+func (tu *TierUpdate) AddMaxConcurrentBuilds(i int64) *TierUpdate {
+	tu.mutation.AddMaxConcurrentBuilds(i)
+	return tu
+}
+
+// ClearMaxConcurrentBuilds clears the value of the "max_concurrent_builds" field.
+func (tu *TierUpdate) ClearMaxConcurrentBuilds() *TierUpdate {
+	tu.mutation.ClearMaxConcurrentBuilds()
+	return tu
+}
+
+// SetMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field.
+func (tu *TierUpdate) SetMaxSnapshotStorageMB(i int64) *TierUpdate {
+	tu.mutation.ResetMaxSnapshotStorageMB()
+	tu.mutation.SetMaxSnapshotStorageMB(i)
+	return tu
+}
+
+// SetNillableMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxSnapshotStorageMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxSnapshotStorageMB(*i)
+	}
+	return tu
+}
+
+// AddMaxSnapshotStorageMB adds i to the "max_snapshot_storage_mb" field.
+func (tu *TierUpdate) AddMaxSnapshotStorageMB(i int64) *TierUpdate {
+	tu.mutation.AddMaxSnapshotStorageMB(i)
+	return tu
+}
+
+// ClearMaxSnapshotStorageMB clears the value of the "max_snapshot_storage_mb" field.
+func (tu *TierUpdate) ClearMaxSnapshotStorageMB() *TierUpdate {
+	tu.mutation.ClearMaxSnapshotStorageMB()
+	return tu
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tu *TierUpdate) AddTeamIDs(ids ...uuid.UUID) *TierUpdate {
 	tu.mutation.AddTeamIDs(ids...)
@@ -212,6 +347,51 @@ func (tu *TierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tu.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxVcpu(); ok {
+		_spec.AddField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if tu.mutation.MaxVcpuCleared() {
+		_spec.ClearField(tier.FieldMaxVcpu, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxRAMMB(); ok {
+		_spec.AddField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if tu.mutation.MaxRAMMBCleared() {
+		_spec.ClearField(tier.FieldMaxRAMMB, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.MaxSandboxStartsPerMinute(); ok {
+		_spec.SetField(tier.FieldMaxSandboxStartsPerMinute, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxSandboxStartsPerMinute(); ok {
+		_spec.AddField(tier.FieldMaxSandboxStartsPerMinute, field.TypeInt64, value)
+	}
+	if tu.mutation.MaxSandboxStartsPerMinuteCleared() {
+		_spec.ClearField(tier.FieldMaxSandboxStartsPerMinute, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.MaxConcurrentBuilds(); ok {
+		_spec.SetField(tier.FieldMaxConcurrentBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxConcurrentBuilds(); ok {
+		_spec.AddField(tier.FieldMaxConcurrentBuilds, field.TypeInt64, value)
+	}
+	if tu.mutation.MaxConcurrentBuildsCleared() {
+		_spec.ClearField(tier.FieldMaxConcurrentBuilds, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.MaxSnapshotStorageMB(); ok {
+		_spec.SetField(tier.FieldMaxSnapshotStorageMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxSnapshotStorageMB(); ok {
+		_spec.AddField(tier.FieldMaxSnapshotStorageMB, field.TypeInt64, value)
+	}
+	if tu.mutation.MaxSnapshotStorageMBCleared() {
+		_spec.ClearField(tier.FieldMaxSnapshotStorageMB, field.TypeInt64)
+	}
 	if tu.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -361,6 +541,141 @@ func (tuo *TierUpdateOne) AddMaxLengthHours(i int64) *TierUpdateOne {
 	return tuo
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tuo *TierUpdateOne) SetMaxVcpu(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxVcpu()
+	tuo.mutation.SetMaxVcpu(i)
+	return tuo
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxVcpu(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxVcpu(*i)
+	}
+	return tuo
+}
+
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (tuo *TierUpdateOne) AddMaxVcpu(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxVcpu(i)
+	return tuo
+}
+
+// ClearMaxVcpu clears the value of the "max_vcpu" field.
+func (tuo *TierUpdateOne) ClearMaxVcpu() *TierUpdateOne {
+	tuo.mutation.ClearMaxVcpu()
+	return tuo
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tuo *TierUpdateOne) SetMaxRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxRAMMB()
+	tuo.mutation.SetMaxRAMMB(i)
+	return tuo
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxRAMMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxRAMMB(*i)
+	}
+	return tuo
+}
+
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (tuo *TierUpdateOne) AddMaxRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxRAMMB(i)
+	return tuo
+}
+
+// ClearMaxRAMMB clears the value of the "max_ram_mb" field.
+func (tuo *TierUpdateOne) ClearMaxRAMMB() *TierUpdateOne {
+	tuo.mutation.ClearMaxRAMMB()
+	return tuo
+}
+
+// SetMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field.
+func (tuo *TierUpdateOne) SetMaxSandboxStartsPerMinute(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxSandboxStartsPerMinute()
+	tuo.mutation.SetMaxSandboxStartsPerMinute(i)
+	return tuo
+}
+
+// SetNillableMaxSandboxStartsPerMinute sets the "max_sandbox_starts_per_minute" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxSandboxStartsPerMinute(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxSandboxStartsPerMinute(*i)
+	}
+	return tuo
+}
+
+// AddMaxSandboxStartsPerMinute adds i to the "max_sandbox_starts_per_minute" field.
+func (tuo *TierUpdateOne) AddMaxSandboxStartsPerMinute(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxSandboxStartsPerMinute(i)
+	return tuo
+}
+
+// ClearMaxSandboxStartsPerMinute clears the value of the "max_sandbox_starts_per_minute" field.
+func (tuo *TierUpdateOne) ClearMaxSandboxStartsPerMinute() *TierUpdateOne {
+	tuo.mutation.ClearMaxSandboxStartsPerMinute()
+	return tuo
+}
+
+// SetMaxConcurrentBuilds sets the "max_concurrent_builds" field.
+func (tuo *TierUpdateOne) SetMaxConcurrentBuilds(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxConcurrentBuilds()
+	tuo.mutation.SetMaxConcurrentBuilds(i)
+	return tuo
+}
+
+// SetNillableMaxConcurrentBuilds sets the "max_concurrent_builds" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxConcurrentBuilds(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxConcurrentBuilds(*i)
+	}
+	return tuo
+}
+
+// AddMaxConcurrentBuilds adds i to the "max_concurrent_builds" field.
+func (tuo *TierUpdateOne) AddMaxConcurrentBuilds(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxConcurrentBuilds(i)
+	return tuo
+}
+
+// ClearMaxConcurrentBuilds clears the value of the "max_concurrent_builds" field.
+func (tuo *TierUpdateOne) ClearMaxConcurrentBuilds() *TierUpdateOne {
+	tuo.mutation.ClearMaxConcurrentBuilds()
+	return tuo
+}
+
+// SetMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field.
+func (tuo *TierUpdateOne) SetMaxSnapshotStorageMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxSnapshotStorageMB()
+	tuo.mutation.SetMaxSnapshotStorageMB(i)
+	return tuo
+}
+
+// SetNillableMaxSnapshotStorageMB sets the "max_snapshot_storage_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxSnapshotStorageMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxSnapshotStorageMB(*i)
+	}
+	return tuo
+}
+
+// AddMaxSnapshotStorageMB adds i to the "max_snapshot_storage_mb" field.
+func (tuo *TierUpdateOne) AddMaxSnapshotStorageMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxSnapshotStorageMB(i)
+	return tuo
+}
+
+// ClearMaxSnapshotStorageMB clears the value of the "max_snapshot_storage_mb" field.
+func (tuo *TierUpdateOne) ClearMaxSnapshotStorageMB() *TierUpdateOne {
+	tuo.mutation.ClearMaxSnapshotStorageMB()
+	return tuo
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tuo *TierUpdateOne) AddTeamIDs(ids ...uuid.UUID) *TierUpdateOne {
 	tuo.mutation.AddTeamIDs(ids...)
@@ -495,6 +810,51 @@ func (tuo *TierUpdateOne) sqlSave(ctx context.Context) (_node *Tier, err error)
 	if value, ok := tuo.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tuo.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxVcpu(); ok {
+		_spec.AddField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if tuo.mutation.MaxVcpuCleared() {
+		_spec.ClearField(tier.FieldMaxVcpu, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxRAMMB(); ok {
+		_spec.AddField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if tuo.mutation.MaxRAMMBCleared() {
+		_spec.ClearField(tier.FieldMaxRAMMB, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.MaxSandboxStartsPerMinute(); ok {
+		_spec.SetField(tier.FieldMaxSandboxStartsPerMinute, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxSandboxStartsPerMinute(); ok {
+		_spec.AddField(tier.FieldMaxSandboxStartsPerMinute, field.TypeInt64, value)
+	}
+	if tuo.mutation.MaxSandboxStartsPerMinuteCleared() {
+		_spec.ClearField(tier.FieldMaxSandboxStartsPerMinute, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.MaxConcurrentBuilds(); ok {
+		_spec.SetField(tier.FieldMaxConcurrentBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxConcurrentBuilds(); ok {
+		_spec.AddField(tier.FieldMaxConcurrentBuilds, field.TypeInt64, value)
+	}
+	if tuo.mutation.MaxConcurrentBuildsCleared() {
+		_spec.ClearField(tier.FieldMaxConcurrentBuilds, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.MaxSnapshotStorageMB(); ok {
+		_spec.SetField(tier.FieldMaxSnapshotStorageMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxSnapshotStorageMB(); ok {
+		_spec.AddField(tier.FieldMaxSnapshotStorageMB, field.TypeInt64, value)
+	}
+	if tuo.mutation.MaxSnapshotStorageMBCleared() {
+		_spec.ClearField(tier.FieldMaxSnapshotStorageMB, field.TypeInt64)
+	}
 	if tuo.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Checkpoint is a named snapshot of a sandbox created by the user.
+// Unlike Snapshot, there can be many checkpoints per sandbox, each backed by its own build.
+type Checkpoint struct {
+	ent.Schema
+}
+
+func (Checkpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
+		field.String("sandbox_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("base_env_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("env_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.UUID("build_id", uuid.UUID{}),
+		field.JSON("metadata", map[string]string{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+	}
+}
+
+func (Checkpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("env", Env.Type).Ref("checkpoints").Unique().Field("env_id").Required(),
+		edge.From("build", EnvBuild.Type).Ref("checkpoints").Unique().Field("build_id").Required(),
+	}
+}
+
+func (Checkpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
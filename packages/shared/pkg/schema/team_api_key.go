@@ -28,6 +28,11 @@ func (TeamAPIKey) Fields() []ent.Field {
 		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}).Default("Unnamed API Key"),
 		field.UUID("created_by", uuid.UUID{}).Nillable().Optional(),
 		field.Time("last_used").Nillable().Optional(),
+		// Scopes limit the operations the key can be used for, a key without scopes has full access.
+		field.JSON("scopes", []string{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.Time("expires_at").Nillable().Optional(),
+		// If set, the key can create sandboxes only from this template.
+		field.String("template_id").SchemaType(map[string]string{dialect.Postgres: "text"}).Nillable().Optional(),
 	}
 }
 
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TeamWebhook is an endpoint the sandbox and template lifecycle events of the team are delivered to.
+type TeamWebhook struct {
+	ent.Schema
+}
+
+func (TeamWebhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("url").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// The secret is used to sign the payloads of the deliveries.
+		field.String("secret").Sensitive().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// Events the webhook is subscribed to, a webhook without events receives all of them.
+		field.JSON("events", []string{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+	}
+}
+
+func (TeamWebhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).Unique().Required().
+			Ref("team_webhooks").
+			Field("team_id"),
+		edge.To("deliveries", WebhookDelivery.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (TeamWebhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
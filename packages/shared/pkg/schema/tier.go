@@ -20,6 +20,11 @@ func (Tier) Fields() []ent.Field {
 		field.Int64("disk_mb").Annotations(entsql.Check("disk_mb > 0"), entsql.Default("512")),
 		field.Int64("concurrent_instances").Annotations(entsql.Check("concurrent_instances > 0")).Comment("The number of instances the team can run concurrently"),
 		field.Int64("max_length_hours"),
+		field.Int64("max_vcpu").Optional().Nillable().Comment("The total number of vCPUs the running sandboxes of the team can use, unlimited if null"),
+		field.Int64("max_ram_mb").Optional().Nillable().Comment("The total RAM in MB the running sandboxes of the team can use, unlimited if null"),
+		field.Int64("max_sandbox_starts_per_minute").Optional().Nillable().Comment("The number of sandboxes the team can start per minute, unlimited if null"),
+		field.Int64("max_concurrent_builds").Optional().Nillable().Comment("The number of template builds the team can run concurrently, unlimited if null"),
+		field.Int64("max_snapshot_storage_mb").Optional().Nillable().Comment("The total size in MB of the stored snapshots of the team, unlimited if null"),
 	}
 }
 
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery is a log of the delivery of one event to the team webhook.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.UUID("event_id", uuid.UUID{}),
+		field.String("event_type").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("payload").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Enum("status").Values("pending", "succeeded", "failed").Default("pending").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int32("attempts").Default(0),
+		field.Int32("response_status").Nillable().Optional(),
+		field.String("error").SchemaType(map[string]string{dialect.Postgres: "text"}).Nillable().Optional(),
+		field.Time("delivered_at").Nillable().Optional(),
+		field.Time("next_attempt_at").Nillable().Optional().Comment("The time of the next delivery attempt of the pending delivery"),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", TeamWebhook.Type).Ref("deliveries").Unique().Field("webhook_id").Required(),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -0,0 +1,278 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// compactionChunkSize is the size of the reads from the layers when the data is copied to the compacted build.
+const compactionChunkSize = 4 << 20
+
+// CompactionThreshold defines when the header chain of a build is deep enough to be compacted.
+type CompactionThreshold struct {
+	MaxGeneration uint64
+	MaxMappings   int
+}
+
+// Exceeded returns true if the header has more generations or mappings than allowed.
+func (t CompactionThreshold) Exceeded(h *header.Header) bool {
+	return h.Metadata.Generation > t.MaxGeneration || len(h.Mapping) > t.MaxMappings
+}
+
+// NeedsCompaction checks the memfile and rootfs headers of the build against the threshold.
+// Builds without headers (templates) are never compacted.
+func NeedsCompaction(ctx context.Context, provider Provider, buildID string, threshold CompactionThreshold) (bool, error) {
+	files := TemplateFiles{BuildId: buildID}
+
+	for _, path := range []string{files.StorageMemfileHeaderPath(), files.StorageRootfsHeaderPath()} {
+		h, err := readHeader(ctx, provider, path)
+		if errors.Is(err, ErrObjectNotExist) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if threshold.Exceeded(h) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CompactBuild rewrites the memfile and rootfs of the build into a new build with a single layer.
+// The data of all the layers referenced by the headers is copied to the new build, so the layers are not needed to start a sandbox from it anymore.
+// The snapfile is copied as is, the headers are written in the current format.
+func CompactBuild(ctx context.Context, provider Provider, buildID string, newBuildID string) error {
+	newID, err := uuid.Parse(newBuildID)
+	if err != nil {
+		return fmt.Errorf("failed to parse new build id: %w", err)
+	}
+
+	src := TemplateFiles{BuildId: buildID}
+	dst := TemplateFiles{BuildId: newBuildID}
+
+	err = compactFile(ctx, provider, newID, MemfileName, src.StorageMemfileHeaderPath(), dst.StorageMemfilePath(), dst.StorageMemfileHeaderPath())
+	if err != nil {
+		return fmt.Errorf("failed to compact memfile: %w", err)
+	}
+
+	err = compactFile(ctx, provider, newID, RootfsName, src.StorageRootfsHeaderPath(), dst.StorageRootfsPath(), dst.StorageRootfsHeaderPath())
+	if err != nil {
+		return fmt.Errorf("failed to compact rootfs: %w", err)
+	}
+
+	err = copyObject(ctx, provider, src.StorageSnapfilePath(), dst.StorageSnapfilePath())
+	if err != nil {
+		return fmt.Errorf("failed to copy snapfile: %w", err)
+	}
+
+	return nil
+}
+
+// CompactMappings returns the mappings of a single layer build with the given ID and compression that contains the same data as the original mappings.
+// The data of the new build is stored in the order of the mappings, the empty (nil build) mappings are kept as they are.
+func CompactMappings(mappings []*header.BuildMap, buildID uuid.UUID, compression header.Compression) []*header.BuildMap {
+	compacted := make([]*header.BuildMap, 0, len(mappings))
+
+	var storageOffset uint64
+
+	for _, mapping := range mappings {
+		if mapping.BuildId == uuid.Nil {
+			compacted = append(compacted, &header.BuildMap{
+				Offset:  mapping.Offset,
+				Length:  mapping.Length,
+				BuildId: uuid.Nil,
+			})
+
+			continue
+		}
+
+		if len(compacted) > 0 {
+			last := compacted[len(compacted)-1]
+
+			if last.BuildId == buildID && last.Offset+last.Length == mapping.Offset {
+				last.Length += mapping.Length
+				storageOffset += mapping.Length
+
+				continue
+			}
+		}
+
+		compacted = append(compacted, &header.BuildMap{
+			Offset:             mapping.Offset,
+			Length:             mapping.Length,
+			BuildId:            buildID,
+			BuildStorageOffset: storageOffset,
+			Compression:        compression,
+		})
+
+		storageOffset += mapping.Length
+	}
+
+	return compacted
+}
+
+func compactFile(
+	ctx context.Context,
+	provider Provider,
+	newBuildID uuid.UUID,
+	fileName string,
+	headerPath string,
+	dataPath string,
+	newHeaderPath string,
+) error {
+	h, err := readHeader(ctx, provider, headerPath)
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(writeLayers(ctx, provider, fileName, h.Mapping, writer))
+	}()
+
+	// The compacted build keeps the compression of the original one.
+	err = WriteCompressed(ctx, provider, dataPath, reader, h.Metadata.Compression)
+	// Unblock the writer if the upload failed before reading everything.
+	reader.CloseWithError(err)
+	if err != nil {
+		return err
+	}
+
+	metadata := &header.Metadata{
+		Version:     header.CurrentVersion,
+		BlockSize:   h.Metadata.BlockSize,
+		Size:        h.Metadata.Size,
+		Generation:  1,
+		BuildId:     newBuildID,
+		BaseBuildId: h.Metadata.BaseBuildId,
+		Compression: h.Metadata.Compression,
+	}
+
+	serialized, err := header.Serialize(metadata, CompactMappings(h.Mapping, newBuildID, h.Metadata.Compression))
+	if err != nil {
+		return fmt.Errorf("failed to serialize header: %w", err)
+	}
+
+	headerObject, err := provider.OpenObject(ctx, newHeaderPath)
+	if err != nil {
+		return fmt.Errorf("failed to open object '%s': %w", newHeaderPath, err)
+	}
+
+	_, err = headerObject.ReadFrom(serialized)
+	if err != nil {
+		return fmt.Errorf("failed to write object '%s': %w", newHeaderPath, err)
+	}
+
+	return nil
+}
+
+// writeLayers writes the data of all non-empty mappings to the writer in the order of the mappings.
+// The compressed layers are decompressed.
+func writeLayers(ctx context.Context, provider Provider, fileName string, mappings []*header.BuildMap, w io.Writer) error {
+	layers := make(map[uuid.UUID]io.ReaderAt)
+	buf := make([]byte, compactionChunkSize)
+
+	for _, mapping := range mappings {
+		if mapping.BuildId == uuid.Nil {
+			continue
+		}
+
+		layer, ok := layers[mapping.BuildId]
+		if !ok {
+			path := fmt.Sprintf("%s/%s", mapping.BuildId.String(), fileName)
+
+			var err error
+
+			layer, _, err = OpenData(ctx, provider, path, mapping.Compression)
+			if err != nil {
+				return fmt.Errorf("failed to open layer '%s': %w", path, err)
+			}
+
+			layers[mapping.BuildId] = layer
+		}
+
+		err := copyRange(w, layer, int64(mapping.BuildStorageOffset), int64(mapping.Length), buf)
+		if err != nil {
+			return fmt.Errorf("failed to copy mapping at offset %d from build '%s': %w", mapping.Offset, mapping.BuildId, err)
+		}
+	}
+
+	return nil
+}
+
+func copyRange(w io.Writer, r io.ReaderAt, off, length int64, buf []byte) error {
+	for length > 0 {
+		chunk := buf[:min(int64(len(buf)), length)]
+
+		n, err := r.ReadAt(chunk, off)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read at offset %d: %w", off, err)
+		}
+
+		if n == 0 {
+			return fmt.Errorf("failed to read at offset %d: %w", off, io.ErrUnexpectedEOF)
+		}
+
+		_, err = w.Write(chunk[:n])
+		if err != nil {
+			return err
+		}
+
+		off += int64(n)
+		length -= int64(n)
+	}
+
+	return nil
+}
+
+func copyObject(ctx context.Context, provider Provider, srcPath, dstPath string) error {
+	src, err := provider.OpenObject(ctx, srcPath)
+	if err != nil {
+		return fmt.Errorf("failed to open object '%s': %w", srcPath, err)
+	}
+
+	dst, err := provider.OpenObject(ctx, dstPath)
+	if err != nil {
+		return fmt.Errorf("failed to open object '%s': %w", dstPath, err)
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		_, copyErr := src.WriteTo(writer)
+		writer.CloseWithError(copyErr)
+	}()
+
+	_, err = dst.ReadFrom(reader)
+	reader.CloseWithError(err)
+	if err != nil {
+		return fmt.Errorf("failed to write object '%s': %w", dstPath, err)
+	}
+
+	return nil
+}
+
+func readHeader(ctx context.Context, provider Provider, path string) (*header.Header, error) {
+	object, err := provider.OpenObject(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open header '%s': %w", path, err)
+	}
+
+	h, err := header.Deserialize(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize header '%s': %w", path, err)
+	}
+
+	return h, nil
+}
@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const testBlockSize = 16
+
+func writeTestObject(t *testing.T, provider Provider, path string, data []byte) {
+	t.Helper()
+
+	object, err := provider.OpenObject(context.Background(), path)
+	require.NoError(t, err)
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	require.NoError(t, err)
+}
+
+func readTestObject(t *testing.T, provider Provider, path string) []byte {
+	t.Helper()
+
+	object, err := provider.OpenObject(context.Background(), path)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	_, err = object.WriteTo(&buf)
+	require.NoError(t, err)
+
+	return buf.Bytes()
+}
+
+// readThroughHeader reads the whole file described by the header from the layers it maps to.
+func readThroughHeader(t *testing.T, provider Provider, h *header.Header, fileName string) []byte {
+	t.Helper()
+
+	data := make([]byte, h.Metadata.Size)
+
+	for _, mapping := range h.Mapping {
+		if mapping.BuildId == uuid.Nil {
+			continue
+		}
+
+		layer, _, err := OpenData(context.Background(), provider, fmt.Sprintf("%s/%s", mapping.BuildId, fileName), mapping.Compression)
+		require.NoError(t, err)
+
+		_, err = layer.ReadAt(data[mapping.Offset:mapping.Offset+mapping.Length], int64(mapping.BuildStorageOffset))
+		require.NoError(t, err)
+	}
+
+	return data
+}
+
+func TestCompactBuild(t *testing.T) {
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	templateID := uuid.New()
+	diffID := uuid.New()
+	compactedID := uuid.New()
+
+	for _, fileName := range []string{MemfileName, RootfsName} {
+		writeTestObject(t, provider, fmt.Sprintf("%s/%s", templateID, fileName), bytes.Repeat([]byte("t"), 4*testBlockSize))
+		writeTestObject(t, provider, fmt.Sprintf("%s/%s", diffID, fileName), bytes.Repeat([]byte("d"), 2*testBlockSize))
+
+		mappings := []*header.BuildMap{
+			{Offset: 0, Length: testBlockSize, BuildId: templateID},
+			{Offset: testBlockSize, Length: testBlockSize, BuildId: diffID, BuildStorageOffset: testBlockSize},
+			{Offset: 2 * testBlockSize, Length: testBlockSize, BuildId: uuid.Nil},
+			{Offset: 3 * testBlockSize, Length: testBlockSize, BuildId: templateID, BuildStorageOffset: 3 * testBlockSize},
+		}
+
+		serialized, err := header.Serialize(&header.Metadata{
+			Version:     1,
+			BlockSize:   testBlockSize,
+			Size:        4 * testBlockSize,
+			Generation:  5,
+			BuildId:     diffID,
+			BaseBuildId: templateID,
+		}, mappings)
+		require.NoError(t, err)
+
+		object, err := provider.OpenObject(ctx, fmt.Sprintf("%s/%s%s", diffID, fileName, HeaderSuffix))
+		require.NoError(t, err)
+
+		_, err = object.ReadFrom(serialized)
+		require.NoError(t, err)
+	}
+
+	writeTestObject(t, provider, fmt.Sprintf("%s/%s", diffID, SnapfileName), []byte("snapfile"))
+
+	needed, err := NeedsCompaction(ctx, provider, diffID.String(), CompactionThreshold{MaxGeneration: 10, MaxMappings: 10})
+	require.NoError(t, err)
+	assert.False(t, needed)
+
+	needed, err = NeedsCompaction(ctx, provider, diffID.String(), CompactionThreshold{MaxGeneration: 4, MaxMappings: 10})
+	require.NoError(t, err)
+	assert.True(t, needed)
+
+	needed, err = NeedsCompaction(ctx, provider, templateID.String(), CompactionThreshold{})
+	require.NoError(t, err)
+	assert.False(t, needed)
+
+	err = CompactBuild(ctx, provider, diffID.String(), compactedID.String())
+	require.NoError(t, err)
+
+	for _, fileName := range []string{MemfileName, RootfsName} {
+		original, err := readHeader(ctx, provider, fmt.Sprintf("%s/%s%s", diffID, fileName, HeaderSuffix))
+		require.NoError(t, err)
+
+		compacted, err := readHeader(ctx, provider, fmt.Sprintf("%s/%s%s", compactedID, fileName, HeaderSuffix))
+		require.NoError(t, err)
+
+		assert.Equal(t, compactedID, compacted.Metadata.BuildId)
+		assert.Equal(t, templateID, compacted.Metadata.BaseBuildId)
+		assert.Equal(t, uint64(1), compacted.Metadata.Generation)
+
+		require.NoError(t, header.ValidateMappings(compacted.Mapping, compacted.Metadata.Size, compacted.Metadata.BlockSize))
+
+		layers := header.Layers(compacted.Mapping)
+		assert.Len(t, *layers, 2)
+		assert.Contains(t, *layers, compactedID)
+		assert.Contains(t, *layers, uuid.Nil)
+
+		assert.Equal(t, readThroughHeader(t, provider, original, fileName), readThroughHeader(t, provider, compacted, fileName))
+
+		// The empty block is not stored in the compacted build.
+		assert.Len(t, readTestObject(t, provider, fmt.Sprintf("%s/%s", compactedID, fileName)), 3*testBlockSize)
+	}
+
+	assert.Equal(t, []byte("snapfile"), readTestObject(t, provider, fmt.Sprintf("%s/%s", compactedID, SnapfileName)))
+}
+
+func TestCompactMappings(t *testing.T) {
+	buildID := uuid.New()
+	otherID := uuid.New()
+
+	compacted := CompactMappings([]*header.BuildMap{
+		{Offset: 0, Length: 32, BuildId: otherID, BuildStorageOffset: 64},
+		{Offset: 32, Length: 16, BuildId: uuid.New()},
+		{Offset: 48, Length: 16, BuildId: uuid.Nil},
+		{Offset: 64, Length: 16, BuildId: otherID},
+	}, buildID, header.CompressionZstd)
+
+	assert.True(t, header.Equal(compacted, []*header.BuildMap{
+		{Offset: 0, Length: 48, BuildId: buildID},
+		{Offset: 48, Length: 16, BuildId: uuid.Nil},
+		{Offset: 64, Length: 16, BuildId: buildID},
+	}))
+
+	assert.Equal(t, uint64(0), compacted[0].BuildStorageOffset)
+	assert.Equal(t, uint64(48), compacted[2].BuildStorageOffset)
+
+	assert.Equal(t, header.CompressionZstd, compacted[0].Compression)
+	assert.Equal(t, header.CompressionNone, compacted[1].Compression)
+}
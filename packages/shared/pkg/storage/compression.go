@@ -0,0 +1,383 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	// FrameSize is the uncompressed size of the independently compressed frames.
+	// It matches the size of the chunks fetched by the orchestrator, so each fetch decompresses exactly one frame.
+	FrameSize = 4 << 20
+
+	// FrameIndexSuffix is appended to the path of a compressed object to get the path of its frame index.
+	FrameIndexSuffix = ".frames"
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// FrameIndex makes a compressed object seekable.
+// The object is a sequence of frames, each holding FrameSize bytes of the uncompressed data (the last one can be shorter).
+// A frame is stored uncompressed if the compression would not make it smaller, which is recognized by the stored size being the same as the uncompressed one.
+type FrameIndex struct {
+	Compression header.Compression
+	FrameSize   uint64
+	// Size is the uncompressed size of the object.
+	Size uint64
+	// Offsets are the offsets of the frames in the compressed object, followed by the size of the compressed object.
+	Offsets []uint64
+}
+
+type frameIndexPreamble struct {
+	Compression header.Compression
+	FrameSize   uint64
+	Size        uint64
+	FrameCount  uint64
+}
+
+func (i *FrameIndex) Serialize() io.Reader {
+	var buf bytes.Buffer
+
+	// Writing to a buffer can't fail.
+	_ = binary.Write(&buf, binary.LittleEndian, &frameIndexPreamble{
+		Compression: i.Compression,
+		FrameSize:   i.FrameSize,
+		Size:        i.Size,
+		FrameCount:  uint64(len(i.Offsets) - 1),
+	})
+	_ = binary.Write(&buf, binary.LittleEndian, i.Offsets)
+
+	return &buf
+}
+
+func DeserializeFrameIndex(in io.WriterTo) (*FrameIndex, error) {
+	var buf bytes.Buffer
+
+	_, err := in.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+	}
+
+	var preamble frameIndexPreamble
+
+	err = binary.Read(&buf, binary.LittleEndian, &preamble)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read frame index: %w", err)
+	}
+
+	if preamble.FrameSize == 0 || preamble.FrameCount != (preamble.Size+preamble.FrameSize-1)/preamble.FrameSize {
+		return nil, fmt.Errorf("frame index has %d frames of %d B for %d B", preamble.FrameCount, preamble.FrameSize, preamble.Size)
+	}
+
+	if uint64(buf.Len()) != (preamble.FrameCount+1)*8 {
+		return nil, fmt.Errorf("frame index has %d B of offsets, expected %d", buf.Len(), (preamble.FrameCount+1)*8)
+	}
+
+	offsets := make([]uint64, preamble.FrameCount+1)
+
+	err = binary.Read(&buf, binary.LittleEndian, offsets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read frame offsets: %w", err)
+	}
+
+	return &FrameIndex{
+		Compression: preamble.Compression,
+		FrameSize:   preamble.FrameSize,
+		Size:        preamble.Size,
+		Offsets:     offsets,
+	}, nil
+}
+
+// frameLength returns the uncompressed length of the frame.
+func (i *FrameIndex) frameLength(frame uint64) uint64 {
+	return min(i.FrameSize, i.Size-frame*i.FrameSize)
+}
+
+// WriteCompressed compresses the data from the reader to the object at the path and writes the frame index next to it.
+// The index is written before the data, so an existing object is never read without its index.
+// With no compression the data is written as is, without the index.
+func WriteCompressed(ctx context.Context, provider Provider, path string, r io.Reader, compression header.Compression) error {
+	object, err := provider.OpenObject(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to open object '%s': %w", path, err)
+	}
+
+	if compression == header.CompressionNone {
+		_, err = object.ReadFrom(r)
+		if err != nil {
+			return fmt.Errorf("failed to write object '%s': %w", path, err)
+		}
+
+		return nil
+	}
+
+	compressed, err := os.CreateTemp("", "compressed-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	defer os.Remove(compressed.Name())
+	defer compressed.Close()
+
+	index := &FrameIndex{
+		Compression: compression,
+		FrameSize:   FrameSize,
+		Offsets:     []uint64{0},
+	}
+
+	err = compressFrames(r, compressed, index)
+	if err != nil {
+		return fmt.Errorf("failed to compress '%s': %w", path, err)
+	}
+
+	err = compressed.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+
+	indexObject, err := provider.OpenObject(ctx, path+FrameIndexSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to open frame index '%s': %w", path+FrameIndexSuffix, err)
+	}
+
+	_, err = indexObject.ReadFrom(index.Serialize())
+	if err != nil {
+		return fmt.Errorf("failed to write frame index '%s': %w", path+FrameIndexSuffix, err)
+	}
+
+	err = object.WriteFromFileSystem(compressed.Name())
+	if err != nil {
+		return fmt.Errorf("failed to write object '%s': %w", path, err)
+	}
+
+	return nil
+}
+
+// WriteCompressedFile is WriteCompressed for a local file.
+func WriteCompressedFile(ctx context.Context, provider Provider, path string, filePath string, compression header.Compression) error {
+	if compression == header.CompressionNone {
+		object, err := provider.OpenObject(ctx, path)
+		if err != nil {
+			return fmt.Errorf("failed to open object '%s': %w", path, err)
+		}
+
+		return object.WriteFromFileSystem(filePath)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s: %w", filePath, err)
+	}
+
+	defer file.Close()
+
+	return WriteCompressed(ctx, provider, path, file, compression)
+}
+
+// compressFrames writes the compressed frames of the data to the writer and fills the index.
+func compressFrames(r io.Reader, w io.Writer, index *FrameIndex) error {
+	frame := make([]byte, index.FrameSize)
+
+	for {
+		n, err := io.ReadFull(r, frame)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("failed to read frame %d: %w", len(index.Offsets)-1, err)
+		}
+
+		compressed, compressErr := compressFrame(index.Compression, frame[:n])
+		if compressErr != nil {
+			return fmt.Errorf("failed to compress frame %d: %w", len(index.Offsets)-1, compressErr)
+		}
+
+		_, writeErr := w.Write(compressed)
+		if writeErr != nil {
+			return writeErr
+		}
+
+		index.Size += uint64(n)
+		index.Offsets = append(index.Offsets, index.Offsets[len(index.Offsets)-1]+uint64(len(compressed)))
+
+		if err != nil {
+			return nil
+		}
+	}
+}
+
+func compressFrame(compression header.Compression, frame []byte) ([]byte, error) {
+	var compressed []byte
+
+	switch compression {
+	case header.CompressionZstd:
+		compressed = zstdEncoder.EncodeAll(frame, nil)
+	case header.CompressionLZ4:
+		compressed = make([]byte, lz4.CompressBlockBound(len(frame)))
+
+		n, err := lz4.CompressBlock(frame, compressed, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// Zero means the frame is not compressible.
+		if n == 0 {
+			return frame, nil
+		}
+
+		compressed = compressed[:n]
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", compression)
+	}
+
+	if len(compressed) >= len(frame) {
+		return frame, nil
+	}
+
+	return compressed, nil
+}
+
+func decompressFrame(compression header.Compression, stored []byte, length uint64) ([]byte, error) {
+	if uint64(len(stored)) == length {
+		return stored, nil
+	}
+
+	switch compression {
+	case header.CompressionZstd:
+		frame, err := zstdDecoder.DecodeAll(stored, make([]byte, 0, length))
+		if err != nil {
+			return nil, err
+		}
+
+		if uint64(len(frame)) != length {
+			return nil, fmt.Errorf("frame has %d B, expected %d", len(frame), length)
+		}
+
+		return frame, nil
+	case header.CompressionLZ4:
+		frame := make([]byte, length)
+
+		n, err := lz4.UncompressBlock(stored, frame)
+		if err != nil {
+			return nil, err
+		}
+
+		if uint64(n) != length {
+			return nil, fmt.Errorf("frame has %d B, expected %d", n, length)
+		}
+
+		return frame, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", compression)
+	}
+}
+
+// FrameReader reads arbitrary ranges of the uncompressed data of a compressed object.
+// Only the frames overlapping the range are fetched and decompressed.
+type FrameReader struct {
+	object io.ReaderAt
+	index  *FrameIndex
+}
+
+func NewFrameReader(object io.ReaderAt, index *FrameIndex) *FrameReader {
+	return &FrameReader{
+		object: object,
+		index:  index,
+	}
+}
+
+func (r *FrameReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset %d", off)
+	}
+
+	if uint64(off) >= r.index.Size {
+		return 0, io.EOF
+	}
+
+	end := min(uint64(off)+uint64(len(p)), r.index.Size)
+
+	first := uint64(off) / r.index.FrameSize
+	last := (end - 1) / r.index.FrameSize
+
+	stored := make([]byte, r.index.Offsets[last+1]-r.index.Offsets[first])
+
+	_, err := r.object.ReadAt(stored, int64(r.index.Offsets[first]))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, fmt.Errorf("failed to read frames %d-%d: %w", first, last, err)
+	}
+
+	n := 0
+
+	for frame := first; frame <= last; frame++ {
+		start := r.index.Offsets[frame] - r.index.Offsets[first]
+		length := r.index.Offsets[frame+1] - r.index.Offsets[frame]
+
+		data, err := decompressFrame(r.index.Compression, stored[start:start+length], r.index.frameLength(frame))
+		if err != nil {
+			return n, fmt.Errorf("failed to decompress frame %d: %w", frame, err)
+		}
+
+		frameOff := frame * r.index.FrameSize
+		if frame == first {
+			data = data[uint64(off)-frameOff:]
+		}
+
+		n += copy(p[n:], data)
+	}
+
+	if uint64(off)+uint64(len(p)) > r.index.Size {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// OpenData opens the data object of a build file, transparently decompressing it if it was stored compressed.
+// The compression is taken from the header mapping of the build, so the uncompressed objects are read without looking up the frame index.
+// It returns the reader of the uncompressed data and its size.
+func OpenData(ctx context.Context, provider Provider, path string, compression header.Compression) (io.ReaderAt, int64, error) {
+	object, err := provider.OpenObject(ctx, path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open object '%s': %w", path, err)
+	}
+
+	if compression == header.CompressionNone {
+		size, err := object.Size()
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get object size: %w", err)
+		}
+
+		return object, size, nil
+	}
+
+	indexObject, err := provider.OpenObject(ctx, path+FrameIndexSuffix)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open frame index '%s': %w", path+FrameIndexSuffix, err)
+	}
+
+	index, err := DeserializeFrameIndex(indexObject)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read frame index '%s': %w", path+FrameIndexSuffix, err)
+	}
+
+	if index.Compression != compression {
+		return nil, 0, fmt.Errorf("frame index '%s' has %s compression, expected %s", path+FrameIndexSuffix, index.Compression, compression)
+	}
+
+	return NewFrameReader(object, index), int64(index.Size), nil
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// testCompressibleData returns data with compressible and incompressible frames, ending with a partial frame.
+func testCompressibleData() []byte {
+	random := make([]byte, FrameSize)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	var data []byte
+	data = append(data, bytes.Repeat([]byte("memory"), FrameSize/6+1)[:FrameSize]...)
+	data = append(data, random...)
+	data = append(data, make([]byte, FrameSize/2)...)
+
+	return data
+}
+
+func TestWriteCompressed(t *testing.T) {
+	data := testCompressibleData()
+
+	for _, compression := range []header.Compression{header.CompressionNone, header.CompressionZstd, header.CompressionLZ4} {
+		t.Run(compression.String(), func(t *testing.T) {
+			provider, err := NewFileSystemStorageProvider(t.TempDir())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+
+			require.NoError(t, WriteCompressed(ctx, provider, "build/memfile", bytes.NewReader(data), compression))
+
+			if compression != header.CompressionNone {
+				assert.Less(t, len(readTestObject(t, provider, "build/memfile")), len(data))
+			}
+
+			reader, size, err := OpenData(ctx, provider, "build/memfile", compression)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(data)), size)
+
+			// Ranges within a frame, across frames and past the end.
+			for _, r := range []struct{ off, length int64 }{
+				{0, FrameSize},
+				{FrameSize - 10, 20},
+				{100, 2 * FrameSize},
+				{int64(len(data)) - 5, 5},
+			} {
+				p := make([]byte, r.length)
+
+				n, err := reader.ReadAt(p, r.off)
+				if err != nil {
+					require.ErrorIs(t, err, io.EOF)
+				}
+
+				assert.Equal(t, data[r.off:r.off+int64(n)], p[:n], "range %d+%d", r.off, r.length)
+			}
+
+			n, _ := reader.ReadAt(make([]byte, 10), int64(len(data))+FrameSize)
+			assert.Zero(t, n)
+		})
+	}
+}
+
+func TestOpenDataCompressionMismatch(t *testing.T) {
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, WriteCompressed(ctx, provider, "build/memfile", bytes.NewReader(testCompressibleData()), header.CompressionZstd))
+
+	_, _, err = OpenData(ctx, provider, "build/memfile", header.CompressionLZ4)
+	require.Error(t, err)
+
+	// The uncompressed objects have no frame index.
+	require.NoError(t, WriteCompressed(ctx, provider, "other/memfile", bytes.NewReader(testCompressibleData()), header.CompressionNone))
+
+	_, _, err = OpenData(ctx, provider, "other/memfile", header.CompressionZstd)
+	require.ErrorIs(t, err, ErrObjectNotExist)
+}
+
+func TestDeserializeFrameIndexRejectsInvalid(t *testing.T) {
+	index := &FrameIndex{
+		Compression: header.CompressionZstd,
+		FrameSize:   FrameSize,
+		Size:        2*FrameSize + 1,
+		Offsets:     []uint64{0, 10, 20},
+	}
+
+	_, err := DeserializeFrameIndex(index.Serialize().(io.WriterTo))
+	require.Error(t, err)
+
+	index.Offsets = append(index.Offsets, 30)
+
+	parsed, err := DeserializeFrameIndex(index.Serialize().(io.WriterTo))
+	require.NoError(t, err)
+	assert.Equal(t, index, parsed)
+}
@@ -0,0 +1,347 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	// DedupChunkSize is the size of the content-addressed chunks.
+	// It is a multiple of the memfile page sizes and of the rootfs block size, so the chunks never split a block.
+	DedupChunkSize = header.HugepageSize
+
+	dedupEnabledEnv = "TEMPLATE_STORAGE_DEDUP"
+
+	dedupUploadConcurrency = 16
+
+	// chunkRefsDir contains an empty object for each build referencing the chunk, named by the build ID.
+	chunkRefsDir = "refs"
+)
+
+// chunkLocks serialize adding and releasing the references of the same chunk, so a chunk is never deleted
+// after a new reference was added but before the new build checked the chunk is stored.
+// The references are only added and released by the template manager, the uploads of the snapshots are not deduplicated.
+var chunkLocks [64]sync.Mutex
+
+func chunkLock(chunkID uuid.UUID) *sync.Mutex {
+	return &chunkLocks[int(chunkID[0])%len(chunkLocks)]
+}
+
+// DedupEnabled returns true if the template files should be stored as content-addressed chunks.
+func DedupEnabled() bool {
+	return env.GetEnv(dedupEnabledEnv, "false") == "true"
+}
+
+// ChunkID returns the content address of the chunk.
+// The address has the form of a UUID, so the header mappings can reference the chunk the same way as a build
+// and the chunk is stored as the only data of its own "build": <chunk id>/<file name>.
+// Each build using the chunk is recorded as <chunk id>/refs/<build id>, the chunk is deleted with its last reference.
+func ChunkID(data []byte) uuid.UUID {
+	sum := sha256.Sum256(data)
+
+	var id uuid.UUID
+
+	copy(id[:], sum[:16])
+
+	// Mark the ID as a custom (v8) UUID, so it can't collide with the random (v4) build IDs.
+	id[6] = (id[6] & 0x0f) | 0x80
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	return id
+}
+
+// Deduplicate stores the file as content-addressed chunks and returns the header that maps the file to the chunks.
+// Only the chunks that are not in the storage yet are uploaded and the empty chunks are not stored at all.
+// The build is added to the references of all its chunks before the chunk is checked, so it can't be deleted in the meantime.
+func Deduplicate(
+	ctx context.Context,
+	provider Provider,
+	path string,
+	fileName string,
+	buildID uuid.UUID,
+	blockSize int64,
+	compression header.Compression,
+) (*header.Header, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", path, err)
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file %s: %w", path, err)
+	}
+
+	mappings := make([]*header.BuildMap, 0)
+
+	seen := make(map[uuid.UUID]struct{})
+
+	// The compression of each chunk as stored, it can differ from the requested one if the chunk was already stored by another build.
+	var compressionsMu sync.Mutex
+	compressions := make(map[uuid.UUID]header.Compression)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(dedupUploadConcurrency)
+
+	for off := int64(0); off < info.Size() && egCtx.Err() == nil; off += DedupChunkSize {
+		chunk := make([]byte, min(DedupChunkSize, info.Size()-off))
+
+		_, err := file.ReadAt(chunk, off)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, errors.Join(fmt.Errorf("failed to read chunk at %d: %w", off, err), eg.Wait())
+		}
+
+		chunkID := uuid.Nil
+		if !bytes.Equal(chunk, header.EmptyHugePage[:len(chunk)]) {
+			chunkID = ChunkID(chunk)
+		}
+
+		mappings = appendChunkMapping(mappings, uint64(off), uint64(len(chunk)), chunkID)
+
+		if chunkID == uuid.Nil {
+			continue
+		}
+
+		if _, ok := seen[chunkID]; ok {
+			continue
+		}
+
+		seen[chunkID] = struct{}{}
+
+		eg.Go(func() error {
+			lock := chunkLock(chunkID)
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			err := addChunkRef(egCtx, provider, chunkID, buildID)
+			if err != nil {
+				return err
+			}
+
+			stored, err := uploadChunk(egCtx, provider, fmt.Sprintf("%s/%s", chunkID, fileName), chunk, compression)
+			if err != nil {
+				return err
+			}
+
+			compressionsMu.Lock()
+			compressions[chunkID] = stored
+			compressionsMu.Unlock()
+
+			return nil
+		})
+	}
+
+	err = eg.Wait()
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload chunks: %w", err)
+	}
+
+	for _, mapping := range mappings {
+		mapping.Compression = compressions[mapping.BuildId]
+	}
+
+	return header.NewHeader(&header.Metadata{
+		Version:     header.CurrentVersion,
+		BlockSize:   uint64(blockSize),
+		Size:        uint64(info.Size()),
+		Generation:  1,
+		BuildId:     buildID,
+		BaseBuildId: buildID,
+		Compression: compression,
+	}, mappings), nil
+}
+
+// appendChunkMapping appends the chunk to the mappings, merging it with the previous mapping if both are empty.
+func appendChunkMapping(mappings []*header.BuildMap, offset, length uint64, chunkID uuid.UUID) []*header.BuildMap {
+	if chunkID == uuid.Nil && len(mappings) > 0 {
+		last := mappings[len(mappings)-1]
+
+		if last.BuildId == uuid.Nil {
+			last.Length += length
+
+			return mappings
+		}
+	}
+
+	return append(mappings, &header.BuildMap{
+		Offset:  offset,
+		Length:  length,
+		BuildId: chunkID,
+	})
+}
+
+// uploadChunk uploads the chunk if it is not stored yet and returns the compression of the stored chunk.
+// A chunk already stored with another compression is reused, its compression is recorded in the header mappings.
+func uploadChunk(ctx context.Context, provider Provider, path string, chunk []byte, compression header.Compression) (header.Compression, error) {
+	object, err := provider.OpenObject(ctx, path)
+	if err != nil {
+		return header.CompressionNone, fmt.Errorf("failed to open chunk '%s': %w", path, err)
+	}
+
+	_, err = object.Size()
+	if err == nil {
+		// The chunk is already stored, the content is the same because the path is derived from it.
+		return storedCompression(ctx, provider, path)
+	}
+
+	if !errors.Is(err, ErrObjectNotExist) {
+		return header.CompressionNone, fmt.Errorf("failed to check chunk '%s': %w", path, err)
+	}
+
+	err = WriteCompressed(ctx, provider, path, bytes.NewReader(chunk), compression)
+	if err != nil {
+		return header.CompressionNone, fmt.Errorf("failed to upload chunk '%s': %w", path, err)
+	}
+
+	return compression, nil
+}
+
+// storedCompression returns the compression of the stored object, the uncompressed objects have no frame index.
+func storedCompression(ctx context.Context, provider Provider, path string) (header.Compression, error) {
+	indexObject, err := provider.OpenObject(ctx, path+FrameIndexSuffix)
+	if err != nil {
+		return header.CompressionNone, fmt.Errorf("failed to open frame index '%s': %w", path+FrameIndexSuffix, err)
+	}
+
+	index, err := DeserializeFrameIndex(indexObject)
+	if errors.Is(err, ErrObjectNotExist) {
+		return header.CompressionNone, nil
+	}
+
+	if err != nil {
+		return header.CompressionNone, fmt.Errorf("failed to read frame index '%s': %w", path+FrameIndexSuffix, err)
+	}
+
+	return index.Compression, nil
+}
+
+func chunkRefPath(chunkID, buildID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s/%s", chunkID, chunkRefsDir, buildID)
+}
+
+func addChunkRef(ctx context.Context, provider Provider, chunkID, buildID uuid.UUID) error {
+	object, err := provider.OpenObject(ctx, chunkRefPath(chunkID, buildID))
+	if err != nil {
+		return fmt.Errorf("failed to open reference of chunk '%s': %w", chunkID, err)
+	}
+
+	_, err = object.ReadFrom(bytes.NewReader(nil))
+	if err != nil {
+		return fmt.Errorf("failed to add reference of chunk '%s': %w", chunkID, err)
+	}
+
+	return nil
+}
+
+// releaseChunkRef removes the reference of the build to the chunk and deletes the chunk if it isn't referenced anymore.
+func releaseChunkRef(ctx context.Context, provider Provider, chunkID, buildID uuid.UUID) error {
+	lock := chunkLock(chunkID)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	object, err := provider.OpenObject(ctx, chunkRefPath(chunkID, buildID))
+	if err != nil {
+		return fmt.Errorf("failed to open reference of chunk '%s': %w", chunkID, err)
+	}
+
+	err = object.Delete()
+	if err != nil {
+		return fmt.Errorf("failed to delete reference of chunk '%s': %w", chunkID, err)
+	}
+
+	refs, err := provider.ListObjectsWithPrefix(ctx, fmt.Sprintf("%s/%s/", chunkID, chunkRefsDir))
+	if err != nil {
+		return fmt.Errorf("failed to list references of chunk '%s': %w", chunkID, err)
+	}
+
+	if len(refs) > 0 {
+		return nil
+	}
+
+	err = provider.DeleteObjectsWithPrefix(ctx, chunkID.String()+"/")
+	if err != nil {
+		return fmt.Errorf("failed to delete chunk '%s': %w", chunkID, err)
+	}
+
+	return nil
+}
+
+// isChunkID returns true if the layer of the header mapping is a content-addressed chunk and not a build.
+func isChunkID(id uuid.UUID) bool {
+	return id != uuid.Nil && id.Version() == 8
+}
+
+// RemoveBuild deletes the files of the build and releases its references to the chunks, the chunks not used by any other build are deleted.
+// The chunks are released first, so the removal can be retried if it fails, as the headers listing the chunks are still stored.
+//
+// Only the references added by Deduplicate are released. The snapshots derived from the build also map to its chunks,
+// but they don't hold any references, as the build can't be removed while its snapshots exist.
+func RemoveBuild(ctx context.Context, provider Provider, buildID string) error {
+	id, err := uuid.Parse(buildID)
+	if err != nil {
+		return fmt.Errorf("failed to parse build id '%s': %w", buildID, err)
+	}
+
+	files := TemplateFiles{BuildId: buildID}
+
+	chunks := make(map[uuid.UUID]struct{})
+
+	for _, path := range []string{files.StorageMemfileHeaderPath(), files.StorageRootfsHeaderPath()} {
+		h, err := readHeader(ctx, provider, path)
+		if errors.Is(err, ErrObjectNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read header of build '%s': %w", buildID, err)
+		}
+
+		// Only the deduplicated headers of the build itself reference chunks it holds.
+		if h.Metadata.BaseBuildId != id {
+			continue
+		}
+
+		for layer := range *header.Layers(h.Mapping) {
+			if isChunkID(layer) {
+				chunks[layer] = struct{}{}
+			}
+		}
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(dedupUploadConcurrency)
+
+	for chunkID := range chunks {
+		eg.Go(func() error {
+			return releaseChunkRef(egCtx, provider, chunkID, id)
+		})
+	}
+
+	err = eg.Wait()
+	if err != nil {
+		return fmt.Errorf("failed to release chunks of build '%s': %w", buildID, err)
+	}
+
+	err = provider.DeleteObjectsWithPrefix(ctx, buildID+"/")
+	if err != nil {
+		return fmt.Errorf("failed to delete files of build '%s': %w", buildID, err)
+	}
+
+	return nil
+}
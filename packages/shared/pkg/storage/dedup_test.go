@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func TestDeduplicate(t *testing.T) {
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	chunkA := bytes.Repeat([]byte("a"), DedupChunkSize)
+	chunkB := bytes.Repeat([]byte("b"), DedupChunkSize)
+	empty := make([]byte, DedupChunkSize)
+	tail := []byte("tail")
+
+	var data []byte
+	for _, chunk := range [][]byte{chunkA, empty, empty, chunkB, chunkA, tail} {
+		data = append(data, chunk...)
+	}
+
+	path := filepath.Join(t.TempDir(), MemfileName)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	buildID := uuid.New()
+
+	h, err := Deduplicate(ctx, provider, path, MemfileName, buildID, header.PageSize, header.CompressionNone)
+	require.NoError(t, err)
+
+	assert.Equal(t, buildID, h.Metadata.BuildId)
+	assert.Equal(t, uint64(len(data)), h.Metadata.Size)
+
+	// The empty chunks are merged into a single mapping.
+	require.Len(t, h.Mapping, 5)
+	assert.Equal(t, uuid.Nil, h.Mapping[1].BuildId)
+	assert.Equal(t, uint64(2*DedupChunkSize), h.Mapping[1].Length)
+
+	// The same content is stored only once.
+	assert.Equal(t, ChunkID(chunkA), h.Mapping[0].BuildId)
+	assert.Equal(t, h.Mapping[0].BuildId, h.Mapping[3].BuildId)
+	assert.NotEqual(t, h.Mapping[0].BuildId, h.Mapping[2].BuildId)
+
+	assert.Equal(t, data, readThroughHeader(t, provider, h, MemfileName))
+
+	// Deduplicating the same file again for another build reuses the stored chunks.
+	other, err := Deduplicate(ctx, provider, path, MemfileName, uuid.New(), header.PageSize, header.CompressionNone)
+	require.NoError(t, err)
+	assert.True(t, header.Equal(h.Mapping, other.Mapping))
+
+	assert.Equal(t, tail, readTestObject(t, provider, fmt.Sprintf("%s/%s", ChunkID(tail), MemfileName)))
+}
+
+func TestDeduplicateRecordsStoredCompression(t *testing.T) {
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	stored := bytes.Repeat([]byte("a"), DedupChunkSize)
+	added := bytes.Repeat([]byte("b"), DedupChunkSize)
+
+	path := filepath.Join(t.TempDir(), MemfileName)
+	require.NoError(t, os.WriteFile(path, stored, 0o644))
+
+	_, err = Deduplicate(ctx, provider, path, MemfileName, uuid.New(), header.PageSize, header.CompressionZstd)
+	require.NoError(t, err)
+
+	data := append(bytes.Clone(stored), added...)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	// The chunk stored by the previous build keeps its compression, the new chunk is stored uncompressed.
+	h, err := Deduplicate(ctx, provider, path, MemfileName, uuid.New(), header.PageSize, header.CompressionNone)
+	require.NoError(t, err)
+
+	require.Len(t, h.Mapping, 2)
+	assert.Equal(t, header.CompressionZstd, h.Mapping[0].Compression)
+	assert.Equal(t, header.CompressionNone, h.Mapping[1].Compression)
+
+	assert.Equal(t, data, readThroughHeader(t, provider, h, MemfileName))
+}
+
+func chunkExists(t *testing.T, provider Provider, chunkID uuid.UUID, fileName string) bool {
+	t.Helper()
+
+	object, err := provider.OpenObject(context.Background(), fmt.Sprintf("%s/%s", chunkID, fileName))
+	require.NoError(t, err)
+
+	_, err = object.Size()
+	if errors.Is(err, ErrObjectNotExist) {
+		return false
+	}
+
+	require.NoError(t, err)
+
+	return true
+}
+
+func TestRemoveBuildReleasesChunks(t *testing.T) {
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	chunkA := bytes.Repeat([]byte("a"), DedupChunkSize)
+	chunkB := bytes.Repeat([]byte("b"), DedupChunkSize)
+	chunkC := bytes.Repeat([]byte("c"), DedupChunkSize)
+
+	upload := func(chunks ...[]byte) uuid.UUID {
+		path := filepath.Join(t.TempDir(), MemfileName)
+		require.NoError(t, os.WriteFile(path, bytes.Join(chunks, nil), 0o644))
+
+		buildID := uuid.New()
+
+		h, err := Deduplicate(ctx, provider, path, MemfileName, buildID, header.PageSize, header.CompressionNone)
+		require.NoError(t, err)
+
+		b := NewTemplateBuild(nil, nil, &TemplateFiles{BuildId: buildID.String()}, provider, header.CompressionNone)
+		require.NoError(t, b.uploadMemfileHeader(ctx, h))
+
+		return buildID
+	}
+
+	first := upload(chunkA, chunkB)
+	second := upload(chunkA, chunkC)
+
+	require.NoError(t, RemoveBuild(ctx, provider, first.String()))
+
+	// The chunk shared with the other build is kept.
+	assert.True(t, chunkExists(t, provider, ChunkID(chunkA), MemfileName))
+	assert.False(t, chunkExists(t, provider, ChunkID(chunkB), MemfileName))
+	assert.True(t, chunkExists(t, provider, ChunkID(chunkC), MemfileName))
+
+	remaining, err := provider.ListObjectsWithPrefix(ctx, first.String()+"/")
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+
+	// Removing the build again is a no-op.
+	require.NoError(t, RemoveBuild(ctx, provider, first.String()))
+
+	require.NoError(t, RemoveBuild(ctx, provider, second.String()))
+
+	assert.False(t, chunkExists(t, provider, ChunkID(chunkA), MemfileName))
+	assert.False(t, chunkExists(t, provider, ChunkID(chunkC), MemfileName))
+}
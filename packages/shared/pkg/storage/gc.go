@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// gcHeaderReadConcurrency is the number of the headers read from the storage at the same time.
+const gcHeaderReadConcurrency = 16
+
+var errHeaderNotUploaded = errors.New("header of the live build is not uploaded yet")
+
+// GarbageCollector deletes the files of the builds that are not referenced by any of the live builds.
+// The headers don't change after they are uploaded, so the layers referenced by each live build are read only once
+// and the following runs read only the headers of the new live builds.
+type GarbageCollector struct {
+	provider Provider
+
+	mu sync.Mutex
+	// layers are the builds referenced by the headers of the live builds, keyed by the live build.
+	layers map[string][]string
+}
+
+func NewGarbageCollector(provider Provider) *GarbageCollector {
+	return &GarbageCollector{
+		provider: provider,
+		layers:   make(map[string][]string),
+	}
+}
+
+// CollectGarbage deletes the files of the candidate builds that are not referenced by the headers of any of the live or used builds.
+// It is a shorthand for a single run of a new collector.
+func CollectGarbage(ctx context.Context, provider Provider, liveBuildIDs, usedBuildIDs, candidateBuildIDs []string) ([]string, error) {
+	return NewGarbageCollector(provider).Collect(ctx, liveBuildIDs, usedBuildIDs, candidateBuildIDs)
+}
+
+// Collect deletes the files of the candidate builds that are not referenced by the headers of any of the live or used builds.
+// It is a shorthand for Referenced followed by Delete.
+func (gc *GarbageCollector) Collect(ctx context.Context, liveBuildIDs, usedBuildIDs, candidateBuildIDs []string) ([]string, error) {
+	referenced, err := gc.Referenced(ctx, liveBuildIDs, usedBuildIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return gc.Delete(ctx, referenced, candidateBuildIDs)
+}
+
+// Delete deletes the files of the candidate builds that are not referenced.
+// The errors of deleting the single candidates don't stop the run, the deleted builds are returned together with the joined errors.
+func (gc *GarbageCollector) Delete(ctx context.Context, referenced map[string]struct{}, candidateBuildIDs []string) ([]string, error) {
+	deleted := make([]string, 0, len(candidateBuildIDs))
+
+	var deleteErrs []error
+
+	for _, buildID := range candidateBuildIDs {
+		if _, ok := referenced[buildID]; ok {
+			continue
+		}
+
+		err := gc.provider.DeleteObjectsWithPrefix(ctx, buildID+"/")
+		if err != nil {
+			deleteErrs = append(deleteErrs, fmt.Errorf("failed to delete build '%s': %w", buildID, err))
+
+			continue
+		}
+
+		deleted = append(deleted, buildID)
+	}
+
+	return deleted, errors.Join(deleteErrs...)
+}
+
+// Referenced returns all the builds referenced by the headers of the live and used builds.
+// A header references its own build and all the layers it was derived from, so a candidate build
+// can only be deleted when no live or used build was derived from it.
+//
+// The live builds are the snapshot builds that can be started, their headers must exist.
+// A missing header means it is still being uploaded and it can reference any candidate, so it is handled as a read failure.
+// The used builds, e.g. the templates of the running sandboxes or the bases of the snapshots being created, may not have headers,
+// the builds without headers reference only themselves.
+//
+// If any of the required headers can't be read, an error is returned, because the unread header could reference any candidate.
+// The headers read successfully are kept, so the next run retries only the failed ones.
+func (gc *GarbageCollector) Referenced(ctx context.Context, liveBuildIDs, usedBuildIDs []string) (map[string]struct{}, error) {
+	// The value is true if the header of the build is required.
+	builds := make(map[string]bool, len(liveBuildIDs)+len(usedBuildIDs))
+	for _, buildID := range usedBuildIDs {
+		builds[buildID] = false
+	}
+
+	for _, buildID := range liveBuildIDs {
+		builds[buildID] = true
+	}
+
+	gc.mu.Lock()
+	// Forget the builds that are not in use anymore.
+	for buildID := range gc.layers {
+		if _, ok := builds[buildID]; !ok {
+			delete(gc.layers, buildID)
+		}
+	}
+
+	uncached := make([]string, 0)
+	for buildID := range builds {
+		if _, ok := gc.layers[buildID]; !ok {
+			uncached = append(uncached, buildID)
+		}
+	}
+	gc.mu.Unlock()
+
+	var (
+		errsMu sync.Mutex
+		errs   []error
+	)
+
+	eg := errgroup.Group{}
+	eg.SetLimit(gcHeaderReadConcurrency)
+
+	for _, buildID := range uncached {
+		eg.Go(func() error {
+			layers, found, err := buildLayers(ctx, gc.provider, buildID)
+			if err == nil && !found && builds[buildID] {
+				err = errHeaderNotUploaded
+			}
+
+			if err != nil {
+				errsMu.Lock()
+				errs = append(errs, fmt.Errorf("build '%s': %w", buildID, err))
+				errsMu.Unlock()
+
+				return nil
+			}
+
+			// The builds without headers reference only themselves, they are not cached as their headers can still be uploaded.
+			if !found {
+				return nil
+			}
+
+			gc.mu.Lock()
+			gc.layers[buildID] = layers
+			gc.mu.Unlock()
+
+			return nil
+		})
+	}
+
+	// The errors are collected per build, so all the readable headers are cached.
+	_ = eg.Wait()
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to read headers of %d builds, skipping deletion: %w", len(errs), errors.Join(errs...))
+	}
+
+	referenced := make(map[string]struct{}, len(builds))
+
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	for buildID := range builds {
+		referenced[buildID] = struct{}{}
+
+		for _, layer := range gc.layers[buildID] {
+			referenced[layer] = struct{}{}
+		}
+	}
+
+	return referenced, nil
+}
+
+// buildLayers returns the builds referenced by the memfile and rootfs headers of the build and whether any header was found.
+func buildLayers(ctx context.Context, provider Provider, buildID string) ([]string, bool, error) {
+	files := TemplateFiles{BuildId: buildID}
+
+	layers := make([]string, 0)
+	found := false
+
+	for _, path := range []string{files.StorageMemfileHeaderPath(), files.StorageRootfsHeaderPath()} {
+		h, err := readHeader(ctx, provider, path)
+		if errors.Is(err, ErrObjectNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		found = true
+
+		for layer := range *header.Layers(h.Mapping) {
+			layers = append(layers, layer.String())
+		}
+	}
+
+	return layers, found, nil
+}
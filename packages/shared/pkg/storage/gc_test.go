@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func writeTestBuild(t *testing.T, provider Provider, buildID uuid.UUID, layers ...uuid.UUID) {
+	t.Helper()
+
+	ctx := context.Background()
+	files := TemplateFiles{BuildId: buildID.String()}
+
+	memfile, err := provider.OpenObject(ctx, files.StorageMemfilePath())
+	require.NoError(t, err)
+
+	_, err = memfile.ReadFrom(bytes.NewReader([]byte(buildID.String())))
+	require.NoError(t, err)
+
+	if len(layers) == 0 {
+		return
+	}
+
+	mappings := make([]*header.BuildMap, 0, len(layers))
+	for i, layer := range layers {
+		mappings = append(mappings, &header.BuildMap{
+			Offset:  uint64(i) * 4096,
+			Length:  4096,
+			BuildId: layer,
+		})
+	}
+
+	data, err := header.Serialize(&header.Metadata{
+		Version:   1,
+		BlockSize: 4096,
+		Size:      uint64(len(layers)) * 4096,
+		BuildId:   buildID,
+	}, mappings)
+	require.NoError(t, err)
+
+	object, err := provider.OpenObject(ctx, files.StorageMemfileHeaderPath())
+	require.NoError(t, err)
+
+	_, err = object.ReadFrom(data)
+	require.NoError(t, err)
+}
+
+func buildExists(t *testing.T, provider Provider, buildID uuid.UUID) bool {
+	t.Helper()
+
+	files := TemplateFiles{BuildId: buildID.String()}
+
+	object, err := provider.OpenObject(context.Background(), files.StorageMemfilePath())
+	require.NoError(t, err)
+
+	_, err = object.Size()
+	if err != nil {
+		require.ErrorIs(t, err, ErrObjectNotExist)
+
+		return false
+	}
+
+	return true
+}
+
+func TestCollectGarbage(t *testing.T) {
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	template := uuid.New()
+	referencedCheckpoint := uuid.New()
+	unreferencedCheckpoint := uuid.New()
+	live := uuid.New()
+
+	writeTestBuild(t, provider, template)
+	writeTestBuild(t, provider, referencedCheckpoint, template, referencedCheckpoint)
+	writeTestBuild(t, provider, unreferencedCheckpoint, template, unreferencedCheckpoint)
+	writeTestBuild(t, provider, live, template, referencedCheckpoint, live)
+
+	deleted, err := CollectGarbage(
+		context.Background(),
+		provider,
+		[]string{live.String()},
+		[]string{template.String()},
+		[]string{referencedCheckpoint.String(), unreferencedCheckpoint.String()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{unreferencedCheckpoint.String()}, deleted)
+
+	require.True(t, buildExists(t, provider, template))
+	require.True(t, buildExists(t, provider, referencedCheckpoint))
+	require.False(t, buildExists(t, provider, unreferencedCheckpoint))
+	require.True(t, buildExists(t, provider, live))
+}
+
+// countingProvider counts the opened objects and fails to open the paths in failing.
+type countingProvider struct {
+	Provider
+
+	mu      sync.Mutex
+	opened  map[string]int
+	failing map[string]bool
+}
+
+func newCountingProvider(provider Provider) *countingProvider {
+	return &countingProvider{Provider: provider, opened: make(map[string]int), failing: make(map[string]bool)}
+}
+
+func (p *countingProvider) OpenObject(ctx context.Context, path string) (Object, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.opened[path]++
+
+	if p.failing[path] {
+		return nil, errors.New("transient error")
+	}
+
+	return p.Provider.OpenObject(ctx, path)
+}
+
+func (p *countingProvider) openedHeaders(buildID uuid.UUID) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	files := TemplateFiles{BuildId: buildID.String()}
+
+	return p.opened[files.StorageMemfileHeaderPath()] + p.opened[files.StorageRootfsHeaderPath()]
+}
+
+func TestGarbageCollectorReadsHeadersOnce(t *testing.T) {
+	fs, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	provider := newCountingProvider(fs)
+
+	template := uuid.New()
+	live := uuid.New()
+	candidate := uuid.New()
+
+	writeTestBuild(t, provider, template)
+	writeTestBuild(t, provider, live, template, live)
+	writeTestBuild(t, provider, candidate, template, candidate)
+
+	// Count only the reads of the collector.
+	provider.opened = make(map[string]int)
+
+	gc := NewGarbageCollector(provider)
+
+	_, err = gc.Collect(context.Background(), []string{live.String()}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, provider.openedHeaders(live))
+
+	deleted, err := gc.Collect(context.Background(), []string{live.String()}, nil, []string{candidate.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{candidate.String()}, deleted)
+
+	// The header of the live build is cached.
+	require.Equal(t, 2, provider.openedHeaders(live))
+}
+
+func TestGarbageCollectorSkipsDeletionOnHeaderError(t *testing.T) {
+	fs, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	provider := newCountingProvider(fs)
+
+	template := uuid.New()
+	readable := uuid.New()
+	unreadable := uuid.New()
+	candidate := uuid.New()
+
+	writeTestBuild(t, provider, template)
+	writeTestBuild(t, provider, readable, template, readable)
+	writeTestBuild(t, provider, candidate, template, candidate)
+	writeTestBuild(t, provider, unreadable, template, candidate, unreadable)
+
+	unreadableHeader := (&TemplateFiles{BuildId: unreadable.String()}).StorageMemfileHeaderPath()
+	provider.failing[unreadableHeader] = true
+
+	// Count only the reads of the collector.
+	provider.opened = make(map[string]int)
+
+	gc := NewGarbageCollector(provider)
+	live := []string{readable.String(), unreadable.String()}
+
+	// The unreadable header can reference the candidate, so nothing can be deleted.
+	deleted, err := gc.Collect(context.Background(), live, nil, []string{candidate.String()})
+	require.Error(t, err)
+	require.Empty(t, deleted)
+	require.True(t, buildExists(t, provider, candidate))
+
+	delete(provider.failing, unreadableHeader)
+
+	deleted, err = gc.Collect(context.Background(), live, nil, []string{candidate.String()})
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+	require.True(t, buildExists(t, provider, candidate))
+
+	// Only the failed header is read again.
+	require.Equal(t, 2, provider.openedHeaders(readable))
+
+	// The candidate is deleted after the build referencing it is not live anymore.
+	deleted, err = gc.Collect(context.Background(), []string{readable.String()}, nil, []string{candidate.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{candidate.String()}, deleted)
+}
+
+func TestGarbageCollectorSkipsDeletionOnMissingLiveHeader(t *testing.T) {
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	base := uuid.New()
+	snapshot := uuid.New()
+
+	writeTestBuild(t, provider, base)
+	// The snapshot is live, but its header is still being uploaded.
+	writeTestBuild(t, provider, snapshot)
+
+	gc := NewGarbageCollector(provider)
+
+	deleted, err := gc.Collect(context.Background(), []string{snapshot.String()}, nil, []string{base.String()})
+	require.ErrorIs(t, err, errHeaderNotUploaded)
+	require.Empty(t, deleted)
+	require.True(t, buildExists(t, provider, base))
+
+	// The used builds, e.g. the base of a sandbox being paused, may not have headers.
+	deleted, err = gc.Collect(context.Background(), nil, []string{base.String(), snapshot.String()}, []string{base.String()})
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+
+	writeTestBuild(t, provider, snapshot, base, snapshot)
+
+	deleted, err = gc.Collect(context.Background(), []string{snapshot.String()}, nil, []string{base.String()})
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+	require.True(t, buildExists(t, provider, base))
+}
@@ -0,0 +1,41 @@
+package header
+
+import (
+	"fmt"
+)
+
+// Compression is the compression of the data of a build file in the storage.
+type Compression uint64
+
+const (
+	CompressionNone Compression = iota
+	CompressionZstd
+	CompressionLZ4
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionZstd:
+		return "zstd"
+	case CompressionLZ4:
+		return "lz4"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint64(c))
+	}
+}
+
+// ParseCompression parses the compression name, an empty name means no compression.
+func ParseCompression(name string) (Compression, error) {
+	switch name {
+	case "", "none":
+		return CompressionNone, nil
+	case "zstd":
+		return CompressionZstd, nil
+	case "lz4":
+		return CompressionLZ4, nil
+	default:
+		return CompressionNone, fmt.Errorf("unknown compression: %s", name)
+	}
+}
@@ -1,6 +1,7 @@
 package header
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 
@@ -18,20 +19,43 @@ var (
 	EmptyBlock    = make([]byte, RootfsBlockSize)
 )
 
-func CreateDiff(source io.ReaderAt, blockSize int64, dirty *bitset.BitSet, diff io.Writer) error {
+// CreateDiff writes the dirty blocks from the source to the diff.
+// Blocks that contain only zeros are not written and are returned separately, so they can be mapped to the nil build.
+func CreateDiff(source io.ReaderAt, blockSize int64, dirty *bitset.BitSet, diff io.Writer) (*bitset.BitSet, error) {
 	b := make([]byte, blockSize)
+	empty := bitset.New(0)
 
 	for i, e := dirty.NextSet(0); e; i, e = dirty.NextSet(i + 1) {
 		_, err := source.ReadAt(b, int64(i)*blockSize)
 		if err != nil {
-			return fmt.Errorf("error reading from source: %w", err)
+			return nil, fmt.Errorf("error reading from source: %w", err)
+		}
+
+		if isEmpty(b) {
+			empty.Set(i)
+
+			continue
 		}
 
 		_, err = diff.Write(b)
 		if err != nil {
-			return fmt.Errorf("error writing to diff: %w", err)
+			return nil, fmt.Errorf("error writing to diff: %w", err)
+		}
+	}
+
+	return empty, nil
+}
+
+func isEmpty(b []byte) bool {
+	for len(b) > 0 {
+		n := min(len(b), HugepageSize)
+
+		if !bytes.Equal(b[:n], EmptyHugePage[:n]) {
+			return false
 		}
+
+		b = b[n:]
 	}
 
-	return nil
+	return true
 }
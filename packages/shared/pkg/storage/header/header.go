@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/bits-and-blooms/bitset"
-	"github.com/google/uuid"
 )
 
 type Header struct {
@@ -22,6 +21,7 @@ func NewHeader(metadata *Metadata, mapping []*BuildMap) *Header {
 			Length:             metadata.Size,
 			BuildId:            metadata.BuildId,
 			BuildStorageOffset: 0,
+			Compression:        metadata.Compression,
 		}}
 	}
 
@@ -45,13 +45,13 @@ func NewHeader(metadata *Metadata, mapping []*BuildMap) *Header {
 	}
 }
 
-func (t *Header) GetShiftedMapping(offset int64) (mappedOffset int64, mappedLength int64, buildID *uuid.UUID, err error) {
+func (t *Header) GetShiftedMapping(offset int64) (mappedOffset int64, mappedLength int64, mapping *BuildMap, err error) {
 	mapping, shift, err := t.getMapping(offset)
 	if err != nil {
 		return 0, 0, nil, err
 	}
 
-	return int64(mapping.BuildStorageOffset) + shift, int64(mapping.Length) - shift, &mapping.BuildId, nil
+	return int64(mapping.BuildStorageOffset) + shift, int64(mapping.Length) - shift, mapping, nil
 }
 
 func (t *Header) getMapping(offset int64) (*BuildMap, int64, error) {
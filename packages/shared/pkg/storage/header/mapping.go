@@ -17,6 +17,8 @@ type BuildMap struct {
 	Length             uint64
 	BuildId            uuid.UUID
 	BuildStorageOffset uint64
+	// Compression of the data of the build, so the data can be read without checking how it is stored.
+	Compression Compression
 }
 
 func CreateMapping(
@@ -43,6 +45,7 @@ func CreateMapping(
 				BuildId:            *buildId,
 				Length:             uint64(blockLength) * uint64(metadata.BlockSize),
 				BuildStorageOffset: buildStorageOffset,
+				Compression:        metadata.Compression,
 			}
 
 			mappings = append(mappings, m)
@@ -60,6 +63,7 @@ func CreateMapping(
 			BuildId:            *buildId,
 			Length:             uint64(blockLength) * uint64(metadata.BlockSize),
 			BuildStorageOffset: buildStorageOffset,
+			Compression:        metadata.Compression,
 		})
 	}
 
@@ -150,6 +154,7 @@ func MergeMappings(
 					BuildId: base.BuildId,
 					// the build storage offset is the same as the base mapping
 					BuildStorageOffset: base.BuildStorageOffset,
+					Compression:        base.Compression,
 				}
 
 				mappings = append(mappings, leftBase)
@@ -168,6 +173,7 @@ func MergeMappings(
 					Length:             uint64(rightBaseLength),
 					BuildId:            base.BuildId,
 					BuildStorageOffset: base.BuildStorageOffset + uint64(rightBaseShift),
+					Compression:        base.Compression,
 				}
 
 				baseMapping[baseIdx] = rightBase
@@ -195,6 +201,7 @@ func MergeMappings(
 					Length:             uint64(rightBaseLength),
 					BuildId:            base.BuildId,
 					BuildStorageOffset: base.BuildStorageOffset + uint64(rightBaseShift),
+					Compression:        base.Compression,
 				}
 
 				baseMapping[baseIdx] = rightBase
@@ -216,6 +223,7 @@ func MergeMappings(
 					Length:             uint64(leftBaseLength),
 					BuildId:            base.BuildId,
 					BuildStorageOffset: base.BuildStorageOffset,
+					Compression:        base.Compression,
 				}
 
 				mappings = append(mappings, leftBase)
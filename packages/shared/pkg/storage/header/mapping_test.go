@@ -285,3 +285,31 @@ func TestMergeMappingsDiffAfterBaseWithOverlap(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestMergeMappingsKeepsCompression(t *testing.T) {
+	base := []*BuildMap{
+		{
+			Offset:      0,
+			Length:      size,
+			BuildId:     baseID,
+			Compression: CompressionZstd,
+		},
+	}
+
+	diff := []*BuildMap{
+		{
+			Offset:      2 * blockSize,
+			Length:      2 * blockSize,
+			BuildId:     diffID,
+			Compression: CompressionLZ4,
+		},
+	}
+
+	m := MergeMappings(base, diff)
+
+	// The split parts of the base are still read with its compression.
+	require.Len(t, m, 3)
+	require.Equal(t, CompressionZstd, m[0].Compression)
+	require.Equal(t, CompressionLZ4, m[1].Compression)
+	require.Equal(t, CompressionZstd, m[2].Compression)
+}
@@ -3,10 +3,41 @@ package header
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 
 	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// VersionV1 is the legacy format: raw metadata followed by raw mappings, without any integrity checks.
+	VersionV1 uint64 = 1
+	// VersionV2 starts with a magic, checksums the metadata and stores the mappings in checksummed, optionally compressed blocks.
+	VersionV2 uint64 = 2
+	// VersionV3 is the v2 format with the compression of the build data in the metadata and in the mappings.
+	VersionV3 uint64 = 3
+
+	// CurrentVersion is the version used for newly written headers.
+	CurrentVersion = VersionV3
+
+	// mappingsPerBlock is the number of mappings in one checksummed block of the v2 format.
+	mappingsPerBlock = 1024
+)
+
+var (
+	// magic marks the v2+ headers. The v1 headers start with the version (1) as little-endian uint64, so they can't start with the magic.
+	magic = [4]byte{'E', '2', 'B', 'H'}
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	ErrUnsupportedVersion = errors.New("unsupported header version")
+	ErrChecksumMismatch   = errors.New("header checksum mismatch")
+
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
 )
 
 type Metadata struct {
@@ -17,49 +48,211 @@ type Metadata struct {
 	BuildId    uuid.UUID
 	// TODO: Use the base build id when setting up the snapshot rootfs
 	BaseBuildId uuid.UUID
+	// Compression of the data uploaded by this build, stored only since v3.
+	Compression Compression
+}
+
+// metadataV1 is the metadata as stored by the v1 and v2 formats.
+type metadataV1 struct {
+	Version     uint64
+	BlockSize   uint64
+	Size        uint64
+	Generation  uint64
+	BuildId     uuid.UUID
+	BaseBuildId uuid.UUID
+}
+
+// buildMapV1 is the mapping as stored by the v1 and v2 formats.
+type buildMapV1 struct {
+	Offset             uint64
+	Length             uint64
+	BuildId            uuid.UUID
+	BuildStorageOffset uint64
+}
+
+// v2BlockHeader precedes each block of mappings in the v2 format.
+type v2BlockHeader struct {
+	Count uint32
+	// Size is the size of the payload as stored, after compression.
+	Size uint32
+	// Compressed is 1 if the payload is zstd compressed.
+	Compressed uint32
+	// Checksum is the CRC32 (Castagnoli) of the stored payload.
+	Checksum uint32
 }
 
+// mappingSize returns the size of one mapping in the layout of the version.
+func mappingSize(version uint64) int {
+	if version >= VersionV3 {
+		return binary.Size(BuildMap{})
+	}
 
+	return binary.Size(buildMapV1{})
+}
+
+// Serialize writes the header in the format given by the metadata version.
 func Serialize(metadata *Metadata, mappings []*BuildMap) (io.Reader, error) {
+	switch metadata.Version {
+	case VersionV1:
+		return serializeV1(metadata, mappings)
+	case VersionV2, VersionV3:
+		return serializeV2(metadata, mappings)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, metadata.Version)
+	}
+}
+
+// Deserialize reads all the supported formats.
+// Truncated or corrupted v2+ headers are rejected, v1 headers can only be checked for truncated mappings.
+func Deserialize(in io.WriterTo) (*Header, error) {
 	var buf bytes.Buffer
 
-	err := binary.Write(&buf, binary.LittleEndian, metadata)
+	_, err := in.WriteTo(&buf)
 	if err != nil {
-		return nil, fmt.Errorf("failed to write metadata: %w", err)
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
 	}
 
-	for _, mapping := range mappings {
-		err := binary.Write(&buf, binary.LittleEndian, mapping)
+	data := buf.Bytes()
+
+	if bytes.HasPrefix(data, magic[:]) {
+		return deserializeV2(data)
+	}
+
+	return deserializeV1(data)
+}
+
+// writeMetadata writes the metadata in the layout of its version.
+func writeMetadata(w io.Writer, metadata *Metadata) error {
+	if metadata.Version >= VersionV3 {
+		return binary.Write(w, binary.LittleEndian, metadata)
+	}
+
+	if metadata.Compression != CompressionNone {
+		return fmt.Errorf("%w: compression requires version %d, got %d", ErrUnsupportedVersion, VersionV3, metadata.Version)
+	}
+
+	return binary.Write(w, binary.LittleEndian, &metadataV1{
+		Version:     metadata.Version,
+		BlockSize:   metadata.BlockSize,
+		Size:        metadata.Size,
+		Generation:  metadata.Generation,
+		BuildId:     metadata.BuildId,
+		BaseBuildId: metadata.BaseBuildId,
+	})
+}
+
+// readMetadata reads the metadata in the layout of the given version.
+func readMetadata(r io.Reader, version uint64) (*Metadata, error) {
+	if version >= VersionV3 {
+		var metadata Metadata
+
+		err := binary.Read(r, binary.LittleEndian, &metadata)
 		if err != nil {
-			return nil, fmt.Errorf("failed to write block mapping: %w", err)
+			return nil, err
 		}
+
+		return &metadata, nil
 	}
 
-	return &buf, nil
+	var metadata metadataV1
+
+	err := binary.Read(r, binary.LittleEndian, &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metadata{
+		Version:     metadata.Version,
+		BlockSize:   metadata.BlockSize,
+		Size:        metadata.Size,
+		Generation:  metadata.Generation,
+		BuildId:     metadata.BuildId,
+		BaseBuildId: metadata.BaseBuildId,
+		Compression: CompressionNone,
+	}, nil
 }
 
-func Deserialize(in io.WriterTo) (*Header, error) {
+// writeMapping writes the mapping in the layout of the version.
+func writeMapping(w io.Writer, version uint64, mapping *BuildMap) error {
+	if version >= VersionV3 {
+		return binary.Write(w, binary.LittleEndian, mapping)
+	}
+
+	if mapping.Compression != CompressionNone {
+		return fmt.Errorf("%w: compression requires version %d, got %d", ErrUnsupportedVersion, VersionV3, version)
+	}
+
+	return binary.Write(w, binary.LittleEndian, &buildMapV1{
+		Offset:             mapping.Offset,
+		Length:             mapping.Length,
+		BuildId:            mapping.BuildId,
+		BuildStorageOffset: mapping.BuildStorageOffset,
+	})
+}
+
+// readMapping reads the mapping in the layout of the version.
+func readMapping(r io.Reader, version uint64) (*BuildMap, error) {
+	if version >= VersionV3 {
+		var mapping BuildMap
+
+		err := binary.Read(r, binary.LittleEndian, &mapping)
+		if err != nil {
+			return nil, err
+		}
+
+		return &mapping, nil
+	}
+
+	var mapping buildMapV1
+
+	err := binary.Read(r, binary.LittleEndian, &mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuildMap{
+		Offset:             mapping.Offset,
+		Length:             mapping.Length,
+		BuildId:            mapping.BuildId,
+		BuildStorageOffset: mapping.BuildStorageOffset,
+		Compression:        CompressionNone,
+	}, nil
+}
+
+func serializeV1(metadata *Metadata, mappings []*BuildMap) (io.Reader, error) {
 	var buf bytes.Buffer
 
-	_, err := in.WriteTo(&buf)
+	err := writeMetadata(&buf, metadata)
 	if err != nil {
-		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+		return nil, fmt.Errorf("failed to write metadata: %w", err)
 	}
 
-	reader := bytes.NewReader(buf.Bytes())
+	for _, mapping := range mappings {
+		err := writeMapping(&buf, metadata.Version, mapping)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write block mapping: %w", err)
+		}
+	}
 
-	var metadata Metadata
+	return &buf, nil
+}
 
-	err = binary.Read(reader, binary.LittleEndian, &metadata)
+func deserializeV1(data []byte) (*Header, error) {
+	reader := bytes.NewReader(data)
+
+	metadata, err := readMetadata(reader, VersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read metadata: %w", err)
 	}
 
+	if metadata.Version != VersionV1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, metadata.Version)
+	}
+
 	mappings := make([]*BuildMap, 0)
 
 	for {
-		var m BuildMap
-		err := binary.Read(reader, binary.LittleEndian, &m)
+		m, err := readMapping(reader, VersionV1)
 		if err == io.EOF {
 			break
 		}
@@ -68,8 +261,168 @@ func Deserialize(in io.WriterTo) (*Header, error) {
 			return nil, fmt.Errorf("failed to read block mapping: %w", err)
 		}
 
-		mappings = append(mappings, &m)
+		mappings = append(mappings, m)
+	}
+
+	return NewHeader(metadata, mappings), nil
+}
+
+// serializeV2 writes the v2 and v3 formats, they differ only in the metadata and mapping layouts.
+func serializeV2(metadata *Metadata, mappings []*BuildMap) (io.Reader, error) {
+	var buf bytes.Buffer
+
+	buf.Write(magic[:])
+
+	err := writeMetadata(&buf, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write metadata: %w", err)
+	}
+
+	err = binary.Write(&buf, binary.LittleEndian, uint64(len(mappings)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to write mapping count: %w", err)
+	}
+
+	err = binary.Write(&buf, binary.LittleEndian, crc32.Checksum(buf.Bytes(), crcTable))
+	if err != nil {
+		return nil, fmt.Errorf("failed to write metadata checksum: %w", err)
+	}
+
+	var block bytes.Buffer
+
+	for start := 0; start < len(mappings); start += mappingsPerBlock {
+		end := min(start+mappingsPerBlock, len(mappings))
+
+		block.Reset()
+
+		for _, mapping := range mappings[start:end] {
+			err := writeMapping(&block, metadata.Version, mapping)
+			if err != nil {
+				return nil, fmt.Errorf("failed to write block mapping: %w", err)
+			}
+		}
+
+		payload := block.Bytes()
+		compressed := uint32(0)
+
+		// Compress only if it actually saves space, the mappings of a fresh build are often too few to benefit.
+		if c := zstdEncoder.EncodeAll(payload, nil); len(c) < len(payload) {
+			payload = c
+			compressed = 1
+		}
+
+		err := binary.Write(&buf, binary.LittleEndian, &v2BlockHeader{
+			Count:      uint32(end - start),
+			Size:       uint32(len(payload)),
+			Compressed: compressed,
+			Checksum:   crc32.Checksum(payload, crcTable),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to write mapping block header: %w", err)
+		}
+
+		buf.Write(payload)
+	}
+
+	return &buf, nil
+}
+
+func deserializeV2(data []byte) (*Header, error) {
+	reader := bytes.NewReader(data[len(magic):])
+
+	// The version is the first field of the metadata in all the layouts.
+	var version uint64
+
+	err := binary.Read(bytes.NewReader(data[len(magic):]), binary.LittleEndian, &version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version: %w", err)
+	}
+
+	metadata, err := readMetadata(reader, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata: %w", err)
+	}
+
+	var mappingCount uint64
+
+	err = binary.Read(reader, binary.LittleEndian, &mappingCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping count: %w", err)
+	}
+
+	// The checksum covers everything before it, including the magic.
+	checksumOffset := len(data) - reader.Len()
+
+	var checksum uint32
+
+	err = binary.Read(reader, binary.LittleEndian, &checksum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata checksum: %w", err)
+	}
+
+	if crc32.Checksum(data[:checksumOffset], crcTable) != checksum {
+		return nil, fmt.Errorf("%w: metadata", ErrChecksumMismatch)
+	}
+
+	if metadata.Version != VersionV2 && metadata.Version != VersionV3 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, metadata.Version)
+	}
+
+	mappings := make([]*BuildMap, 0, mappingCount)
+
+	for uint64(len(mappings)) < mappingCount {
+		var blockHeader v2BlockHeader
+
+		err := binary.Read(reader, binary.LittleEndian, &blockHeader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mapping block header: %w", err)
+		}
+
+		if int64(blockHeader.Size) > int64(reader.Len()) {
+			return nil, fmt.Errorf("failed to read mapping block: %w", io.ErrUnexpectedEOF)
+		}
+
+		payload := make([]byte, blockHeader.Size)
+
+		_, err = io.ReadFull(reader, payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mapping block: %w", err)
+		}
+
+		if crc32.Checksum(payload, crcTable) != blockHeader.Checksum {
+			return nil, fmt.Errorf("%w: mapping block %d", ErrChecksumMismatch, len(mappings)/mappingsPerBlock)
+		}
+
+		if blockHeader.Compressed == 1 {
+			payload, err = zstdDecoder.DecodeAll(payload, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decompress mapping block: %w", err)
+			}
+		}
+
+		if len(payload) != int(blockHeader.Count)*mappingSize(metadata.Version) {
+			return nil, fmt.Errorf("mapping block has %d B, expected %d mappings", len(payload), blockHeader.Count)
+		}
+
+		blockReader := bytes.NewReader(payload)
+
+		for range blockHeader.Count {
+			m, err := readMapping(blockReader, metadata.Version)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read block mapping: %w", err)
+			}
+
+			mappings = append(mappings, m)
+		}
+	}
+
+	if uint64(len(mappings)) != mappingCount {
+		return nil, fmt.Errorf("header has %d mappings, expected %d", len(mappings), mappingCount)
+	}
+
+	if reader.Len() != 0 {
+		return nil, fmt.Errorf("header has %d B of trailing data", reader.Len())
 	}
 
-	return NewHeader(&metadata, mappings), nil
+	return NewHeader(metadata, mappings), nil
 }
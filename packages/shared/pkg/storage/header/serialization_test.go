@@ -0,0 +1,171 @@
+package header
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMetadata(version uint64) *Metadata {
+	return &Metadata{
+		Version:     version,
+		BlockSize:   blockSize,
+		Size:        size,
+		Generation:  3,
+		BuildId:     diffID,
+		BaseBuildId: baseID,
+	}
+}
+
+// testMappings returns n mappings covering n blocks, alternating between the base and the diff build.
+func testMappings(n int) []*BuildMap {
+	mappings := make([]*BuildMap, 0, n)
+
+	for i := range n {
+		buildID := baseID
+		if i%2 == 1 {
+			buildID = diffID
+		}
+
+		mappings = append(mappings, &BuildMap{
+			Offset:             uint64(i) * blockSize,
+			Length:             blockSize,
+			BuildId:            buildID,
+			BuildStorageOffset: uint64(i/2) * blockSize,
+		})
+	}
+
+	return mappings
+}
+
+func serializeBytes(t *testing.T, metadata *Metadata, mappings []*BuildMap) []byte {
+	t.Helper()
+
+	serialized, err := Serialize(metadata, mappings)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(serialized)
+	require.NoError(t, err)
+
+	return data
+}
+
+func deserializeBytes(data []byte) (*Header, error) {
+	return Deserialize(bytes.NewBuffer(data))
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	for _, version := range []uint64{VersionV1, VersionV2, VersionV3} {
+		// More mappings than fit in one v2 block.
+		for _, count := range []int{1, 8, mappingsPerBlock*2 + 3} {
+			mappings := testMappings(count)
+
+			h, err := deserializeBytes(serializeBytes(t, testMetadata(version), mappings))
+			require.NoError(t, err, "version %d, %d mappings", version, count)
+
+			assert.Equal(t, *testMetadata(version), *h.Metadata)
+			assert.True(t, Equal(mappings, h.Mapping))
+
+			for i := range mappings {
+				assert.Equal(t, mappings[i].BuildStorageOffset, h.Mapping[i].BuildStorageOffset)
+			}
+		}
+	}
+}
+
+func TestSerializeV2CompressesMappings(t *testing.T) {
+	mappings := testMappings(mappingsPerBlock)
+
+	v1 := serializeBytes(t, testMetadata(VersionV1), mappings)
+	v2 := serializeBytes(t, testMetadata(VersionV2), mappings)
+
+	assert.Less(t, len(v2), len(v1))
+}
+
+func TestDeserializeV2DetectsCorruption(t *testing.T) {
+	data := serializeBytes(t, testMetadata(VersionV2), testMappings(mappingsPerBlock+1))
+
+	// Metadata
+	corrupted := bytes.Clone(data)
+	corrupted[len(magic)+16] ^= 0xff
+
+	_, err := deserializeBytes(corrupted)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+
+	// Last byte of the last mapping block
+	corrupted = bytes.Clone(data)
+	corrupted[len(corrupted)-1] ^= 0xff
+
+	_, err = deserializeBytes(corrupted)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestDeserializeV2DetectsTruncation(t *testing.T) {
+	data := serializeBytes(t, testMetadata(VersionV2), testMappings(mappingsPerBlock+1))
+
+	for _, length := range []int{len(magic) + 10, len(data) / 2, len(data) - 1} {
+		_, err := deserializeBytes(data[:length])
+		require.Error(t, err, "truncated to %d B", length)
+	}
+
+	_, err := deserializeBytes(append(bytes.Clone(data), 0))
+	require.Error(t, err)
+}
+
+func TestDeserializeV1DetectsTruncatedMapping(t *testing.T) {
+	data := serializeBytes(t, testMetadata(VersionV1), testMappings(4))
+
+	_, err := deserializeBytes(data[:len(data)-1])
+	require.Error(t, err)
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	_, err := Serialize(testMetadata(4), testMappings(1))
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, testMetadata(4)))
+
+	_, err = deserializeBytes(buf.Bytes())
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestSerializeCompression(t *testing.T) {
+	metadata := testMetadata(VersionV3)
+	metadata.Compression = CompressionZstd
+
+	mappings := testMappings(2)
+	mappings[1].Compression = CompressionLZ4
+
+	h, err := deserializeBytes(serializeBytes(t, metadata, mappings))
+	require.NoError(t, err)
+	assert.Equal(t, CompressionZstd, h.Metadata.Compression)
+	assert.Equal(t, CompressionNone, h.Mapping[0].Compression)
+	assert.Equal(t, CompressionLZ4, h.Mapping[1].Compression)
+
+	// The older formats can't record the compression.
+	for _, version := range []uint64{VersionV1, VersionV2} {
+		metadata.Version = version
+
+		_, err := Serialize(metadata, testMappings(2))
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+		_, err = Serialize(testMetadata(version), mappings)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	}
+}
+
+func TestDeserializeEmptyMappings(t *testing.T) {
+	h, err := deserializeBytes(serializeBytes(t, testMetadata(VersionV2), nil))
+	require.NoError(t, err)
+
+	// Headers without mappings cover the whole size with the current build.
+	require.Len(t, h.Mapping, 1)
+	assert.Equal(t, diffID, h.Mapping[0].BuildId)
+	assert.Equal(t, diffID, h.Metadata.BuildId)
+}
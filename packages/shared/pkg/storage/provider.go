@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+type ProviderType string
+
+const (
+	GCPStorageProvider   ProviderType = "GCPBucket"
+	AWSStorageProvider   ProviderType = "AWSBucket"
+	LocalStorageProvider ProviderType = "Local"
+
+	DefaultStorageProvider ProviderType = GCPStorageProvider
+
+	storageProviderEnv = "STORAGE_PROVIDER"
+
+	templateBucketNameEnv     = "TEMPLATE_BUCKET_NAME"
+	localTemplateStorageEnv   = "LOCAL_TEMPLATE_STORAGE_BASE_PATH"
+	defaultLocalTemplateStore = "/tmp/templates"
+)
+
+var ErrObjectNotExist = errors.New("object does not exist")
+
+// Provider is the object storage backend used for template and snapshot artifacts.
+type Provider interface {
+	// DeleteObjectsWithPrefix removes all objects whose path starts with the prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, prefix string) error
+	// ListObjectsWithPrefix returns the paths of all objects whose path starts with the prefix.
+	ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error)
+	OpenObject(ctx context.Context, path string) (Object, error)
+	// GetDetails returns a human-readable description of the backend, e.g. the bucket name.
+	GetDetails() string
+}
+
+// Object is a handle to a single object in the storage backend.
+// The object is bound to the context it was opened with.
+type Object interface {
+	io.WriterTo
+	io.ReaderFrom
+	io.ReaderAt
+
+	// WriteFromFileSystem uploads the local file at path to the object.
+	WriteFromFileSystem(path string) error
+	Size() (int64, error)
+	// Delete removes the object, deleting an object that doesn't exist is not an error.
+	Delete() error
+}
+
+// GetTemplateStorageProvider returns the provider selected by the STORAGE_PROVIDER env variable.
+func GetTemplateStorageProvider(ctx context.Context) (Provider, error) {
+	providerType := ProviderType(env.GetEnv(storageProviderEnv, string(DefaultStorageProvider)))
+
+	switch providerType {
+	case LocalStorageProvider:
+		basePath := env.GetEnv(localTemplateStorageEnv, defaultLocalTemplateStore)
+
+		return NewFileSystemStorageProvider(basePath)
+	case AWSStorageProvider:
+		bucketName := utils.RequiredEnv(templateBucketNameEnv, "bucket for storing template files")
+
+		return NewAWSBucketStorageProvider(ctx, bucketName)
+	case GCPStorageProvider:
+		bucketName := utils.RequiredEnv(templateBucketNameEnv, "bucket for storing template files")
+
+		return NewGCPBucketStorageProvider(ctx, bucketName)
+	}
+
+	return nil, fmt.Errorf("unknown storage provider: %s", providerType)
+}
@@ -0,0 +1,303 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+const (
+	awsReadTimeout      = 10 * time.Second
+	awsOperationTimeout = 5 * time.Second
+	awsUploadPartSize   = 64 * 1024 * 1024 // 64 MB
+	awsUploadWorkers    = 8
+	awsDeleteBatchSize  = 1000
+
+	// awsEndpointEnv allows using S3-compatible storages (MinIO, Ceph, R2, ...).
+	awsEndpointEnv = "TEMPLATE_BUCKET_ENDPOINT"
+)
+
+type AWSBucketStorageProvider struct {
+	client     *s3.Client
+	bucketName string
+}
+
+type AWSBucketStorageObject struct {
+	client     *s3.Client
+	path       string
+	bucketName string
+	ctx        context.Context
+}
+
+func NewAWSBucketStorageProvider(ctx context.Context, bucketName string) (*AWSBucketStorageProvider, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	endpoint := env.GetEnv(awsEndpointEnv, "")
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+			// Most S3-compatible storages don't support virtual-hosted-style requests.
+			o.UsePathStyle = true
+		}
+	})
+
+	return &AWSBucketStorageProvider{
+		client:     client,
+		bucketName: bucketName,
+	}, nil
+}
+
+func (a *AWSBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context, prefix string) error {
+	paginator := s3.NewListObjectsV2Paginator(a.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(a.bucketName),
+		Prefix: aws.String(prefix),
+	})
+
+	objects := make([]types.ObjectIdentifier, 0, awsDeleteBatchSize)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("error when listing objects with prefix '%s': %w", prefix, err)
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, types.ObjectIdentifier{Key: object.Key})
+		}
+
+		// Each page has at most 1000 keys, which is also the limit of the batch delete.
+		if len(objects) == 0 {
+			continue
+		}
+
+		output, err := a.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(a.bucketName),
+			Delete: &types.Delete{Objects: objects},
+		})
+		if err != nil {
+			return fmt.Errorf("error when deleting objects with prefix '%s': %w", prefix, err)
+		}
+
+		if len(output.Errors) > 0 {
+			first := output.Errors[0]
+
+			return fmt.Errorf("error when deleting object '%s': %s", aws.ToString(first.Key), aws.ToString(first.Message))
+		}
+
+		objects = objects[:0]
+	}
+
+	return nil
+}
+
+func (a *AWSBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	paginator := s3.NewListObjectsV2Paginator(a.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(a.bucketName),
+		Prefix: aws.String(prefix),
+	})
+
+	paths := make([]string, 0)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error when listing objects with prefix '%s': %w", prefix, err)
+		}
+
+		for _, object := range page.Contents {
+			paths = append(paths, aws.ToString(object.Key))
+		}
+	}
+
+	return paths, nil
+}
+
+func (a *AWSBucketStorageProvider) GetDetails() string {
+	return fmt.Sprintf("[AWS Storage, bucket set to %s]", a.bucketName)
+}
+
+func (a *AWSBucketStorageProvider) OpenObject(ctx context.Context, path string) (Object, error) {
+	return &AWSBucketStorageObject{
+		client:     a.client,
+		bucketName: a.bucketName,
+		path:       path,
+		ctx:        ctx,
+	}, nil
+}
+
+func (a *AWSBucketStorageObject) WriteTo(dst io.Writer) (int64, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, awsReadTimeout)
+	defer cancel()
+
+	resp, err := a.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(a.bucketName),
+		Key:    aws.String(a.path),
+	})
+	if err != nil {
+		var nsk *types.NoSuchKey
+		if errors.As(err, &nsk) {
+			return 0, fmt.Errorf("failed to get S3 object %s: %w", a.path, ErrObjectNotExist)
+		}
+
+		return 0, fmt.Errorf("failed to get S3 object: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	n, err := io.Copy(dst, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to copy S3 object to writer: %w", err)
+	}
+
+	return n, nil
+}
+
+// ReadFrom streams the source in parts, so only the parts being uploaded are kept in memory.
+func (a *AWSBucketStorageObject) ReadFrom(src io.Reader) (int64, error) {
+	counter := &countingReader{reader: src}
+
+	uploader := manager.NewUploader(a.client)
+
+	_, err := uploader.Upload(a.ctx, &s3.PutObjectInput{
+		Bucket: aws.String(a.bucketName),
+		Key:    aws.String(a.path),
+		Body:   counter,
+	})
+	if err != nil {
+		return counter.n, fmt.Errorf("failed to upload S3 object: %w", err)
+	}
+
+	return counter.n, nil
+}
+
+// countingReader counts the bytes read from the reader.
+type countingReader struct {
+	reader io.Reader
+	n      int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+// WriteFromFileSystem uses a multipart upload, so large memfiles and rootfs files are uploaded in parallel.
+func (a *AWSBucketStorageObject) WriteFromFileSystem(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s: %w", path, err)
+	}
+
+	defer file.Close()
+
+	uploader := manager.NewUploader(a.client, func(u *manager.Uploader) {
+		u.PartSize = awsUploadPartSize
+		u.Concurrency = awsUploadWorkers
+	})
+
+	_, err = uploader.Upload(a.ctx, &s3.PutObjectInput{
+		Bucket: aws.String(a.bucketName),
+		Key:    aws.String(a.path),
+		Body:   file,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload file to S3: %w", err)
+	}
+
+	return nil
+}
+
+func (a *AWSBucketStorageObject) ReadAt(b []byte, off int64) (n int, err error) {
+	// The range can't be empty.
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(a.ctx, awsReadTimeout)
+	defer cancel()
+
+	resp, err := a.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(a.bucketName),
+		Key:    aws.String(a.path),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", off, off+int64(len(b))-1)),
+	})
+	if err != nil {
+		var nsk *types.NoSuchKey
+		if errors.As(err, &nsk) {
+			return 0, fmt.Errorf("failed to get S3 object %s: %w", a.path, ErrObjectNotExist)
+		}
+
+		return 0, fmt.Errorf("failed to create S3 range reader: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	n, err = io.ReadFull(resp.Body, b)
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return n, nil
+	}
+
+	if err != nil {
+		return n, fmt.Errorf("failed to read from S3 object: %w", err)
+	}
+
+	return n, nil
+}
+
+func (a *AWSBucketStorageObject) Size() (int64, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, awsOperationTimeout)
+	defer cancel()
+
+	resp, err := a.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(a.bucketName),
+		Key:    aws.String(a.path),
+	})
+	if err != nil {
+		var nf *types.NotFound
+		if errors.As(err, &nf) {
+			return 0, fmt.Errorf("failed to get S3 object (%s) attributes: %w", a.path, ErrObjectNotExist)
+		}
+
+		return 0, fmt.Errorf("failed to get S3 object (%s) attributes: %w", a.path, err)
+	}
+
+	return aws.ToInt64(resp.ContentLength), nil
+}
+
+func (a *AWSBucketStorageObject) Delete() error {
+	ctx, cancel := context.WithTimeout(a.ctx, awsOperationTimeout)
+	defer cancel()
+
+	_, err := a.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(a.bucketName),
+		Key:    aws.String(a.path),
+	})
+	if err != nil {
+		// S3 doesn't report the missing objects, but some of the compatible stores do.
+		var nsk *types.NoSuchKey
+		if errors.As(err, &nsk) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to delete S3 object %s: %w", a.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type FileSystemStorageProvider struct {
+	basePath string
+}
+
+type FileSystemStorageObject struct {
+	path string
+}
+
+func NewFileSystemStorageProvider(basePath string) (*FileSystemStorageProvider, error) {
+	err := os.MkdirAll(basePath, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage directory %s: %w", basePath, err)
+	}
+
+	return &FileSystemStorageProvider{
+		basePath: basePath,
+	}, nil
+}
+
+func (fs *FileSystemStorageProvider) DeleteObjectsWithPrefix(_ context.Context, prefix string) error {
+	fullPath := fs.getPath(prefix)
+
+	// Prefix ending with a separator is a whole directory.
+	if strings.HasSuffix(prefix, "/") {
+		return os.RemoveAll(fullPath)
+	}
+
+	dir, namePrefix := filepath.Split(fullPath)
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error when listing objects with prefix '%s': %w", prefix, err)
+	}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), namePrefix) {
+			continue
+		}
+
+		err = os.RemoveAll(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("error when deleting object '%s': %w", entry.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+func (fs *FileSystemStorageProvider) ListObjectsWithPrefix(_ context.Context, prefix string) ([]string, error) {
+	// Only the directory containing the prefix can have matching objects.
+	dir := fs.getPath(prefix)
+	if !strings.HasSuffix(prefix, "/") {
+		dir = filepath.Dir(dir)
+	}
+
+	paths := make([]string, 0)
+
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// The temporary files of the objects being written are not objects yet.
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), ".tmp") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(fs.basePath, path)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+		if strings.HasPrefix(rel, prefix) {
+			paths = append(paths, rel)
+		}
+
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return paths, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error when listing objects with prefix '%s': %w", prefix, err)
+	}
+
+	return paths, nil
+}
+
+func (fs *FileSystemStorageProvider) GetDetails() string {
+	return fmt.Sprintf("[Local file storage, base path set to %s]", fs.basePath)
+}
+
+// OpenObject doesn't touch the file system, the directory of the object is created when it is written.
+func (fs *FileSystemStorageProvider) OpenObject(_ context.Context, path string) (Object, error) {
+	return &FileSystemStorageObject{
+		path: fs.getPath(path),
+	}, nil
+}
+
+func (fs *FileSystemStorageProvider) getPath(path string) string {
+	return filepath.Join(fs.basePath, path)
+}
+
+func (f *FileSystemStorageObject) WriteTo(dst io.Writer) (int64, error) {
+	file, err := f.open()
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+
+	return io.Copy(dst, file)
+}
+
+// ReadFrom writes to a temporary file first, so readers never see a partially written object.
+func (f *FileSystemStorageObject) ReadFrom(src io.Reader) (int64, error) {
+	err := os.MkdirAll(filepath.Dir(f.path), 0o755)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create directory for %s: %w", f.path, err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*.tmp")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temporary file for %s: %w", f.path, err)
+	}
+
+	tmpPath := file.Name()
+
+	n, err := io.Copy(file, src)
+	if err != nil {
+		return n, errors.Join(fmt.Errorf("failed to write file %s: %w", tmpPath, err), file.Close(), os.Remove(tmpPath))
+	}
+
+	err = file.Close()
+	if err != nil {
+		return n, errors.Join(fmt.Errorf("failed to close file %s: %w", tmpPath, err), os.Remove(tmpPath))
+	}
+
+	err = os.Rename(tmpPath, f.path)
+	if err != nil {
+		return n, fmt.Errorf("failed to rename file %s: %w", tmpPath, err)
+	}
+
+	return n, nil
+}
+
+func (f *FileSystemStorageObject) WriteFromFileSystem(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s: %w", path, err)
+	}
+
+	defer src.Close()
+
+	_, err = f.ReadFrom(src)
+
+	return err
+}
+
+func (f *FileSystemStorageObject) ReadAt(b []byte, off int64) (int, error) {
+	file, err := f.open()
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+
+	n, err := file.ReadAt(b, off)
+	if errors.Is(err, io.EOF) {
+		return n, nil
+	}
+
+	return n, err
+}
+
+func (f *FileSystemStorageObject) Size() (int64, error) {
+	info, err := os.Stat(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("failed to stat file %s: %w", f.path, ErrObjectNotExist)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to stat file %s: %w", f.path, err)
+	}
+
+	return info.Size(), nil
+}
+
+func (f *FileSystemStorageObject) Delete() error {
+	err := os.Remove(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (f *FileSystemStorageObject) open() (*os.File, error) {
+	file, err := os.Open(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to open file %s: %w", f.path, ErrObjectNotExist)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", f.path, err)
+	}
+
+	return file, nil
+}
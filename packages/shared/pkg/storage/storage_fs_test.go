@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSystemStorageObjectReadWrite(t *testing.T) {
+	ctx := context.Background()
+
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	obj, err := provider.OpenObject(ctx, "build-id/memfile")
+	require.NoError(t, err)
+
+	data := []byte("0123456789")
+
+	n, err := obj.ReadFrom(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), n)
+
+	size, err := obj.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), size)
+
+	b := make([]byte, 4)
+	read, err := obj.ReadAt(b, 3)
+	require.NoError(t, err)
+	require.Equal(t, 4, read)
+	require.Equal(t, []byte("3456"), b)
+
+	// Reading past the end returns only the remaining bytes.
+	read, err = obj.ReadAt(b, 8)
+	require.NoError(t, err)
+	require.Equal(t, 2, read)
+
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, data, buf.Bytes())
+}
+
+func TestFileSystemStorageObjectWriteFromFileSystem(t *testing.T) {
+	ctx := context.Background()
+
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	src := filepath.Join(t.TempDir(), "rootfs.ext4")
+	require.NoError(t, os.WriteFile(src, []byte("rootfs"), 0o644))
+
+	obj, err := provider.OpenObject(ctx, "build-id/rootfs.ext4")
+	require.NoError(t, err)
+
+	require.NoError(t, obj.WriteFromFileSystem(src))
+
+	size, err := obj.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len("rootfs")), size)
+}
+
+func TestFileSystemStorageDeleteObjectsWithPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	for _, path := range []string{"build-a/memfile", "build-a/snapfile", "build-b/memfile"} {
+		obj, err := provider.OpenObject(ctx, path)
+		require.NoError(t, err)
+
+		_, err = obj.ReadFrom(bytes.NewReader([]byte(path)))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, provider.DeleteObjectsWithPrefix(ctx, "build-a/"))
+
+	deleted, err := provider.OpenObject(ctx, "build-a/memfile")
+	require.NoError(t, err)
+
+	_, err = deleted.Size()
+	require.ErrorIs(t, err, ErrObjectNotExist)
+
+	kept, err := provider.OpenObject(ctx, "build-b/memfile")
+	require.NoError(t, err)
+
+	_, err = kept.Size()
+	require.NoError(t, err)
+}
+
+func TestFileSystemStorageListObjectsWithPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	provider, err := NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	for _, path := range []string{"build-a/memfile", "build-a/refs/build-c", "build-ab/memfile", "build-b/memfile"} {
+		obj, err := provider.OpenObject(ctx, path)
+		require.NoError(t, err)
+
+		_, err = obj.ReadFrom(bytes.NewReader([]byte(path)))
+		require.NoError(t, err)
+	}
+
+	paths, err := provider.ListObjectsWithPrefix(ctx, "build-a/")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"build-a/memfile", "build-a/refs/build-c"}, paths)
+
+	paths, err = provider.ListObjectsWithPrefix(ctx, "build-a")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"build-a/memfile", "build-a/refs/build-c", "build-ab/memfile"}, paths)
+
+	paths, err = provider.ListObjectsWithPrefix(ctx, "missing/")
+	require.NoError(t, err)
+	require.Empty(t, paths)
+}
+
+func TestFileSystemStorageReadMissingObject(t *testing.T) {
+	ctx := context.Background()
+
+	basePath := t.TempDir()
+
+	provider, err := NewFileSystemStorageProvider(basePath)
+	require.NoError(t, err)
+
+	obj, err := provider.OpenObject(ctx, "missing-build/memfile")
+	require.NoError(t, err)
+
+	_, err = obj.ReadAt(make([]byte, 4), 0)
+	require.ErrorIs(t, err, ErrObjectNotExist)
+
+	_, err = obj.Size()
+	require.ErrorIs(t, err, ErrObjectNotExist)
+
+	// Deleting the missing object is not an error, like with the other providers.
+	require.NoError(t, obj.Delete())
+
+	// Reading doesn't create the directories of the missing objects.
+	_, err = os.Stat(filepath.Join(basePath, "missing-build"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/iterator"
+)
+
+const (
+	googleReadTimeout       = 10 * time.Second
+	googleOperationTimeout  = 5 * time.Second
+	googleBufferSize        = 2 << 21
+	googleInitialBackoff    = 10 * time.Millisecond
+	googleMaxBackoff        = 10 * time.Second
+	googleBackoffMultiplier = 2
+	googleMaxAttempts       = 10
+)
+
+type GCPBucketStorageProvider struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+}
+
+type GCPBucketStorageObject struct {
+	storage *GCPBucketStorageProvider
+	path    string
+	handle  *storage.ObjectHandle
+	ctx     context.Context
+}
+
+func NewGCPBucketStorageProvider(ctx context.Context, bucketName string) (*GCPBucketStorageProvider, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+
+	return &GCPBucketStorageProvider{
+		client: client,
+		bucket: client.Bucket(bucketName),
+	}, nil
+}
+
+func (g *GCPBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context, prefix string) error {
+	objects := g.bucket.Objects(ctx, &storage.Query{
+		Prefix: prefix,
+	})
+
+	for {
+		object, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("error when iterating over objects with prefix '%s': %w", prefix, err)
+		}
+
+		err = g.bucket.Object(object.Name).Delete(ctx)
+		if err != nil {
+			return fmt.Errorf("error when deleting object '%s': %w", object.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (g *GCPBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	objects := g.bucket.Objects(ctx, &storage.Query{
+		Prefix: prefix,
+	})
+
+	paths := make([]string, 0)
+
+	for {
+		object, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error when iterating over objects with prefix '%s': %w", prefix, err)
+		}
+
+		paths = append(paths, object.Name)
+	}
+
+	return paths, nil
+}
+
+func (g *GCPBucketStorageProvider) GetDetails() string {
+	return fmt.Sprintf("[GCP Storage, bucket set to %s]", g.bucket.BucketName())
+}
+
+func (g *GCPBucketStorageProvider) OpenObject(ctx context.Context, path string) (Object, error) {
+	handle := g.bucket.Object(path).Retryer(
+		storage.WithMaxAttempts(googleMaxAttempts),
+		storage.WithBackoff(gax.Backoff{
+			Initial:    googleInitialBackoff,
+			Max:        googleMaxBackoff,
+			Multiplier: googleBackoffMultiplier,
+		}),
+		storage.WithPolicy(storage.RetryAlways),
+	)
+
+	return &GCPBucketStorageObject{
+		storage: g,
+		path:    path,
+		handle:  handle,
+		ctx:     ctx,
+	}, nil
+}
+
+func (g *GCPBucketStorageObject) WriteTo(dst io.Writer) (int64, error) {
+	ctx, cancel := context.WithTimeout(g.ctx, googleReadTimeout)
+	defer cancel()
+
+	reader, err := g.handle.NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return 0, fmt.Errorf("failed to create GCS reader for %s: %w", g.path, ErrObjectNotExist)
+		}
+
+		return 0, fmt.Errorf("failed to create GCS reader: %w", err)
+	}
+
+	defer reader.Close()
+
+	b := make([]byte, googleBufferSize)
+
+	n, err := io.CopyBuffer(dst, reader, b)
+	if err != nil {
+		return n, fmt.Errorf("failed to copy GCS object to writer: %w", err)
+	}
+
+	return n, nil
+}
+
+func (g *GCPBucketStorageObject) ReadFrom(src io.Reader) (int64, error) {
+	w := g.handle.NewWriter(g.ctx)
+
+	n, err := io.Copy(w, src)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, fmt.Errorf("failed to copy buffer to storage: %w", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		return n, fmt.Errorf("failed to close GCS writer: %w", err)
+	}
+
+	return n, nil
+}
+
+// WriteFromFileSystem uses the gcloud CLI, which handles composite uploads of large files.
+func (g *GCPBucketStorageObject) WriteFromFileSystem(path string) error {
+	cmd := exec.CommandContext(
+		g.ctx,
+		"gcloud",
+		"storage",
+		"cp",
+		"--verbosity",
+		"error",
+		path,
+		fmt.Sprintf("gs://%s/%s", g.storage.bucket.BucketName(), g.path),
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to upload file to GCS: %w\n%s", err, string(output))
+	}
+
+	return nil
+}
+
+func (g *GCPBucketStorageObject) ReadAt(b []byte, off int64) (n int, err error) {
+	ctx, cancel := context.WithTimeout(g.ctx, googleReadTimeout)
+	defer cancel()
+
+	// The file should not be gzip compressed
+	reader, err := g.handle.NewRangeReader(ctx, off, int64(len(b)))
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return 0, fmt.Errorf("failed to create GCS reader for %s: %w", g.path, ErrObjectNotExist)
+		}
+
+		return 0, fmt.Errorf("failed to create GCS reader: %w", err)
+	}
+
+	defer reader.Close()
+
+	for reader.Remain() > 0 {
+		nr, readErr := reader.Read(b[n:])
+		n += nr
+
+		if readErr == nil {
+			continue
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+
+		return n, fmt.Errorf("failed to read from GCS object: %w", readErr)
+	}
+
+	return n, nil
+}
+
+func (g *GCPBucketStorageObject) Size() (int64, error) {
+	ctx, cancel := context.WithTimeout(g.ctx, googleOperationTimeout)
+	defer cancel()
+
+	attrs, err := g.handle.Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return 0, fmt.Errorf("failed to get GCS object (%s) attributes: %w", g.path, ErrObjectNotExist)
+		}
+
+		return 0, fmt.Errorf("failed to get GCS object (%s) attributes: %w", g.path, err)
+	}
+
+	return attrs.Size, nil
+}
+
+func (g *GCPBucketStorageObject) Delete() error {
+	ctx, cancel := context.WithTimeout(g.ctx, googleOperationTimeout)
+	defer cancel()
+
+	err := g.handle.Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete GCS object %s: %w", g.path, err)
+	}
+
+	return nil
+}
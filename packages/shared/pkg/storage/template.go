@@ -32,6 +32,8 @@ const (
 	SnapfileName = "snapfile"
 
 	HeaderSuffix = ".header"
+	// TraceSuffix is appended to the memfile path for the order of the pages faulted in by the first started sandbox.
+	TraceSuffix = ".trace"
 )
 
 // Path to the directory where the kernel can be accessed inside when the dirs are mounted.
@@ -99,6 +101,10 @@ func (t *TemplateFiles) StorageMemfileHeaderPath() string {
 	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, HeaderSuffix)
 }
 
+func (t *TemplateFiles) StorageMemfileTracePath() string {
+	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, TraceSuffix)
+}
+
 func (t *TemplateFiles) StorageRootfsPath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), RootfsName)
 }
@@ -6,9 +6,9 @@ import (
 	"io"
 	"os"
 
+	"github.com/google/uuid"
 	"golang.org/x/sync/errgroup"
 
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
@@ -18,24 +18,34 @@ type TemplateBuild struct {
 	memfileHeader *header.Header
 	rootfsHeader  *header.Header
 
-	bucket *gcs.BucketHandle
+	provider Provider
+
+	// dedup stores the files of template builds as content-addressed chunks.
+	dedup bool
+	// compression of the uploaded memfile and rootfs, the headers must record the same compression.
+	compression header.Compression
 }
 
 func NewTemplateBuild(
 	memfileHeader *header.Header,
 	rootfsHeader *header.Header,
 	files *TemplateFiles,
+	provider Provider,
+	compression header.Compression,
 ) *TemplateBuild {
 	return &TemplateBuild{
-		bucket:        gcs.GetTemplateBucket(),
+		provider:      provider,
 		memfileHeader: memfileHeader,
 		rootfsHeader:  rootfsHeader,
 		files:         files,
+		dedup:         DedupEnabled(),
+		compression:   compression,
 	}
 }
 
+// Remove deletes the files of the build and the chunks used only by this build.
 func (t *TemplateBuild) Remove(ctx context.Context) error {
-	err := gcs.RemoveDir(ctx, t.bucket, t.files.StorageDir())
+	err := RemoveBuild(ctx, t.provider, t.files.BuildId)
 	if err != nil {
 		return fmt.Errorf("error when removing template build '%s': %w", t.files.StorageDir(), err)
 	}
@@ -44,7 +54,10 @@ func (t *TemplateBuild) Remove(ctx context.Context) error {
 }
 
 func (t *TemplateBuild) uploadMemfileHeader(ctx context.Context, h *header.Header) error {
-	object := gcs.NewObject(ctx, t.bucket, t.files.StorageMemfileHeaderPath())
+	object, err := t.provider.OpenObject(ctx, t.files.StorageMemfileHeaderPath())
+	if err != nil {
+		return err
+	}
 
 	serialized, err := header.Serialize(h.Metadata, h.Mapping)
 	if err != nil {
@@ -60,9 +73,7 @@ func (t *TemplateBuild) uploadMemfileHeader(ctx context.Context, h *header.Heade
 }
 
 func (t *TemplateBuild) uploadMemfile(ctx context.Context, memfilePath string) error {
-	object := gcs.NewObject(ctx, t.bucket, t.files.StorageMemfilePath())
-
-	err := object.UploadWithCli(ctx, memfilePath)
+	err := WriteCompressedFile(ctx, t.provider, t.files.StorageMemfilePath(), memfilePath, t.compression)
 	if err != nil {
 		return fmt.Errorf("error when uploading memfile: %w", err)
 	}
@@ -71,7 +82,10 @@ func (t *TemplateBuild) uploadMemfile(ctx context.Context, memfilePath string) e
 }
 
 func (t *TemplateBuild) uploadRootfsHeader(ctx context.Context, h *header.Header) error {
-	object := gcs.NewObject(ctx, t.bucket, t.files.StorageRootfsHeaderPath())
+	object, err := t.provider.OpenObject(ctx, t.files.StorageRootfsHeaderPath())
+	if err != nil {
+		return err
+	}
 
 	serialized, err := header.Serialize(h.Metadata, h.Mapping)
 	if err != nil {
@@ -87,9 +101,7 @@ func (t *TemplateBuild) uploadRootfsHeader(ctx context.Context, h *header.Header
 }
 
 func (t *TemplateBuild) uploadRootfs(ctx context.Context, rootfsPath string) error {
-	object := gcs.NewObject(ctx, t.bucket, t.files.StorageRootfsPath())
-
-	err := object.UploadWithCli(ctx, rootfsPath)
+	err := WriteCompressedFile(ctx, t.provider, t.files.StorageRootfsPath(), rootfsPath, t.compression)
 	if err != nil {
 		return fmt.Errorf("error when uploading rootfs: %w", err)
 	}
@@ -97,9 +109,66 @@ func (t *TemplateBuild) uploadRootfs(ctx context.Context, rootfsPath string) err
 	return nil
 }
 
+// uploadDeduplicated stores the file as content-addressed chunks and uploads the header mapping the file to them instead of the file itself.
+func (t *TemplateBuild) uploadDeduplicated(
+	ctx context.Context,
+	path string,
+	fileName string,
+	blockSize int64,
+	uploadHeader func(ctx context.Context, h *header.Header) error,
+) error {
+	buildID, err := uuid.Parse(t.files.BuildId)
+	if err != nil {
+		return fmt.Errorf("error when parsing build id: %w", err)
+	}
+
+	h, err := Deduplicate(ctx, t.provider, path, fileName, buildID, blockSize, t.compression)
+	if err != nil {
+		return fmt.Errorf("error when deduplicating %s: %w", fileName, err)
+	}
+
+	return uploadHeader(ctx, h)
+}
+
+// uploadCompressedHeader uploads the header of a template file stored as a whole, so the compression of the file is recorded.
+// Uncompressed template files don't need a header.
+func (t *TemplateBuild) uploadCompressedHeader(
+	ctx context.Context,
+	path string,
+	blockSize int64,
+	uploadHeader func(ctx context.Context, h *header.Header) error,
+) error {
+	if t.compression == header.CompressionNone {
+		return nil
+	}
+
+	buildID, err := uuid.Parse(t.files.BuildId)
+	if err != nil {
+		return fmt.Errorf("error when parsing build id: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("error when getting file size: %w", err)
+	}
+
+	return uploadHeader(ctx, header.NewHeader(&header.Metadata{
+		Version:     header.CurrentVersion,
+		BlockSize:   uint64(blockSize),
+		Size:        uint64(info.Size()),
+		Generation:  1,
+		BuildId:     buildID,
+		BaseBuildId: buildID,
+		Compression: t.compression,
+	}, nil))
+}
+
 // Snapfile is small enough so we dont use composite upload.
 func (t *TemplateBuild) uploadSnapfile(ctx context.Context, snapfile io.Reader) error {
-	object := gcs.NewObject(ctx, t.bucket, t.files.StorageSnapfilePath())
+	object, err := t.provider.OpenObject(ctx, t.files.StorageSnapfilePath())
+	if err != nil {
+		return err
+	}
 
 	n, err := object.ReadFrom(snapfile)
 	if err != nil {
@@ -135,11 +204,22 @@ func (t *TemplateBuild) Upload(
 			return nil
 		}
 
+		// Snapshots are not deduplicated. Their headers are already used from the local cache before the upload,
+		// so remapping the diff to chunks would need the cached header to be replaced under the running sandboxes.
+		// The diffs also contain only the dirty blocks, which rarely fill a whole chunk with the same content as another build.
+		if t.dedup && t.rootfsHeader == nil {
+			return t.uploadDeduplicated(ctx, *rootfsPath, RootfsName, t.files.RootfsBlockSize(), t.uploadRootfsHeader)
+		}
+
 		err := t.uploadRootfs(ctx, *rootfsPath)
 		if err != nil {
 			return err
 		}
 
+		if t.rootfsHeader == nil {
+			return t.uploadCompressedHeader(ctx, *rootfsPath, t.files.RootfsBlockSize(), t.uploadRootfsHeader)
+		}
+
 		return nil
 	})
 
@@ -161,11 +241,19 @@ func (t *TemplateBuild) Upload(
 			return nil
 		}
 
+		if t.dedup && t.memfileHeader == nil {
+			return t.uploadDeduplicated(ctx, *memfilePath, MemfileName, t.files.MemfilePageSize(), t.uploadMemfileHeader)
+		}
+
 		err := t.uploadMemfile(ctx, *memfilePath)
 		if err != nil {
 			return err
 		}
 
+		if t.memfileHeader == nil {
+			return t.uploadCompressedHeader(ctx, *memfilePath, t.files.MemfilePageSize(), t.uploadMemfileHeader)
+		}
+
 		return nil
 	})
 
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/build"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/template"
 )
@@ -26,16 +27,22 @@ func main() {
 	buildID := flag.String("build", "", "build id")
 	kernelVersion := flag.String("kernel", "", "kernel version")
 	fcVersion := flag.String("firecracker", "", "firecracker version")
+	compressionName := flag.String("compression", "", "compression of the uploaded files (zstd, lz4)")
 	flag.Parse()
 
-	err := Build(ctx, *kernelVersion, *fcVersion, *templateID, *buildID)
+	compression, err := header.ParseCompression(*compressionName)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid compression")
+	}
+
+	err = Build(ctx, *kernelVersion, *fcVersion, *templateID, *buildID, compression)
 	if err != nil {
 		log.Fatal().Err(err).Msg("error building template")
 		os.Exit(1)
 	}
 }
 
-func Build(ctx context.Context, kernelVersion, fcVersion, templateID, buildID string) error {
+func Build(ctx context.Context, kernelVersion, fcVersion, templateID, buildID string, compression header.Compression) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Minute*3)
 	defer cancel()
 
@@ -72,9 +79,14 @@ func Build(ctx context.Context, kernelVersion, fcVersion, templateID, buildID st
 		return fmt.Errorf("error building template: %w", err)
 	}
 
-	tempStorage := template.NewStorage(ctx)
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting storage provider: %w", err)
+	}
+
+	tempStorage := template.NewStorage(persistence)
 
-	buildStorage := tempStorage.NewBuild(t.TemplateFiles)
+	buildStorage := tempStorage.NewBuild(t.TemplateFiles, compression)
 
 	memfilePath := t.BuildMemfilePath()
 	rootfsPath := t.BuildRootfsPath()
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func (s *serverStore) TemplateBuildCompact(ctx context.Context, in *template_manager.TemplateBuildCompactRequest) (*template_manager.TemplateBuildCompactResponse, error) {
+	childCtx, childSpan := s.tracer.Start(ctx, "template-compact-request")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("build.id", in.BuildID),
+		attribute.String("build.new_id", in.NewBuildID),
+	)
+
+	// The IDs are used as storage prefixes, an empty ID would match all builds.
+	for _, buildID := range []string{in.BuildID, in.NewBuildID} {
+		_, err := uuid.Parse(buildID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid build id '%s': %s", buildID, err)
+		}
+	}
+
+	compacted, err := s.templateStorage.Compact(childCtx, in.BuildID, in.NewBuildID, storage.CompactionThreshold{
+		MaxGeneration: in.MaxGeneration,
+		MaxMappings:   int(in.MaxMappings),
+	})
+	if err != nil {
+		zap.L().Error("error compacting build", zap.String("build_id", in.BuildID), zap.Error(err))
+
+		// Remove the partially written build, it is not referenced anywhere yet.
+		cleanupErr := s.templateStorage.Remove(childCtx, in.NewBuildID)
+		if cleanupErr != nil {
+			zap.L().Error("error removing partially compacted build", zap.String("build_id", in.NewBuildID), zap.Error(cleanupErr))
+		}
+
+		return nil, err
+	}
+
+	if compacted {
+		zap.L().Info("compacted build", zap.String("build_id", in.BuildID), zap.String("new_build_id", in.NewBuildID))
+	}
+
+	return &template_manager.TemplateBuildCompactResponse{
+		Compacted: compacted,
+	}, nil
+}
@@ -11,10 +11,13 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/build"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/build/writer"
@@ -39,8 +42,14 @@ func (s *serverStore) TemplateCreate(templateRequest *template_manager.TemplateC
 		attribute.Int64("env.memory_mb", int64(config.MemoryMB)),
 		attribute.Int64("env.vcpu_count", int64(config.VCpuCount)),
 		attribute.Bool("env.huge_pages", config.HugePages),
+		attribute.String("env.compression", config.Compression),
 	)
 
+	compression, err := header.ParseCompression(config.Compression)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	logsWriter := writer.New(
 		stream,
 		s.buildLogger.
@@ -63,9 +72,7 @@ func (s *serverStore) TemplateCreate(templateRequest *template_manager.TemplateC
 		BuildLogsWriter: logsWriter,
 	}
 
-	buildStorage := s.templateStorage.NewBuild(template.TemplateFiles)
-
-	var err error
+	buildStorage := s.templateStorage.NewBuild(template.TemplateFiles, compression)
 
 	// Remove local template files if build fails
 	defer func() {
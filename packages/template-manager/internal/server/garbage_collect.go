@@ -0,0 +1,92 @@
+package server
+
+import (
+	"errors"
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+// TemplateBuildGarbageCollect receives the pages of the live and used builds first, so the whole set never has to fit into a single message.
+// The builds referenced by them are resolved once with the first page of the candidates, each page of the candidates is answered with the deleted builds.
+func (s *serverStore) TemplateBuildGarbageCollect(stream template_manager.TemplateService_TemplateBuildGarbageCollectServer) error {
+	ctx := stream.Context()
+
+	childCtx, childSpan := s.tracer.Start(ctx, "template-garbage-collect-request")
+	defer childSpan.End()
+
+	var (
+		live       []string
+		used       []string
+		referenced map[string]struct{}
+		candidates int
+		deleted    int
+	)
+
+	defer func() {
+		childSpan.SetAttributes(
+			attribute.Int("builds.live", len(live)),
+			attribute.Int("builds.used", len(used)),
+			attribute.Int("builds.candidates", candidates),
+			attribute.Int("builds.deleted", deleted),
+		)
+	}()
+
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if referenced != nil && (len(in.LiveBuildIDs) > 0 || len(in.UsedBuildIDs) > 0) {
+			return status.Error(codes.InvalidArgument, "the live and used builds must be sent before the candidates")
+		}
+
+		live = append(live, in.LiveBuildIDs...)
+		used = append(used, in.UsedBuildIDs...)
+
+		if len(in.CandidateBuildIDs) == 0 {
+			continue
+		}
+
+		if referenced == nil {
+			referenced, err = s.templateStorage.ReferencedBuilds(childCtx, live, used)
+			if err != nil {
+				zap.L().Error("error reading referenced builds", zap.Error(err))
+
+				return err
+			}
+		}
+
+		candidates += len(in.CandidateBuildIDs)
+
+		pageDeleted, err := s.templateStorage.DeleteUnreferencedBuilds(childCtx, referenced, in.CandidateBuildIDs)
+		if err != nil {
+			// The builds that failed stay candidates and are retried in the next run.
+			zap.L().Error("error collecting garbage builds", zap.Strings("deleted", pageDeleted), zap.Error(err))
+		}
+
+		if len(pageDeleted) > 0 {
+			zap.L().Info("collected garbage builds", zap.Strings("deleted", pageDeleted))
+		}
+
+		deleted += len(pageDeleted)
+
+		// The deleted builds are always returned, so their records can be removed.
+		err = stream.Send(&template_manager.TemplateBuildGarbageCollectResponse{
+			DeletedBuildIDs: pageDeleted,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -5,21 +5,23 @@ import (
 	"fmt"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
-	"github.com/e2b-dev/infra/packages/shared/pkg/storage/gcs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
 type Storage struct {
-	bucket *gcs.BucketHandle
+	persistence      storage.Provider
+	garbageCollector *storage.GarbageCollector
 }
 
-func NewStorage(ctx context.Context) *Storage {
+func NewStorage(persistence storage.Provider) *Storage {
 	return &Storage{
-		bucket: gcs.GetTemplateBucket(),
+		persistence:      persistence,
+		garbageCollector: storage.NewGarbageCollector(persistence),
 	}
 }
 
 func (t *Storage) Remove(ctx context.Context, buildId string) error {
-	err := gcs.RemoveDir(ctx, t.bucket, buildId)
+	err := storage.RemoveBuild(ctx, t.persistence, buildId)
 	if err != nil {
 		return fmt.Errorf("error when removing template '%s': %w", buildId, err)
 	}
@@ -27,6 +29,46 @@ func (t *Storage) Remove(ctx context.Context, buildId string) error {
 	return nil
 }
 
-func (t *Storage) NewBuild(files *storage.TemplateFiles) *storage.TemplateBuild {
-	return storage.NewTemplateBuild(nil, nil, files)
+func (t *Storage) NewBuild(files *storage.TemplateFiles, compression header.Compression) *storage.TemplateBuild {
+	return storage.NewTemplateBuild(nil, nil, files, t.persistence, compression)
+}
+
+// ReferencedBuilds returns the builds referenced by the headers of the live and used builds.
+func (t *Storage) ReferencedBuilds(ctx context.Context, liveBuildIds []string, usedBuildIds []string) (map[string]struct{}, error) {
+	referenced, err := t.garbageCollector.Referenced(ctx, liveBuildIds, usedBuildIds)
+	if err != nil {
+		return nil, fmt.Errorf("error when reading referenced builds: %w", err)
+	}
+
+	return referenced, nil
+}
+
+// DeleteUnreferencedBuilds deletes the files of the candidate builds that are not referenced.
+func (t *Storage) DeleteUnreferencedBuilds(ctx context.Context, referenced map[string]struct{}, candidateBuildIds []string) ([]string, error) {
+	deleted, err := t.garbageCollector.Delete(ctx, referenced, candidateBuildIds)
+	if err != nil {
+		return deleted, fmt.Errorf("error when collecting garbage builds: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// Compact rewrites the header chain of the build into a new single layer build if the threshold is exceeded.
+// It returns false if the build didn't need compaction.
+func (t *Storage) Compact(ctx context.Context, buildId string, newBuildId string, threshold storage.CompactionThreshold) (bool, error) {
+	needed, err := storage.NeedsCompaction(ctx, t.persistence, buildId, threshold)
+	if err != nil {
+		return false, fmt.Errorf("error when checking build '%s' for compaction: %w", buildId, err)
+	}
+
+	if !needed {
+		return false, nil
+	}
+
+	err = storage.CompactBuild(ctx, t.persistence, buildId, newBuildId)
+	if err != nil {
+		return false, fmt.Errorf("error when compacting build '%s': %w", buildId, err)
+	}
+
+	return true, nil
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/build"
 	"github.com/e2b-dev/infra/packages/template-manager/internal/template"
 )
@@ -59,9 +60,14 @@ func Build(templateID, buildID string) {
 		return
 	}
 
-	tempStorage := template.NewStorage(ctx)
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatal().Err(err).Msg("error getting storage provider")
+	}
+
+	tempStorage := template.NewStorage(persistence)
 
-	buildStorage := tempStorage.NewBuild(t.TemplateFiles)
+	buildStorage := tempStorage.NewBuild(t.TemplateFiles, header.CompressionNone)
 
 	memfilePath := t.BuildMemfilePath()
 	rootfsPath := t.BuildRootfsPath()